@@ -8,6 +8,8 @@ import (
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	apiDocs "github.com/emiliogozo/panahon-api-go/internal/docs/api"
+	"github.com/emiliogozo/panahon-api-go/internal/mqtt"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
 	"github.com/emiliogozo/panahon-api-go/internal/server"
 	"github.com/emiliogozo/panahon-api-go/internal/service"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
@@ -48,6 +50,13 @@ func main() {
 
 	apiDocs.SwaggerInfo.BasePath = config.SwagAPIBasePath
 
+	if len(config.LufftLayoutsFile) > 0 {
+		err = sensor.LoadLufftLayoutsFile(config.LufftLayoutsFile)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("cannot load lufft layouts")
+		}
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
 	defer stop()
 
@@ -67,7 +76,8 @@ func main() {
 
 	store := db.NewStore(connPool)
 
-	service.ScheduleJobs(ctx, store, config, logger)
+	svc := service.NewServices(config, store, logger)
+	scheduler := service.ScheduleJobs(ctx, store, config, svc, logger)
 
 	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
 	if err != nil {
@@ -75,7 +85,13 @@ func main() {
 	}
 
 	g, ctx := errgroup.WithContext(ctx)
-	runGinServer(ctx, g, config, store, tokenMaker, logger)
+	runGinServer(ctx, g, config, store, tokenMaker, scheduler, svc, logger)
+	if svc.Notifier != nil {
+		svc.Notifier.Start(ctx, g)
+	}
+	if len(config.MQTTBrokerURL) > 0 {
+		runMQTTListener(ctx, g, config, store, logger)
+	}
 
 	err = g.Wait()
 	if err != nil {
@@ -83,11 +99,26 @@ func main() {
 	}
 }
 
-func runGinServer(ctx context.Context, g *errgroup.Group, config util.Config, store db.Store, tokenMaker token.Maker, logger *zerolog.Logger) {
-	server, err := server.NewServer(config, store, tokenMaker, logger)
+func runGinServer(ctx context.Context, g *errgroup.Group, config util.Config, store db.Store, tokenMaker token.Maker, scheduler *service.Scheduler, svc service.Services, logger *zerolog.Logger) {
+	server, err := server.NewServer(config, store, tokenMaker, scheduler, svc, logger)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("cannot create server")
 	}
 
 	server.Start(ctx, g)
 }
+
+func runMQTTListener(ctx context.Context, g *errgroup.Group, config util.Config, store db.Store, logger *zerolog.Logger) {
+	opts := mqtt.Options{
+		Broker:   config.MQTTBrokerURL,
+		ClientID: config.MQTTClientID,
+		Username: config.MQTTUsername,
+		Password: config.MQTTPassword,
+	}
+
+	listener, err := mqtt.NewListener(opts, config.MQTTTopicPrefix, store, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("cannot create mqtt listener")
+	}
+	listener.Start(ctx, g)
+}
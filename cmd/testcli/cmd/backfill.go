@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"os/signal"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	backfillStationID int64
+	backfillStart     string
+	backfillEnd       string
+)
+
+var backfillCmd = &cobra.Command{
+	Use:   "backfill",
+	Short: "Backfill station observations from the logger archive",
+	Long: `Pulls the archive records of a station from its logger cloud service and
+stores the missing ones. Times are RFC 3339, e.g. 2024-06-01T00:00:00+08:00.
+By default the last 24 hours are backfilled.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		backfill()
+	},
+}
+
+func init() {
+	backfillCmd.Flags().Int64Var(&backfillStationID, "station", 0, "station id")
+	backfillCmd.Flags().StringVar(&backfillStart, "start", "", "start time (default end - 24h)")
+	backfillCmd.Flags().StringVar(&backfillEnd, "end", "", "end time (default now)")
+	backfillCmd.MarkFlagRequired("station")
+}
+
+func backfill() {
+	end := time.Now()
+	if len(backfillEnd) > 0 {
+		t, err := time.Parse(time.RFC3339, backfillEnd)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("invalid end time")
+		}
+		end = t
+	}
+	start := end.Add(-24 * time.Hour)
+	if len(backfillStart) > 0 {
+		t, err := time.Parse(time.RFC3339, backfillStart)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("invalid start time")
+		}
+		start = t
+	}
+	if !start.Before(end) {
+		logger.Fatal().Msg("start time must be before end time")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	connPool, store := dbConnect(ctx)
+	defer connPool.Close()
+
+	begin := time.Now()
+	n, err := service.BackfillStationObservations(ctx, store, logger, backfillStationID, start, end)
+	if err != nil {
+		logger.Fatal().Err(err).Int("inserted", n).Msg("backfill failed")
+	}
+
+	logger.Log().
+		Dur("duration", time.Since(begin)).
+		Int64("station_id", backfillStationID).
+		Int("inserted", n).
+		Msg("backfill done")
+}
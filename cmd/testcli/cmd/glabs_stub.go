@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os/signal"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/spf13/cobra"
+)
+
+var (
+	glabsStubAddr  string
+	glabsStubFails []int
+)
+
+var glabsStubCmd = &cobra.Command{
+	Use:   "glabs-stub",
+	Short: "Serve a local stand-in for the Globe Labs send-SMS and rewards APIs",
+	Long: `Serves a local stand-in for the Globe Labs send-SMS and rewards APIs and
+logs every message and reward it accepts. Point the server to it with
+GLABS_API_URL, e.g. GLABS_API_URL=http://localhost:8090. Any access token is
+accepted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		serveGLabsStub()
+	},
+}
+
+func init() {
+	glabsStubCmd.Flags().StringVar(&glabsStubAddr, "addr", "localhost:8090", "listen address")
+	glabsStubCmd.Flags().IntSliceVar(&glabsStubFails, "fail", nil, "status codes returned by the first requests, e.g. 503,503")
+}
+
+func serveGLabsStub() {
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	stub := sms.NewGLabsStub()
+	stub.FailNext(glabsStubFails...)
+
+	srv := &http.Server{
+		Addr: glabsStubAddr,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n, nRewards := len(stub.Sent()), len(stub.Rewards())
+			stub.ServeHTTP(w, r)
+			if rewards := stub.Rewards(); len(rewards) > nRewards {
+				reward := rewards[len(rewards)-1]
+				logger.Warn().
+					Int32("transaction_id", reward.TransactionID).
+					Str("address", reward.Address).
+					Str("promo", reward.Promo).
+					Msg("[GLabsStub] Reward accepted")
+				return
+			}
+			if sent := stub.Sent(); len(sent) > n {
+				msg := sent[len(sent)-1]
+				logger.Warn().
+					Str("from", msg.From).
+					Str("to", msg.To).
+					Str("text", msg.Text).
+					Msg("[GLabsStub] Message accepted")
+				return
+			}
+			logger.Warn().
+				Str("path", r.URL.Path).
+				Msg("[GLabsStub] Message rejected")
+		}),
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	logger.Warn().Str("addr", glabsStubAddr).Msg("[GLabsStub] Listening")
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Fatal().Err(err).Msg("[GLabsStub] Cannot serve")
+	}
+}
@@ -7,14 +7,17 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/emiliogozo/panahon-api-go/internal/handlers"
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
 	"github.com/emiliogozo/panahon-api-go/internal/models"
 	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
 
@@ -85,6 +88,13 @@ func sendLufftRequest(ready <-chan bool, msgs <-chan lufftMsg, luffts chan<- luf
 		}
 
 		req.Header.Set("Content-Type", "application/json")
+		if len(config.PtexterWebhookSecret) > 0 {
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			nonce := uuid.NewString()
+			req.Header.Set(mw.WebhookTimestampHeader, timestamp)
+			req.Header.Set(mw.WebhookNonceHeader, nonce)
+			req.Header.Set(mw.WebhookSignatureHeader, mw.SignWebhook(config.PtexterWebhookSecret, timestamp, nonce, payload))
+		}
 
 		res, err := client.Do(req)
 		if err != nil {
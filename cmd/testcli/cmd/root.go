@@ -11,6 +11,7 @@ import (
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	"github.com/emiliogozo/panahon-api-go/internal/server"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -50,7 +51,7 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	cobra.OnInitialize(initCmd)
-	rootCmd.AddCommand(seedCmd, lufftCmd)
+	rootCmd.AddCommand(seedCmd, lufftCmd, backfillCmd, glabsStubCmd)
 	rootCmd.PersistentFlags().CountP("verbose", "v", "increase verbosity level (up to -vvv)")
 	rootCmd.PersistentFlags().StringVar(&testDBName, "db", "testweather", "db name")
 	rootCmd.PersistentFlags().BoolVarP(&resetDB, "reset", "r", false, "reset db")
@@ -107,7 +108,7 @@ func dbConnect(ctx context.Context) (*pgxpool.Pool, db.Store) {
 }
 
 func runGinServer(ctx context.Context, g *errgroup.Group, store db.Store) {
-	server, err := server.NewServer(config, store, nil, logger)
+	server, err := server.NewServer(config, store, nil, nil, service.NewServices(config, store, logger), logger)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("cannot create server")
 	}
@@ -0,0 +1,177 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Alert states.
+const (
+	StatusOpen         = "OPEN"
+	StatusAcknowledged = "ACKNOWLEDGED"
+	StatusResolved     = "RESOLVED"
+)
+
+// Violation is a rule broken by a station health record.
+type Violation struct {
+	Rule      string
+	Message   string
+	Value     float32
+	Threshold float32
+}
+
+// Rule is a condition on a station health record.
+type Rule interface {
+	Name() string
+	// Evaluate reports whether h breaks the rule. ok is false when h has no value
+	// for the rule, in which case the state of its alert is left unchanged.
+	Evaluate(h db.ObservationsStationhealth) (v Violation, violated bool, ok bool)
+}
+
+// Comparison is the direction in which a ThresholdRule is broken.
+type Comparison int
+
+const (
+	Below Comparison = iota
+	Above
+)
+
+// ThresholdRule is broken when a health value crosses a fixed threshold.
+type ThresholdRule struct {
+	name       string
+	label      string
+	threshold  float32
+	comparison Comparison
+	value      func(h db.ObservationsStationhealth) (float32, bool)
+}
+
+// NewThresholdRule creates a new ThresholdRule
+func NewThresholdRule(name, label string, comparison Comparison, threshold float32, value func(h db.ObservationsStationhealth) (float32, bool)) *ThresholdRule {
+	return &ThresholdRule{
+		name:       name,
+		label:      label,
+		threshold:  threshold,
+		comparison: comparison,
+		value:      value,
+	}
+}
+
+func (r *ThresholdRule) Name() string { return r.name }
+
+func (r *ThresholdRule) Evaluate(h db.ObservationsStationhealth) (Violation, bool, bool) {
+	val, ok := r.value(h)
+	if !ok {
+		return Violation{}, false, false
+	}
+
+	var violated bool
+	var msg string
+	switch r.comparison {
+	case Below:
+		violated = val < r.threshold
+		msg = fmt.Sprintf("%s %.2f is below %.2f", r.label, val, r.threshold)
+	case Above:
+		violated = val > r.threshold
+		msg = fmt.Sprintf("%s %.2f is above %.2f", r.label, val, r.threshold)
+	}
+	if !violated {
+		return Violation{}, false, true
+	}
+
+	return Violation{
+		Rule:      r.name,
+		Message:   msg,
+		Value:     val,
+		Threshold: r.threshold,
+	}, true, true
+}
+
+// DefaultRules are the rules checked on every incoming health record.
+var DefaultRules = []Rule{
+	NewThresholdRule("vb1_low", "battery voltage vb1", Below, 11.5, func(h db.ObservationsStationhealth) (float32, bool) {
+		return h.Vb1.Float32, h.Vb1.Valid
+	}),
+	NewThresholdRule("ss_low", "signal strength", Below, 10, func(h db.ObservationsStationhealth) (float32, bool) {
+		return float32(h.Ss.Int32), h.Ss.Valid
+	}),
+	NewThresholdRule("clock_drift", "clock drift in minutes", Above, 30, func(h db.ObservationsStationhealth) (float32, bool) {
+		d := h.MinutesDifference.Int32
+		if d < 0 {
+			d = -d
+		}
+		return float32(d), h.MinutesDifference.Valid
+	}),
+}
+
+// Engine evaluates health records and keeps the alert events of a station in sync.
+type Engine struct {
+	rules []Rule
+}
+
+// NewEngine creates a new Engine with the given rules
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{rules: rules}
+}
+
+// NewDefaultEngine creates an Engine with the default rules
+func NewDefaultEngine() *Engine {
+	return NewEngine(DefaultRules...)
+}
+
+// Apply evaluates h, opens an alert for every newly broken rule and resolves
+// the active alerts of rules that pass again. It returns the alerts it changed.
+func (e *Engine) Apply(ctx context.Context, store db.Store, h db.ObservationsStationhealth) ([]db.ObservationsStationhealthAlert, error) {
+	active, err := store.ListActiveStationHealthAlerts(ctx, h.StationID)
+	if err != nil {
+		return nil, err
+	}
+	activeByRule := make(map[string]db.ObservationsStationhealthAlert, len(active))
+	for _, a := range active {
+		activeByRule[a.Rule] = a
+	}
+
+	ts := h.Timestamp
+	if !ts.Valid {
+		ts = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	}
+
+	var changed []db.ObservationsStationhealthAlert
+	for _, r := range e.rules {
+		v, violated, ok := r.Evaluate(h)
+		if !ok {
+			continue
+		}
+
+		a, isActive := activeByRule[r.Name()]
+		switch {
+		case violated && !isActive:
+			a, err = store.CreateStationHealthAlert(ctx, db.CreateStationHealthAlertParams{
+				StationID: h.StationID,
+				HealthID:  pgtype.Int8{Int64: h.ID, Valid: h.ID > 0},
+				Rule:      v.Rule,
+				Message:   v.Message,
+				Value:     pgtype.Float4{Float32: v.Value, Valid: true},
+				Threshold: pgtype.Float4{Float32: v.Threshold, Valid: true},
+				OpenedAt:  ts,
+			})
+		case !violated && isActive:
+			a, err = store.ResolveStationHealthAlert(ctx, db.ResolveStationHealthAlertParams{
+				StationID:  h.StationID,
+				ID:         a.ID,
+				ResolvedAt: ts,
+			})
+		default:
+			continue
+		}
+		if err != nil {
+			return changed, err
+		}
+		changed = append(changed, a)
+	}
+
+	return changed, nil
+}
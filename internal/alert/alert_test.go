@@ -0,0 +1,94 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdRule(t *testing.T) {
+	rule := DefaultRules[0]
+
+	_, violated, ok := rule.Evaluate(db.ObservationsStationhealth{Vb1: pgtype.Float4{Float32: 11, Valid: true}})
+	require.True(t, ok)
+	require.True(t, violated)
+
+	_, violated, ok = rule.Evaluate(db.ObservationsStationhealth{Vb1: pgtype.Float4{Float32: 12.5, Valid: true}})
+	require.True(t, ok)
+	require.False(t, violated)
+
+	_, _, ok = rule.Evaluate(db.ObservationsStationhealth{})
+	require.False(t, ok)
+}
+
+func TestEngineApply(t *testing.T) {
+	stationID := int64(1)
+	health := db.ObservationsStationhealth{
+		ID:                10,
+		StationID:         stationID,
+		Vb1:               pgtype.Float4{Float32: 11, Valid: true},
+		Ss:                pgtype.Int4{Int32: 20, Valid: true},
+		MinutesDifference: pgtype.Int4{Int32: -45, Valid: true},
+		Timestamp:         pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+
+	testCases := []struct {
+		name        string
+		buildStubs  func(store *mockdb.MockStore)
+		checkResult func(alerts []db.ObservationsStationhealthAlert, err error)
+	}{
+		{
+			name: "OpenAndResolve",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListActiveStationHealthAlerts(mock.Anything, stationID).
+					Return([]db.ObservationsStationhealthAlert{
+						{ID: 1, StationID: stationID, Rule: "vb1_low", Status: StatusOpen},
+						{ID: 2, StationID: stationID, Rule: "ss_low", Status: StatusAcknowledged},
+					}, nil)
+				store.EXPECT().ResolveStationHealthAlert(mock.Anything, mock.MatchedBy(func(arg db.ResolveStationHealthAlertParams) bool {
+					return arg.ID == 2 && arg.ResolvedAt.Time.Equal(health.Timestamp.Time)
+				})).
+					Return(db.ObservationsStationhealthAlert{ID: 2, Rule: "ss_low", Status: StatusResolved}, nil)
+				store.EXPECT().CreateStationHealthAlert(mock.Anything, mock.MatchedBy(func(arg db.CreateStationHealthAlertParams) bool {
+					return arg.Rule == "clock_drift" && arg.Value.Float32 == 45 && arg.HealthID.Int64 == health.ID
+				})).
+					Return(db.ObservationsStationhealthAlert{ID: 3, Rule: "clock_drift", Status: StatusOpen}, nil)
+			},
+			checkResult: func(alerts []db.ObservationsStationhealthAlert, err error) {
+				require.NoError(t, err)
+				require.Len(t, alerts, 2)
+				require.Equal(t, StatusResolved, alerts[0].Status)
+				require.Equal(t, StatusOpen, alerts[1].Status)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListActiveStationHealthAlerts(mock.Anything, stationID).
+					Return(nil, context.DeadlineExceeded)
+			},
+			checkResult: func(alerts []db.ObservationsStationhealthAlert, err error) {
+				require.Error(t, err)
+				require.Empty(t, alerts)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			alerts, err := NewDefaultEngine().Apply(context.Background(), store, health)
+			tc.checkResult(alerts, err)
+		})
+	}
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "observations_qc_flag";
@@ -0,0 +1,14 @@
+CREATE TABLE "observations_qc_flag" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "observation_id" BIGINT NOT NULL,
+  "check_name" VARCHAR(50) NOT NULL,
+  "variable" VARCHAR(50) NOT NULL,
+  "level" INTEGER NOT NULL,
+  "reason" TEXT NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "observations_qc_flag"
+  ADD CONSTRAINT "observations_qc_flag_observation_id_fkey" FOREIGN KEY ("observation_id") REFERENCES "observations_observation" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+CREATE INDEX "observations_qc_flag_observation_id_idx" ON "observations_qc_flag" ("observation_id");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "observations_deriveddaily";
+DROP TABLE IF EXISTS "observations_derivedhourly";
@@ -0,0 +1,47 @@
+CREATE TABLE "observations_derivedhourly" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "temp" REAL,
+  "rh" REAL,
+  "rain" REAL,
+  "wspd" REAL,
+  "wdir" REAL,
+  "gust" REAL,
+  "tn" REAL,
+  "tx" REAL,
+  "data_count" INTEGER NOT NULL DEFAULT 0,
+  "timestamp" timestamptz(0) NOT NULL,
+  "gust_timestamp" timestamptz,
+  "tn_timestamp" timestamptz,
+  "tx_timestamp" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+CREATE TABLE "observations_deriveddaily" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "temp" REAL,
+  "rh" REAL,
+  "rain" REAL,
+  "wspd" REAL,
+  "wdir" REAL,
+  "gust" REAL,
+  "tn" REAL,
+  "tx" REAL,
+  "data_count" INTEGER NOT NULL DEFAULT 0,
+  "timestamp" timestamptz(0) NOT NULL,
+  "gust_timestamp" timestamptz,
+  "tn_timestamp" timestamptz,
+  "tx_timestamp" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "observations_derivedhourly"
+  ADD CONSTRAINT "observations_derivedhourly_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "observations_derivedhourly_station_id_timestamp_unique" UNIQUE ("station_id", "timestamp");
+
+ALTER TABLE "observations_deriveddaily"
+  ADD CONSTRAINT "observations_deriveddaily_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "observations_deriveddaily_station_id_timestamp_unique" UNIQUE ("station_id", "timestamp");
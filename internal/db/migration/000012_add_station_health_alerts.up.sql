@@ -0,0 +1,24 @@
+CREATE TABLE "observations_stationhealth_alert" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "health_id" BIGINT,
+  "rule" VARCHAR(50) NOT NULL,
+  "message" TEXT NOT NULL,
+  "value" REAL,
+  "threshold" REAL,
+  "status" VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+  "opened_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "acknowledged_by" VARCHAR(255),
+  "acknowledged_at" timestamptz,
+  "resolved_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "observations_stationhealth_alert"
+  ADD CONSTRAINT "observations_stationhealth_alert_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "observations_stationhealth_alert_health_id_fkey" FOREIGN KEY ("health_id") REFERENCES "observations_stationhealth" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+  ADD CONSTRAINT "observations_stationhealth_alert_status_check" CHECK ("status" IN ('OPEN', 'ACKNOWLEDGED', 'RESOLVED'));
+
+-- only one unresolved alert per station and rule
+CREATE UNIQUE INDEX "observations_stationhealth_alert_station_id_rule_active_unique" ON "observations_stationhealth_alert" ("station_id", "rule") WHERE "status" <> 'RESOLVED';
@@ -0,0 +1,13 @@
+CREATE TABLE "observations_stationcredential" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "api_key" VARCHAR(255) NOT NULL,
+  "api_secret" VARCHAR(255) NOT NULL,
+  "api_station_id" VARCHAR(50),
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "observations_stationcredential"
+  ADD CONSTRAINT "observations_stationcredential_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "observations_stationcredential_station_id_unique" UNIQUE ("station_id");
@@ -0,0 +1,17 @@
+CREATE TABLE "job_runs" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "job_name" VARCHAR(50) NOT NULL,
+  "trigger" VARCHAR(20) NOT NULL DEFAULT 'SCHEDULE',
+  "status" VARCHAR(20) NOT NULL DEFAULT 'RUNNING',
+  "count" INTEGER,
+  "count_success" INTEGER,
+  "error" TEXT,
+  "started_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "finished_at" timestamptz
+);
+
+ALTER TABLE "job_runs"
+  ADD CONSTRAINT "job_runs_trigger_check" CHECK ("trigger" IN ('SCHEDULE', 'MANUAL')),
+  ADD CONSTRAINT "job_runs_status_check" CHECK ("status" IN ('RUNNING', 'SUCCESS', 'FAILED'));
+
+CREATE INDEX "job_runs_job_name_started_at_index" ON "job_runs" ("job_name", "started_at");
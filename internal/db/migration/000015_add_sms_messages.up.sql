@@ -0,0 +1,37 @@
+CREATE TABLE "sms_subscriptions" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "alerts" BOOLEAN NOT NULL DEFAULT TRUE,
+  "daily_summary" BOOLEAN NOT NULL DEFAULT TRUE,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "sms_subscriptions"
+  ADD CONSTRAINT "sms_subscriptions_mobile_number_fkey" FOREIGN KEY ("mobile_number") REFERENCES "sim_cards" ("mobile_number") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "sms_subscriptions_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "sms_subscriptions_mobile_number_station_id_unique" UNIQUE ("mobile_number", "station_id");
+
+CREATE INDEX "sms_subscriptions_station_id_index" ON "sms_subscriptions" ("station_id");
+
+CREATE TABLE "sms_messages" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "station_id" BIGINT,
+  "kind" VARCHAR(20) NOT NULL,
+  "message" TEXT NOT NULL,
+  "status" VARCHAR(20) NOT NULL DEFAULT 'QUEUED',
+  "attempts" INTEGER NOT NULL DEFAULT 0,
+  "error" TEXT,
+  "sent_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "sms_messages"
+  ADD CONSTRAINT "sms_messages_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+  ADD CONSTRAINT "sms_messages_kind_check" CHECK ("kind" IN ('ALERT', 'SUMMARY')),
+  ADD CONSTRAINT "sms_messages_status_check" CHECK ("status" IN ('QUEUED', 'SENDING', 'SENT', 'FAILED', 'CANCELLED'));
+
+CREATE INDEX "sms_messages_mobile_number_status_index" ON "sms_messages" ("mobile_number", "status");
@@ -0,0 +1,17 @@
+CREATE TABLE "sms_queries" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "provider" VARCHAR(20) NOT NULL,
+  "keyword" VARCHAR(20) NOT NULL,
+  "message" TEXT NOT NULL,
+  "reply" TEXT NOT NULL,
+  "parts" INTEGER NOT NULL DEFAULT 0,
+  "status" VARCHAR(20) NOT NULL,
+  "error" TEXT,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP)
+);
+
+ALTER TABLE "sms_queries"
+  ADD CONSTRAINT "sms_queries_status_check" CHECK ("status" IN ('SENT', 'FAILED'));
+
+CREATE INDEX "sms_queries_mobile_number_created_at_index" ON "sms_queries" ("mobile_number", "created_at");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "glabs_load_mobile_number_created_at_index";
+
+ALTER TABLE "sim_cards"
+  DROP COLUMN IF EXISTS "load_flagged_at",
+  DROP COLUMN IF EXISTS "load_expires_at";
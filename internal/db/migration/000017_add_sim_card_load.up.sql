@@ -0,0 +1,5 @@
+ALTER TABLE "sim_cards"
+  ADD COLUMN "load_expires_at" timestamptz,
+  ADD COLUMN "load_flagged_at" timestamptz;
+
+CREATE INDEX "glabs_load_mobile_number_created_at_index" ON "glabs_load" ("mobile_number", "created_at");
@@ -0,0 +1,25 @@
+CREATE TABLE "load_requests" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "idempotency_key" VARCHAR(255) NOT NULL,
+  "mobile_number" VARCHAR(50) NOT NULL,
+  "station_id" BIGINT,
+  "trigger" VARCHAR(20) NOT NULL,
+  "promo" VARCHAR(255) NOT NULL,
+  "cost" INTEGER NOT NULL,
+  "status" VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+  "transaction_id" INTEGER,
+  "glabs_load_id" BIGINT,
+  "error" TEXT,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "load_requests"
+  ADD CONSTRAINT "load_requests_idempotency_key_unique" UNIQUE ("idempotency_key"),
+  ADD CONSTRAINT "load_requests_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+  ADD CONSTRAINT "load_requests_glabs_load_id_fkey" FOREIGN KEY ("glabs_load_id") REFERENCES "glabs_load" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+  ADD CONSTRAINT "load_requests_trigger_check" CHECK ("trigger" IN ('SCHEDULE', 'HEALTH', 'MANUAL')),
+  ADD CONSTRAINT "load_requests_status_check" CHECK ("status" IN ('PENDING', 'REQUESTED', 'SUCCESS', 'FAILED'));
+
+CREATE INDEX "load_requests_transaction_id_index" ON "load_requests" ("transaction_id");
+CREATE INDEX "load_requests_created_at_index" ON "load_requests" ("created_at");
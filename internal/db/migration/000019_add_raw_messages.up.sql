@@ -0,0 +1,23 @@
+CREATE TABLE "raw_messages" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "provider" VARCHAR(20) NOT NULL,
+  "message_id" VARCHAR(255),
+  "sender" VARCHAR(50) NOT NULL DEFAULT '',
+  "recipient" VARCHAR(50),
+  "body" TEXT NOT NULL,
+  "sent_at" timestamptz,
+  "received_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "station_id" BIGINT,
+  "status" VARCHAR(20) NOT NULL DEFAULT 'RECEIVED',
+  "error" TEXT,
+  "replay_count" INTEGER NOT NULL DEFAULT 0,
+  "replayed_at" timestamptz
+);
+
+ALTER TABLE "raw_messages"
+  ADD CONSTRAINT "raw_messages_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+  ADD CONSTRAINT "raw_messages_status_check" CHECK ("status" IN ('RECEIVED', 'STORED', 'DUPLICATE', 'REJECTED', 'FAILED', 'QUERY'));
+
+CREATE INDEX "raw_messages_received_at_index" ON "raw_messages" ("received_at");
+CREATE INDEX "raw_messages_sender_received_at_index" ON "raw_messages" ("sender", "received_at");
+CREATE INDEX "raw_messages_status_index" ON "raw_messages" ("status");
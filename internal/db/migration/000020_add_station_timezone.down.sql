@@ -0,0 +1 @@
+ALTER TABLE "observations_station" DROP COLUMN IF EXISTS "timezone";
@@ -0,0 +1 @@
+ALTER TABLE "observations_station" ADD COLUMN "timezone" varchar(64) NOT NULL DEFAULT 'Asia/Manila';
@@ -0,0 +1,13 @@
+CREATE TABLE "observations_stationuploadkey" (
+  "id" BIGSERIAL PRIMARY KEY NOT NULL,
+  "station_id" BIGINT NOT NULL,
+  "upload_id" VARCHAR(64) NOT NULL,
+  "key_hash" VARCHAR(64) NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (CURRENT_TIMESTAMP),
+  "updated_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z'
+);
+
+ALTER TABLE "observations_stationuploadkey"
+  ADD CONSTRAINT "observations_stationuploadkey_station_id_fkey" FOREIGN KEY ("station_id") REFERENCES "observations_station" ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  ADD CONSTRAINT "observations_stationuploadkey_station_id_unique" UNIQUE ("station_id"),
+  ADD CONSTRAINT "observations_stationuploadkey_upload_id_unique" UNIQUE ("upload_id");
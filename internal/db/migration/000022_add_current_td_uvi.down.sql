@@ -0,0 +1,3 @@
+ALTER TABLE "observations_current"
+  DROP COLUMN IF EXISTS "td",
+  DROP COLUMN IF EXISTS "uvi";
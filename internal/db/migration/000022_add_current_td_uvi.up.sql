@@ -0,0 +1,3 @@
+ALTER TABLE "observations_current"
+  ADD COLUMN "td" REAL,
+  ADD COLUMN "uvi" REAL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "observations_mo_qc_flag";
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "job_runs_instance_status_index";
+
+ALTER TABLE "job_runs"
+  DROP COLUMN IF EXISTS "instance";
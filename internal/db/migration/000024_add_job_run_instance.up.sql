@@ -0,0 +1,4 @@
+ALTER TABLE "job_runs"
+  ADD COLUMN "instance" VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX "job_runs_instance_status_index" ON "job_runs" ("instance", "status");
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "raw_messages_provider_message_id_query_index";
@@ -0,0 +1,15 @@
+-- A gateway may deliver the same message more than once; only the first
+-- delivery of a keyword query is answered.
+UPDATE "raw_messages" r
+SET "status" = 'DUPLICATE'
+WHERE r."status" = 'QUERY'
+  AND r."message_id" IS NOT NULL
+  AND EXISTS (
+    SELECT 1 FROM "raw_messages" f
+    WHERE f."provider" = r."provider"
+      AND f."message_id" = r."message_id"
+      AND f."status" = 'QUERY'
+      AND f."id" < r."id"
+  );
+
+CREATE UNIQUE INDEX "raw_messages_provider_message_id_query_index" ON "raw_messages" ("provider", "message_id") WHERE "status" = 'QUERY';
@@ -0,0 +1,144 @@
+-- name: UpsertHourlyObservations :execrows
+INSERT INTO observations_derivedhourly (
+  station_id,
+  "temp", rh, rain,
+  wspd, wdir, gust,
+  tn, tx, data_count,
+  "timestamp", gust_timestamp, tn_timestamp, tx_timestamp
+)
+WITH samples AS (
+  -- rr is a rate in mm/h, held until the next sample
+  SELECT
+    *,
+    LEAST(
+      COALESCE(
+        LEAD("timestamp") OVER w - "timestamp",
+        "timestamp" - LAG("timestamp") OVER w,
+        INTERVAL '10 minutes'
+      ),
+      INTERVAL '1 hour'
+    ) AS span
+  FROM observations_observation
+  WHERE "timestamp" >= @start_date::timestamptz AND "timestamp" < @end_date::timestamptz
+  WINDOW w AS (PARTITION BY station_id ORDER BY "timestamp")
+)
+SELECT
+  station_id,
+  AVG("temp") AS "temp",
+  AVG(rh) AS rh,
+  SUM(rr * EXTRACT(EPOCH FROM span)::real / 3600) AS rain,
+  SQRT(POWER(AVG(wspd * SIN(RADIANS(wdir))), 2) + POWER(AVG(wspd * COS(RADIANS(wdir))), 2)) AS wspd,
+  MOD((DEGREES(ATAN2(AVG(wspd * SIN(RADIANS(wdir))), AVG(wspd * COS(RADIANS(wdir))))) + 360)::numeric, 360) AS wdir,
+  MAX(wspdx) AS gust,
+  MIN("temp") AS tn,
+  MAX("temp") AS tx,
+  COUNT(*) AS data_count,
+  DATE_TRUNC('hour', "timestamp") AS "timestamp",
+  (ARRAY_AGG("timestamp" ORDER BY wspdx DESC) FILTER (WHERE wspdx IS NOT NULL))[1] AS gust_timestamp,
+  (ARRAY_AGG("timestamp" ORDER BY "temp" ASC) FILTER (WHERE "temp" IS NOT NULL))[1] AS tn_timestamp,
+  (ARRAY_AGG("timestamp" ORDER BY "temp" DESC) FILTER (WHERE "temp" IS NOT NULL))[1] AS tx_timestamp
+FROM samples
+GROUP BY station_id, DATE_TRUNC('hour', "timestamp")
+ON CONFLICT (station_id, "timestamp") DO UPDATE SET
+  "temp" = EXCLUDED."temp",
+  rh = EXCLUDED.rh,
+  rain = EXCLUDED.rain,
+  wspd = EXCLUDED.wspd,
+  wdir = EXCLUDED.wdir,
+  gust = EXCLUDED.gust,
+  tn = EXCLUDED.tn,
+  tx = EXCLUDED.tx,
+  data_count = EXCLUDED.data_count,
+  gust_timestamp = EXCLUDED.gust_timestamp,
+  tn_timestamp = EXCLUDED.tn_timestamp,
+  tx_timestamp = EXCLUDED.tx_timestamp,
+  updated_at = now();
+
+-- name: ListStationHourlyObservations :many
+SELECT * FROM observations_derivedhourly
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationHourlyObservations :one
+SELECT count(*) FROM observations_derivedhourly
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END);
+
+-- name: UpsertDailyObservations :execrows
+INSERT INTO observations_deriveddaily (
+  station_id,
+  "temp", rh, rain,
+  wspd, wdir, gust,
+  tn, tx, data_count,
+  "timestamp", gust_timestamp, tn_timestamp, tx_timestamp
+)
+WITH samples AS (
+  -- rr is a rate in mm/h, held until the next sample
+  SELECT
+    o.*,
+    s.timezone,
+    LEAST(
+      COALESCE(
+        LEAD(o."timestamp") OVER w - o."timestamp",
+        o."timestamp" - LAG(o."timestamp") OVER w,
+        INTERVAL '10 minutes'
+      ),
+      INTERVAL '1 hour'
+    ) AS span
+  FROM observations_observation o
+  JOIN observations_station s ON s.id = o.station_id
+  WHERE o."timestamp" >= DATE_TRUNC('day', @start_date::timestamptz AT TIME ZONE s.timezone) AT TIME ZONE s.timezone
+    AND o."timestamp" < @end_date::timestamptz
+  WINDOW w AS (PARTITION BY o.station_id ORDER BY o."timestamp")
+)
+SELECT
+  o.station_id,
+  AVG(o."temp") AS "temp",
+  AVG(o.rh) AS rh,
+  SUM(o.rr * EXTRACT(EPOCH FROM o.span)::real / 3600) AS rain,
+  SQRT(POWER(AVG(o.wspd * SIN(RADIANS(o.wdir))), 2) + POWER(AVG(o.wspd * COS(RADIANS(o.wdir))), 2)) AS wspd,
+  MOD((DEGREES(ATAN2(AVG(o.wspd * SIN(RADIANS(o.wdir))), AVG(o.wspd * COS(RADIANS(o.wdir))))) + 360)::numeric, 360) AS wdir,
+  MAX(o.wspdx) AS gust,
+  MIN(o."temp") AS tn,
+  MAX(o."temp") AS tx,
+  COUNT(*) AS data_count,
+  DATE_TRUNC('day', o."timestamp" AT TIME ZONE o.timezone) AT TIME ZONE o.timezone AS "timestamp",
+  (ARRAY_AGG(o."timestamp" ORDER BY o.wspdx DESC) FILTER (WHERE o.wspdx IS NOT NULL))[1] AS gust_timestamp,
+  (ARRAY_AGG(o."timestamp" ORDER BY o."temp" ASC) FILTER (WHERE o."temp" IS NOT NULL))[1] AS tn_timestamp,
+  (ARRAY_AGG(o."timestamp" ORDER BY o."temp" DESC) FILTER (WHERE o."temp" IS NOT NULL))[1] AS tx_timestamp
+FROM samples o
+GROUP BY o.station_id, o.timezone, DATE_TRUNC('day', o."timestamp" AT TIME ZONE o.timezone)
+ON CONFLICT (station_id, "timestamp") DO UPDATE SET
+  "temp" = EXCLUDED."temp",
+  rh = EXCLUDED.rh,
+  rain = EXCLUDED.rain,
+  wspd = EXCLUDED.wspd,
+  wdir = EXCLUDED.wdir,
+  gust = EXCLUDED.gust,
+  tn = EXCLUDED.tn,
+  tx = EXCLUDED.tx,
+  data_count = EXCLUDED.data_count,
+  gust_timestamp = EXCLUDED.gust_timestamp,
+  tn_timestamp = EXCLUDED.tn_timestamp,
+  tx_timestamp = EXCLUDED.tx_timestamp,
+  updated_at = now();
+
+-- name: ListStationDailyObservations :many
+SELECT * FROM observations_deriveddaily
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationDailyObservations :one
+SELECT count(*) FROM observations_deriveddaily
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END);
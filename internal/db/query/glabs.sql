@@ -7,3 +7,14 @@ INSERT INTO glabs_load (
 ) VALUES (
   $1, $2, $3, $4
 ) RETURNING *;
+
+-- name: ListGLabsLoads :many
+SELECT * FROM glabs_load
+WHERE mobile_number = @mobile_number
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountGLabsLoads :one
+SELECT count(*) FROM glabs_load
+WHERE mobile_number = @mobile_number;
@@ -0,0 +1,47 @@
+-- name: CreateJobRun :one
+INSERT INTO job_runs (
+  job_name,
+  trigger,
+  instance
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetJobRun :one
+SELECT * FROM job_runs
+WHERE id = $1 LIMIT 1;
+
+-- name: ListJobRuns :many
+SELECT * FROM job_runs
+WHERE
+  (CASE WHEN @is_job_name::bool THEN job_name = @job_name ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+ORDER BY started_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountJobRuns :one
+SELECT count(*) FROM job_runs
+WHERE
+  (CASE WHEN @is_job_name::bool THEN job_name = @job_name ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END);
+
+-- name: FinishJobRun :one
+UPDATE job_runs
+SET
+  status = @status,
+  count = @count,
+  count_success = @count_success,
+  error = @error,
+  finished_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: FailRunningJobRuns :exec
+UPDATE job_runs
+SET
+  status = 'FAILED',
+  error = @reason,
+  finished_at = now()
+WHERE status = 'RUNNING'
+  AND (instance = @instance OR instance = '');
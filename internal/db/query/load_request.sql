@@ -0,0 +1,75 @@
+-- name: CreateLoadRequest :one
+INSERT INTO load_requests (
+  idempotency_key,
+  mobile_number,
+  station_id,
+  trigger,
+  promo,
+  cost
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetLoadRequestByKey :one
+SELECT * FROM load_requests
+WHERE idempotency_key = $1 LIMIT 1;
+
+-- name: ListLoadRequests :many
+SELECT * FROM load_requests
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountLoadRequests :one
+SELECT count(*) FROM load_requests
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END);
+
+-- name: SumLoadRequestCost :one
+SELECT COALESCE(SUM(cost), 0)::bigint AS total FROM load_requests
+WHERE created_at >= @since::timestamptz AND status <> 'FAILED';
+
+-- name: LockLoadRequests :exec
+SELECT pg_advisory_xact_lock(hashtext('load_requests'));
+
+-- name: UpdateLoadRequestSent :one
+UPDATE load_requests
+SET
+  status = @status,
+  transaction_id = sqlc.narg(transaction_id),
+  error = sqlc.narg(error),
+  updated_at = now()
+WHERE id = @id AND status = 'PENDING'
+RETURNING *;
+
+-- name: DeleteLoadRequest :exec
+DELETE FROM load_requests
+WHERE id = $1 AND status = 'PENDING';
+
+-- name: CompleteLoadRequest :one
+UPDATE load_requests
+SET
+  status = @status,
+  glabs_load_id = @glabs_load_id,
+  updated_at = now()
+WHERE transaction_id = @transaction_id AND status IN ('PENDING', 'REQUESTED')
+RETURNING *;
+
+-- name: ListSilentStationSims :many
+SELECT
+  stn.id AS station_id, sim.mobile_number, last_obs.timestamp AS last_observed_at
+FROM observations_station stn
+  JOIN sim_cards sim ON sim.mobile_number = stn.mobile_number
+  JOIN LATERAL (
+    SELECT obs.timestamp FROM observations_current obs
+    WHERE obs.station_id = stn.id
+    ORDER BY obs.timestamp DESC
+    LIMIT 1
+  ) last_obs ON TRUE
+WHERE last_obs.timestamp < @silent_since::timestamptz
+  AND last_obs.timestamp >= @active_since::timestamptz
+ORDER BY stn.id;
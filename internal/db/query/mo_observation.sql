@@ -0,0 +1,239 @@
+-- name: CreateStationMoObservation :one
+INSERT INTO observations_mo_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  hi,
+  wchill,
+  rain,
+  tx,
+  tn,
+  wrun,
+  thwi,
+  thswi,
+  senergy,
+  sradx,
+  uvi,
+  uvdose,
+  uvx,
+  hdd,
+  cdd,
+  et,
+  wdirx,
+  timestamp,
+  qc_level,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+  $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29
+) RETURNING *;
+
+-- name: UpsertStationMoObservation :one
+INSERT INTO observations_mo_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  hi,
+  wchill,
+  rain,
+  tx,
+  tn,
+  uvi,
+  et,
+  timestamp,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+)
+ON CONFLICT (station_id, timestamp) DO UPDATE SET
+  pres = EXCLUDED.pres,
+  rr = EXCLUDED.rr,
+  rh = EXCLUDED.rh,
+  temp = EXCLUDED.temp,
+  td = EXCLUDED.td,
+  wdir = EXCLUDED.wdir,
+  wspd = EXCLUDED.wspd,
+  wspdx = EXCLUDED.wspdx,
+  srad = EXCLUDED.srad,
+  hi = EXCLUDED.hi,
+  wchill = EXCLUDED.wchill,
+  rain = EXCLUDED.rain,
+  tx = EXCLUDED.tx,
+  tn = EXCLUDED.tn,
+  uvi = EXCLUDED.uvi,
+  et = EXCLUDED.et,
+  updated_at = now()
+RETURNING *;
+
+-- name: BatchCreateStationMoObservations :batchone
+INSERT INTO observations_mo_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  hi,
+  wchill,
+  rain,
+  tx,
+  tn,
+  uvi,
+  et,
+  thwi,
+  senergy,
+  uvdose,
+  hdd,
+  cdd,
+  timestamp,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18,
+  $19, $20, $21, $22, $23
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING id;
+
+-- name: BatchUpsertStationMoObservations :batchexec
+INSERT INTO observations_mo_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  hi,
+  wchill,
+  rain,
+  tx,
+  tn,
+  uvi,
+  et,
+  thwi,
+  senergy,
+  uvdose,
+  hdd,
+  cdd,
+  timestamp,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18,
+  $19, $20, $21, $22, $23
+)
+ON CONFLICT (station_id, timestamp) DO UPDATE SET
+  pres = EXCLUDED.pres,
+  rr = EXCLUDED.rr,
+  rh = EXCLUDED.rh,
+  temp = EXCLUDED.temp,
+  td = EXCLUDED.td,
+  wdir = EXCLUDED.wdir,
+  wspd = EXCLUDED.wspd,
+  wspdx = EXCLUDED.wspdx,
+  srad = EXCLUDED.srad,
+  hi = EXCLUDED.hi,
+  wchill = EXCLUDED.wchill,
+  rain = EXCLUDED.rain,
+  tx = EXCLUDED.tx,
+  tn = EXCLUDED.tn,
+  uvi = EXCLUDED.uvi,
+  et = EXCLUDED.et,
+  thwi = EXCLUDED.thwi,
+  senergy = EXCLUDED.senergy,
+  uvdose = EXCLUDED.uvdose,
+  hdd = EXCLUDED.hdd,
+  cdd = EXCLUDED.cdd,
+  updated_at = now();
+
+-- name: GetStationMoObservation :one
+SELECT * FROM observations_mo_observation
+WHERE station_id = $1 AND id = $2 LIMIT 1;
+
+-- name: GetLatestStationMoObservation :one
+SELECT * FROM observations_mo_observation
+WHERE station_id = $1
+ORDER BY timestamp DESC
+LIMIT 1;
+
+-- name: ListStationMoObservations :many
+SELECT * FROM observations_mo_observation
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationMoObservations :one
+SELECT count(*) FROM observations_mo_observation
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END);
+
+-- name: UpdateStationMoObservation :one
+UPDATE observations_mo_observation
+SET
+  pres = COALESCE(sqlc.narg(pres), pres),
+  rr = COALESCE(sqlc.narg(rr), rr),
+  rh = COALESCE(sqlc.narg(rh), rh),
+  temp = COALESCE(sqlc.narg(temp), temp),
+  td = COALESCE(sqlc.narg(td), td),
+  wdir = COALESCE(sqlc.narg(wdir), wdir),
+  wspd = COALESCE(sqlc.narg(wspd), wspd),
+  wspdx = COALESCE(sqlc.narg(wspdx), wspdx),
+  srad = COALESCE(sqlc.narg(srad), srad),
+  hi = COALESCE(sqlc.narg(hi), hi),
+  wchill = COALESCE(sqlc.narg(wchill), wchill),
+  rain = COALESCE(sqlc.narg(rain), rain),
+  tx = COALESCE(sqlc.narg(tx), tx),
+  tn = COALESCE(sqlc.narg(tn), tn),
+  wrun = COALESCE(sqlc.narg(wrun), wrun),
+  thwi = COALESCE(sqlc.narg(thwi), thwi),
+  thswi = COALESCE(sqlc.narg(thswi), thswi),
+  senergy = COALESCE(sqlc.narg(senergy), senergy),
+  sradx = COALESCE(sqlc.narg(sradx), sradx),
+  uvi = COALESCE(sqlc.narg(uvi), uvi),
+  uvdose = COALESCE(sqlc.narg(uvdose), uvdose),
+  uvx = COALESCE(sqlc.narg(uvx), uvx),
+  hdd = COALESCE(sqlc.narg(hdd), hdd),
+  cdd = COALESCE(sqlc.narg(cdd), cdd),
+  et = COALESCE(sqlc.narg(et), et),
+  wdirx = COALESCE(sqlc.narg(wdirx), wdirx),
+  timestamp = COALESCE(sqlc.narg(timestamp), timestamp),
+  qc_level = COALESCE(sqlc.narg(qc_level), qc_level),
+  updated_at = now()
+WHERE station_id = sqlc.arg(station_id) AND id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteStationMoObservation :exec
+DELETE FROM observations_mo_observation WHERE station_id = $1 AND id = $2;
+
+-- name: ListPreviousStationMoObservations :many
+SELECT * FROM observations_mo_observation
+WHERE station_id = @station_id
+  AND timestamp < @timestamp
+ORDER BY timestamp DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListStationMoObservationsForQc :many
+SELECT * FROM observations_mo_observation
+WHERE station_id = @station_id
+  AND timestamp BETWEEN @start_date AND @end_date
+ORDER BY timestamp;
@@ -19,6 +19,52 @@ INSERT INTO observations_observation (
   $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 ) RETURNING *;
 
+-- name: CreateStationObservationIfNotExists :one
+INSERT INTO observations_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  mslp,
+  hi,
+  wchill,
+  timestamp,
+  qc_level,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING *;
+
+-- name: BatchCreateStationObservations :batchone
+INSERT INTO observations_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  mslp,
+  hi,
+  wchill,
+  timestamp,
+  qc_level,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING id;
+
 -- name: GetStationObservation :one
 SELECT * FROM observations_observation
 WHERE station_id = $1 AND id = $2 LIMIT 1;
@@ -76,3 +122,21 @@ RETURNING *;
 
 -- name: DeleteStationObservation :exec
 DELETE FROM observations_observation WHERE station_id = $1 AND id = $2;
+
+-- name: ListPreviousStationObservations :many
+SELECT * FROM observations_observation
+WHERE station_id = @station_id
+  AND timestamp < @timestamp
+ORDER BY timestamp DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListObservationsForQc :many
+SELECT * FROM observations_observation
+WHERE timestamp BETWEEN @start_date AND @end_date
+ORDER BY station_id, timestamp;
+
+-- name: ListStationObservationsForQc :many
+SELECT * FROM observations_observation
+WHERE station_id = @station_id
+  AND timestamp BETWEEN @start_date AND @end_date
+ORDER BY timestamp;
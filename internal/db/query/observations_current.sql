@@ -80,10 +80,23 @@ RETURNING *;
 -- name: CreateCurrentObservation :one
 INSERT INTO observations_current (
   station_id,
-	rain, "temp", rh,
-	wdir, wspd, srad, mslp,
+	rain, "temp", rh, td,
+	wdir, wspd, srad, mslp, uvi,
+	tn, tx, gust, rain_accum,
+	tn_timestamp, tx_timestamp, gust_timestamp, "timestamp"
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+) RETURNING *;
+
+-- name: BatchCreateCurrentObservations :batchone
+INSERT INTO observations_current (
+  station_id,
+	rain, "temp", rh, td,
+	wdir, wspd, srad, mslp, uvi,
 	tn, tx, gust, rain_accum,
 	tn_timestamp, tx_timestamp, gust_timestamp, "timestamp"
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
-) RETURNING *;
\ No newline at end of file
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+)
+ON CONFLICT (station_id, "timestamp") DO NOTHING
+RETURNING id;
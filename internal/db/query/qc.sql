@@ -0,0 +1,73 @@
+-- name: CreateObservationQcFlag :one
+INSERT INTO observations_qc_flag (
+  observation_id,
+  check_name,
+  variable,
+  level,
+  reason
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListObservationQcFlags :many
+SELECT * FROM observations_qc_flag
+WHERE observation_id = $1
+ORDER BY level, id;
+
+-- name: DeleteObservationQcFlags :exec
+DELETE FROM observations_qc_flag WHERE observation_id = $1;
+
+-- name: UpdateObservationQcLevel :one
+UPDATE observations_observation
+SET
+  qc_level = sqlc.arg(qc_level),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: BatchDeleteObservationQcFlags :batchexec
+DELETE FROM observations_qc_flag WHERE observation_id = $1;
+
+-- name: BatchCreateObservationQcFlags :batchexec
+INSERT INTO observations_qc_flag (
+  observation_id,
+  check_name,
+  variable,
+  level,
+  reason
+) VALUES (
+  $1, $2, $3, $4, $5
+);
+
+-- name: BatchUpdateObservationQcLevel :batchexec
+UPDATE observations_observation
+SET
+  qc_level = sqlc.arg(qc_level),
+  updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: ListMoObservationQcFlags :many
+SELECT * FROM observations_mo_qc_flag
+WHERE mo_observation_id = $1
+ORDER BY level, id;
+
+-- name: BatchDeleteMoObservationQcFlags :batchexec
+DELETE FROM observations_mo_qc_flag WHERE mo_observation_id = $1;
+
+-- name: BatchCreateMoObservationQcFlags :batchexec
+INSERT INTO observations_mo_qc_flag (
+  mo_observation_id,
+  check_name,
+  variable,
+  level,
+  reason
+) VALUES (
+  $1, $2, $3, $4, $5
+);
+
+-- name: BatchUpdateMoObservationQcLevel :batchexec
+UPDATE observations_mo_observation
+SET
+  qc_level = sqlc.arg(qc_level),
+  updated_at = now()
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,54 @@
+-- name: CreateRawMessage :one
+INSERT INTO raw_messages (
+  provider,
+  message_id,
+  sender,
+  recipient,
+  body,
+  sent_at,
+  status,
+  error
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetRawMessage :one
+SELECT * FROM raw_messages
+WHERE id = $1 LIMIT 1;
+
+-- name: ListRawMessages :many
+SELECT * FROM raw_messages
+WHERE
+  (CASE WHEN @is_provider::bool THEN provider = @provider ELSE TRUE END)
+  AND (CASE WHEN @is_sender::bool THEN sender = @sender ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+  AND (CASE WHEN @is_start_date::bool THEN received_at >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN received_at <= @end_date ELSE TRUE END)
+ORDER BY received_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountRawMessages :one
+SELECT count(*) FROM raw_messages
+WHERE
+  (CASE WHEN @is_provider::bool THEN provider = @provider ELSE TRUE END)
+  AND (CASE WHEN @is_sender::bool THEN sender = @sender ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+  AND (CASE WHEN @is_start_date::bool THEN received_at >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN received_at <= @end_date ELSE TRUE END);
+
+-- name: ListRawMessagesByIDs :many
+SELECT * FROM raw_messages
+WHERE id = ANY(@ids::bigint[])
+ORDER BY id;
+
+-- name: UpdateRawMessageOutcome :one
+UPDATE raw_messages
+SET
+  status = @status,
+  station_id = COALESCE(sqlc.narg(station_id), station_id),
+  error = sqlc.narg(error),
+  replay_count = replay_count + (CASE WHEN @is_replay::bool THEN 1 ELSE 0 END),
+  replayed_at = (CASE WHEN @is_replay::bool THEN now() ELSE replayed_at END)
+WHERE id = @id
+RETURNING *;
@@ -13,3 +13,9 @@ WHERE access_token = $1 LIMIT 1;
 
 -- name: DeleteSimAccessToken :exec
 DELETE FROM sim_access_tokens WHERE access_token = $1;
+
+-- name: GetLatestSimAccessToken :one
+SELECT * FROM sim_access_tokens
+WHERE mobile_number = $1 AND type = $2
+ORDER BY created_at DESC
+LIMIT 1;
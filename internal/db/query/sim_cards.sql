@@ -8,4 +8,63 @@ INSERT INTO sim_cards (
 
 -- name: GetSimCard :one
 SELECT * FROM sim_cards
-WHERE mobile_number = $1 LIMIT 1;
\ No newline at end of file
+WHERE mobile_number = $1 LIMIT 1;
+
+-- name: ListSimCards :many
+SELECT
+  sim.mobile_number, sim.type, sim.load_expires_at, sim.load_flagged_at, sim.created_at,
+  stn.id AS station_id, stn.name AS station_name
+FROM sim_cards sim
+  LEFT JOIN observations_station stn ON stn.mobile_number = sim.mobile_number
+WHERE
+  (CASE WHEN @is_flagged::bool THEN sim.load_flagged_at IS NOT NULL ELSE TRUE END)
+ORDER BY sim.mobile_number
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSimCards :one
+SELECT count(*) FROM sim_cards
+WHERE
+  (CASE WHEN @is_flagged::bool THEN load_flagged_at IS NOT NULL ELSE TRUE END);
+
+-- name: UpdateSimCard :one
+UPDATE sim_cards
+SET
+  type = COALESCE(sqlc.narg(type), type),
+  updated_at = now()
+WHERE mobile_number = sqlc.arg(mobile_number)
+RETURNING *;
+
+-- name: DeleteSimCard :exec
+DELETE FROM sim_cards WHERE mobile_number = $1;
+
+-- name: ListSimCardLastLoads :many
+SELECT
+  sim.mobile_number, sim.created_at, sim.load_flagged_at,
+  last_load.promo, last_load.created_at AS loaded_at
+FROM sim_cards sim
+  LEFT JOIN LATERAL (
+    SELECT promo, created_at FROM glabs_load
+    WHERE glabs_load.mobile_number = sim.mobile_number AND glabs_load.status = 'SUCCESS'
+    ORDER BY created_at DESC
+    LIMIT 1
+  ) last_load ON TRUE
+ORDER BY sim.mobile_number;
+
+-- name: UpdateSimCardLoad :exec
+UPDATE sim_cards
+SET
+  load_expires_at = sqlc.narg(load_expires_at),
+  load_flagged_at = CASE WHEN @is_expired::bool THEN COALESCE(load_flagged_at, now()) ELSE NULL END,
+  updated_at = now()
+WHERE mobile_number = sqlc.arg(mobile_number);
+
+-- name: ListStationsAtRisk :many
+SELECT
+  stn.id AS station_id, stn.name AS station_name, stn.status AS station_status,
+  sim.mobile_number, sim.load_expires_at, sim.load_flagged_at
+FROM sim_cards sim
+  JOIN observations_station stn ON stn.mobile_number = sim.mobile_number
+WHERE sim.load_flagged_at IS NOT NULL
+  OR sim.load_expires_at < @expires_before::timestamptz
+ORDER BY sim.load_expires_at NULLS FIRST, stn.id;
@@ -0,0 +1,56 @@
+-- name: CreateSmsMessage :one
+INSERT INTO sms_messages (
+  mobile_number,
+  station_id,
+  kind,
+  message
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetSmsMessage :one
+SELECT * FROM sms_messages
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSmsMessages :many
+SELECT * FROM sms_messages
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSmsMessages :one
+SELECT count(*) FROM sms_messages
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END);
+
+-- name: StartSmsMessageAttempt :one
+UPDATE sms_messages
+SET
+  status = 'SENDING',
+  attempts = attempts + 1,
+  updated_at = now()
+WHERE id = $1 AND status = 'QUEUED'
+RETURNING *;
+
+-- name: FinishSmsMessageAttempt :one
+UPDATE sms_messages
+SET
+  status = @status,
+  error = @error,
+  sent_at = CASE WHEN @status = 'SENT' THEN now() ELSE sent_at END,
+  updated_at = now()
+WHERE id = @id
+  AND (status = 'SENDING' OR (status = 'CANCELLED' AND @status = 'SENT'))
+RETURNING *;
+
+-- name: CancelPendingSmsMessages :execrows
+UPDATE sms_messages
+SET
+  status = 'CANCELLED',
+  error = @reason,
+  updated_at = now()
+WHERE mobile_number = @mobile_number AND status IN ('QUEUED', 'SENDING');
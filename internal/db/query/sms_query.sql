@@ -0,0 +1,28 @@
+-- name: CreateSmsQuery :one
+INSERT INTO sms_queries (
+  mobile_number,
+  provider,
+  keyword,
+  message,
+  reply,
+  parts,
+  status,
+  error
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: ListSmsQueries :many
+SELECT * FROM sms_queries
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_keyword::bool THEN keyword = @keyword ELSE TRUE END)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSmsQueries :one
+SELECT count(*) FROM sms_queries
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_keyword::bool THEN keyword = @keyword ELSE TRUE END);
@@ -0,0 +1,51 @@
+-- name: UpsertSmsSubscription :one
+INSERT INTO sms_subscriptions (
+  mobile_number,
+  station_id,
+  alerts,
+  daily_summary
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (mobile_number, station_id) DO UPDATE SET
+  alerts = EXCLUDED.alerts,
+  daily_summary = EXCLUDED.daily_summary,
+  updated_at = now()
+RETURNING *;
+
+-- name: ListSmsSubscriptions :many
+SELECT * FROM sms_subscriptions
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_station_id::bool THEN station_id = @station_id ELSE TRUE END)
+ORDER BY id
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountSmsSubscriptions :one
+SELECT count(*) FROM sms_subscriptions
+WHERE
+  (CASE WHEN @is_mobile_number::bool THEN mobile_number = @mobile_number ELSE TRUE END)
+  AND (CASE WHEN @is_station_id::bool THEN station_id = @station_id ELSE TRUE END);
+
+-- name: DeleteSmsSubscription :exec
+DELETE FROM sms_subscriptions WHERE id = $1;
+
+-- name: ListStationAlertSubscriptions :many
+SELECT * FROM sms_subscriptions s
+WHERE s.station_id = $1
+  AND s.alerts
+  AND EXISTS (
+    SELECT 1 FROM sim_access_tokens t
+    WHERE t.mobile_number = s.mobile_number AND t.type = @token_type::text
+  )
+ORDER BY s.id;
+
+-- name: ListDailySummarySubscriptions :many
+SELECT * FROM sms_subscriptions s
+WHERE s.daily_summary
+  AND EXISTS (
+    SELECT 1 FROM sim_access_tokens t
+    WHERE t.mobile_number = s.mobile_number AND t.type = @token_type::text
+  )
+ORDER BY s.station_id, s.id;
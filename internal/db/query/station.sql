@@ -18,13 +18,15 @@ INSERT INTO observations_station (
   province,
   region,
   address,
-  geom
+  geom,
+  timezone
 ) VALUES (
   $1, @lat, @lon, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, 
   CASE
     WHEN @lon::real IS NOT NULL AND @lat::real IS NOT NULL THEN ST_Point(@lon::real, @lat::real, 4326)
     ELSE ST_GeomFromEWKT('POINT EMPTY')
-  END
+  END,
+  COALESCE(sqlc.narg(timezone), 'Asia/Manila')
 ) RETURNING *;
 
 -- name: GetStation :one
@@ -35,6 +37,22 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM observations_station
 WHERE mobile_number = $1 LIMIT 1;
 
+-- name: FindStation :one
+SELECT * FROM observations_station
+WHERE id::text = @search::text
+  OR lower(mo_station_id) = lower(@search::text)
+  OR name ILIKE '%' || @search::text || '%'
+ORDER BY
+  (id::text = @search::text OR lower(mo_station_id) = lower(@search::text)) DESC,
+  lower(name) = lower(@search::text) DESC,
+  length(name), id
+LIMIT 1;
+
+-- name: ListProvinceStationIDs :many
+SELECT id FROM observations_station
+WHERE lower(province) = lower(@province::text)
+ORDER BY id;
+
 -- name: ListStations :many
 SELECT * FROM observations_station
 WHERE
@@ -95,9 +113,17 @@ SET
   region = COALESCE(sqlc.narg(region), region),
   address = COALESCE(sqlc.narg(address), address),
   geom = COALESCE(ST_POINT(sqlc.narg(lon), sqlc.narg(lat), 4326), geom),
+  timezone = COALESCE(sqlc.narg(timezone), timezone),
   updated_at = now()
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
+-- name: BatchUpdateStationStatus :batchexec
+UPDATE observations_station
+SET
+  status = $2,
+  updated_at = now()
+WHERE id = $1;
+
 -- name: DeleteStation :exec
 DELETE FROM observations_station WHERE id = $1;
@@ -0,0 +1,22 @@
+-- name: GetStationCredential :one
+SELECT * FROM observations_stationcredential
+WHERE station_id = $1 LIMIT 1;
+
+-- name: UpsertStationCredential :one
+INSERT INTO observations_stationcredential (
+  station_id,
+  api_key,
+  api_secret,
+  api_station_id
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (station_id) DO UPDATE SET
+  api_key = EXCLUDED.api_key,
+  api_secret = EXCLUDED.api_secret,
+  api_station_id = EXCLUDED.api_station_id,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteStationCredential :exec
+DELETE FROM observations_stationcredential WHERE station_id = $1;
@@ -21,6 +21,31 @@ INSERT INTO observations_stationhealth (
   $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 ) RETURNING *;
 
+-- name: CreateStationHealthIfNotExists :one
+INSERT INTO observations_stationhealth (
+  vb1,
+  vb2,
+  curr,
+  bp1,
+  bp2,
+  cm,
+  ss,
+  temp_arq,
+  rh_arq,
+  fpm,
+  error_msg,
+  message,
+  data_count,
+  data_status,
+  timestamp,
+  minutes_difference,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING *;
+
 -- name: GetStationHealth :one
 SELECT * FROM observations_stationhealth
 WHERE station_id = $1 AND id = $2 LIMIT 1;
@@ -57,3 +82,24 @@ RETURNING *;
 
 -- name: DeleteStationHealth :exec
 DELETE FROM observations_stationhealth WHERE station_id = $1 AND id = $2;
+
+-- name: ListStationHealthsByDate :many
+SELECT * FROM observations_stationhealth
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationHealths :one
+SELECT count(*) FROM observations_stationhealth
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_start_date::bool THEN timestamp >= @start_date ELSE TRUE END)
+  AND (CASE WHEN @is_end_date::bool THEN timestamp <= @end_date ELSE TRUE END);
+
+-- name: GetLatestStationHealth :one
+SELECT * FROM observations_stationhealth
+WHERE station_id = $1
+ORDER BY timestamp DESC
+LIMIT 1;
@@ -0,0 +1,53 @@
+-- name: CreateStationHealthAlert :one
+INSERT INTO observations_stationhealth_alert (
+  station_id,
+  health_id,
+  rule,
+  message,
+  value,
+  threshold,
+  opened_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetStationHealthAlert :one
+SELECT * FROM observations_stationhealth_alert
+WHERE station_id = $1 AND id = $2 LIMIT 1;
+
+-- name: ListActiveStationHealthAlerts :many
+SELECT * FROM observations_stationhealth_alert
+WHERE station_id = $1 AND status <> 'RESOLVED'
+ORDER BY id;
+
+-- name: ListStationHealthAlerts :many
+SELECT * FROM observations_stationhealth_alert
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+ORDER BY opened_at DESC
+LIMIT sqlc.narg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountStationHealthAlerts :one
+SELECT count(*) FROM observations_stationhealth_alert
+WHERE station_id = @station_id
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END);
+
+-- name: AcknowledgeStationHealthAlert :one
+UPDATE observations_stationhealth_alert
+SET
+  status = 'ACKNOWLEDGED',
+  acknowledged_by = @acknowledged_by,
+  acknowledged_at = now(),
+  updated_at = now()
+WHERE station_id = @station_id AND id = @id AND status = 'OPEN'
+RETURNING *;
+
+-- name: ResolveStationHealthAlert :one
+UPDATE observations_stationhealth_alert
+SET
+  status = 'RESOLVED',
+  resolved_at = @resolved_at,
+  updated_at = now()
+WHERE station_id = @station_id AND id = @id AND status <> 'RESOLVED'
+RETURNING *;
@@ -0,0 +1,20 @@
+-- name: GetStationUploadKey :one
+SELECT * FROM observations_stationuploadkey
+WHERE upload_id = $1 LIMIT 1;
+
+-- name: UpsertStationUploadKey :one
+INSERT INTO observations_stationuploadkey (
+  station_id,
+  upload_id,
+  key_hash
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (station_id) DO UPDATE SET
+  upload_id = EXCLUDED.upload_id,
+  key_hash = EXCLUDED.key_hash,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteStationUploadKey :exec
+DELETE FROM observations_stationuploadkey WHERE station_id = $1;
@@ -10,12 +10,466 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 var (
 	ErrBatchAlreadyClosed = errors.New("batch already closed")
 )
 
+const batchCreateStationMoObservations = `-- name: BatchCreateStationMoObservations :batchone
+INSERT INTO observations_mo_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  hi,
+  wchill,
+  rain,
+  tx,
+  tn,
+  uvi,
+  et,
+  thwi,
+  senergy,
+  uvdose,
+  hdd,
+  cdd,
+  timestamp,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18,
+  $19, $20, $21, $22, $23
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING id
+`
+
+type BatchCreateStationMoObservationsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchCreateStationMoObservationsParams struct {
+	Pres      pgtype.Float4      `json:"pres"`
+	Rr        pgtype.Float4      `json:"rr"`
+	Rh        pgtype.Float4      `json:"rh"`
+	Temp      pgtype.Float4      `json:"temp"`
+	Td        pgtype.Float4      `json:"td"`
+	Wdir      pgtype.Float4      `json:"wdir"`
+	Wspd      pgtype.Float4      `json:"wspd"`
+	Wspdx     pgtype.Float4      `json:"wspdx"`
+	Srad      pgtype.Float4      `json:"srad"`
+	Hi        pgtype.Float4      `json:"hi"`
+	Wchill    pgtype.Float4      `json:"wchill"`
+	Rain      pgtype.Float4      `json:"rain"`
+	Tx        pgtype.Float4      `json:"tx"`
+	Tn        pgtype.Float4      `json:"tn"`
+	Uvi       pgtype.Float4      `json:"uvi"`
+	Et        pgtype.Float4      `json:"et"`
+	Thwi      pgtype.Float4      `json:"thwi"`
+	Senergy   pgtype.Float4      `json:"senergy"`
+	Uvdose    pgtype.Float4      `json:"uvdose"`
+	Hdd       pgtype.Float4      `json:"hdd"`
+	Cdd       pgtype.Float4      `json:"cdd"`
+	Timestamp pgtype.Timestamptz `json:"timestamp"`
+	StationID int64              `json:"station_id"`
+}
+
+func (q *Queries) BatchCreateStationMoObservations(ctx context.Context, arg []BatchCreateStationMoObservationsParams) *BatchCreateStationMoObservationsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.Pres,
+			a.Rr,
+			a.Rh,
+			a.Temp,
+			a.Td,
+			a.Wdir,
+			a.Wspd,
+			a.Wspdx,
+			a.Srad,
+			a.Hi,
+			a.Wchill,
+			a.Rain,
+			a.Tx,
+			a.Tn,
+			a.Uvi,
+			a.Et,
+			a.Thwi,
+			a.Senergy,
+			a.Uvdose,
+			a.Hdd,
+			a.Cdd,
+			a.Timestamp,
+			a.StationID,
+		}
+		batch.Queue(batchCreateStationMoObservations, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchCreateStationMoObservationsBatchResults{br, len(arg), false}
+}
+
+func (b *BatchCreateStationMoObservationsBatchResults) QueryRow(f func(int, int64, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var id int64
+		if b.closed {
+			if f != nil {
+				f(t, id, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&id)
+		if f != nil {
+			f(t, id, err)
+		}
+	}
+}
+
+func (b *BatchCreateStationMoObservationsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchCreateStationObservations = `-- name: BatchCreateStationObservations :batchone
+INSERT INTO observations_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  mslp,
+  hi,
+  wchill,
+  timestamp,
+  qc_level,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING id
+`
+
+type BatchCreateStationObservationsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchCreateStationObservationsParams struct {
+	Pres      pgtype.Float4      `json:"pres"`
+	Rr        pgtype.Float4      `json:"rr"`
+	Rh        pgtype.Float4      `json:"rh"`
+	Temp      pgtype.Float4      `json:"temp"`
+	Td        pgtype.Float4      `json:"td"`
+	Wdir      pgtype.Float4      `json:"wdir"`
+	Wspd      pgtype.Float4      `json:"wspd"`
+	Wspdx     pgtype.Float4      `json:"wspdx"`
+	Srad      pgtype.Float4      `json:"srad"`
+	Mslp      pgtype.Float4      `json:"mslp"`
+	Hi        pgtype.Float4      `json:"hi"`
+	Wchill    pgtype.Float4      `json:"wchill"`
+	Timestamp pgtype.Timestamptz `json:"timestamp"`
+	QcLevel   int32              `json:"qc_level"`
+	StationID int64              `json:"station_id"`
+}
+
+func (q *Queries) BatchCreateStationObservations(ctx context.Context, arg []BatchCreateStationObservationsParams) *BatchCreateStationObservationsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.Pres,
+			a.Rr,
+			a.Rh,
+			a.Temp,
+			a.Td,
+			a.Wdir,
+			a.Wspd,
+			a.Wspdx,
+			a.Srad,
+			a.Mslp,
+			a.Hi,
+			a.Wchill,
+			a.Timestamp,
+			a.QcLevel,
+			a.StationID,
+		}
+		batch.Queue(batchCreateStationObservations, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchCreateStationObservationsBatchResults{br, len(arg), false}
+}
+
+func (b *BatchCreateStationObservationsBatchResults) QueryRow(f func(int, int64, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var id int64
+		if b.closed {
+			if f != nil {
+				f(t, id, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&id)
+		if f != nil {
+			f(t, id, err)
+		}
+	}
+}
+
+func (b *BatchCreateStationObservationsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchUpsertStationMoObservations = `-- name: BatchUpsertStationMoObservations :batchexec
+INSERT INTO observations_mo_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  hi,
+  wchill,
+  rain,
+  tx,
+  tn,
+  uvi,
+  et,
+  thwi,
+  senergy,
+  uvdose,
+  hdd,
+  cdd,
+  timestamp,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18,
+  $19, $20, $21, $22, $23
+)
+ON CONFLICT (station_id, timestamp) DO UPDATE SET
+  pres = EXCLUDED.pres,
+  rr = EXCLUDED.rr,
+  rh = EXCLUDED.rh,
+  temp = EXCLUDED.temp,
+  td = EXCLUDED.td,
+  wdir = EXCLUDED.wdir,
+  wspd = EXCLUDED.wspd,
+  wspdx = EXCLUDED.wspdx,
+  srad = EXCLUDED.srad,
+  hi = EXCLUDED.hi,
+  wchill = EXCLUDED.wchill,
+  rain = EXCLUDED.rain,
+  tx = EXCLUDED.tx,
+  tn = EXCLUDED.tn,
+  uvi = EXCLUDED.uvi,
+  et = EXCLUDED.et,
+  thwi = EXCLUDED.thwi,
+  senergy = EXCLUDED.senergy,
+  uvdose = EXCLUDED.uvdose,
+  hdd = EXCLUDED.hdd,
+  cdd = EXCLUDED.cdd,
+  updated_at = now()
+`
+
+type BatchUpsertStationMoObservationsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchUpsertStationMoObservationsParams struct {
+	Pres      pgtype.Float4      `json:"pres"`
+	Rr        pgtype.Float4      `json:"rr"`
+	Rh        pgtype.Float4      `json:"rh"`
+	Temp      pgtype.Float4      `json:"temp"`
+	Td        pgtype.Float4      `json:"td"`
+	Wdir      pgtype.Float4      `json:"wdir"`
+	Wspd      pgtype.Float4      `json:"wspd"`
+	Wspdx     pgtype.Float4      `json:"wspdx"`
+	Srad      pgtype.Float4      `json:"srad"`
+	Hi        pgtype.Float4      `json:"hi"`
+	Wchill    pgtype.Float4      `json:"wchill"`
+	Rain      pgtype.Float4      `json:"rain"`
+	Tx        pgtype.Float4      `json:"tx"`
+	Tn        pgtype.Float4      `json:"tn"`
+	Uvi       pgtype.Float4      `json:"uvi"`
+	Et        pgtype.Float4      `json:"et"`
+	Thwi      pgtype.Float4      `json:"thwi"`
+	Senergy   pgtype.Float4      `json:"senergy"`
+	Uvdose    pgtype.Float4      `json:"uvdose"`
+	Hdd       pgtype.Float4      `json:"hdd"`
+	Cdd       pgtype.Float4      `json:"cdd"`
+	Timestamp pgtype.Timestamptz `json:"timestamp"`
+	StationID int64              `json:"station_id"`
+}
+
+func (q *Queries) BatchUpsertStationMoObservations(ctx context.Context, arg []BatchUpsertStationMoObservationsParams) *BatchUpsertStationMoObservationsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.Pres,
+			a.Rr,
+			a.Rh,
+			a.Temp,
+			a.Td,
+			a.Wdir,
+			a.Wspd,
+			a.Wspdx,
+			a.Srad,
+			a.Hi,
+			a.Wchill,
+			a.Rain,
+			a.Tx,
+			a.Tn,
+			a.Uvi,
+			a.Et,
+			a.Thwi,
+			a.Senergy,
+			a.Uvdose,
+			a.Hdd,
+			a.Cdd,
+			a.Timestamp,
+			a.StationID,
+		}
+		batch.Queue(batchUpsertStationMoObservations, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchUpsertStationMoObservationsBatchResults{br, len(arg), false}
+}
+
+func (b *BatchUpsertStationMoObservationsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchUpsertStationMoObservationsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchCreateCurrentObservations = `-- name: BatchCreateCurrentObservations :batchone
+INSERT INTO observations_current (
+  station_id,
+	rain, "temp", rh, td,
+	wdir, wspd, srad, mslp, uvi,
+	tn, tx, gust, rain_accum,
+	tn_timestamp, tx_timestamp, gust_timestamp, "timestamp"
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+)
+ON CONFLICT (station_id, "timestamp") DO NOTHING
+RETURNING id
+`
+
+type BatchCreateCurrentObservationsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchCreateCurrentObservationsParams struct {
+	StationID     int64              `json:"station_id"`
+	Rain          pgtype.Float4      `json:"rain"`
+	Temp          pgtype.Float4      `json:"temp"`
+	Rh            pgtype.Float4      `json:"rh"`
+	Td            pgtype.Float4      `json:"td"`
+	Wdir          pgtype.Float4      `json:"wdir"`
+	Wspd          pgtype.Float4      `json:"wspd"`
+	Srad          pgtype.Float4      `json:"srad"`
+	Mslp          pgtype.Float4      `json:"mslp"`
+	Uvi           pgtype.Float4      `json:"uvi"`
+	Tn            pgtype.Float4      `json:"tn"`
+	Tx            pgtype.Float4      `json:"tx"`
+	Gust          pgtype.Float4      `json:"gust"`
+	RainAccum     pgtype.Float4      `json:"rain_accum"`
+	TnTimestamp   pgtype.Timestamptz `json:"tn_timestamp"`
+	TxTimestamp   pgtype.Timestamptz `json:"tx_timestamp"`
+	GustTimestamp pgtype.Timestamptz `json:"gust_timestamp"`
+	Timestamp     pgtype.Timestamptz `json:"timestamp"`
+}
+
+func (q *Queries) BatchCreateCurrentObservations(ctx context.Context, arg []BatchCreateCurrentObservationsParams) *BatchCreateCurrentObservationsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.StationID,
+			a.Rain,
+			a.Temp,
+			a.Rh,
+			a.Td,
+			a.Wdir,
+			a.Wspd,
+			a.Srad,
+			a.Mslp,
+			a.Uvi,
+			a.Tn,
+			a.Tx,
+			a.Gust,
+			a.RainAccum,
+			a.TnTimestamp,
+			a.TxTimestamp,
+			a.GustTimestamp,
+			a.Timestamp,
+		}
+		batch.Queue(batchCreateCurrentObservations, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchCreateCurrentObservationsBatchResults{br, len(arg), false}
+}
+
+func (b *BatchCreateCurrentObservationsBatchResults) QueryRow(f func(int, int64, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var id int64
+		if b.closed {
+			if f != nil {
+				f(t, id, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&id)
+		if f != nil {
+			f(t, id, err)
+		}
+	}
+}
+
+func (b *BatchCreateCurrentObservationsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const batchCreateUserRoles = `-- name: BatchCreateUserRoles :batchone
 INSERT INTO role_user (user_id, role_id)
 SELECT u.id, r.id
@@ -136,3 +590,374 @@ func (b *BatchDeleteUserRolesBatchResults) Close() error {
 	b.closed = true
 	return b.br.Close()
 }
+
+const batchCreateMoObservationQcFlags = `-- name: BatchCreateMoObservationQcFlags :batchexec
+INSERT INTO observations_mo_qc_flag (
+  mo_observation_id,
+  check_name,
+  variable,
+  level,
+  reason
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+`
+
+type BatchCreateMoObservationQcFlagsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchCreateMoObservationQcFlagsParams struct {
+	MoObservationID int64  `json:"mo_observation_id"`
+	CheckName       string `json:"check_name"`
+	Variable        string `json:"variable"`
+	Level           int32  `json:"level"`
+	Reason          string `json:"reason"`
+}
+
+func (q *Queries) BatchCreateMoObservationQcFlags(ctx context.Context, arg []BatchCreateMoObservationQcFlagsParams) *BatchCreateMoObservationQcFlagsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.MoObservationID,
+			a.CheckName,
+			a.Variable,
+			a.Level,
+			a.Reason,
+		}
+		batch.Queue(batchCreateMoObservationQcFlags, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchCreateMoObservationQcFlagsBatchResults{br, len(arg), false}
+}
+
+func (b *BatchCreateMoObservationQcFlagsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchCreateMoObservationQcFlagsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchCreateObservationQcFlags = `-- name: BatchCreateObservationQcFlags :batchexec
+INSERT INTO observations_qc_flag (
+  observation_id,
+  check_name,
+  variable,
+  level,
+  reason
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+`
+
+type BatchCreateObservationQcFlagsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchCreateObservationQcFlagsParams struct {
+	ObservationID int64  `json:"observation_id"`
+	CheckName     string `json:"check_name"`
+	Variable      string `json:"variable"`
+	Level         int32  `json:"level"`
+	Reason        string `json:"reason"`
+}
+
+func (q *Queries) BatchCreateObservationQcFlags(ctx context.Context, arg []BatchCreateObservationQcFlagsParams) *BatchCreateObservationQcFlagsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ObservationID,
+			a.CheckName,
+			a.Variable,
+			a.Level,
+			a.Reason,
+		}
+		batch.Queue(batchCreateObservationQcFlags, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchCreateObservationQcFlagsBatchResults{br, len(arg), false}
+}
+
+func (b *BatchCreateObservationQcFlagsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchCreateObservationQcFlagsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchDeleteMoObservationQcFlags = `-- name: BatchDeleteMoObservationQcFlags :batchexec
+DELETE FROM observations_mo_qc_flag WHERE mo_observation_id = $1
+`
+
+type BatchDeleteMoObservationQcFlagsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) BatchDeleteMoObservationQcFlags(ctx context.Context, moObservationID []int64) *BatchDeleteMoObservationQcFlagsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range moObservationID {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(batchDeleteMoObservationQcFlags, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchDeleteMoObservationQcFlagsBatchResults{br, len(moObservationID), false}
+}
+
+func (b *BatchDeleteMoObservationQcFlagsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchDeleteMoObservationQcFlagsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchDeleteObservationQcFlags = `-- name: BatchDeleteObservationQcFlags :batchexec
+DELETE FROM observations_qc_flag WHERE observation_id = $1
+`
+
+type BatchDeleteObservationQcFlagsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) BatchDeleteObservationQcFlags(ctx context.Context, observationID []int64) *BatchDeleteObservationQcFlagsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range observationID {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(batchDeleteObservationQcFlags, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchDeleteObservationQcFlagsBatchResults{br, len(observationID), false}
+}
+
+func (b *BatchDeleteObservationQcFlagsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchDeleteObservationQcFlagsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchUpdateMoObservationQcLevel = `-- name: BatchUpdateMoObservationQcLevel :batchexec
+UPDATE observations_mo_observation
+SET
+  qc_level = $1,
+  updated_at = now()
+WHERE id = $2
+`
+
+type BatchUpdateMoObservationQcLevelBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchUpdateMoObservationQcLevelParams struct {
+	QcLevel int32 `json:"qc_level"`
+	ID      int64 `json:"id"`
+}
+
+func (q *Queries) BatchUpdateMoObservationQcLevel(ctx context.Context, arg []BatchUpdateMoObservationQcLevelParams) *BatchUpdateMoObservationQcLevelBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.QcLevel,
+			a.ID,
+		}
+		batch.Queue(batchUpdateMoObservationQcLevel, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchUpdateMoObservationQcLevelBatchResults{br, len(arg), false}
+}
+
+func (b *BatchUpdateMoObservationQcLevelBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchUpdateMoObservationQcLevelBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchUpdateObservationQcLevel = `-- name: BatchUpdateObservationQcLevel :batchexec
+UPDATE observations_observation
+SET
+  qc_level = $1,
+  updated_at = now()
+WHERE id = $2
+`
+
+type BatchUpdateObservationQcLevelBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchUpdateObservationQcLevelParams struct {
+	QcLevel int32 `json:"qc_level"`
+	ID      int64 `json:"id"`
+}
+
+func (q *Queries) BatchUpdateObservationQcLevel(ctx context.Context, arg []BatchUpdateObservationQcLevelParams) *BatchUpdateObservationQcLevelBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.QcLevel,
+			a.ID,
+		}
+		batch.Queue(batchUpdateObservationQcLevel, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchUpdateObservationQcLevelBatchResults{br, len(arg), false}
+}
+
+func (b *BatchUpdateObservationQcLevelBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchUpdateObservationQcLevelBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const batchUpdateStationStatus = `-- name: BatchUpdateStationStatus :batchexec
+UPDATE observations_station
+SET
+  status = $2,
+  updated_at = now()
+WHERE id = $1
+`
+
+type BatchUpdateStationStatusBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type BatchUpdateStationStatusParams struct {
+	ID     int64       `json:"id"`
+	Status pgtype.Text `json:"status"`
+}
+
+func (q *Queries) BatchUpdateStationStatus(ctx context.Context, arg []BatchUpdateStationStatusParams) *BatchUpdateStationStatusBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ID,
+			a.Status,
+		}
+		batch.Queue(batchUpdateStationStatus, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &BatchUpdateStationStatusBatchResults{br, len(arg), false}
+}
+
+func (b *BatchUpdateStationStatusBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *BatchUpdateStationStatusBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
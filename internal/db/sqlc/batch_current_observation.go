@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkCreateCurrentObservations inserts the observations in a single batch.
+// Observations already stored for the same station and timestamp are skipped
+// and not counted in the returned number of inserted rows.
+func (s *SQLStore) BulkCreateCurrentObservations(ctx context.Context, arg []BatchCreateCurrentObservationsParams) (n int, errs []error) {
+	s.BatchCreateCurrentObservations(ctx, arg).QueryRow(func(i int, id int64, err error) {
+		switch {
+		case err == nil:
+			n++
+		case errors.Is(err, pgx.ErrNoRows):
+			// already stored
+		default:
+			errs = append(errs, err)
+		}
+	})
+	return
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkCreateStationMoObservations inserts the observations in a single batch.
+// Observations already stored for the same station and timestamp are skipped
+// and not counted in the returned number of inserted rows.
+func (s *SQLStore) BulkCreateStationMoObservations(ctx context.Context, arg []BatchCreateStationMoObservationsParams) (n int, errs []error) {
+	s.BatchCreateStationMoObservations(ctx, arg).QueryRow(func(i int, id int64, err error) {
+		switch {
+		case err == nil:
+			n++
+		case errors.Is(err, pgx.ErrNoRows):
+			// already stored
+		default:
+			errs = append(errs, err)
+		}
+	})
+	return
+}
+
+// BulkUpsertStationMoObservations inserts or updates the observations in a single batch.
+func (s *SQLStore) BulkUpsertStationMoObservations(ctx context.Context, arg []BatchUpsertStationMoObservationsParams) []error {
+	var errs []error
+	s.BatchUpsertStationMoObservations(ctx, arg).Exec(func(i int, err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	})
+	return errs
+}
+
+// BulkUpdateMoObservationQc replaces the qc flags and sets the qc level of
+// each MO observation in arg, in batches within a single transaction. The
+// ObservationID of each param is the id of the MO observation. On error, none
+// of the observations is updated.
+func (s *SQLStore) BulkUpdateMoObservationQc(ctx context.Context, arg []UpdateObservationQcTxParams) error {
+	ids := make([]int64, len(arg))
+	levels := make([]BatchUpdateMoObservationQcLevelParams, len(arg))
+	var flags []BatchCreateMoObservationQcFlagsParams
+	for i, a := range arg {
+		ids[i] = a.ObservationID
+		levels[i] = BatchUpdateMoObservationQcLevelParams{ID: a.ObservationID, QcLevel: a.QcLevel}
+		for _, f := range a.Flags {
+			flags = append(flags, BatchCreateMoObservationQcFlagsParams{
+				MoObservationID: a.ObservationID,
+				CheckName:       f.CheckName,
+				Variable:        f.Variable,
+				Level:           f.Level,
+				Reason:          f.Reason,
+			})
+		}
+	}
+
+	return s.execTx(ctx, func(q *Queries) error {
+		var batchErr error
+		setErr := func(_ int, err error) {
+			if err != nil && batchErr == nil {
+				batchErr = err
+			}
+		}
+
+		q.BatchDeleteMoObservationQcFlags(ctx, ids).Exec(setErr)
+		if batchErr != nil {
+			return batchErr
+		}
+		q.BatchCreateMoObservationQcFlags(ctx, flags).Exec(setErr)
+		if batchErr != nil {
+			return batchErr
+		}
+		q.BatchUpdateMoObservationQcLevel(ctx, levels).Exec(setErr)
+		return batchErr
+	})
+}
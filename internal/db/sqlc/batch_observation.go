@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkCreateStationObservations inserts the observations in a single batch,
+// within a transaction, and returns the id of each inserted row in the order
+// of arg. Observations already stored for the same station and timestamp are
+// skipped and get a zero id. On error, none of the observations is stored.
+func (store *SQLStore) BulkCreateStationObservations(ctx context.Context, arg []BatchCreateStationObservationsParams) ([]int64, error) {
+	ids := make([]int64, len(arg))
+	err := store.execTx(ctx, func(q *Queries) error {
+		var batchErr error
+		q.BatchCreateStationObservations(ctx, arg).QueryRow(func(i int, id int64, err error) {
+			switch {
+			case err == nil:
+				ids[i] = id
+			case errors.Is(err, pgx.ErrNoRows):
+				// already stored
+			case batchErr == nil:
+				batchErr = err
+			}
+		})
+		return batchErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// BulkUpdateObservationQc replaces the qc flags and sets the qc level of each
+// observation in arg, in batches within a single transaction. On error, none
+// of the observations is updated.
+func (store *SQLStore) BulkUpdateObservationQc(ctx context.Context, arg []UpdateObservationQcTxParams) error {
+	ids := make([]int64, len(arg))
+	levels := make([]BatchUpdateObservationQcLevelParams, len(arg))
+	var flags []BatchCreateObservationQcFlagsParams
+	for i, a := range arg {
+		ids[i] = a.ObservationID
+		levels[i] = BatchUpdateObservationQcLevelParams{ID: a.ObservationID, QcLevel: a.QcLevel}
+		for _, f := range a.Flags {
+			flags = append(flags, BatchCreateObservationQcFlagsParams{
+				ObservationID: a.ObservationID,
+				CheckName:     f.CheckName,
+				Variable:      f.Variable,
+				Level:         f.Level,
+				Reason:        f.Reason,
+			})
+		}
+	}
+
+	return store.execTx(ctx, func(q *Queries) error {
+		var batchErr error
+		setErr := func(_ int, err error) {
+			if err != nil && batchErr == nil {
+				batchErr = err
+			}
+		}
+
+		q.BatchDeleteObservationQcFlags(ctx, ids).Exec(setErr)
+		if batchErr != nil {
+			return batchErr
+		}
+		q.BatchCreateObservationQcFlags(ctx, flags).Exec(setErr)
+		if batchErr != nil {
+			return batchErr
+		}
+		q.BatchUpdateObservationQcLevel(ctx, levels).Exec(setErr)
+		return batchErr
+	})
+}
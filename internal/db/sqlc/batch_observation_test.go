@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type BulkObservationTestSuite struct {
+	suite.Suite
+}
+
+func TestBulkObservationTestSuite(t *testing.T) {
+	suite.Run(t, new(BulkObservationTestSuite))
+}
+
+func (ts *BulkObservationTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *BulkObservationTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *BulkObservationTestSuite) TestBulkCreateStationObservations() {
+	t := ts.T()
+	ctx := context.Background()
+	station := createRandomStation(t, false)
+	timeNow := time.Now().Truncate(time.Minute)
+
+	newArg := func(stationID int64, i int) BatchCreateStationObservationsParams {
+		return BatchCreateStationObservationsParams{
+			StationID: stationID,
+			Temp:      pgtype.Float4{Float32: util.RandomFloat[float32](25, 35), Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: timeNow.Add(-time.Duration(i) * 10 * time.Minute), Valid: true},
+		}
+	}
+
+	arg := []BatchCreateStationObservationsParams{newArg(station.ID, 1), newArg(station.ID, 2), newArg(station.ID, 3)}
+	ids, err := testStore.BulkCreateStationObservations(ctx, arg)
+	require.NoError(t, err)
+	require.Len(t, ids, 3)
+	for _, id := range ids {
+		require.NotZero(t, id)
+	}
+
+	arg = []BatchCreateStationObservationsParams{newArg(station.ID, 2), newArg(station.ID, 4), newArg(station.ID, 3)}
+	ids, err = testStore.BulkCreateStationObservations(ctx, arg)
+	require.NoError(t, err)
+	require.Len(t, ids, 3)
+	require.Zero(t, ids[0])
+	require.NotZero(t, ids[1])
+	require.Zero(t, ids[2])
+
+	arg = []BatchCreateStationObservationsParams{newArg(station.ID, 5), newArg(station.ID+1000, 6)}
+	ids, err = testStore.BulkCreateStationObservations(ctx, arg)
+	require.Error(t, err)
+	require.Nil(t, ids)
+
+	count, err := testStore.CountStationObservations(ctx, CountStationObservationsParams{StationID: station.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), count)
+}
+
+func (ts *BulkObservationTestSuite) TestBulkUpdateObservationQc() {
+	t := ts.T()
+	ctx := context.Background()
+	station := createRandomStation(t, false)
+	obs1 := createRandomObservation(t, station.ID)
+	obs2 := createRandomObservation(t, station.ID)
+
+	_, err := testStore.UpdateObservationQcTx(ctx, UpdateObservationQcTxParams{
+		ObservationID: obs1.ID,
+		QcLevel:       0,
+		Flags:         []CreateObservationQcFlagParams{{CheckName: "range", Variable: "temp", Level: 1, Reason: "out of range"}},
+	})
+	require.NoError(t, err)
+
+	err = testStore.BulkUpdateObservationQc(ctx, []UpdateObservationQcTxParams{
+		{ObservationID: obs1.ID, QcLevel: 3},
+		{
+			ObservationID: obs2.ID,
+			QcLevel:       1,
+			Flags: []CreateObservationQcFlagParams{
+				{CheckName: "step", Variable: "temp", Level: 2, Reason: "step too large"},
+				{CheckName: "step", Variable: "rh", Level: 2, Reason: "step too large"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	gotObs1, err := testStore.GetStationObservation(ctx, GetStationObservationParams{StationID: station.ID, ID: obs1.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), gotObs1.QcLevel)
+	flags1, err := testStore.ListObservationQcFlags(ctx, obs1.ID)
+	require.NoError(t, err)
+	require.Empty(t, flags1)
+
+	gotObs2, err := testStore.GetStationObservation(ctx, GetStationObservationParams{StationID: station.ID, ID: obs2.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), gotObs2.QcLevel)
+	flags2, err := testStore.ListObservationQcFlags(ctx, obs2.ID)
+	require.NoError(t, err)
+	require.Len(t, flags2, 2)
+}
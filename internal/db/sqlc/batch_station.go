@@ -0,0 +1,14 @@
+package db
+
+import "context"
+
+// BulkUpdateStationStatus sets the status of the stations in a single batch.
+func (s *SQLStore) BulkUpdateStationStatus(ctx context.Context, arg []BatchUpdateStationStatusParams) []error {
+	var errs []error
+	s.BatchUpdateStationStatus(ctx, arg).Exec(func(i int, err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	})
+	return errs
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type DerivedObservationTestSuite struct {
+	suite.Suite
+}
+
+func TestDerivedObservationTestSuite(t *testing.T) {
+	suite.Run(t, new(DerivedObservationTestSuite))
+}
+
+func (ts *DerivedObservationTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *DerivedObservationTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *DerivedObservationTestSuite) TestUpsertHourlyObservations() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	hour := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	temps := []float32{25, 27, 26}
+	for i, temp := range temps {
+		createDerivedTestObservation(t, station.ID, hour.Add(time.Duration(i*10)*time.Minute), temp, 90, 3)
+	}
+
+	arg := UpsertHourlyObservationsParams{
+		StartDate: pgtype.Timestamptz{Time: hour, Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: hour.Add(time.Hour), Valid: true},
+	}
+	n, err := testStore.UpsertHourlyObservations(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	listArg := ListStationHourlyObservationsParams{
+		StationID: station.ID,
+		Limit:     pgtype.Int4{Int32: 10, Valid: true},
+	}
+	gotObs, err := testStore.ListStationHourlyObservations(context.Background(), listArg)
+	require.NoError(t, err)
+	require.Len(t, gotObs, 1)
+
+	obs := gotObs[0]
+	require.WithinDuration(t, hour, obs.Timestamp.Time, time.Second)
+	require.Equal(t, int32(3), obs.DataCount)
+	require.InDelta(t, 26, obs.Temp.Float32, 0.01)
+	require.Equal(t, float32(25), obs.Tn.Float32)
+	require.Equal(t, float32(27), obs.Tx.Float32)
+	require.WithinDuration(t, hour, obs.TnTimestamp.Time, time.Second)
+	require.WithinDuration(t, hour.Add(10*time.Minute), obs.TxTimestamp.Time, time.Second)
+	require.InDelta(t, 90, obs.Wdir.Float32, 0.01)
+	require.InDelta(t, 3, obs.Wspd.Float32, 0.01)
+
+	// re-running updates the existing row
+	createDerivedTestObservation(t, station.ID, hour.Add(30*time.Minute), 30, 90, 3)
+	n, err = testStore.UpsertHourlyObservations(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	count, err := testStore.CountStationHourlyObservations(context.Background(), CountStationHourlyObservationsParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	gotObs, err = testStore.ListStationHourlyObservations(context.Background(), listArg)
+	require.NoError(t, err)
+	require.Equal(t, float32(30), gotObs[0].Tx.Float32)
+	require.Equal(t, int32(4), gotObs[0].DataCount)
+}
+
+func (ts *DerivedObservationTestSuite) TestUpsertHourlyObservationsRain() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	// Each rain rate, in mm/h, holds until the next sample; the last one for
+	// as long as the interval before it.
+	hour := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	for _, s := range []struct {
+		offset time.Duration
+		rr     float32
+	}{
+		{0, 6},                 // 10 minutes, 1 mm
+		{10 * time.Minute, 3},  // 20 minutes, 1 mm
+		{30 * time.Minute, 12}, // 20 minutes, 4 mm
+	} {
+		_, err := testStore.CreateStationObservation(context.Background(), CreateStationObservationParams{
+			StationID: station.ID,
+			Rr:        pgtype.Float4{Float32: s.rr, Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: hour.Add(s.offset), Valid: true},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := testStore.UpsertHourlyObservations(context.Background(), UpsertHourlyObservationsParams{
+		StartDate: pgtype.Timestamptz{Time: hour, Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: hour.Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	gotObs, err := testStore.ListStationHourlyObservations(context.Background(), ListStationHourlyObservationsParams{
+		StationID: station.ID,
+		Limit:     pgtype.Int4{Int32: 10, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotObs, 1)
+	require.InDelta(t, 6, gotObs[0].Rain.Float32, 0.01)
+}
+
+func (ts *DerivedObservationTestSuite) TestUpsertDailyObservations() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	// The station is in Asia/Manila, whatever the time zone of the session.
+	loc, err := time.LoadLocation("Asia/Manila")
+	require.NoError(t, err)
+	y, m, d := time.Now().In(loc).Date()
+	day := time.Date(y, m, d-1, 0, 0, 0, 0, loc)
+	createDerivedTestObservation(t, station.ID, day.Add(-30*time.Minute), 20, 0, 2)
+	createDerivedTestObservation(t, station.ID, day.Add(30*time.Minute), 26, 0, 2)
+	createDerivedTestObservation(t, station.ID, day.Add(6*time.Hour), 24, 0, 2)
+	createDerivedTestObservation(t, station.ID, day.Add(14*time.Hour), 32, 180, 2)
+	createDerivedTestObservation(t, station.ID, day.Add(23*time.Hour+30*time.Minute), 28, 180, 2)
+
+	n, err := testStore.UpsertDailyObservations(context.Background(), UpsertDailyObservationsParams{
+		StartDate: pgtype.Timestamptz{Time: day.Add(12 * time.Hour), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: day.Add(24 * time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), n)
+
+	gotObs, err := testStore.ListStationDailyObservations(context.Background(), ListStationDailyObservationsParams{
+		StationID: station.ID,
+		Limit:     pgtype.Int4{Int32: 10, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotObs, 1)
+	require.True(t, day.Equal(gotObs[0].Timestamp.Time))
+	require.Equal(t, int32(4), gotObs[0].DataCount)
+	require.Equal(t, float32(24), gotObs[0].Tn.Float32)
+	require.Equal(t, float32(32), gotObs[0].Tx.Float32)
+	// opposite winds of equal speed cancel out
+	require.InDelta(t, 0, gotObs[0].Wspd.Float32, 0.01)
+}
+
+func createDerivedTestObservation(t *testing.T, stationID int64, ts time.Time, temp, wdir, wspd float32) ObservationsObservation {
+	obs, err := testStore.CreateStationObservation(context.Background(), CreateStationObservationParams{
+		StationID: stationID,
+		Temp:      pgtype.Float4{Float32: temp, Valid: true},
+		Wdir:      pgtype.Float4{Float32: wdir, Valid: true},
+		Wspd:      pgtype.Float4{Float32: wspd, Valid: true},
+		Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+	})
+	require.NoError(t, err)
+
+	return obs
+}
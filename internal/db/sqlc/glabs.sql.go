@@ -11,6 +11,18 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countGLabsLoads = `-- name: CountGLabsLoads :one
+SELECT count(*) FROM glabs_load
+WHERE mobile_number = $1
+`
+
+func (q *Queries) CountGLabsLoads(ctx context.Context, mobileNumber string) (int64, error) {
+	row := q.db.QueryRow(ctx, countGLabsLoads, mobileNumber)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createGLabsLoad = `-- name: CreateGLabsLoad :one
 INSERT INTO glabs_load (
   promo,
@@ -48,3 +60,45 @@ func (q *Queries) CreateGLabsLoad(ctx context.Context, arg CreateGLabsLoadParams
 	)
 	return i, err
 }
+
+const listGLabsLoads = `-- name: ListGLabsLoads :many
+SELECT id, status, promo, transaction_id, mobile_number, created_at, updated_at FROM glabs_load
+WHERE mobile_number = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+OFFSET $2
+`
+
+type ListGLabsLoadsParams struct {
+	MobileNumber string      `json:"mobile_number"`
+	Offset       int32       `json:"offset"`
+	Limit        pgtype.Int4 `json:"limit"`
+}
+
+func (q *Queries) ListGLabsLoads(ctx context.Context, arg ListGLabsLoadsParams) ([]GlabsLoad, error) {
+	rows, err := q.db.Query(ctx, listGLabsLoads, arg.MobileNumber, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GlabsLoad{}
+	for rows.Next() {
+		var i GlabsLoad
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.Promo,
+			&i.TransactionID,
+			&i.MobileNumber,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type JobRunTestSuite struct {
+	suite.Suite
+}
+
+func TestJobRunTestSuite(t *testing.T) {
+	suite.Run(t, new(JobRunTestSuite))
+}
+
+func (ts *JobRunTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *JobRunTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *JobRunTestSuite) TestCreateJobRun() {
+	createRandomJobRun(ts.T(), "TestJob")
+}
+
+func (ts *JobRunTestSuite) TestFinishJobRun() {
+	t := ts.T()
+	run := createRandomJobRun(t, "TestJob")
+
+	arg := FinishJobRunParams{
+		ID:           run.ID,
+		Status:       "FAILED",
+		Count:        pgtype.Int4{Int32: 10, Valid: true},
+		CountSuccess: pgtype.Int4{Int32: 7, Valid: true},
+		Error:        pgtype.Text{String: "api error", Valid: true},
+	}
+	finished, err := testStore.FinishJobRun(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Status, finished.Status)
+	require.Equal(t, arg.Count, finished.Count)
+	require.Equal(t, arg.CountSuccess, finished.CountSuccess)
+	require.Equal(t, arg.Error, finished.Error)
+	require.True(t, finished.FinishedAt.Valid)
+	require.False(t, finished.FinishedAt.Time.Before(finished.StartedAt.Time))
+}
+
+func (ts *JobRunTestSuite) TestListJobRuns() {
+	t := ts.T()
+	for i := 0; i < 3; i++ {
+		createRandomJobRun(t, "JobA")
+	}
+	runB := createRandomJobRun(t, "JobB")
+
+	runs, err := testStore.ListJobRuns(context.Background(), ListJobRunsParams{
+		IsJobName: true,
+		JobName:   "JobA",
+		Limit:     pgtype.Int4{Int32: 2, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	require.Greater(t, runs[0].ID, runs[1].ID)
+
+	count, err := testStore.CountJobRuns(context.Background(), CountJobRunsParams{
+		IsJobName: true,
+		JobName:   "JobA",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	runs, err = testStore.ListJobRuns(context.Background(), ListJobRunsParams{
+		IsStatus: true,
+		Status:   "RUNNING",
+	})
+	require.NoError(t, err)
+	require.Len(t, runs, 4)
+	require.Equal(t, runB.ID, runs[0].ID)
+}
+
+func (ts *JobRunTestSuite) TestFailRunningJobRuns() {
+	t := ts.T()
+	legacy := createRandomJobRun(t, "TestJob")
+	run, err := testStore.CreateJobRun(context.Background(), CreateJobRunParams{
+		JobName:  "TestJob",
+		Trigger:  "SCHEDULE",
+		Instance: "instance-a",
+	})
+	require.NoError(t, err)
+	other, err := testStore.CreateJobRun(context.Background(), CreateJobRunParams{
+		JobName:  "TestJob",
+		Trigger:  "SCHEDULE",
+		Instance: "instance-b",
+	})
+	require.NoError(t, err)
+
+	err = testStore.FailRunningJobRuns(context.Background(), FailRunningJobRunsParams{
+		Reason:   pgtype.Text{String: "interrupted", Valid: true},
+		Instance: "instance-a",
+	})
+	require.NoError(t, err)
+
+	for _, id := range []int64{legacy.ID, run.ID} {
+		got, err := testStore.GetJobRun(context.Background(), id)
+		require.NoError(t, err)
+		require.Equal(t, "FAILED", got.Status)
+		require.Equal(t, "interrupted", got.Error.String)
+		require.True(t, got.FinishedAt.Valid)
+	}
+
+	got, err := testStore.GetJobRun(context.Background(), other.ID)
+	require.NoError(t, err)
+	require.Equal(t, "RUNNING", got.Status)
+	require.False(t, got.FinishedAt.Valid)
+}
+
+func createRandomJobRun(t *testing.T, jobName string) JobRun {
+	arg := CreateJobRunParams{
+		JobName: jobName,
+		Trigger: "MANUAL",
+	}
+	run, err := testStore.CreateJobRun(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, run.ID)
+	require.Equal(t, arg.JobName, run.JobName)
+	require.Equal(t, arg.Trigger, run.Trigger)
+	require.Equal(t, "RUNNING", run.Status)
+	require.True(t, run.StartedAt.Valid)
+	require.False(t, run.FinishedAt.Valid)
+	return run
+}
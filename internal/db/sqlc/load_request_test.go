@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoadRequestTestSuite struct {
+	suite.Suite
+}
+
+func TestLoadRequestTestSuite(t *testing.T) {
+	suite.Run(t, new(LoadRequestTestSuite))
+}
+
+func (ts *LoadRequestTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *LoadRequestTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *LoadRequestTestSuite) TestCreateLoadRequest() {
+	createRandomLoadRequest(ts.T(), util.RandomMobileNumber())
+}
+
+func (ts *LoadRequestTestSuite) TestListLoadRequests() {
+	t := ts.T()
+	mobileNumber := util.RandomMobileNumber()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomLoadRequest(t, mobileNumber)
+	}
+	createRandomLoadRequest(t, util.RandomMobileNumber())
+
+	loadReqs, err := testStore.ListLoadRequests(context.Background(), ListLoadRequestsParams{
+		IsMobileNumber: true,
+		MobileNumber:   mobileNumber,
+		IsStatus:       true,
+		Status:         "PENDING",
+		Limit:          pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, loadReqs, 3)
+	for _, r := range loadReqs {
+		require.Equal(t, mobileNumber, r.MobileNumber)
+	}
+
+	count, err := testStore.CountLoadRequests(context.Background(), CountLoadRequestsParams{
+		IsMobileNumber: true,
+		MobileNumber:   mobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+}
+
+func (ts *LoadRequestTestSuite) TestSumLoadRequestCost() {
+	t := ts.T()
+	since := pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+	r1 := createRandomLoadRequest(t, util.RandomMobileNumber())
+	r2 := createRandomLoadRequest(t, util.RandomMobileNumber())
+
+	_, err := testStore.UpdateLoadRequestSent(context.Background(), UpdateLoadRequestSentParams{
+		ID:     r2.ID,
+		Status: "FAILED",
+		Error:  pgtype.Text{String: "refused", Valid: true},
+	})
+	require.NoError(t, err)
+
+	total, err := testStore.SumLoadRequestCost(context.Background(), since)
+	require.NoError(t, err)
+	require.Equal(t, int64(r1.Cost), total)
+}
+
+func (ts *LoadRequestTestSuite) TestDeleteLoadRequest() {
+	t := ts.T()
+	r1 := createRandomLoadRequest(t, util.RandomMobileNumber())
+	r2 := createRandomLoadRequest(t, util.RandomMobileNumber())
+
+	_, err := testStore.UpdateLoadRequestSent(context.Background(), UpdateLoadRequestSentParams{
+		ID:     r2.ID,
+		Status: "FAILED",
+	})
+	require.NoError(t, err)
+
+	// Only pending requests are deleted.
+	for _, r := range []LoadRequest{r1, r2} {
+		err = testStore.DeleteLoadRequest(context.Background(), r.ID)
+		require.NoError(t, err)
+	}
+
+	_, err = testStore.GetLoadRequestByKey(context.Background(), r1.IdempotencyKey)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+	_, err = testStore.GetLoadRequestByKey(context.Background(), r2.IdempotencyKey)
+	require.NoError(t, err)
+}
+
+func (ts *LoadRequestTestSuite) TestCompleteLoadRequest() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	r := createRandomLoadRequest(t, station.MobileNumber.String)
+	gLabsLoad := createRandomGlabsLoad(t, station.MobileNumber.String)
+
+	sent, err := testStore.UpdateLoadRequestSent(context.Background(), UpdateLoadRequestSentParams{
+		ID:            r.ID,
+		Status:        "REQUESTED",
+		TransactionID: gLabsLoad.TransactionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "REQUESTED", sent.Status)
+
+	_, err = testStore.UpdateLoadRequestSent(context.Background(), UpdateLoadRequestSentParams{
+		ID:     r.ID,
+		Status: "FAILED",
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	done, err := testStore.CompleteLoadRequest(context.Background(), CompleteLoadRequestParams{
+		Status:        "SUCCESS",
+		GlabsLoadID:   pgtype.Int8{Int64: gLabsLoad.ID, Valid: true},
+		TransactionID: gLabsLoad.TransactionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, r.ID, done.ID)
+	require.Equal(t, "SUCCESS", done.Status)
+	require.Equal(t, gLabsLoad.ID, done.GlabsLoadID.Int64)
+
+	_, err = testStore.CompleteLoadRequest(context.Background(), CompleteLoadRequestParams{
+		Status:        "FAILED",
+		GlabsLoadID:   pgtype.Int8{Int64: gLabsLoad.ID, Valid: true},
+		TransactionID: gLabsLoad.TransactionID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func createRandomLoadRequest(t *testing.T, mobileNumber string) LoadRequest {
+	arg := CreateLoadRequestParams{
+		IdempotencyKey: util.RandomString(32),
+		MobileNumber:   mobileNumber,
+		Trigger:        "MANUAL",
+		Promo:          "LOAD 50",
+		Cost:           int32(util.RandomInt(10, 100)),
+	}
+
+	r, err := testStore.CreateLoadRequest(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, r)
+
+	require.Equal(t, arg.IdempotencyKey, r.IdempotencyKey)
+	require.Equal(t, arg.MobileNumber, r.MobileNumber)
+	require.Equal(t, arg.Trigger, r.Trigger)
+	require.Equal(t, arg.Promo, r.Promo)
+	require.Equal(t, arg.Cost, r.Cost)
+	require.Equal(t, "PENDING", r.Status)
+	require.NotZero(t, r.CreatedAt)
+
+	return r
+}
@@ -0,0 +1,263 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MoObservationTestSuite struct {
+	suite.Suite
+}
+
+func TestMoObservationTestSuite(t *testing.T) {
+	suite.Run(t, new(MoObservationTestSuite))
+}
+
+func (ts *MoObservationTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *MoObservationTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *MoObservationTestSuite) TestCreateStationMoObservation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	createRandomMoObservation(t, station.ID, time.Now())
+}
+
+func (ts *MoObservationTestSuite) TestGetStationMoObservation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	obs := createRandomMoObservation(t, station.ID, time.Now())
+
+	gotObs, err := testStore.GetStationMoObservation(context.Background(), GetStationMoObservationParams{
+		StationID: station.ID,
+		ID:        obs.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, obs, gotObs)
+}
+
+func (ts *MoObservationTestSuite) TestUpsertStationMoObservation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	timestamp := pgtype.Timestamptz{Time: time.Now().Truncate(time.Minute), Valid: true}
+
+	arg := UpsertStationMoObservationParams{
+		StationID: station.ID,
+		Temp:      pgtype.Float4{Float32: 28, Valid: true},
+		Uvi:       pgtype.Float4{Float32: 3, Valid: true},
+		Timestamp: timestamp,
+	}
+	obs1, err := testStore.UpsertStationMoObservation(context.Background(), arg)
+	require.NoError(t, err)
+
+	arg.Temp = pgtype.Float4{Float32: 29, Valid: true}
+	obs2, err := testStore.UpsertStationMoObservation(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, obs1.ID, obs2.ID)
+	require.Equal(t, float32(29), obs2.Temp.Float32)
+
+	latest, err := testStore.GetLatestStationMoObservation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, obs2.ID, latest.ID)
+}
+
+func (ts *MoObservationTestSuite) TestBulkCreateStationMoObservations() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	existing := createRandomMoObservation(t, station.ID, time.Now().Truncate(time.Minute))
+
+	n := 5
+	arg := make([]BatchCreateStationMoObservationsParams, n)
+	for i := range arg {
+		arg[i] = BatchCreateStationMoObservationsParams{
+			StationID: station.ID,
+			Temp:      pgtype.Float4{Float32: util.RandomFloat[float32](25, 35), Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: existing.Timestamp.Time.Add(time.Duration(-i) * 10 * time.Minute), Valid: true},
+		}
+	}
+
+	inserted, errs := testStore.BulkCreateStationMoObservations(context.Background(), arg)
+	require.Empty(t, errs)
+	require.Equal(t, n-1, inserted)
+
+	gotObs, err := testStore.GetStationMoObservation(context.Background(), GetStationMoObservationParams{
+		StationID: station.ID,
+		ID:        existing.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, existing.Temp, gotObs.Temp)
+
+	inserted, errs = testStore.BulkCreateStationMoObservations(context.Background(), arg)
+	require.Empty(t, errs)
+	require.Zero(t, inserted)
+}
+
+func (ts *MoObservationTestSuite) TestBulkUpsertStationMoObservations() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	existing := createRandomMoObservation(t, station.ID, time.Now().Truncate(time.Minute))
+
+	arg := []BatchUpsertStationMoObservationsParams{
+		{
+			StationID: station.ID,
+			Temp:      pgtype.Float4{Float32: existing.Temp.Float32 + 1, Valid: true},
+			Timestamp: existing.Timestamp,
+		},
+		{
+			StationID: station.ID,
+			Temp:      pgtype.Float4{Float32: util.RandomFloat[float32](25, 35), Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: existing.Timestamp.Time.Add(10 * time.Minute), Valid: true},
+		},
+	}
+
+	errs := testStore.BulkUpsertStationMoObservations(context.Background(), arg)
+	require.Empty(t, errs)
+
+	gotObs, err := testStore.GetStationMoObservation(context.Background(), GetStationMoObservationParams{
+		StationID: station.ID,
+		ID:        existing.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, arg[0].Temp, gotObs.Temp)
+
+	latest, err := testStore.GetLatestStationMoObservation(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, arg[1].Temp, latest.Temp)
+}
+
+func (ts *MoObservationTestSuite) TestBulkUpdateMoObservationQc() {
+	t := ts.T()
+	ctx := context.Background()
+	station := createRandomStation(t, false)
+	now := time.Now().Truncate(time.Minute)
+	obs1 := createRandomMoObservation(t, station.ID, now)
+	obs2 := createRandomMoObservation(t, station.ID, now.Add(10*time.Minute))
+
+	err := testStore.BulkUpdateMoObservationQc(ctx, []UpdateObservationQcTxParams{
+		{
+			ObservationID: obs1.ID,
+			QcLevel:       0,
+			Flags:         []CreateObservationQcFlagParams{{CheckName: "range", Variable: "temp", Level: 1, Reason: "out of range"}},
+		},
+		{ObservationID: obs2.ID, QcLevel: 3},
+	})
+	require.NoError(t, err)
+
+	// The flags are replaced.
+	err = testStore.BulkUpdateMoObservationQc(ctx, []UpdateObservationQcTxParams{
+		{
+			ObservationID: obs1.ID,
+			QcLevel:       1,
+			Flags:         []CreateObservationQcFlagParams{{CheckName: "step", Variable: "rh", Level: 2, Reason: "step too large"}},
+		},
+	})
+	require.NoError(t, err)
+
+	gotObs1, err := testStore.GetStationMoObservation(ctx, GetStationMoObservationParams{StationID: station.ID, ID: obs1.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), gotObs1.QcLevel)
+	flags1, err := testStore.ListMoObservationQcFlags(ctx, obs1.ID)
+	require.NoError(t, err)
+	require.Len(t, flags1, 1)
+	require.Equal(t, "step", flags1[0].CheckName)
+
+	gotObs2, err := testStore.GetStationMoObservation(ctx, GetStationMoObservationParams{StationID: station.ID, ID: obs2.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), gotObs2.QcLevel)
+	flags2, err := testStore.ListMoObservationQcFlags(ctx, obs2.ID)
+	require.NoError(t, err)
+	require.Empty(t, flags2)
+}
+
+func (ts *MoObservationTestSuite) TestListStationMoObservations() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	n := 10
+	now := time.Now()
+	for i := 0; i < n; i++ {
+		createRandomMoObservation(t, station.ID, now.Add(-time.Duration(i)*time.Hour))
+	}
+
+	gotObs, err := testStore.ListStationMoObservations(context.Background(), ListStationMoObservationsParams{
+		StationID: station.ID,
+		Limit:     pgtype.Int4{Int32: 5, Valid: true},
+		Offset:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, gotObs, 5)
+
+	count, err := testStore.CountStationMoObservations(context.Background(), CountStationMoObservationsParams{
+		StationID:   station.ID,
+		IsStartDate: true,
+		StartDate:   pgtype.Timestamptz{Time: now.Add(-150 * time.Minute), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
+
+func (ts *MoObservationTestSuite) TestUpdateStationMoObservation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	obs := createRandomMoObservation(t, station.ID, time.Now())
+
+	updatedObs, err := testStore.UpdateStationMoObservation(context.Background(), UpdateStationMoObservationParams{
+		StationID: station.ID,
+		ID:        obs.ID,
+		Et:        pgtype.Float4{Float32: 1.5, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, float32(1.5), updatedObs.Et.Float32)
+	require.Equal(t, obs.Temp, updatedObs.Temp)
+}
+
+func (ts *MoObservationTestSuite) TestDeleteStationMoObservation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	obs := createRandomMoObservation(t, station.ID, time.Now())
+
+	err := testStore.DeleteStationMoObservation(context.Background(), DeleteStationMoObservationParams{
+		StationID: station.ID,
+		ID:        obs.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.GetStationMoObservation(context.Background(), GetStationMoObservationParams{
+		StationID: station.ID,
+		ID:        obs.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func createRandomMoObservation(t *testing.T, stationID int64, timestamp time.Time) ObservationsMoObservation {
+	arg := CreateStationMoObservationParams{
+		StationID: stationID,
+		Pres:      pgtype.Float4{Float32: util.RandomFloat[float32](990, 1100), Valid: true},
+		Temp:      pgtype.Float4{Float32: util.RandomFloat[float32](25, 35), Valid: true},
+		Rh:        pgtype.Float4{Float32: util.RandomFloat[float32](0, 100), Valid: true},
+		Uvi:       pgtype.Float4{Float32: util.RandomFloat[float32](0, 12), Valid: true},
+		Et:        pgtype.Float4{Float32: util.RandomFloat[float32](0, 5), Valid: true},
+		Timestamp: pgtype.Timestamptz{Time: timestamp.Truncate(time.Second), Valid: true},
+	}
+
+	obs, err := testStore.CreateStationMoObservation(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, obs.ID)
+	require.Equal(t, arg.StationID, obs.StationID)
+	require.Equal(t, arg.Temp, obs.Temp)
+	require.Equal(t, arg.Uvi, obs.Uvi)
+	require.WithinDuration(t, arg.Timestamp.Time, obs.Timestamp.Time, time.Second)
+
+	return obs
+}
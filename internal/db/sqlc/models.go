@@ -20,6 +20,35 @@ type GlabsLoad struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
+type JobRun struct {
+	ID           int64              `json:"id"`
+	JobName      string             `json:"job_name"`
+	Trigger      string             `json:"trigger"`
+	Status       string             `json:"status"`
+	Count        pgtype.Int4        `json:"count"`
+	CountSuccess pgtype.Int4        `json:"count_success"`
+	Error        pgtype.Text        `json:"error"`
+	StartedAt    pgtype.Timestamptz `json:"started_at"`
+	FinishedAt   pgtype.Timestamptz `json:"finished_at"`
+	Instance     string             `json:"instance"`
+}
+
+type LoadRequest struct {
+	ID             int64              `json:"id"`
+	IdempotencyKey string             `json:"idempotency_key"`
+	MobileNumber   string             `json:"mobile_number"`
+	StationID      pgtype.Int8        `json:"station_id"`
+	Trigger        string             `json:"trigger"`
+	Promo          string             `json:"promo"`
+	Cost           int32              `json:"cost"`
+	Status         string             `json:"status"`
+	TransactionID  pgtype.Int4        `json:"transaction_id"`
+	GlabsLoadID    pgtype.Int8        `json:"glabs_load_id"`
+	Error          pgtype.Text        `json:"error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
 type ObservationsCurrent struct {
 	ID            int64              `json:"id"`
 	StationID     int64              `json:"station_id"`
@@ -38,6 +67,48 @@ type ObservationsCurrent struct {
 	TnTimestamp   pgtype.Timestamptz `json:"tn_timestamp"`
 	TxTimestamp   pgtype.Timestamptz `json:"tx_timestamp"`
 	GustTimestamp pgtype.Timestamptz `json:"gust_timestamp"`
+	Td            pgtype.Float4      `json:"td"`
+	Uvi           pgtype.Float4      `json:"uvi"`
+}
+
+type ObservationsDeriveddaily struct {
+	ID            int64              `json:"id"`
+	StationID     int64              `json:"station_id"`
+	Temp          pgtype.Float4      `json:"temp"`
+	Rh            pgtype.Float4      `json:"rh"`
+	Rain          pgtype.Float4      `json:"rain"`
+	Wspd          pgtype.Float4      `json:"wspd"`
+	Wdir          pgtype.Float4      `json:"wdir"`
+	Gust          pgtype.Float4      `json:"gust"`
+	Tn            pgtype.Float4      `json:"tn"`
+	Tx            pgtype.Float4      `json:"tx"`
+	DataCount     int32              `json:"data_count"`
+	Timestamp     pgtype.Timestamptz `json:"timestamp"`
+	GustTimestamp pgtype.Timestamptz `json:"gust_timestamp"`
+	TnTimestamp   pgtype.Timestamptz `json:"tn_timestamp"`
+	TxTimestamp   pgtype.Timestamptz `json:"tx_timestamp"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ObservationsDerivedhourly struct {
+	ID            int64              `json:"id"`
+	StationID     int64              `json:"station_id"`
+	Temp          pgtype.Float4      `json:"temp"`
+	Rh            pgtype.Float4      `json:"rh"`
+	Rain          pgtype.Float4      `json:"rain"`
+	Wspd          pgtype.Float4      `json:"wspd"`
+	Wdir          pgtype.Float4      `json:"wdir"`
+	Gust          pgtype.Float4      `json:"gust"`
+	Tn            pgtype.Float4      `json:"tn"`
+	Tx            pgtype.Float4      `json:"tx"`
+	DataCount     int32              `json:"data_count"`
+	Timestamp     pgtype.Timestamptz `json:"timestamp"`
+	GustTimestamp pgtype.Timestamptz `json:"gust_timestamp"`
+	TnTimestamp   pgtype.Timestamptz `json:"tn_timestamp"`
+	TxTimestamp   pgtype.Timestamptz `json:"tx_timestamp"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
 type ObservationsMoObservation struct {
@@ -75,6 +146,16 @@ type ObservationsMoObservation struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ObservationsMoQcFlag struct {
+	ID              int64              `json:"id"`
+	MoObservationID int64              `json:"mo_observation_id"`
+	CheckName       string             `json:"check_name"`
+	Variable        string             `json:"variable"`
+	Level           int32              `json:"level"`
+	Reason          string             `json:"reason"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
 type ObservationsObservation struct {
 	ID        int64              `json:"id"`
 	Pres      pgtype.Float4      `json:"pres"`
@@ -96,6 +177,16 @@ type ObservationsObservation struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ObservationsQcFlag struct {
+	ID            int64              `json:"id"`
+	ObservationID int64              `json:"observation_id"`
+	CheckName     string             `json:"check_name"`
+	Variable      string             `json:"variable"`
+	Level         int32              `json:"level"`
+	Reason        string             `json:"reason"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
 type ObservationsStation struct {
 	ID            int64              `json:"id"`
 	Name          string             `json:"name"`
@@ -120,6 +211,17 @@ type ObservationsStation struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
 	Geom          util.Point         `json:"geom"`
+	Timezone      string             `json:"timezone"`
+}
+
+type ObservationsStationcredential struct {
+	ID           int64              `json:"id"`
+	StationID    int64              `json:"station_id"`
+	ApiKey       string             `json:"api_key"`
+	ApiSecret    string             `json:"api_secret"`
+	ApiStationID pgtype.Text        `json:"api_station_id"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
 type ObservationsStationhealth struct {
@@ -145,6 +247,48 @@ type ObservationsStationhealth struct {
 	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ObservationsStationhealthAlert struct {
+	ID             int64              `json:"id"`
+	StationID      int64              `json:"station_id"`
+	HealthID       pgtype.Int8        `json:"health_id"`
+	Rule           string             `json:"rule"`
+	Message        string             `json:"message"`
+	Value          pgtype.Float4      `json:"value"`
+	Threshold      pgtype.Float4      `json:"threshold"`
+	Status         string             `json:"status"`
+	OpenedAt       pgtype.Timestamptz `json:"opened_at"`
+	AcknowledgedBy pgtype.Text        `json:"acknowledged_by"`
+	AcknowledgedAt pgtype.Timestamptz `json:"acknowledged_at"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ObservationsStationuploadkey struct {
+	ID        int64              `json:"id"`
+	StationID int64              `json:"station_id"`
+	UploadID  string             `json:"upload_id"`
+	KeyHash   string             `json:"key_hash"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type RawMessage struct {
+	ID          int64              `json:"id"`
+	Provider    string             `json:"provider"`
+	MessageID   pgtype.Text        `json:"message_id"`
+	Sender      string             `json:"sender"`
+	Recipient   pgtype.Text        `json:"recipient"`
+	Body        string             `json:"body"`
+	SentAt      pgtype.Timestamptz `json:"sent_at"`
+	ReceivedAt  pgtype.Timestamptz `json:"received_at"`
+	StationID   pgtype.Int8        `json:"station_id"`
+	Status      string             `json:"status"`
+	Error       pgtype.Text        `json:"error"`
+	ReplayCount int32              `json:"replay_count"`
+	ReplayedAt  pgtype.Timestamptz `json:"replayed_at"`
+}
+
 type Role struct {
 	ID          int64              `json:"id"`
 	Name        string             `json:"name"`
@@ -180,8 +324,47 @@ type SimAccessToken struct {
 }
 
 type SimCard struct {
+	MobileNumber  string             `json:"mobile_number"`
+	Type          pgtype.Text        `json:"type"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	LoadExpiresAt pgtype.Timestamptz `json:"load_expires_at"`
+	LoadFlaggedAt pgtype.Timestamptz `json:"load_flagged_at"`
+}
+
+type SmsMessage struct {
+	ID           int64              `json:"id"`
+	MobileNumber string             `json:"mobile_number"`
+	StationID    pgtype.Int8        `json:"station_id"`
+	Kind         string             `json:"kind"`
+	Message      string             `json:"message"`
+	Status       string             `json:"status"`
+	Attempts     int32              `json:"attempts"`
+	Error        pgtype.Text        `json:"error"`
+	SentAt       pgtype.Timestamptz `json:"sent_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SmsQuery struct {
+	ID           int64              `json:"id"`
+	MobileNumber string             `json:"mobile_number"`
+	Provider     string             `json:"provider"`
+	Keyword      string             `json:"keyword"`
+	Message      string             `json:"message"`
+	Reply        string             `json:"reply"`
+	Parts        int32              `json:"parts"`
+	Status       string             `json:"status"`
+	Error        pgtype.Text        `json:"error"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type SmsSubscription struct {
+	ID           int64              `json:"id"`
 	MobileNumber string             `json:"mobile_number"`
-	Type         pgtype.Text        `json:"type"`
+	StationID    int64              `json:"station_id"`
+	Alerts       bool               `json:"alerts"`
+	DailySummary bool               `json:"daily_summary"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
@@ -149,6 +149,90 @@ func (q *Queries) CreateStationObservation(ctx context.Context, arg CreateStatio
 	return i, err
 }
 
+const createStationObservationIfNotExists = `-- name: CreateStationObservationIfNotExists :one
+INSERT INTO observations_observation (
+  pres,
+  rr,
+  rh,
+  temp,
+  td,
+  wdir,
+  wspd,
+  wspdx,
+  srad,
+  mslp,
+  hi,
+  wchill,
+  timestamp,
+  qc_level,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at
+`
+
+type CreateStationObservationIfNotExistsParams struct {
+	Pres      pgtype.Float4      `json:"pres"`
+	Rr        pgtype.Float4      `json:"rr"`
+	Rh        pgtype.Float4      `json:"rh"`
+	Temp      pgtype.Float4      `json:"temp"`
+	Td        pgtype.Float4      `json:"td"`
+	Wdir      pgtype.Float4      `json:"wdir"`
+	Wspd      pgtype.Float4      `json:"wspd"`
+	Wspdx     pgtype.Float4      `json:"wspdx"`
+	Srad      pgtype.Float4      `json:"srad"`
+	Mslp      pgtype.Float4      `json:"mslp"`
+	Hi        pgtype.Float4      `json:"hi"`
+	Wchill    pgtype.Float4      `json:"wchill"`
+	Timestamp pgtype.Timestamptz `json:"timestamp"`
+	QcLevel   int32              `json:"qc_level"`
+	StationID int64              `json:"station_id"`
+}
+
+func (q *Queries) CreateStationObservationIfNotExists(ctx context.Context, arg CreateStationObservationIfNotExistsParams) (ObservationsObservation, error) {
+	row := q.db.QueryRow(ctx, createStationObservationIfNotExists,
+		arg.Pres,
+		arg.Rr,
+		arg.Rh,
+		arg.Temp,
+		arg.Td,
+		arg.Wdir,
+		arg.Wspd,
+		arg.Wspdx,
+		arg.Srad,
+		arg.Mslp,
+		arg.Hi,
+		arg.Wchill,
+		arg.Timestamp,
+		arg.QcLevel,
+		arg.StationID,
+	)
+	var i ObservationsObservation
+	err := row.Scan(
+		&i.ID,
+		&i.Pres,
+		&i.Rr,
+		&i.Rh,
+		&i.Temp,
+		&i.Td,
+		&i.Wdir,
+		&i.Wspd,
+		&i.Wspdx,
+		&i.Srad,
+		&i.Mslp,
+		&i.Hi,
+		&i.StationID,
+		&i.Timestamp,
+		&i.Wchill,
+		&i.QcLevel,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const deleteStationObservation = `-- name: DeleteStationObservation :exec
 DELETE FROM observations_observation WHERE station_id = $1 AND id = $2
 `
@@ -266,6 +350,109 @@ func (q *Queries) ListObservations(ctx context.Context, arg ListObservationsPara
 	return items, nil
 }
 
+const listObservationsForQc = `-- name: ListObservationsForQc :many
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at FROM observations_observation
+WHERE timestamp BETWEEN $1 AND $2
+ORDER BY station_id, timestamp
+`
+
+type ListObservationsForQcParams struct {
+	StartDate pgtype.Timestamptz `json:"start_date"`
+	EndDate   pgtype.Timestamptz `json:"end_date"`
+}
+
+func (q *Queries) ListObservationsForQc(ctx context.Context, arg ListObservationsForQcParams) ([]ObservationsObservation, error) {
+	rows, err := q.db.Query(ctx, listObservationsForQc, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ObservationsObservation{}
+	for rows.Next() {
+		var i ObservationsObservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pres,
+			&i.Rr,
+			&i.Rh,
+			&i.Temp,
+			&i.Td,
+			&i.Wdir,
+			&i.Wspd,
+			&i.Wspdx,
+			&i.Srad,
+			&i.Mslp,
+			&i.Hi,
+			&i.StationID,
+			&i.Timestamp,
+			&i.Wchill,
+			&i.QcLevel,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPreviousStationObservations = `-- name: ListPreviousStationObservations :many
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at FROM observations_observation
+WHERE station_id = $1
+  AND timestamp < $2
+ORDER BY timestamp DESC
+LIMIT $3
+`
+
+type ListPreviousStationObservationsParams struct {
+	StationID int64              `json:"station_id"`
+	Timestamp pgtype.Timestamptz `json:"timestamp"`
+	Limit     int32              `json:"limit"`
+}
+
+func (q *Queries) ListPreviousStationObservations(ctx context.Context, arg ListPreviousStationObservationsParams) ([]ObservationsObservation, error) {
+	rows, err := q.db.Query(ctx, listPreviousStationObservations, arg.StationID, arg.Timestamp, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ObservationsObservation{}
+	for rows.Next() {
+		var i ObservationsObservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pres,
+			&i.Rr,
+			&i.Rh,
+			&i.Temp,
+			&i.Td,
+			&i.Wdir,
+			&i.Wspd,
+			&i.Wspdx,
+			&i.Srad,
+			&i.Mslp,
+			&i.Hi,
+			&i.StationID,
+			&i.Timestamp,
+			&i.Wchill,
+			&i.QcLevel,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStationObservations = `-- name: ListStationObservations :many
 SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at FROM observations_observation
 WHERE station_id = $1
@@ -333,6 +520,58 @@ func (q *Queries) ListStationObservations(ctx context.Context, arg ListStationOb
 	return items, nil
 }
 
+const listStationObservationsForQc = `-- name: ListStationObservationsForQc :many
+SELECT id, pres, rr, rh, temp, td, wdir, wspd, wspdx, srad, mslp, hi, station_id, timestamp, wchill, qc_level, created_at, updated_at FROM observations_observation
+WHERE station_id = $1
+  AND timestamp BETWEEN $2 AND $3
+ORDER BY timestamp
+`
+
+type ListStationObservationsForQcParams struct {
+	StationID int64              `json:"station_id"`
+	StartDate pgtype.Timestamptz `json:"start_date"`
+	EndDate   pgtype.Timestamptz `json:"end_date"`
+}
+
+func (q *Queries) ListStationObservationsForQc(ctx context.Context, arg ListStationObservationsForQcParams) ([]ObservationsObservation, error) {
+	rows, err := q.db.Query(ctx, listStationObservationsForQc, arg.StationID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ObservationsObservation{}
+	for rows.Next() {
+		var i ObservationsObservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pres,
+			&i.Rr,
+			&i.Rh,
+			&i.Temp,
+			&i.Td,
+			&i.Wdir,
+			&i.Wspd,
+			&i.Wspdx,
+			&i.Srad,
+			&i.Mslp,
+			&i.Hi,
+			&i.StationID,
+			&i.Timestamp,
+			&i.Wchill,
+			&i.QcLevel,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateStationObservation = `-- name: UpdateStationObservation :one
 UPDATE observations_observation
 SET
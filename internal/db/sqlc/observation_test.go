@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -605,6 +606,50 @@ func (ts *ObservationTestSuite) TestDeleteStationObservation() {
 	require.Empty(t, gotObs)
 }
 
+func (ts *ObservationTestSuite) TestStreamObservations() {
+	t := ts.T()
+	station := createRandomStation(t, true)
+	otherStation := createRandomStation(t, false)
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomObservation(t, station.ID)
+		createRandomObservation(t, otherStation.ID)
+	}
+
+	var gotRows []StreamObservationsRow
+	err := testStore.StreamObservations(context.Background(), StreamObservationsParams{
+		StationIds: []int64{station.ID},
+	}, func(row StreamObservationsRow) error {
+		gotRows = append(gotRows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, gotRows, n)
+	for i, row := range gotRows {
+		require.Equal(t, station.ID, row.ObservationsObservation.StationID)
+		require.Equal(t, station.Name, row.Name)
+		require.InDelta(t, station.Lat.Float32, row.Lat.Float32, 0.0001)
+		require.InDelta(t, station.Lon.Float32, row.Lon.Float32, 0.0001)
+		if i > 0 {
+			require.False(t, row.ObservationsObservation.Timestamp.Time.Before(gotRows[i-1].ObservationsObservation.Timestamp.Time))
+		}
+	}
+
+	errStop := errors.New("stop")
+	count := 0
+	err = testStore.StreamObservations(context.Background(), StreamObservationsParams{
+		StationIds: []int64{station.ID, otherStation.ID},
+	}, func(row StreamObservationsRow) error {
+		count++
+		if count == 3 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 3, count)
+}
+
 func createRandomObservation(t *testing.T, stationID int64) ObservationsObservation {
 	arg := CreateStationObservationParams{
 		Pres: pgtype.Float4{
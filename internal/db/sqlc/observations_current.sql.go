@@ -14,13 +14,13 @@ import (
 const createCurrentObservation = `-- name: CreateCurrentObservation :one
 INSERT INTO observations_current (
   station_id,
-	rain, "temp", rh,
-	wdir, wspd, srad, mslp,
+	rain, "temp", rh, td,
+	wdir, wspd, srad, mslp, uvi,
 	tn, tx, gust, rain_accum,
 	tn_timestamp, tx_timestamp, gust_timestamp, "timestamp"
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
-) RETURNING id, station_id, rain, temp, rh, wdir, wspd, srad, mslp, tn, tx, gust, rain_accum, timestamp, tn_timestamp, tx_timestamp, gust_timestamp
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+) RETURNING id, station_id, rain, temp, rh, wdir, wspd, srad, mslp, tn, tx, gust, rain_accum, timestamp, tn_timestamp, tx_timestamp, gust_timestamp, td, uvi
 `
 
 type CreateCurrentObservationParams struct {
@@ -28,10 +28,12 @@ type CreateCurrentObservationParams struct {
 	Rain          pgtype.Float4      `json:"rain"`
 	Temp          pgtype.Float4      `json:"temp"`
 	Rh            pgtype.Float4      `json:"rh"`
+	Td            pgtype.Float4      `json:"td"`
 	Wdir          pgtype.Float4      `json:"wdir"`
 	Wspd          pgtype.Float4      `json:"wspd"`
 	Srad          pgtype.Float4      `json:"srad"`
 	Mslp          pgtype.Float4      `json:"mslp"`
+	Uvi           pgtype.Float4      `json:"uvi"`
 	Tn            pgtype.Float4      `json:"tn"`
 	Tx            pgtype.Float4      `json:"tx"`
 	Gust          pgtype.Float4      `json:"gust"`
@@ -48,10 +50,12 @@ func (q *Queries) CreateCurrentObservation(ctx context.Context, arg CreateCurren
 		arg.Rain,
 		arg.Temp,
 		arg.Rh,
+		arg.Td,
 		arg.Wdir,
 		arg.Wspd,
 		arg.Srad,
 		arg.Mslp,
+		arg.Uvi,
 		arg.Tn,
 		arg.Tx,
 		arg.Gust,
@@ -80,6 +84,8 @@ func (q *Queries) CreateCurrentObservation(ctx context.Context, arg CreateCurren
 		&i.TnTimestamp,
 		&i.TxTimestamp,
 		&i.GustTimestamp,
+		&i.Td,
+		&i.Uvi,
 	)
 	return i, err
 }
@@ -87,7 +93,7 @@ func (q *Queries) CreateCurrentObservation(ctx context.Context, arg CreateCurren
 const getLatestStationObservation = `-- name: GetLatestStationObservation :one
 SELECT
   stn.id, stn.name, stn.lat, stn.lon, stn.elevation, stn.address,
-  obs.id, obs.station_id, obs.rain, obs.temp, obs.rh, obs.wdir, obs.wspd, obs.srad, obs.mslp, obs.tn, obs.tx, obs.gust, obs.rain_accum, obs.timestamp, obs.tn_timestamp, obs.tx_timestamp, obs.gust_timestamp
+  obs.id, obs.station_id, obs.rain, obs.temp, obs.rh, obs.wdir, obs.wspd, obs.srad, obs.mslp, obs.tn, obs.tx, obs.gust, obs.rain_accum, obs.timestamp, obs.tn_timestamp, obs.tx_timestamp, obs.gust_timestamp, obs.td, obs.uvi
 FROM observations_station stn 
   JOIN observations_current obs 
   ON stn.id = obs.station_id
@@ -133,6 +139,8 @@ func (q *Queries) GetLatestStationObservation(ctx context.Context, id int64) (Ge
 		&i.ObservationsCurrent.TnTimestamp,
 		&i.ObservationsCurrent.TxTimestamp,
 		&i.ObservationsCurrent.GustTimestamp,
+		&i.ObservationsCurrent.Td,
+		&i.ObservationsCurrent.Uvi,
 	)
 	return i, err
 }
@@ -147,7 +155,7 @@ WITH NearestStation AS (
 )
 SELECT
   stn.id, stn.name, stn.lat, stn.lon, stn.elevation, stn.address,
-  obs.id, obs.station_id, obs.rain, obs.temp, obs.rh, obs.wdir, obs.wspd, obs.srad, obs.mslp, obs.tn, obs.tx, obs.gust, obs.rain_accum, obs.timestamp, obs.tn_timestamp, obs.tx_timestamp, obs.gust_timestamp
+  obs.id, obs.station_id, obs.rain, obs.temp, obs.rh, obs.wdir, obs.wspd, obs.srad, obs.mslp, obs.tn, obs.tx, obs.gust, obs.rain_accum, obs.timestamp, obs.tn_timestamp, obs.tx_timestamp, obs.gust_timestamp, obs.td, obs.uvi
 FROM NearestStation stn 
   JOIN observations_current obs 
   ON stn.id = obs.station_id
@@ -197,6 +205,8 @@ func (q *Queries) GetNearestLatestStationObservation(ctx context.Context, arg Ge
 		&i.ObservationsCurrent.TnTimestamp,
 		&i.ObservationsCurrent.TxTimestamp,
 		&i.ObservationsCurrent.GustTimestamp,
+		&i.ObservationsCurrent.Td,
+		&i.ObservationsCurrent.Uvi,
 	)
 	return i, err
 }
@@ -231,7 +241,7 @@ WINDOW
 	t_wdw AS (PARTITION BY station_id ORDER BY "temp" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING),
 	w_wdw AS (PARTITION BY station_id ORDER BY "wspdx" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)
 ON CONFLICT (station_id, "timestamp") DO NOTHING
-RETURNING id, station_id, rain, temp, rh, wdir, wspd, srad, mslp, tn, tx, gust, rain_accum, timestamp, tn_timestamp, tx_timestamp, gust_timestamp
+RETURNING id, station_id, rain, temp, rh, wdir, wspd, srad, mslp, tn, tx, gust, rain_accum, timestamp, tn_timestamp, tx_timestamp, gust_timestamp, td, uvi
 `
 
 func (q *Queries) InsertCurrentObservations(ctx context.Context) ([]ObservationsCurrent, error) {
@@ -261,6 +271,8 @@ func (q *Queries) InsertCurrentObservations(ctx context.Context) ([]Observations
 			&i.TnTimestamp,
 			&i.TxTimestamp,
 			&i.GustTimestamp,
+			&i.Td,
+			&i.Uvi,
 		); err != nil {
 			return nil, err
 		}
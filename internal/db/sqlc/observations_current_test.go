@@ -34,6 +34,33 @@ func (ts *CurrentObservationTestSuite) TestCreateCurrentObservation() {
 	createRandomCurrentObservation(ts.T())
 }
 
+func (ts *CurrentObservationTestSuite) TestBulkCreateCurrentObservations() {
+	t := ts.T()
+	existing := createRandomCurrentObservation(t)
+	station := createRandomStation(t, false)
+
+	arg := []BatchCreateCurrentObservationsParams{
+		{
+			StationID: existing.StationID,
+			Temp:      pgtype.Float4{Float32: existing.Temp.Float32 + 1, Valid: true},
+			Timestamp: existing.Timestamp,
+		},
+		{
+			StationID: station.ID,
+			Temp:      pgtype.Float4{Float32: util.RandomFloat[float32](25, 35), Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		},
+	}
+
+	n, errs := testStore.BulkCreateCurrentObservations(context.Background(), arg)
+	require.Empty(t, errs)
+	require.Equal(t, 1, n)
+
+	stnObs, err := testStore.GetLatestStationObservation(context.Background(), existing.StationID)
+	require.NoError(t, err)
+	require.Equal(t, existing.Temp, stnObs.ObservationsCurrent.Temp)
+}
+
 func (ts *CurrentObservationTestSuite) TestGetLatestStationObservation() {
 	t := ts.T()
 	n := 10
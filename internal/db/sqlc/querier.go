@@ -12,11 +12,38 @@ import (
 )
 
 type Querier interface {
+	AcknowledgeStationHealthAlert(ctx context.Context, arg AcknowledgeStationHealthAlertParams) (ObservationsStationhealthAlert, error)
+	BatchCreateCurrentObservations(ctx context.Context, arg []BatchCreateCurrentObservationsParams) *BatchCreateCurrentObservationsBatchResults
+	BatchCreateMoObservationQcFlags(ctx context.Context, arg []BatchCreateMoObservationQcFlagsParams) *BatchCreateMoObservationQcFlagsBatchResults
+	BatchCreateObservationQcFlags(ctx context.Context, arg []BatchCreateObservationQcFlagsParams) *BatchCreateObservationQcFlagsBatchResults
+	BatchCreateStationMoObservations(ctx context.Context, arg []BatchCreateStationMoObservationsParams) *BatchCreateStationMoObservationsBatchResults
+	BatchCreateStationObservations(ctx context.Context, arg []BatchCreateStationObservationsParams) *BatchCreateStationObservationsBatchResults
 	BatchCreateUserRoles(ctx context.Context, arg []BatchCreateUserRolesParams) *BatchCreateUserRolesBatchResults
+	BatchDeleteMoObservationQcFlags(ctx context.Context, moObservationID []int64) *BatchDeleteMoObservationQcFlagsBatchResults
+	BatchDeleteObservationQcFlags(ctx context.Context, observationID []int64) *BatchDeleteObservationQcFlagsBatchResults
 	BatchDeleteUserRoles(ctx context.Context, arg []BatchDeleteUserRolesParams) *BatchDeleteUserRolesBatchResults
+	BatchUpdateMoObservationQcLevel(ctx context.Context, arg []BatchUpdateMoObservationQcLevelParams) *BatchUpdateMoObservationQcLevelBatchResults
+	BatchUpdateObservationQcLevel(ctx context.Context, arg []BatchUpdateObservationQcLevelParams) *BatchUpdateObservationQcLevelBatchResults
+	BatchUpdateStationStatus(ctx context.Context, arg []BatchUpdateStationStatusParams) *BatchUpdateStationStatusBatchResults
+	BatchUpsertStationMoObservations(ctx context.Context, arg []BatchUpsertStationMoObservationsParams) *BatchUpsertStationMoObservationsBatchResults
+	CancelPendingSmsMessages(ctx context.Context, arg CancelPendingSmsMessagesParams) (int64, error)
+	CompleteLoadRequest(ctx context.Context, arg CompleteLoadRequestParams) (LoadRequest, error)
+	CountGLabsLoads(ctx context.Context, mobileNumber string) (int64, error)
+	CountJobRuns(ctx context.Context, arg CountJobRunsParams) (int64, error)
+	CountLoadRequests(ctx context.Context, arg CountLoadRequestsParams) (int64, error)
 	CountLufftStationMsg(ctx context.Context, stationID int64) (int64, error)
 	CountObservations(ctx context.Context, arg CountObservationsParams) (int64, error)
+	CountRawMessages(ctx context.Context, arg CountRawMessagesParams) (int64, error)
 	CountRoles(ctx context.Context) (int64, error)
+	CountSimCards(ctx context.Context, isFlagged bool) (int64, error)
+	CountSmsMessages(ctx context.Context, arg CountSmsMessagesParams) (int64, error)
+	CountSmsQueries(ctx context.Context, arg CountSmsQueriesParams) (int64, error)
+	CountSmsSubscriptions(ctx context.Context, arg CountSmsSubscriptionsParams) (int64, error)
+	CountStationDailyObservations(ctx context.Context, arg CountStationDailyObservationsParams) (int64, error)
+	CountStationHealthAlerts(ctx context.Context, arg CountStationHealthAlertsParams) (int64, error)
+	CountStationHealths(ctx context.Context, arg CountStationHealthsParams) (int64, error)
+	CountStationHourlyObservations(ctx context.Context, arg CountStationHourlyObservationsParams) (int64, error)
+	CountStationMoObservations(ctx context.Context, arg CountStationMoObservationsParams) (int64, error)
 	CountStationObservations(ctx context.Context, arg CountStationObservationsParams) (int64, error)
 	CountStations(ctx context.Context, status pgtype.Text) (int64, error)
 	CountStationsWithinBBox(ctx context.Context, arg CountStationsWithinBBoxParams) (int64, error)
@@ -24,52 +51,128 @@ type Querier interface {
 	CountUsers(ctx context.Context) (int64, error)
 	CreateCurrentObservation(ctx context.Context, arg CreateCurrentObservationParams) (ObservationsCurrent, error)
 	CreateGLabsLoad(ctx context.Context, arg CreateGLabsLoadParams) (GlabsLoad, error)
+	CreateJobRun(ctx context.Context, arg CreateJobRunParams) (JobRun, error)
+	CreateLoadRequest(ctx context.Context, arg CreateLoadRequestParams) (LoadRequest, error)
+	CreateObservationQcFlag(ctx context.Context, arg CreateObservationQcFlagParams) (ObservationsQcFlag, error)
+	CreateRawMessage(ctx context.Context, arg CreateRawMessageParams) (RawMessage, error)
 	CreateRole(ctx context.Context, arg CreateRoleParams) (Role, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateSimAccessToken(ctx context.Context, arg CreateSimAccessTokenParams) (SimAccessToken, error)
 	CreateSimCard(ctx context.Context, arg CreateSimCardParams) (SimCard, error)
+	CreateSmsMessage(ctx context.Context, arg CreateSmsMessageParams) (SmsMessage, error)
+	CreateSmsQuery(ctx context.Context, arg CreateSmsQueryParams) (SmsQuery, error)
 	CreateStation(ctx context.Context, arg CreateStationParams) (ObservationsStation, error)
 	CreateStationHealth(ctx context.Context, arg CreateStationHealthParams) (ObservationsStationhealth, error)
+	CreateStationHealthAlert(ctx context.Context, arg CreateStationHealthAlertParams) (ObservationsStationhealthAlert, error)
+	CreateStationHealthIfNotExists(ctx context.Context, arg CreateStationHealthIfNotExistsParams) (ObservationsStationhealth, error)
+	CreateStationMoObservation(ctx context.Context, arg CreateStationMoObservationParams) (ObservationsMoObservation, error)
 	CreateStationObservation(ctx context.Context, arg CreateStationObservationParams) (ObservationsObservation, error)
+	CreateStationObservationIfNotExists(ctx context.Context, arg CreateStationObservationIfNotExistsParams) (ObservationsObservation, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteLoadRequest(ctx context.Context, id int64) error
+	DeleteObservationQcFlags(ctx context.Context, observationID int64) error
 	DeleteRole(ctx context.Context, id int64) error
 	DeleteSession(ctx context.Context, id uuid.UUID) error
 	DeleteSimAccessToken(ctx context.Context, accessToken string) error
+	DeleteSimCard(ctx context.Context, mobileNumber string) error
+	DeleteSmsSubscription(ctx context.Context, id int64) error
 	DeleteStation(ctx context.Context, id int64) error
+	DeleteStationCredential(ctx context.Context, stationID int64) error
 	DeleteStationHealth(ctx context.Context, arg DeleteStationHealthParams) error
+	DeleteStationMoObservation(ctx context.Context, arg DeleteStationMoObservationParams) error
 	DeleteStationObservation(ctx context.Context, arg DeleteStationObservationParams) error
+	DeleteStationUploadKey(ctx context.Context, stationID int64) error
 	DeleteUser(ctx context.Context, id int64) error
+	FailRunningJobRuns(ctx context.Context, arg FailRunningJobRunsParams) error
+	FindStation(ctx context.Context, search string) (ObservationsStation, error)
+	FinishJobRun(ctx context.Context, arg FinishJobRunParams) (JobRun, error)
+	FinishSmsMessageAttempt(ctx context.Context, arg FinishSmsMessageAttemptParams) (SmsMessage, error)
+	GetJobRun(ctx context.Context, id int64) (JobRun, error)
+	GetLatestSimAccessToken(ctx context.Context, arg GetLatestSimAccessTokenParams) (SimAccessToken, error)
+	GetLatestStationHealth(ctx context.Context, stationID int64) (ObservationsStationhealth, error)
+	GetLatestStationMoObservation(ctx context.Context, stationID int64) (ObservationsMoObservation, error)
 	GetLatestStationObservation(ctx context.Context, id int64) (GetLatestStationObservationRow, error)
+	GetLoadRequestByKey(ctx context.Context, idempotencyKey string) (LoadRequest, error)
 	GetNearestLatestStationObservation(ctx context.Context, arg GetNearestLatestStationObservationParams) (GetNearestLatestStationObservationRow, error)
+	GetRawMessage(ctx context.Context, id int64) (RawMessage, error)
 	GetRole(ctx context.Context, id int64) (Role, error)
 	GetRoleByName(ctx context.Context, name string) (Role, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetSimAccessToken(ctx context.Context, accessToken string) (SimAccessToken, error)
 	GetSimCard(ctx context.Context, mobileNumber string) (SimCard, error)
+	GetSmsMessage(ctx context.Context, id int64) (SmsMessage, error)
 	GetStation(ctx context.Context, id int64) (ObservationsStation, error)
 	GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (ObservationsStation, error)
+	GetStationCredential(ctx context.Context, stationID int64) (ObservationsStationcredential, error)
 	GetStationHealth(ctx context.Context, arg GetStationHealthParams) (ObservationsStationhealth, error)
+	GetStationHealthAlert(ctx context.Context, arg GetStationHealthAlertParams) (ObservationsStationhealthAlert, error)
+	GetStationMoObservation(ctx context.Context, arg GetStationMoObservationParams) (ObservationsMoObservation, error)
 	GetStationObservation(ctx context.Context, arg GetStationObservationParams) (ObservationsObservation, error)
+	GetStationUploadKey(ctx context.Context, uploadID string) (ObservationsStationuploadkey, error)
 	GetUser(ctx context.Context, id int64) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
 	InsertCurrentObservations(ctx context.Context) ([]ObservationsCurrent, error)
+	ListActiveStationHealthAlerts(ctx context.Context, stationID int64) ([]ObservationsStationhealthAlert, error)
+	ListDailySummarySubscriptions(ctx context.Context, tokenType string) ([]SmsSubscription, error)
+	ListGLabsLoads(ctx context.Context, arg ListGLabsLoadsParams) ([]GlabsLoad, error)
+	ListJobRuns(ctx context.Context, arg ListJobRunsParams) ([]JobRun, error)
 	ListLatestObservations(ctx context.Context) ([]ListLatestObservationsRow, error)
+	ListLoadRequests(ctx context.Context, arg ListLoadRequestsParams) ([]LoadRequest, error)
 	ListLufftStationMsg(ctx context.Context, arg ListLufftStationMsgParams) ([]ListLufftStationMsgRow, error)
+	ListMoObservationQcFlags(ctx context.Context, moObservationID int64) ([]ObservationsMoQcFlag, error)
+	ListObservationQcFlags(ctx context.Context, observationID int64) ([]ObservationsQcFlag, error)
 	ListObservations(ctx context.Context, arg ListObservationsParams) ([]ObservationsObservation, error)
+	ListObservationsForQc(ctx context.Context, arg ListObservationsForQcParams) ([]ObservationsObservation, error)
+	ListPreviousStationMoObservations(ctx context.Context, arg ListPreviousStationMoObservationsParams) ([]ObservationsMoObservation, error)
+	ListPreviousStationObservations(ctx context.Context, arg ListPreviousStationObservationsParams) ([]ObservationsObservation, error)
+	ListProvinceStationIDs(ctx context.Context, province string) ([]int64, error)
+	ListRawMessages(ctx context.Context, arg ListRawMessagesParams) ([]RawMessage, error)
+	ListRawMessagesByIDs(ctx context.Context, ids []int64) ([]RawMessage, error)
 	ListRoles(ctx context.Context, arg ListRolesParams) ([]Role, error)
+	ListSilentStationSims(ctx context.Context, arg ListSilentStationSimsParams) ([]ListSilentStationSimsRow, error)
+	ListSimCardLastLoads(ctx context.Context) ([]ListSimCardLastLoadsRow, error)
+	ListSimCards(ctx context.Context, arg ListSimCardsParams) ([]ListSimCardsRow, error)
+	ListSmsMessages(ctx context.Context, arg ListSmsMessagesParams) ([]SmsMessage, error)
+	ListSmsQueries(ctx context.Context, arg ListSmsQueriesParams) ([]SmsQuery, error)
+	ListSmsSubscriptions(ctx context.Context, arg ListSmsSubscriptionsParams) ([]SmsSubscription, error)
+	ListStationAlertSubscriptions(ctx context.Context, arg ListStationAlertSubscriptionsParams) ([]SmsSubscription, error)
+	ListStationDailyObservations(ctx context.Context, arg ListStationDailyObservationsParams) ([]ObservationsDeriveddaily, error)
+	ListStationHealthAlerts(ctx context.Context, arg ListStationHealthAlertsParams) ([]ObservationsStationhealthAlert, error)
 	ListStationHealths(ctx context.Context, arg ListStationHealthsParams) ([]ObservationsStationhealth, error)
+	ListStationHealthsByDate(ctx context.Context, arg ListStationHealthsByDateParams) ([]ObservationsStationhealth, error)
+	ListStationHourlyObservations(ctx context.Context, arg ListStationHourlyObservationsParams) ([]ObservationsDerivedhourly, error)
+	ListStationMoObservations(ctx context.Context, arg ListStationMoObservationsParams) ([]ObservationsMoObservation, error)
+	ListStationMoObservationsForQc(ctx context.Context, arg ListStationMoObservationsForQcParams) ([]ObservationsMoObservation, error)
 	ListStationObservations(ctx context.Context, arg ListStationObservationsParams) ([]ObservationsObservation, error)
+	ListStationObservationsForQc(ctx context.Context, arg ListStationObservationsForQcParams) ([]ObservationsObservation, error)
 	ListStations(ctx context.Context, arg ListStationsParams) ([]ObservationsStation, error)
+	ListStationsAtRisk(ctx context.Context, expiresBefore pgtype.Timestamptz) ([]ListStationsAtRiskRow, error)
 	ListStationsWithinBBox(ctx context.Context, arg ListStationsWithinBBoxParams) ([]ObservationsStation, error)
 	ListStationsWithinRadius(ctx context.Context, arg ListStationsWithinRadiusParams) ([]ObservationsStation, error)
 	ListUserRoles(ctx context.Context, userID int64) ([]string, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	LockLoadRequests(ctx context.Context) error
+	ResolveStationHealthAlert(ctx context.Context, arg ResolveStationHealthAlertParams) (ObservationsStationhealthAlert, error)
+	StartSmsMessageAttempt(ctx context.Context, id int64) (SmsMessage, error)
+	SumLoadRequestCost(ctx context.Context, since pgtype.Timestamptz) (int64, error)
+	UpdateLoadRequestSent(ctx context.Context, arg UpdateLoadRequestSentParams) (LoadRequest, error)
+	UpdateObservationQcLevel(ctx context.Context, arg UpdateObservationQcLevelParams) (ObservationsObservation, error)
+	UpdateRawMessageOutcome(ctx context.Context, arg UpdateRawMessageOutcomeParams) (RawMessage, error)
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
+	UpdateSimCard(ctx context.Context, arg UpdateSimCardParams) (SimCard, error)
+	UpdateSimCardLoad(ctx context.Context, arg UpdateSimCardLoadParams) error
 	UpdateStation(ctx context.Context, arg UpdateStationParams) (ObservationsStation, error)
 	UpdateStationHealth(ctx context.Context, arg UpdateStationHealthParams) (ObservationsStationhealth, error)
+	UpdateStationMoObservation(ctx context.Context, arg UpdateStationMoObservationParams) (ObservationsMoObservation, error)
 	UpdateStationObservation(ctx context.Context, arg UpdateStationObservationParams) (ObservationsObservation, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpsertDailyObservations(ctx context.Context, arg UpsertDailyObservationsParams) (int64, error)
+	UpsertHourlyObservations(ctx context.Context, arg UpsertHourlyObservationsParams) (int64, error)
+	UpsertSmsSubscription(ctx context.Context, arg UpsertSmsSubscriptionParams) (SmsSubscription, error)
+	UpsertStationCredential(ctx context.Context, arg UpsertStationCredentialParams) (ObservationsStationcredential, error)
+	UpsertStationMoObservation(ctx context.Context, arg UpsertStationMoObservationParams) (ObservationsMoObservation, error)
+	UpsertStationUploadKey(ctx context.Context, arg UpsertStationUploadKeyParams) (ObservationsStationuploadkey, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,160 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type RawMessageTestSuite struct {
+	suite.Suite
+}
+
+func TestRawMessageTestSuite(t *testing.T) {
+	suite.Run(t, new(RawMessageTestSuite))
+}
+
+func (ts *RawMessageTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *RawMessageTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *RawMessageTestSuite) TestCreateRawMessage() {
+	createRandomRawMessage(ts.T(), util.RandomMobileNumber())
+}
+
+func (ts *RawMessageTestSuite) TestListRawMessages() {
+	t := ts.T()
+	sender := util.RandomMobileNumber()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomRawMessage(t, sender)
+	}
+	createRandomRawMessage(t, util.RandomMobileNumber())
+
+	msgs, err := testStore.ListRawMessages(context.Background(), ListRawMessagesParams{
+		IsSender: true,
+		Sender:   sender,
+		Limit:    pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	for _, m := range msgs {
+		require.Equal(t, sender, m.Sender)
+	}
+
+	count, err := testStore.CountRawMessages(context.Background(), CountRawMessagesParams{
+		IsSender: true,
+		Sender:   sender,
+		IsStatus: true,
+		Status:   "RECEIVED",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+
+	byIDs, err := testStore.ListRawMessagesByIDs(context.Background(), []int64{msgs[0].ID, msgs[1].ID})
+	require.NoError(t, err)
+	require.Len(t, byIDs, 2)
+}
+
+func (ts *RawMessageTestSuite) TestUpdateRawMessageOutcome() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	msg := createRandomRawMessage(t, util.RandomMobileNumber())
+
+	stored, err := testStore.UpdateRawMessageOutcome(context.Background(), UpdateRawMessageOutcomeParams{
+		ID:        msg.ID,
+		Status:    "STORED",
+		StationID: pgtype.Int8{Int64: station.ID, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "STORED", stored.Status)
+	require.Equal(t, station.ID, stored.StationID.Int64)
+	require.Zero(t, stored.ReplayCount)
+	require.False(t, stored.ReplayedAt.Valid)
+
+	replayed, err := testStore.UpdateRawMessageOutcome(context.Background(), UpdateRawMessageOutcomeParams{
+		ID:       msg.ID,
+		Status:   "DUPLICATE",
+		Error:    util.ToPgText("observation already exists"),
+		IsReplay: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "DUPLICATE", replayed.Status)
+	require.Equal(t, station.ID, replayed.StationID.Int64)
+	require.Equal(t, "observation already exists", replayed.Error.String)
+	require.Equal(t, int32(1), replayed.ReplayCount)
+	require.True(t, replayed.ReplayedAt.Valid)
+}
+
+func (ts *RawMessageTestSuite) TestUpdateRawMessageOutcomeQueryOnce() {
+	t := ts.T()
+	sender := util.RandomMobileNumber()
+	messageID := util.ToPgText(util.RandomString(16))
+
+	// The gateway delivers the same query twice.
+	ids := make([]int64, 2)
+	for i := range ids {
+		msg, err := testStore.CreateRawMessage(context.Background(), CreateRawMessageParams{
+			Provider:  "GLABS",
+			MessageID: messageID,
+			Sender:    sender,
+			Body:      "HELP",
+			Status:    "RECEIVED",
+		})
+		require.NoError(t, err)
+		ids[i] = msg.ID
+	}
+
+	_, err := testStore.UpdateRawMessageOutcome(context.Background(), UpdateRawMessageOutcomeParams{
+		ID:     ids[0],
+		Status: "QUERY",
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.UpdateRawMessageOutcome(context.Background(), UpdateRawMessageOutcomeParams{
+		ID:     ids[1],
+		Status: "QUERY",
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	// Messages without an ID are not deduplicated.
+	for i := 0; i < 2; i++ {
+		msg := createRandomRawMessage(t, sender)
+		_, err := testStore.UpdateRawMessageOutcome(context.Background(), UpdateRawMessageOutcomeParams{
+			ID:     msg.ID,
+			Status: "QUERY",
+		})
+		require.NoError(t, err)
+	}
+}
+
+func createRandomRawMessage(t *testing.T, sender string) RawMessage {
+	arg := CreateRawMessageParams{
+		Provider: "GLABS",
+		Sender:   sender,
+		Body:     util.RandomString(32),
+		Status:   "RECEIVED",
+	}
+
+	msg, err := testStore.CreateRawMessage(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, msg)
+
+	require.Equal(t, arg.Provider, msg.Provider)
+	require.Equal(t, arg.Sender, msg.Sender)
+	require.Equal(t, arg.Body, msg.Body)
+	require.Equal(t, arg.Status, msg.Status)
+	require.True(t, msg.ReceivedAt.Valid)
+
+	return msg
+}
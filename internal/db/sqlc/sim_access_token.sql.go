@@ -47,6 +47,31 @@ func (q *Queries) DeleteSimAccessToken(ctx context.Context, accessToken string)
 	return err
 }
 
+const getLatestSimAccessToken = `-- name: GetLatestSimAccessToken :one
+SELECT access_token, type, mobile_number, created_at, updated_at FROM sim_access_tokens
+WHERE mobile_number = $1 AND type = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestSimAccessTokenParams struct {
+	MobileNumber string `json:"mobile_number"`
+	Type         string `json:"type"`
+}
+
+func (q *Queries) GetLatestSimAccessToken(ctx context.Context, arg GetLatestSimAccessTokenParams) (SimAccessToken, error) {
+	row := q.db.QueryRow(ctx, getLatestSimAccessToken, arg.MobileNumber, arg.Type)
+	var i SimAccessToken
+	err := row.Scan(
+		&i.AccessToken,
+		&i.Type,
+		&i.MobileNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getSimAccessToken = `-- name: GetSimAccessToken :one
 SELECT access_token, type, mobile_number, created_at, updated_at FROM sim_access_tokens
 WHERE access_token = $1 LIMIT 1
@@ -50,6 +50,26 @@ func (ts *SimAccTknTestSuite) TestGetSimAccessToken() {
 	require.WithinDuration(t, accTkn.UpdatedAt.Time, gotAccTkn.UpdatedAt.Time, time.Second)
 }
 
+func (ts *SimAccTknTestSuite) TestGetLatestSimAccessToken() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+	createRandomSimAccessToken(t, simCard.MobileNumber)
+	accTkn := createRandomSimAccessToken(t, simCard.MobileNumber)
+
+	gotAccTkn, err := testStore.GetLatestSimAccessToken(context.Background(), GetLatestSimAccessTokenParams{
+		MobileNumber: simCard.MobileNumber,
+		Type:         accTkn.Type,
+	})
+	require.NoError(t, err)
+	require.Equal(t, accTkn.AccessToken, gotAccTkn.AccessToken)
+
+	_, err = testStore.GetLatestSimAccessToken(context.Background(), GetLatestSimAccessTokenParams{
+		MobileNumber: simCard.MobileNumber,
+		Type:         accTkn.Type + "X",
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
 func (ts *SimAccTknTestSuite) TestDeleteSimAccessToken() {
 	t := ts.T()
 	simCard := createRandomSimCard(t)
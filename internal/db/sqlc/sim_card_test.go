@@ -33,6 +33,143 @@ func (ts *SimCardTestSuite) TestCreateSimCard() {
 	createRandomSimCard(ts.T())
 }
 
+func (ts *SimCardTestSuite) TestListSimCards() {
+	t := ts.T()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomSimCard(t)
+	}
+	station := createRandomStation(t, false)
+	_, err := testStore.CreateSimCard(context.Background(), CreateSimCardParams{
+		MobileNumber: station.MobileNumber.String,
+	})
+	require.NoError(t, err)
+
+	sims, err := testStore.ListSimCards(context.Background(), ListSimCardsParams{
+		Limit: pgtype.Int4{Int32: int32(n + 1), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, sims, n+1)
+
+	var linked int
+	for _, sim := range sims {
+		if sim.StationID.Valid {
+			linked++
+			require.Equal(t, station.ID, sim.StationID.Int64)
+			require.Equal(t, station.Name, sim.StationName.String)
+		}
+	}
+	require.Equal(t, 1, linked)
+
+	count, err := testStore.CountSimCards(context.Background(), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(n+1), count)
+}
+
+func (ts *SimCardTestSuite) TestUpdateSimCard() {
+	t := ts.T()
+	sim := createRandomSimCard(t)
+
+	updated, err := testStore.UpdateSimCard(context.Background(), UpdateSimCardParams{
+		MobileNumber: sim.MobileNumber,
+		Type:         pgtype.Text{String: "GLOBE", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "GLOBE", updated.Type.String)
+	require.False(t, updated.UpdatedAt.Time.IsZero())
+
+	_, err = testStore.UpdateSimCard(context.Background(), UpdateSimCardParams{
+		MobileNumber: util.RandomMobileNumber(),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *SimCardTestSuite) TestDeleteSimCard() {
+	t := ts.T()
+	sim := createRandomSimCard(t)
+
+	err := testStore.DeleteSimCard(context.Background(), sim.MobileNumber)
+	require.NoError(t, err)
+
+	_, err = testStore.GetSimCard(context.Background(), sim.MobileNumber)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *SimCardTestSuite) TestSimCardLoad() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	sim, err := testStore.CreateSimCard(context.Background(), CreateSimCardParams{
+		MobileNumber: station.MobileNumber.String,
+	})
+	require.NoError(t, err)
+	createRandomSimCard(t)
+
+	load := createRandomGlabsLoad(t, sim.MobileNumber)
+	_, err = testStore.CreateGLabsLoad(context.Background(), CreateGLabsLoadParams{
+		Promo:        pgtype.Text{String: "GOSURF50", Valid: true},
+		Status:       pgtype.Text{String: "SUCCESS", Valid: true},
+		MobileNumber: sim.MobileNumber,
+	})
+	require.NoError(t, err)
+
+	lastLoads, err := testStore.ListSimCardLastLoads(context.Background())
+	require.NoError(t, err)
+	require.Len(t, lastLoads, 2)
+	for _, l := range lastLoads {
+		if l.MobileNumber == sim.MobileNumber {
+			require.Equal(t, "GOSURF50", l.Promo.String)
+			require.True(t, l.LoadedAt.Valid)
+		} else {
+			require.False(t, l.LoadedAt.Valid)
+		}
+	}
+
+	expiresAt := time.Now().Add(-time.Hour)
+	err = testStore.UpdateSimCardLoad(context.Background(), UpdateSimCardLoadParams{
+		MobileNumber:  sim.MobileNumber,
+		LoadExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		IsExpired:     true,
+	})
+	require.NoError(t, err)
+
+	flagged, err := testStore.GetSimCard(context.Background(), sim.MobileNumber)
+	require.NoError(t, err)
+	require.WithinDuration(t, expiresAt, flagged.LoadExpiresAt.Time, time.Second)
+	require.True(t, flagged.LoadFlaggedAt.Valid)
+
+	atRisk, err := testStore.ListStationsAtRisk(context.Background(), pgtype.Timestamptz{Time: time.Now(), Valid: true})
+	require.NoError(t, err)
+	require.Len(t, atRisk, 1)
+	require.Equal(t, station.ID, atRisk[0].StationID)
+	require.Equal(t, sim.MobileNumber, atRisk[0].MobileNumber)
+
+	count, err := testStore.CountSimCards(context.Background(), true)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	loads, err := testStore.ListGLabsLoads(context.Background(), ListGLabsLoadsParams{
+		MobileNumber: sim.MobileNumber,
+		Limit:        pgtype.Int4{Int32: 5, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, loads, 2)
+	require.Equal(t, load.ID, loads[1].ID)
+
+	loadCount, err := testStore.CountGLabsLoads(context.Background(), sim.MobileNumber)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), loadCount)
+
+	err = testStore.UpdateSimCardLoad(context.Background(), UpdateSimCardLoadParams{
+		MobileNumber:  sim.MobileNumber,
+		LoadExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+	})
+	require.NoError(t, err)
+
+	reloaded, err := testStore.GetSimCard(context.Background(), sim.MobileNumber)
+	require.NoError(t, err)
+	require.False(t, reloaded.LoadFlaggedAt.Valid)
+}
+
 func createRandomSimCard(t *testing.T) SimCard {
 	simCard := randomSimCard()
 	arg := CreateSimCardParams{
@@ -11,13 +11,26 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countSimCards = `-- name: CountSimCards :one
+SELECT count(*) FROM sim_cards
+WHERE
+  (CASE WHEN $1::bool THEN load_flagged_at IS NOT NULL ELSE TRUE END)
+`
+
+func (q *Queries) CountSimCards(ctx context.Context, isFlagged bool) (int64, error) {
+	row := q.db.QueryRow(ctx, countSimCards, isFlagged)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createSimCard = `-- name: CreateSimCard :one
 INSERT INTO sim_cards (
   mobile_number,
   type
 ) VALUES (
   $1, $2
-) RETURNING mobile_number, type, created_at, updated_at
+) RETURNING mobile_number, type, created_at, updated_at, load_expires_at, load_flagged_at
 `
 
 type CreateSimCardParams struct {
@@ -33,12 +46,23 @@ func (q *Queries) CreateSimCard(ctx context.Context, arg CreateSimCardParams) (S
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LoadExpiresAt,
+		&i.LoadFlaggedAt,
 	)
 	return i, err
 }
 
+const deleteSimCard = `-- name: DeleteSimCard :exec
+DELETE FROM sim_cards WHERE mobile_number = $1
+`
+
+func (q *Queries) DeleteSimCard(ctx context.Context, mobileNumber string) error {
+	_, err := q.db.Exec(ctx, deleteSimCard, mobileNumber)
+	return err
+}
+
 const getSimCard = `-- name: GetSimCard :one
-SELECT mobile_number, type, created_at, updated_at FROM sim_cards
+SELECT mobile_number, type, created_at, updated_at, load_expires_at, load_flagged_at FROM sim_cards
 WHERE mobile_number = $1 LIMIT 1
 `
 
@@ -50,6 +74,208 @@ func (q *Queries) GetSimCard(ctx context.Context, mobileNumber string) (SimCard,
 		&i.Type,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LoadExpiresAt,
+		&i.LoadFlaggedAt,
+	)
+	return i, err
+}
+
+const listSimCardLastLoads = `-- name: ListSimCardLastLoads :many
+SELECT
+  sim.mobile_number, sim.created_at, sim.load_flagged_at,
+  last_load.promo, last_load.created_at AS loaded_at
+FROM sim_cards sim
+  LEFT JOIN LATERAL (
+    SELECT promo, created_at FROM glabs_load
+    WHERE glabs_load.mobile_number = sim.mobile_number AND glabs_load.status = 'SUCCESS'
+    ORDER BY created_at DESC
+    LIMIT 1
+  ) last_load ON TRUE
+ORDER BY sim.mobile_number
+`
+
+type ListSimCardLastLoadsRow struct {
+	MobileNumber  string             `json:"mobile_number"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	LoadFlaggedAt pgtype.Timestamptz `json:"load_flagged_at"`
+	Promo         pgtype.Text        `json:"promo"`
+	LoadedAt      pgtype.Timestamptz `json:"loaded_at"`
+}
+
+func (q *Queries) ListSimCardLastLoads(ctx context.Context) ([]ListSimCardLastLoadsRow, error) {
+	rows, err := q.db.Query(ctx, listSimCardLastLoads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSimCardLastLoadsRow{}
+	for rows.Next() {
+		var i ListSimCardLastLoadsRow
+		if err := rows.Scan(
+			&i.MobileNumber,
+			&i.CreatedAt,
+			&i.LoadFlaggedAt,
+			&i.Promo,
+			&i.LoadedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSimCards = `-- name: ListSimCards :many
+SELECT
+  sim.mobile_number, sim.type, sim.load_expires_at, sim.load_flagged_at, sim.created_at,
+  stn.id AS station_id, stn.name AS station_name
+FROM sim_cards sim
+  LEFT JOIN observations_station stn ON stn.mobile_number = sim.mobile_number
+WHERE
+  (CASE WHEN $1::bool THEN sim.load_flagged_at IS NOT NULL ELSE TRUE END)
+ORDER BY sim.mobile_number
+LIMIT $3
+OFFSET $2
+`
+
+type ListSimCardsParams struct {
+	IsFlagged bool        `json:"is_flagged"`
+	Offset    int32       `json:"offset"`
+	Limit     pgtype.Int4 `json:"limit"`
+}
+
+type ListSimCardsRow struct {
+	MobileNumber  string             `json:"mobile_number"`
+	Type          pgtype.Text        `json:"type"`
+	LoadExpiresAt pgtype.Timestamptz `json:"load_expires_at"`
+	LoadFlaggedAt pgtype.Timestamptz `json:"load_flagged_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	StationID     pgtype.Int8        `json:"station_id"`
+	StationName   pgtype.Text        `json:"station_name"`
+}
+
+func (q *Queries) ListSimCards(ctx context.Context, arg ListSimCardsParams) ([]ListSimCardsRow, error) {
+	rows, err := q.db.Query(ctx, listSimCards, arg.IsFlagged, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSimCardsRow{}
+	for rows.Next() {
+		var i ListSimCardsRow
+		if err := rows.Scan(
+			&i.MobileNumber,
+			&i.Type,
+			&i.LoadExpiresAt,
+			&i.LoadFlaggedAt,
+			&i.CreatedAt,
+			&i.StationID,
+			&i.StationName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStationsAtRisk = `-- name: ListStationsAtRisk :many
+SELECT
+  stn.id AS station_id, stn.name AS station_name, stn.status AS station_status,
+  sim.mobile_number, sim.load_expires_at, sim.load_flagged_at
+FROM sim_cards sim
+  JOIN observations_station stn ON stn.mobile_number = sim.mobile_number
+WHERE sim.load_flagged_at IS NOT NULL
+  OR sim.load_expires_at < $1::timestamptz
+ORDER BY sim.load_expires_at NULLS FIRST, stn.id
+`
+
+type ListStationsAtRiskRow struct {
+	StationID     int64              `json:"station_id"`
+	StationName   string             `json:"station_name"`
+	StationStatus pgtype.Text        `json:"station_status"`
+	MobileNumber  string             `json:"mobile_number"`
+	LoadExpiresAt pgtype.Timestamptz `json:"load_expires_at"`
+	LoadFlaggedAt pgtype.Timestamptz `json:"load_flagged_at"`
+}
+
+func (q *Queries) ListStationsAtRisk(ctx context.Context, expiresBefore pgtype.Timestamptz) ([]ListStationsAtRiskRow, error) {
+	rows, err := q.db.Query(ctx, listStationsAtRisk, expiresBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStationsAtRiskRow{}
+	for rows.Next() {
+		var i ListStationsAtRiskRow
+		if err := rows.Scan(
+			&i.StationID,
+			&i.StationName,
+			&i.StationStatus,
+			&i.MobileNumber,
+			&i.LoadExpiresAt,
+			&i.LoadFlaggedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSimCard = `-- name: UpdateSimCard :one
+UPDATE sim_cards
+SET
+  type = COALESCE($1, type),
+  updated_at = now()
+WHERE mobile_number = $2
+RETURNING mobile_number, type, created_at, updated_at, load_expires_at, load_flagged_at
+`
+
+type UpdateSimCardParams struct {
+	Type         pgtype.Text `json:"type"`
+	MobileNumber string      `json:"mobile_number"`
+}
+
+func (q *Queries) UpdateSimCard(ctx context.Context, arg UpdateSimCardParams) (SimCard, error) {
+	row := q.db.QueryRow(ctx, updateSimCard, arg.Type, arg.MobileNumber)
+	var i SimCard
+	err := row.Scan(
+		&i.MobileNumber,
+		&i.Type,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LoadExpiresAt,
+		&i.LoadFlaggedAt,
 	)
 	return i, err
 }
+
+const updateSimCardLoad = `-- name: UpdateSimCardLoad :exec
+UPDATE sim_cards
+SET
+  load_expires_at = $1,
+  load_flagged_at = CASE WHEN $2::bool THEN COALESCE(load_flagged_at, now()) ELSE NULL END,
+  updated_at = now()
+WHERE mobile_number = $3
+`
+
+type UpdateSimCardLoadParams struct {
+	LoadExpiresAt pgtype.Timestamptz `json:"load_expires_at"`
+	IsExpired     bool               `json:"is_expired"`
+	MobileNumber  string             `json:"mobile_number"`
+}
+
+func (q *Queries) UpdateSimCardLoad(ctx context.Context, arg UpdateSimCardLoadParams) error {
+	_, err := q.db.Exec(ctx, updateSimCardLoad, arg.LoadExpiresAt, arg.IsExpired, arg.MobileNumber)
+	return err
+}
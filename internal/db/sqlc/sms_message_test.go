@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SmsMessageTestSuite struct {
+	suite.Suite
+}
+
+func TestSmsMessageTestSuite(t *testing.T) {
+	suite.Run(t, new(SmsMessageTestSuite))
+}
+
+func (ts *SmsMessageTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *SmsMessageTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *SmsMessageTestSuite) TestCreateSmsMessage() {
+	createRandomSmsMessage(ts.T(), util.RandomMobileNumber())
+}
+
+func (ts *SmsMessageTestSuite) TestSmsMessageDelivery() {
+	t := ts.T()
+	msg := createRandomSmsMessage(t, util.RandomMobileNumber())
+
+	sending, err := testStore.StartSmsMessageAttempt(context.Background(), msg.ID)
+	require.NoError(t, err)
+	require.Equal(t, "SENDING", sending.Status)
+	require.Equal(t, int32(1), sending.Attempts)
+
+	// Only a queued message can be attempted.
+	_, err = testStore.StartSmsMessageAttempt(context.Background(), msg.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	queued, err := testStore.FinishSmsMessageAttempt(context.Background(), FinishSmsMessageAttemptParams{
+		ID:     msg.ID,
+		Status: "QUEUED",
+		Error:  pgtype.Text{String: "timeout", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "QUEUED", queued.Status)
+	require.Equal(t, "timeout", queued.Error.String)
+
+	_, err = testStore.StartSmsMessageAttempt(context.Background(), msg.ID)
+	require.NoError(t, err)
+
+	sent, err := testStore.FinishSmsMessageAttempt(context.Background(), FinishSmsMessageAttemptParams{
+		ID:     msg.ID,
+		Status: "SENT",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "SENT", sent.Status)
+	require.Equal(t, int32(2), sent.Attempts)
+	require.False(t, sent.Error.Valid)
+	require.True(t, sent.SentAt.Valid)
+
+	gotMsg, err := testStore.GetSmsMessage(context.Background(), msg.ID)
+	require.NoError(t, err)
+	require.Equal(t, sent.Status, gotMsg.Status)
+}
+
+func (ts *SmsMessageTestSuite) TestCancelPendingSmsMessages() {
+	t := ts.T()
+	mobileNumber := util.RandomMobileNumber()
+	queued := createRandomSmsMessage(t, mobileNumber)
+	inFlight := createRandomSmsMessage(t, mobileNumber)
+	other := createRandomSmsMessage(t, util.RandomMobileNumber())
+
+	_, err := testStore.StartSmsMessageAttempt(context.Background(), inFlight.ID)
+	require.NoError(t, err)
+
+	n, err := testStore.CancelPendingSmsMessages(context.Background(), CancelPendingSmsMessagesParams{
+		MobileNumber: mobileNumber,
+		Reason:       pgtype.Text{String: "unsubscribed", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	gotMsg, err := testStore.GetSmsMessage(context.Background(), queued.ID)
+	require.NoError(t, err)
+	require.Equal(t, "CANCELLED", gotMsg.Status)
+	require.Equal(t, "unsubscribed", gotMsg.Error.String)
+
+	gotMsg, err = testStore.GetSmsMessage(context.Background(), other.ID)
+	require.NoError(t, err)
+	require.Equal(t, "QUEUED", gotMsg.Status)
+
+	// A failure of the in-flight send does not override the cancellation...
+	_, err = testStore.FinishSmsMessageAttempt(context.Background(), FinishSmsMessageAttemptParams{
+		ID:     inFlight.ID,
+		Status: "FAILED",
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// ...but a delivery is still recorded.
+	sent, err := testStore.FinishSmsMessageAttempt(context.Background(), FinishSmsMessageAttemptParams{
+		ID:     inFlight.ID,
+		Status: "SENT",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "SENT", sent.Status)
+}
+
+func (ts *SmsMessageTestSuite) TestListSmsMessages() {
+	t := ts.T()
+	mobileNumber := util.RandomMobileNumber()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomSmsMessage(t, mobileNumber)
+	}
+	createRandomSmsMessage(t, util.RandomMobileNumber())
+
+	msgs, err := testStore.ListSmsMessages(context.Background(), ListSmsMessagesParams{
+		IsMobileNumber: true,
+		MobileNumber:   mobileNumber,
+		IsStatus:       true,
+		Status:         "QUEUED",
+		Limit:          pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+
+	count, err := testStore.CountSmsMessages(context.Background(), CountSmsMessagesParams{
+		IsMobileNumber: true,
+		MobileNumber:   mobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+}
+
+func createRandomSmsMessage(t *testing.T, mobileNumber string) SmsMessage {
+	arg := CreateSmsMessageParams{
+		MobileNumber: mobileNumber,
+		Kind:         "ALERT",
+		Message:      util.RandomString(32),
+	}
+
+	msg, err := testStore.CreateSmsMessage(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, msg.ID)
+	require.Equal(t, arg.MobileNumber, msg.MobileNumber)
+	require.Equal(t, arg.Kind, msg.Kind)
+	require.Equal(t, arg.Message, msg.Message)
+	require.Equal(t, "QUEUED", msg.Status)
+	require.Zero(t, msg.Attempts)
+	require.False(t, msg.SentAt.Valid)
+
+	return msg
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SmsQueryTestSuite struct {
+	suite.Suite
+}
+
+func TestSmsQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(SmsQueryTestSuite))
+}
+
+func (ts *SmsQueryTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *SmsQueryTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *SmsQueryTestSuite) TestCreateSmsQuery() {
+	createRandomSmsQuery(ts.T(), util.RandomMobileNumber(), "WEATHER")
+}
+
+func (ts *SmsQueryTestSuite) TestListSmsQueries() {
+	t := ts.T()
+	mobileNumber := util.RandomMobileNumber()
+	n := 5
+	for i := 0; i < n; i++ {
+		createRandomSmsQuery(t, mobileNumber, "WEATHER")
+	}
+	createRandomSmsQuery(t, mobileNumber, "HELP")
+	createRandomSmsQuery(t, util.RandomMobileNumber(), "WEATHER")
+
+	queries, err := testStore.ListSmsQueries(context.Background(), ListSmsQueriesParams{
+		IsMobileNumber: true,
+		MobileNumber:   mobileNumber,
+		IsKeyword:      true,
+		Keyword:        "WEATHER",
+		Limit:          pgtype.Int4{Int32: 3, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, queries, 3)
+	for _, q := range queries {
+		require.Equal(t, mobileNumber, q.MobileNumber)
+		require.Equal(t, "WEATHER", q.Keyword)
+	}
+
+	count, err := testStore.CountSmsQueries(context.Background(), CountSmsQueriesParams{
+		IsMobileNumber: true,
+		MobileNumber:   mobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n+1), count)
+}
+
+func createRandomSmsQuery(t *testing.T, mobileNumber, keyword string) SmsQuery {
+	arg := CreateSmsQueryParams{
+		MobileNumber: mobileNumber,
+		Provider:     "GLABS",
+		Keyword:      keyword,
+		Message:      keyword + " " + util.RandomString(8),
+		Reply:        util.RandomString(32),
+		Parts:        1,
+		Status:       "SENT",
+	}
+
+	q, err := testStore.CreateSmsQuery(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, q)
+
+	require.Equal(t, arg.MobileNumber, q.MobileNumber)
+	require.Equal(t, arg.Keyword, q.Keyword)
+	require.Equal(t, arg.Reply, q.Reply)
+	require.Equal(t, arg.Status, q.Status)
+	require.False(t, q.Error.Valid)
+	require.True(t, q.CreatedAt.Valid)
+
+	return q
+}
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SmsSubscriptionTestSuite struct {
+	suite.Suite
+}
+
+func TestSmsSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, new(SmsSubscriptionTestSuite))
+}
+
+func (ts *SmsSubscriptionTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *SmsSubscriptionTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *SmsSubscriptionTestSuite) TestUpsertSmsSubscription() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+	station := createRandomStation(t, false)
+	sub := createRandomSmsSubscription(t, simCard.MobileNumber, station.ID)
+
+	gotSub, err := testStore.UpsertSmsSubscription(context.Background(), UpsertSmsSubscriptionParams{
+		MobileNumber: simCard.MobileNumber,
+		StationID:    station.ID,
+		Alerts:       false,
+		DailySummary: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sub.ID, gotSub.ID)
+	require.False(t, gotSub.Alerts)
+	require.True(t, gotSub.DailySummary)
+}
+
+func (ts *SmsSubscriptionTestSuite) TestListSmsSubscriptions() {
+	t := ts.T()
+	simCard := createRandomSimCard(t)
+	n := 5
+	for i := 0; i < n; i++ {
+		station := createRandomStation(t, false)
+		createRandomSmsSubscription(t, simCard.MobileNumber, station.ID)
+	}
+	createRandomSmsSubscription(t, createRandomSimCard(t).MobileNumber, createRandomStation(t, false).ID)
+
+	arg := ListSmsSubscriptionsParams{
+		IsMobileNumber: true,
+		MobileNumber:   simCard.MobileNumber,
+		Limit:          pgtype.Int4{Int32: 3, Valid: true},
+	}
+	subs, err := testStore.ListSmsSubscriptions(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, subs, 3)
+	for _, s := range subs {
+		require.Equal(t, simCard.MobileNumber, s.MobileNumber)
+	}
+
+	count, err := testStore.CountSmsSubscriptions(context.Background(), CountSmsSubscriptionsParams{
+		IsMobileNumber: true,
+		MobileNumber:   simCard.MobileNumber,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(n), count)
+}
+
+func (ts *SmsSubscriptionTestSuite) TestDeleteSmsSubscription() {
+	t := ts.T()
+	sub := createRandomSmsSubscription(t, createRandomSimCard(t).MobileNumber, createRandomStation(t, false).ID)
+
+	err := testStore.DeleteSmsSubscription(context.Background(), sub.ID)
+	require.NoError(t, err)
+
+	count, err := testStore.CountSmsSubscriptions(context.Background(), CountSmsSubscriptionsParams{})
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func (ts *SmsSubscriptionTestSuite) TestListStationAlertSubscriptions() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	subscribed := createRandomSimCard(t)
+	accTkn := createRandomSimAccessToken(t, subscribed.MobileNumber)
+	sub := createRandomSmsSubscription(t, subscribed.MobileNumber, station.ID)
+
+	// No access token, i.e. unsubscribed.
+	createRandomSmsSubscription(t, createRandomSimCard(t).MobileNumber, station.ID)
+
+	// Alerts turned off.
+	muted := createRandomSimCard(t)
+	createRandomSimAccessToken(t, muted.MobileNumber)
+	_, err := testStore.UpsertSmsSubscription(context.Background(), UpsertSmsSubscriptionParams{
+		MobileNumber: muted.MobileNumber,
+		StationID:    station.ID,
+		Alerts:       false,
+		DailySummary: true,
+	})
+	require.NoError(t, err)
+
+	subs, err := testStore.ListStationAlertSubscriptions(context.Background(), ListStationAlertSubscriptionsParams{
+		StationID: station.ID,
+		TokenType: accTkn.Type,
+	})
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, sub.ID, subs[0].ID)
+}
+
+func (ts *SmsSubscriptionTestSuite) TestListDailySummarySubscriptions() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	subscribed := createRandomSimCard(t)
+	accTkn := createRandomSimAccessToken(t, subscribed.MobileNumber)
+	sub := createRandomSmsSubscription(t, subscribed.MobileNumber, station.ID)
+
+	createRandomSmsSubscription(t, createRandomSimCard(t).MobileNumber, station.ID)
+
+	subs, err := testStore.ListDailySummarySubscriptions(context.Background(), accTkn.Type)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, sub.ID, subs[0].ID)
+}
+
+func createRandomSmsSubscription(t *testing.T, mobileNumber string, stationID int64) SmsSubscription {
+	arg := UpsertSmsSubscriptionParams{
+		MobileNumber: mobileNumber,
+		StationID:    stationID,
+		Alerts:       true,
+		DailySummary: true,
+	}
+
+	sub, err := testStore.UpsertSmsSubscription(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, sub.ID)
+	require.Equal(t, arg.MobileNumber, sub.MobileNumber)
+	require.Equal(t, arg.StationID, sub.StationID)
+	require.True(t, sub.Alerts)
+	require.True(t, sub.DailySummary)
+	require.True(t, sub.CreatedAt.Valid)
+
+	return sub
+}
@@ -95,14 +95,16 @@ INSERT INTO observations_station (
   province,
   region,
   address,
-  geom
+  geom,
+  timezone
 ) VALUES (
   $1, $17, $18, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, 
   CASE
     WHEN $18::real IS NOT NULL AND $17::real IS NOT NULL THEN ST_Point($18::real, $17::real, 4326)
     ELSE ST_GeomFromEWKT('POINT EMPTY')
-  END
-) RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom
+  END,
+  COALESCE($19, 'Asia/Manila')
+) RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone
 `
 
 type CreateStationParams struct {
@@ -124,6 +126,7 @@ type CreateStationParams struct {
 	Address       pgtype.Text   `json:"address"`
 	Lat           pgtype.Float4 `json:"lat"`
 	Lon           pgtype.Float4 `json:"lon"`
+	Timezone      pgtype.Text   `json:"timezone"`
 }
 
 func (q *Queries) CreateStation(ctx context.Context, arg CreateStationParams) (ObservationsStation, error) {
@@ -146,6 +149,7 @@ func (q *Queries) CreateStation(ctx context.Context, arg CreateStationParams) (O
 		arg.Address,
 		arg.Lat,
 		arg.Lon,
+		arg.Timezone,
 	)
 	var i ObservationsStation
 	err := row.Scan(
@@ -172,6 +176,7 @@ func (q *Queries) CreateStation(ctx context.Context, arg CreateStationParams) (O
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.Timezone,
 	)
 	return i, err
 }
@@ -185,8 +190,52 @@ func (q *Queries) DeleteStation(ctx context.Context, id int64) error {
 	return err
 }
 
+const findStation = `-- name: FindStation :one
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone FROM observations_station
+WHERE id::text = $1::text
+  OR lower(mo_station_id) = lower($1::text)
+  OR name ILIKE '%' || $1::text || '%'
+ORDER BY
+  (id::text = $1::text OR lower(mo_station_id) = lower($1::text)) DESC,
+  lower(name) = lower($1::text) DESC,
+  length(name), id
+LIMIT 1
+`
+
+func (q *Queries) FindStation(ctx context.Context, search string) (ObservationsStation, error) {
+	row := q.db.QueryRow(ctx, findStation, search)
+	var i ObservationsStation
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Lat,
+		&i.Lon,
+		&i.Elevation,
+		&i.DateInstalled,
+		&i.MoStationID,
+		&i.SmsSystemType,
+		&i.MobileNumber,
+		&i.StationType,
+		&i.StationType2,
+		&i.StationUrl,
+		&i.Status,
+		&i.LoggerVersion,
+		&i.PriorityLevel,
+		&i.ProviderID,
+		&i.Province,
+		&i.Region,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Geom,
+		&i.Timezone,
+	)
+	return i, err
+}
+
 const getStation = `-- name: GetStation :one
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone FROM observations_station
 WHERE id = $1 LIMIT 1
 `
 
@@ -217,12 +266,13 @@ func (q *Queries) GetStation(ctx context.Context, id int64) (ObservationsStation
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.Timezone,
 	)
 	return i, err
 }
 
 const getStationByMobileNumber = `-- name: GetStationByMobileNumber :one
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone FROM observations_station
 WHERE mobile_number = $1 LIMIT 1
 `
 
@@ -253,12 +303,39 @@ func (q *Queries) GetStationByMobileNumber(ctx context.Context, mobileNumber pgt
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.Timezone,
 	)
 	return i, err
 }
 
+const listProvinceStationIDs = `-- name: ListProvinceStationIDs :many
+SELECT id FROM observations_station
+WHERE lower(province) = lower($1::text)
+ORDER BY id
+`
+
+func (q *Queries) ListProvinceStationIDs(ctx context.Context, province string) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listProvinceStationIDs, province)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStations = `-- name: ListStations :many
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone FROM observations_station
 WHERE
   (CASE WHEN $1::text IS NOT NULL THEN status = $1 ELSE TRUE END)
 ORDER BY id
@@ -305,6 +382,7 @@ func (q *Queries) ListStations(ctx context.Context, arg ListStationsParams) ([]O
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Geom,
+			&i.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -317,7 +395,7 @@ func (q *Queries) ListStations(ctx context.Context, arg ListStationsParams) ([]O
 }
 
 const listStationsWithinBBox = `-- name: ListStationsWithinBBox :many
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone FROM observations_station
 WHERE geom && ST_MakeEnvelope($1::real, $2::real, $3::real, $4::real, 4326)
   AND (CASE WHEN $5::text IS NOT NULL THEN status = $5 ELSE TRUE END)
 ORDER BY id
@@ -376,6 +454,7 @@ func (q *Queries) ListStationsWithinBBox(ctx context.Context, arg ListStationsWi
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Geom,
+			&i.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -388,7 +467,7 @@ func (q *Queries) ListStationsWithinBBox(ctx context.Context, arg ListStationsWi
 }
 
 const listStationsWithinRadius = `-- name: ListStationsWithinRadius :many
-SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom FROM observations_station
+SELECT id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone FROM observations_station
 WHERE ST_DWithin(geom, ST_Point($1::real, $2::real, 4326), $3::real)
   AND (CASE WHEN $4::text IS NOT NULL THEN status = $4 ELSE TRUE END)
 ORDER BY id
@@ -445,6 +524,7 @@ func (q *Queries) ListStationsWithinRadius(ctx context.Context, arg ListStations
 			&i.UpdatedAt,
 			&i.DeletedAt,
 			&i.Geom,
+			&i.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -478,9 +558,10 @@ SET
   region = COALESCE($17, region),
   address = COALESCE($18, address),
   geom = COALESCE(ST_POINT($3, $2, 4326), geom),
+  timezone = COALESCE($19, timezone),
   updated_at = now()
-WHERE id = $19
-RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom
+WHERE id = $20
+RETURNING id, name, lat, lon, elevation, date_installed, mo_station_id, sms_system_type, mobile_number, station_type, station_type2, station_url, status, logger_version, priority_level, provider_id, province, region, address, created_at, updated_at, deleted_at, geom, timezone
 `
 
 type UpdateStationParams struct {
@@ -502,6 +583,7 @@ type UpdateStationParams struct {
 	Province      pgtype.Text   `json:"province"`
 	Region        pgtype.Text   `json:"region"`
 	Address       pgtype.Text   `json:"address"`
+	Timezone      pgtype.Text   `json:"timezone"`
 	ID            int64         `json:"id"`
 }
 
@@ -525,6 +607,7 @@ func (q *Queries) UpdateStation(ctx context.Context, arg UpdateStationParams) (O
 		arg.Province,
 		arg.Region,
 		arg.Address,
+		arg.Timezone,
 		arg.ID,
 	)
 	var i ObservationsStation
@@ -552,6 +635,7 @@ func (q *Queries) UpdateStation(ctx context.Context, arg UpdateStationParams) (O
 		&i.UpdatedAt,
 		&i.DeletedAt,
 		&i.Geom,
+		&i.Timezone,
 	)
 	return i, err
 }
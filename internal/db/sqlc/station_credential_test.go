@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationCredentialTestSuite struct {
+	suite.Suite
+}
+
+func TestStationCredentialTestSuite(t *testing.T) {
+	suite.Run(t, new(StationCredentialTestSuite))
+}
+
+func (ts *StationCredentialTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationCredentialTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationCredentialTestSuite) TestUpsertStationCredential() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	arg := UpsertStationCredentialParams{
+		StationID:    station.ID,
+		ApiKey:       util.RandomString(32),
+		ApiSecret:    util.RandomString(32),
+		ApiStationID: pgtype.Text{String: "1234", Valid: true},
+	}
+	cred, err := testStore.UpsertStationCredential(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ApiKey, cred.ApiKey)
+	require.Equal(t, arg.ApiStationID, cred.ApiStationID)
+
+	arg.ApiSecret = util.RandomString(32)
+	updated, err := testStore.UpsertStationCredential(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, cred.ID, updated.ID)
+	require.Equal(t, arg.ApiSecret, updated.ApiSecret)
+
+	got, err := testStore.GetStationCredential(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, got)
+}
+
+func (ts *StationCredentialTestSuite) TestDeleteStationCredential() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	_, err := testStore.UpsertStationCredential(context.Background(), UpsertStationCredentialParams{
+		StationID: station.ID,
+		ApiKey:    util.RandomString(32),
+		ApiSecret: util.RandomString(32),
+	})
+	require.NoError(t, err)
+
+	err = testStore.DeleteStationCredential(context.Background(), station.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetStationCredential(context.Background(), station.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
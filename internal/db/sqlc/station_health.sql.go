@@ -11,6 +11,34 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countStationHealths = `-- name: CountStationHealths :one
+SELECT count(*) FROM observations_stationhealth
+WHERE station_id = $1
+  AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
+  AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
+`
+
+type CountStationHealthsParams struct {
+	StationID   int64              `json:"station_id"`
+	IsStartDate bool               `json:"is_start_date"`
+	StartDate   pgtype.Timestamptz `json:"start_date"`
+	IsEndDate   bool               `json:"is_end_date"`
+	EndDate     pgtype.Timestamptz `json:"end_date"`
+}
+
+func (q *Queries) CountStationHealths(ctx context.Context, arg CountStationHealthsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countStationHealths,
+		arg.StationID,
+		arg.IsStartDate,
+		arg.StartDate,
+		arg.IsEndDate,
+		arg.EndDate,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createStationHealth = `-- name: CreateStationHealth :one
 INSERT INTO observations_stationhealth (
   vb1,
@@ -101,6 +129,98 @@ func (q *Queries) CreateStationHealth(ctx context.Context, arg CreateStationHeal
 	return i, err
 }
 
+const createStationHealthIfNotExists = `-- name: CreateStationHealthIfNotExists :one
+INSERT INTO observations_stationhealth (
+  vb1,
+  vb2,
+  curr,
+  bp1,
+  bp2,
+  cm,
+  ss,
+  temp_arq,
+  rh_arq,
+  fpm,
+  error_msg,
+  message,
+  data_count,
+  data_status,
+  timestamp,
+  minutes_difference,
+  station_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+)
+ON CONFLICT (station_id, timestamp) DO NOTHING
+RETURNING id, vb1, vb2, curr, bp1, bp2, cm, ss, temp_arq, rh_arq, fpm, error_msg, message, data_count, data_status, timestamp, station_id, minutes_difference, created_at, updated_at
+`
+
+type CreateStationHealthIfNotExistsParams struct {
+	Vb1               pgtype.Float4      `json:"vb1"`
+	Vb2               pgtype.Float4      `json:"vb2"`
+	Curr              pgtype.Float4      `json:"curr"`
+	Bp1               pgtype.Float4      `json:"bp1"`
+	Bp2               pgtype.Float4      `json:"bp2"`
+	Cm                pgtype.Text        `json:"cm"`
+	Ss                pgtype.Int4        `json:"ss"`
+	TempArq           pgtype.Float4      `json:"temp_arq"`
+	RhArq             pgtype.Float4      `json:"rh_arq"`
+	Fpm               pgtype.Text        `json:"fpm"`
+	ErrorMsg          pgtype.Text        `json:"error_msg"`
+	Message           pgtype.Text        `json:"message"`
+	DataCount         pgtype.Int4        `json:"data_count"`
+	DataStatus        pgtype.Text        `json:"data_status"`
+	Timestamp         pgtype.Timestamptz `json:"timestamp"`
+	MinutesDifference pgtype.Int4        `json:"minutes_difference"`
+	StationID         int64              `json:"station_id"`
+}
+
+func (q *Queries) CreateStationHealthIfNotExists(ctx context.Context, arg CreateStationHealthIfNotExistsParams) (ObservationsStationhealth, error) {
+	row := q.db.QueryRow(ctx, createStationHealthIfNotExists,
+		arg.Vb1,
+		arg.Vb2,
+		arg.Curr,
+		arg.Bp1,
+		arg.Bp2,
+		arg.Cm,
+		arg.Ss,
+		arg.TempArq,
+		arg.RhArq,
+		arg.Fpm,
+		arg.ErrorMsg,
+		arg.Message,
+		arg.DataCount,
+		arg.DataStatus,
+		arg.Timestamp,
+		arg.MinutesDifference,
+		arg.StationID,
+	)
+	var i ObservationsStationhealth
+	err := row.Scan(
+		&i.ID,
+		&i.Vb1,
+		&i.Vb2,
+		&i.Curr,
+		&i.Bp1,
+		&i.Bp2,
+		&i.Cm,
+		&i.Ss,
+		&i.TempArq,
+		&i.RhArq,
+		&i.Fpm,
+		&i.ErrorMsg,
+		&i.Message,
+		&i.DataCount,
+		&i.DataStatus,
+		&i.Timestamp,
+		&i.StationID,
+		&i.MinutesDifference,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const deleteStationHealth = `-- name: DeleteStationHealth :exec
 DELETE FROM observations_stationhealth WHERE station_id = $1 AND id = $2
 `
@@ -115,6 +235,41 @@ func (q *Queries) DeleteStationHealth(ctx context.Context, arg DeleteStationHeal
 	return err
 }
 
+const getLatestStationHealth = `-- name: GetLatestStationHealth :one
+SELECT id, vb1, vb2, curr, bp1, bp2, cm, ss, temp_arq, rh_arq, fpm, error_msg, message, data_count, data_status, timestamp, station_id, minutes_difference, created_at, updated_at FROM observations_stationhealth
+WHERE station_id = $1
+ORDER BY timestamp DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestStationHealth(ctx context.Context, stationID int64) (ObservationsStationhealth, error) {
+	row := q.db.QueryRow(ctx, getLatestStationHealth, stationID)
+	var i ObservationsStationhealth
+	err := row.Scan(
+		&i.ID,
+		&i.Vb1,
+		&i.Vb2,
+		&i.Curr,
+		&i.Bp1,
+		&i.Bp2,
+		&i.Cm,
+		&i.Ss,
+		&i.TempArq,
+		&i.RhArq,
+		&i.Fpm,
+		&i.ErrorMsg,
+		&i.Message,
+		&i.DataCount,
+		&i.DataStatus,
+		&i.Timestamp,
+		&i.StationID,
+		&i.MinutesDifference,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getStationHealth = `-- name: GetStationHealth :one
 SELECT id, vb1, vb2, curr, bp1, bp2, cm, ss, temp_arq, rh_arq, fpm, error_msg, message, data_count, data_status, timestamp, station_id, minutes_difference, created_at, updated_at FROM observations_stationhealth
 WHERE station_id = $1 AND id = $2 LIMIT 1
@@ -208,6 +363,75 @@ func (q *Queries) ListStationHealths(ctx context.Context, arg ListStationHealths
 	return items, nil
 }
 
+const listStationHealthsByDate = `-- name: ListStationHealthsByDate :many
+SELECT id, vb1, vb2, curr, bp1, bp2, cm, ss, temp_arq, rh_arq, fpm, error_msg, message, data_count, data_status, timestamp, station_id, minutes_difference, created_at, updated_at FROM observations_stationhealth
+WHERE station_id = $1
+  AND (CASE WHEN $2::bool THEN timestamp >= $3 ELSE TRUE END)
+  AND (CASE WHEN $4::bool THEN timestamp <= $5 ELSE TRUE END)
+ORDER BY timestamp DESC
+LIMIT $7
+OFFSET $6
+`
+
+type ListStationHealthsByDateParams struct {
+	StationID   int64              `json:"station_id"`
+	IsStartDate bool               `json:"is_start_date"`
+	StartDate   pgtype.Timestamptz `json:"start_date"`
+	IsEndDate   bool               `json:"is_end_date"`
+	EndDate     pgtype.Timestamptz `json:"end_date"`
+	Offset      int32              `json:"offset"`
+	Limit       pgtype.Int4        `json:"limit"`
+}
+
+func (q *Queries) ListStationHealthsByDate(ctx context.Context, arg ListStationHealthsByDateParams) ([]ObservationsStationhealth, error) {
+	rows, err := q.db.Query(ctx, listStationHealthsByDate,
+		arg.StationID,
+		arg.IsStartDate,
+		arg.StartDate,
+		arg.IsEndDate,
+		arg.EndDate,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ObservationsStationhealth{}
+	for rows.Next() {
+		var i ObservationsStationhealth
+		if err := rows.Scan(
+			&i.ID,
+			&i.Vb1,
+			&i.Vb2,
+			&i.Curr,
+			&i.Bp1,
+			&i.Bp2,
+			&i.Cm,
+			&i.Ss,
+			&i.TempArq,
+			&i.RhArq,
+			&i.Fpm,
+			&i.ErrorMsg,
+			&i.Message,
+			&i.DataCount,
+			&i.DataStatus,
+			&i.Timestamp,
+			&i.StationID,
+			&i.MinutesDifference,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateStationHealth = `-- name: UpdateStationHealth :one
 UPDATE observations_stationhealth
 SET
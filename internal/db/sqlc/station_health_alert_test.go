@@ -0,0 +1,136 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationHealthAlertTestSuite struct {
+	suite.Suite
+}
+
+func TestStationHealthAlertTestSuite(t *testing.T) {
+	suite.Run(t, new(StationHealthAlertTestSuite))
+}
+
+func (ts *StationHealthAlertTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationHealthAlertTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationHealthAlertTestSuite) TestCreateStationHealthAlert() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	health := createRandomStationHealth(t, station.ID)
+	a := createTestStationHealthAlert(t, station.ID, health.ID, "vb1_low")
+
+	// only one active alert per rule
+	_, err := testStore.CreateStationHealthAlert(context.Background(), CreateStationHealthAlertParams{
+		StationID: station.ID,
+		Rule:      a.Rule,
+		Message:   a.Message,
+		OpenedAt:  a.OpenedAt,
+	})
+	require.Error(t, err)
+}
+
+func (ts *StationHealthAlertTestSuite) TestAlertLifecycle() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	a := createTestStationHealthAlert(t, station.ID, 0, "vb1_low")
+
+	active, err := testStore.ListActiveStationHealthAlerts(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+
+	acked, err := testStore.AcknowledgeStationHealthAlert(context.Background(), AcknowledgeStationHealthAlertParams{
+		StationID:      station.ID,
+		ID:             a.ID,
+		AcknowledgedBy: pgtype.Text{String: "admin", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ACKNOWLEDGED", acked.Status)
+	require.Equal(t, "admin", acked.AcknowledgedBy.String)
+	require.True(t, acked.AcknowledgedAt.Valid)
+
+	// an acknowledged alert cannot be acknowledged again
+	_, err = testStore.AcknowledgeStationHealthAlert(context.Background(), AcknowledgeStationHealthAlertParams{
+		StationID: station.ID,
+		ID:        a.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	resolved, err := testStore.ResolveStationHealthAlert(context.Background(), ResolveStationHealthAlertParams{
+		StationID:  station.ID,
+		ID:         a.ID,
+		ResolvedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "RESOLVED", resolved.Status)
+
+	active, err = testStore.ListActiveStationHealthAlerts(context.Background(), station.ID)
+	require.NoError(t, err)
+	require.Empty(t, active)
+
+	// the rule can be opened again once resolved
+	createTestStationHealthAlert(t, station.ID, 0, "vb1_low")
+}
+
+func (ts *StationHealthAlertTestSuite) TestListStationHealthAlerts() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	a := createTestStationHealthAlert(t, station.ID, 0, "vb1_low")
+	createTestStationHealthAlert(t, station.ID, 0, "ss_low")
+	_, err := testStore.ResolveStationHealthAlert(context.Background(), ResolveStationHealthAlertParams{
+		StationID:  station.ID,
+		ID:         a.ID,
+		ResolvedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+
+	alerts, err := testStore.ListStationHealthAlerts(context.Background(), ListStationHealthAlertsParams{
+		StationID: station.ID,
+		IsStatus:  true,
+		Status:    "OPEN",
+	})
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.Equal(t, "ss_low", alerts[0].Rule)
+
+	count, err := testStore.CountStationHealthAlerts(context.Background(), CountStationHealthAlertsParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
+func createTestStationHealthAlert(t *testing.T, stationID, healthID int64, rule string) ObservationsStationhealthAlert {
+	arg := CreateStationHealthAlertParams{
+		StationID: stationID,
+		HealthID:  pgtype.Int8{Int64: healthID, Valid: healthID > 0},
+		Rule:      rule,
+		Message:   rule + " violated",
+		Value:     pgtype.Float4{Float32: 10, Valid: true},
+		Threshold: pgtype.Float4{Float32: 11.5, Valid: true},
+		OpenedAt:  pgtype.Timestamptz{Time: time.Now().Truncate(time.Second), Valid: true},
+	}
+
+	a, err := testStore.CreateStationHealthAlert(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.StationID, a.StationID)
+	require.Equal(t, arg.Rule, a.Rule)
+	require.Equal(t, "OPEN", a.Status)
+	require.False(t, a.ResolvedAt.Valid)
+
+	return a
+}
@@ -2,7 +2,9 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -48,6 +50,43 @@ func (ts *StationTestSuite) TestGetStation() {
 	require.Equal(t, gotStation.MobileNumber, station.MobileNumber)
 }
 
+func (ts *StationTestSuite) TestFindStation() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	createRandomStation(t, false)
+
+	gotStation, err := testStore.FindStation(context.Background(), station.Name[2:10])
+	require.NoError(t, err)
+	require.Equal(t, station.ID, gotStation.ID)
+
+	gotStation, err = testStore.FindStation(context.Background(), fmt.Sprint(station.ID))
+	require.NoError(t, err)
+	require.Equal(t, station.ID, gotStation.ID)
+
+	_, err = testStore.FindStation(context.Background(), "no such station")
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func (ts *StationTestSuite) TestListProvinceStationIDs() {
+	t := ts.T()
+	province := util.RandomString(12)
+	var want []int64
+	for i := 0; i < 3; i++ {
+		station := createRandomStation(t, false)
+		_, err := testStore.UpdateStation(context.Background(), UpdateStationParams{
+			ID:       station.ID,
+			Province: pgtype.Text{String: province, Valid: true},
+		})
+		require.NoError(t, err)
+		want = append(want, station.ID)
+	}
+	createRandomStation(t, false)
+
+	ids, err := testStore.ListProvinceStationIDs(context.Background(), strings.ToUpper(province))
+	require.NoError(t, err)
+	require.Equal(t, want, ids)
+}
+
 func (ts *StationTestSuite) TestListStations() {
 	t := ts.T()
 	n := 10
@@ -369,6 +408,30 @@ func (ts *StationTestSuite) TestUpdateStation() {
 	}
 }
 
+func (ts *StationTestSuite) TestBulkUpdateStationStatus() {
+	t := ts.T()
+	stations := []ObservationsStation{createRandomStation(t, false), createRandomStation(t, false)}
+	statuses := []string{"ONLINE", "OFFLINE"}
+
+	arg := make([]BatchUpdateStationStatusParams, len(stations))
+	for i, stn := range stations {
+		arg[i] = BatchUpdateStationStatusParams{
+			ID:     stn.ID,
+			Status: pgtype.Text{String: statuses[i], Valid: true},
+		}
+	}
+
+	errs := testStore.BulkUpdateStationStatus(context.Background(), arg)
+	require.Empty(t, errs)
+
+	for i, stn := range stations {
+		gotStation, err := testStore.GetStation(context.Background(), stn.ID)
+		require.NoError(t, err)
+		require.Equal(t, statuses[i], gotStation.Status.String)
+		require.Equal(t, stn.Name, gotStation.Name)
+	}
+}
+
 func (ts *StationTestSuite) TestDeleteStation() {
 	t := ts.T()
 	station := createRandomStation(t, false)
@@ -431,6 +494,7 @@ func createRandomStation(t *testing.T, geom any) ObservationsStation {
 	require.Equal(t, arg.Name, station.Name)
 	require.Equal(t, arg.MobileNumber, station.MobileNumber)
 	require.WithinDuration(t, dateInstalled.Time, station.DateInstalled.Time, time.Second*10)
+	require.Equal(t, "Asia/Manila", station.Timezone)
 	require.True(t, station.UpdatedAt.Time.IsZero())
 	require.True(t, station.CreatedAt.Valid)
 	require.NotZero(t, station.CreatedAt.Time)
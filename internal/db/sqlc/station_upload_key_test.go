@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StationUploadKeyTestSuite struct {
+	suite.Suite
+}
+
+func TestStationUploadKeyTestSuite(t *testing.T) {
+	suite.Run(t, new(StationUploadKeyTestSuite))
+}
+
+func (ts *StationUploadKeyTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *StationUploadKeyTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *StationUploadKeyTestSuite) TestUpsertStationUploadKey() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	arg := UpsertStationUploadKeyParams{
+		StationID: station.ID,
+		UploadID:  util.RandomString(12),
+		KeyHash:   util.RandomString(64),
+	}
+	key, err := testStore.UpsertStationUploadKey(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.UploadID, key.UploadID)
+	require.Equal(t, arg.KeyHash, key.KeyHash)
+
+	arg.UploadID = util.RandomString(12)
+	updated, err := testStore.UpsertStationUploadKey(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, key.ID, updated.ID)
+	require.Equal(t, arg.UploadID, updated.UploadID)
+
+	got, err := testStore.GetStationUploadKey(context.Background(), arg.UploadID)
+	require.NoError(t, err)
+	require.Equal(t, updated, got)
+
+	_, err = testStore.GetStationUploadKey(context.Background(), key.UploadID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	other := createRandomStation(t, false)
+	_, err = testStore.UpsertStationUploadKey(context.Background(), UpsertStationUploadKeyParams{
+		StationID: other.ID,
+		UploadID:  arg.UploadID,
+		KeyHash:   util.RandomString(64),
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func (ts *StationUploadKeyTestSuite) TestDeleteStationUploadKey() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+
+	key, err := testStore.UpsertStationUploadKey(context.Background(), UpsertStationUploadKeyParams{
+		StationID: station.ID,
+		UploadID:  util.RandomString(12),
+		KeyHash:   util.RandomString(64),
+	})
+	require.NoError(t, err)
+
+	err = testStore.DeleteStationUploadKey(context.Background(), station.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetStationUploadKey(context.Background(), key.UploadID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
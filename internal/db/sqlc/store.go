@@ -10,8 +10,20 @@ import (
 type Store interface {
 	Querier
 	FirstOrCreateSimAccessTokenTx(ctx context.Context, arg FirstOrCreateSimAccessTokenTxParams) (FirstOrCreateSimAccessTokenTxResult, error)
+	CreateLoadRequestTx(ctx context.Context, arg CreateLoadRequestTxParams) (CreateLoadRequestTxResult, error)
+	CreateStationReadingTx(ctx context.Context, arg CreateStationReadingTxParams) (CreateStationReadingTxResult, error)
+	CreateStationUploadTx(ctx context.Context, arg CreateStationUploadTxParams) (CreateStationUploadTxResult, error)
+	UpdateObservationQcTx(ctx context.Context, arg UpdateObservationQcTxParams) (UpdateObservationQcTxResult, error)
+	StreamObservations(ctx context.Context, arg StreamObservationsParams, fn func(StreamObservationsRow) error) error
 	BulkCreateUserRoles(ctx context.Context, arg []UserRolesParams) (ret []UserRolesParams, errs []error)
 	BulkDeleteUserRoles(ctx context.Context, arg []UserRolesParams) []error
+	BulkCreateStationMoObservations(ctx context.Context, arg []BatchCreateStationMoObservationsParams) (n int, errs []error)
+	BulkUpsertStationMoObservations(ctx context.Context, arg []BatchUpsertStationMoObservationsParams) []error
+	BulkUpdateMoObservationQc(ctx context.Context, arg []UpdateObservationQcTxParams) error
+	BulkCreateStationObservations(ctx context.Context, arg []BatchCreateStationObservationsParams) (ids []int64, err error)
+	BulkUpdateObservationQc(ctx context.Context, arg []UpdateObservationQcTxParams) error
+	BulkCreateCurrentObservations(ctx context.Context, arg []BatchCreateCurrentObservationsParams) (n int, errs []error)
+	BulkUpdateStationStatus(ctx context.Context, arg []BatchUpdateStationStatusParams) []error
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const streamObservations = `
+SELECT obs.id, obs.pres, obs.rr, obs.rh, obs.temp, obs.td, obs.wdir, obs.wspd, obs.wspdx, obs.srad, obs.mslp, obs.hi, obs.station_id, obs.timestamp, obs.wchill, obs.qc_level, obs.created_at, obs.updated_at,
+  stn.name, ST_Y(stn.geom)::real AS lat, ST_X(stn.geom)::real AS lon, stn.elevation
+FROM observations_observation obs
+  JOIN observations_station stn
+  ON stn.id = obs.station_id
+WHERE obs.station_id = ANY($1::bigint[])
+  AND (CASE WHEN $2::bool THEN obs.timestamp >= $3 ELSE TRUE END)
+  AND (CASE WHEN $4::bool THEN obs.timestamp <= $5 ELSE TRUE END)
+ORDER BY obs.station_id, obs.timestamp
+`
+
+type StreamObservationsParams struct {
+	StationIds  []int64            `json:"station_ids"`
+	IsStartDate bool               `json:"is_start_date"`
+	StartDate   pgtype.Timestamptz `json:"start_date"`
+	IsEndDate   bool               `json:"is_end_date"`
+	EndDate     pgtype.Timestamptz `json:"end_date"`
+}
+
+type StreamObservationsRow struct {
+	ObservationsObservation ObservationsObservation `json:"observations_observation"`
+	Name                    string                  `json:"name"`
+	Lat                     pgtype.Float4           `json:"lat"`
+	Lon                     pgtype.Float4           `json:"lon"`
+	Elevation               pgtype.Float4           `json:"elevation"`
+}
+
+// StreamObservations calls fn for every observation matching arg, in station and time order.
+// Rows are scanned one at a time as they are read from the connection, so the result set is
+// never held in memory. Iteration stops at the first error returned by fn.
+func (store *SQLStore) StreamObservations(ctx context.Context, arg StreamObservationsParams, fn func(StreamObservationsRow) error) error {
+	rows, err := store.connPool.Query(ctx, streamObservations,
+		arg.StationIds,
+		arg.IsStartDate,
+		arg.StartDate,
+		arg.IsEndDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i StreamObservationsRow
+		if err := rows.Scan(
+			&i.ObservationsObservation.ID,
+			&i.ObservationsObservation.Pres,
+			&i.ObservationsObservation.Rr,
+			&i.ObservationsObservation.Rh,
+			&i.ObservationsObservation.Temp,
+			&i.ObservationsObservation.Td,
+			&i.ObservationsObservation.Wdir,
+			&i.ObservationsObservation.Wspd,
+			&i.ObservationsObservation.Wspdx,
+			&i.ObservationsObservation.Srad,
+			&i.ObservationsObservation.Mslp,
+			&i.ObservationsObservation.Hi,
+			&i.ObservationsObservation.StationID,
+			&i.ObservationsObservation.Timestamp,
+			&i.ObservationsObservation.Wchill,
+			&i.ObservationsObservation.QcLevel,
+			&i.ObservationsObservation.CreatedAt,
+			&i.ObservationsObservation.UpdatedAt,
+			&i.Name,
+			&i.Lat,
+			&i.Lon,
+			&i.Elevation,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
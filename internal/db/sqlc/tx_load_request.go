@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrLoadBudgetExceeded is returned when a load request would go over the
+// budget.
+var ErrLoadBudgetExceeded = errors.New("load budget exceeded")
+
+type CreateLoadRequestTxParams struct {
+	CreateLoadRequestParams
+	Budget      int64              `json:"budget"`
+	BudgetSince pgtype.Timestamptz `json:"budget_since"`
+}
+
+type CreateLoadRequestTxResult struct {
+	LoadRequest LoadRequest
+	IsCreated   bool
+}
+
+// CreateLoadRequestTx creates a load request unless one with the same
+// idempotency key exists, in which case that one is returned. The request is
+// refused with ErrLoadBudgetExceeded when the cost of the requests made since
+// BudgetSince, including this one, would exceed Budget. A zero Budget means no
+// cap.
+func (store *SQLStore) CreateLoadRequestTx(ctx context.Context, arg CreateLoadRequestTxParams) (CreateLoadRequestTxResult, error) {
+	var result CreateLoadRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.LockLoadRequests(ctx)
+		if err != nil {
+			return err
+		}
+
+		result.LoadRequest, err = q.GetLoadRequestByKey(ctx, arg.IdempotencyKey)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+
+		if arg.Budget > 0 {
+			total, err := q.SumLoadRequestCost(ctx, arg.BudgetSince)
+			if err != nil {
+				return err
+			}
+			if total+int64(arg.Cost) > arg.Budget {
+				return ErrLoadBudgetExceeded
+			}
+		}
+
+		result.LoadRequest, err = q.CreateLoadRequest(ctx, arg.CreateLoadRequestParams)
+		if err != nil {
+			return err
+		}
+		result.IsCreated = true
+		return nil
+	})
+
+	return result, err
+}
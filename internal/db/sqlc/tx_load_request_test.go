@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateLoadRequestTxTestSuite struct {
+	suite.Suite
+}
+
+func TestCreateLoadRequestTxTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateLoadRequestTxTestSuite))
+}
+
+func (ts *CreateLoadRequestTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *CreateLoadRequestTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *CreateLoadRequestTxTestSuite) TestCreateLoadRequestTx() {
+	t := ts.T()
+	since := pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}
+	arg := func(key string) CreateLoadRequestTxParams {
+		return CreateLoadRequestTxParams{
+			CreateLoadRequestParams: CreateLoadRequestParams{
+				IdempotencyKey: key,
+				MobileNumber:   util.RandomMobileNumber(),
+				Trigger:        "SCHEDULE",
+				Promo:          "LOAD 50",
+				Cost:           50,
+			},
+			Budget:      100,
+			BudgetSince: since,
+		}
+	}
+
+	first, err := testStore.CreateLoadRequestTx(context.Background(), arg("key-1"))
+	require.NoError(t, err)
+	require.True(t, first.IsCreated)
+
+	dup, err := testStore.CreateLoadRequestTx(context.Background(), arg("key-1"))
+	require.NoError(t, err)
+	require.False(t, dup.IsCreated)
+	require.Equal(t, first.LoadRequest.ID, dup.LoadRequest.ID)
+	require.Equal(t, first.LoadRequest.MobileNumber, dup.LoadRequest.MobileNumber)
+
+	second, err := testStore.CreateLoadRequestTx(context.Background(), arg("key-2"))
+	require.NoError(t, err)
+	require.True(t, second.IsCreated)
+
+	_, err = testStore.CreateLoadRequestTx(context.Background(), arg("key-3"))
+	require.ErrorIs(t, err, ErrLoadBudgetExceeded)
+
+	noCap := arg("key-3")
+	noCap.Budget = 0
+	third, err := testStore.CreateLoadRequestTx(context.Background(), noCap)
+	require.NoError(t, err)
+	require.True(t, third.IsCreated)
+
+	count, err := testStore.CountLoadRequests(context.Background(), CountLoadRequestsParams{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+)
+
+type UpdateObservationQcTxParams struct {
+	ObservationID int64                           `json:"observation_id"`
+	QcLevel       int32                           `json:"qc_level"`
+	Flags         []CreateObservationQcFlagParams `json:"flags"`
+}
+
+type UpdateObservationQcTxResult struct {
+	Observation ObservationsObservation
+	Flags       []ObservationsQcFlag
+}
+
+// UpdateObservationQcTx replaces the qc flags of an observation and sets its qc level
+func (store *SQLStore) UpdateObservationQcTx(ctx context.Context, arg UpdateObservationQcTxParams) (UpdateObservationQcTxResult, error) {
+	var result UpdateObservationQcTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		err = q.DeleteObservationQcFlags(ctx, arg.ObservationID)
+		if err != nil {
+			return err
+		}
+
+		result.Flags = make([]ObservationsQcFlag, len(arg.Flags))
+		for i, f := range arg.Flags {
+			f.ObservationID = arg.ObservationID
+			result.Flags[i], err = q.CreateObservationQcFlag(ctx, f)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Observation, err = q.UpdateObservationQcLevel(ctx, UpdateObservationQcLevelParams{
+			ID:      arg.ObservationID,
+			QcLevel: arg.QcLevel,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ObservationQcTxTestSuite struct {
+	suite.Suite
+}
+
+func TestObservationQcTxTestSuite(t *testing.T) {
+	suite.Run(t, new(ObservationQcTxTestSuite))
+}
+
+func (ts *ObservationQcTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *ObservationQcTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *ObservationQcTxTestSuite) TestUpdateObservationQcTx() {
+	t := ts.T()
+	station := createRandomStation(t, false)
+	obs := createRandomObservation(t, station.ID)
+
+	arg := UpdateObservationQcTxParams{
+		ObservationID: obs.ID,
+		QcLevel:       1,
+		Flags: []CreateObservationQcFlagParams{
+			{CheckName: "step", Variable: "temp", Level: 2, Reason: "spike"},
+			{CheckName: "consistency", Variable: "td", Level: 3, Reason: "td > temp"},
+		},
+	}
+	res, err := testStore.UpdateObservationQcTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, obs.ID, res.Observation.ID)
+	require.Equal(t, int32(1), res.Observation.QcLevel)
+	require.Len(t, res.Flags, 2)
+	for _, f := range res.Flags {
+		require.Equal(t, obs.ID, f.ObservationID)
+	}
+
+	// a second run replaces the previous flags
+	arg.QcLevel = 3
+	arg.Flags = nil
+	res, err = testStore.UpdateObservationQcTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Observation.QcLevel)
+
+	flags, err := testStore.ListObservationQcFlags(context.Background(), obs.ID)
+	require.NoError(t, err)
+	require.Empty(t, flags)
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+type CreateStationReadingTxParams struct {
+	Observation CreateStationObservationIfNotExistsParams `json:"observation"`
+	Health      *CreateStationHealthIfNotExistsParams     `json:"health"`
+}
+
+type CreateStationReadingTxResult struct {
+	Observation ObservationsObservation
+	Health      ObservationsStationhealth
+	IsCreated   bool
+}
+
+// CreateStationReadingTx stores the observation of a station reading together
+// with its health, if any. Nothing is stored and IsCreated is false when the
+// station already has an observation at that timestamp. A health already
+// stored at its timestamp is left as is.
+func (store *SQLStore) CreateStationReadingTx(ctx context.Context, arg CreateStationReadingTxParams) (CreateStationReadingTxResult, error) {
+	var result CreateStationReadingTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Observation, err = q.CreateStationObservationIfNotExists(ctx, arg.Observation)
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		result.IsCreated = true
+
+		if arg.Health == nil {
+			return nil
+		}
+		result.Health, err = q.CreateStationHealthIfNotExists(ctx, *arg.Health)
+		if err != nil && !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+		return nil
+	})
+
+	return result, err
+}
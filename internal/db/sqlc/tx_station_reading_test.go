@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateStationReadingTxTestSuite struct {
+	suite.Suite
+}
+
+func TestCreateStationReadingTxTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateStationReadingTxTestSuite))
+}
+
+func (ts *CreateStationReadingTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *CreateStationReadingTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *CreateStationReadingTxTestSuite) TestCreateStationReadingTx() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	timestamp := pgtype.Timestamptz{Time: time.Now().Truncate(time.Minute), Valid: true}
+
+	arg := CreateStationReadingTxParams{
+		Observation: CreateStationObservationIfNotExistsParams{
+			StationID: station.ID,
+			Temp:      pgtype.Float4{Float32: util.RandomFloat[float32](16.0, 38.0), Valid: true},
+			Timestamp: timestamp,
+		},
+		Health: &CreateStationHealthIfNotExistsParams{
+			StationID: station.ID,
+			Vb1:       pgtype.Float4{Float32: util.RandomFloat[float32](10.0, 14.0), Valid: true},
+			Timestamp: timestamp,
+		},
+	}
+
+	result, err := testStore.CreateStationReadingTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.IsCreated)
+	require.NotZero(t, result.Observation.ID)
+	require.Equal(t, arg.Observation.Temp, result.Observation.Temp)
+	require.NotZero(t, result.Health.ID)
+	require.Equal(t, arg.Health.Vb1, result.Health.Vb1)
+
+	arg.Observation.Temp.Float32++
+	dup, err := testStore.CreateStationReadingTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, dup.IsCreated)
+	require.Zero(t, dup.Observation.ID)
+	require.Zero(t, dup.Health.ID)
+
+	count, err := testStore.CountStationObservations(context.Background(), CountStationObservationsParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func (ts *CreateStationReadingTxTestSuite) TestCreateStationReadingTxHealthExists() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	health := createRandomStationHealth(t, station.ID)
+
+	result, err := testStore.CreateStationReadingTx(context.Background(), CreateStationReadingTxParams{
+		Observation: CreateStationObservationIfNotExistsParams{
+			StationID: station.ID,
+			Timestamp: health.Timestamp,
+		},
+		Health: &CreateStationHealthIfNotExistsParams{
+			StationID: station.ID,
+			Timestamp: health.Timestamp,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.IsCreated)
+	require.NotZero(t, result.Observation.ID)
+	require.Zero(t, result.Health.ID)
+}
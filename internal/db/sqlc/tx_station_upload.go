@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+type CreateStationUploadTxParams struct {
+	Observation CreateStationObservationIfNotExistsParams `json:"observation"`
+	Current     CreateCurrentObservationParams            `json:"current"`
+}
+
+type CreateStationUploadTxResult struct {
+	Observation ObservationsObservation
+	Current     ObservationsCurrent
+	IsCreated   bool
+}
+
+// CreateStationUploadTx stores an upload of a station both as an observation
+// and as a current observation. Nothing is stored and IsCreated is false when
+// the station already has an observation at that timestamp.
+func (store *SQLStore) CreateStationUploadTx(ctx context.Context, arg CreateStationUploadTxParams) (CreateStationUploadTxResult, error) {
+	var result CreateStationUploadTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Observation, err = q.CreateStationObservationIfNotExists(ctx, arg.Observation)
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		result.Current, err = q.CreateCurrentObservation(ctx, arg.Current)
+		if err != nil {
+			return err
+		}
+		result.IsCreated = true
+		return nil
+	})
+
+	return result, err
+}
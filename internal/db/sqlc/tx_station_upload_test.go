@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateStationUploadTxTestSuite struct {
+	suite.Suite
+}
+
+func TestCreateStationUploadTxTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateStationUploadTxTestSuite))
+}
+
+func (ts *CreateStationUploadTxTestSuite) SetupTest() {
+	err := testMigration.Up()
+	require.NoError(ts.T(), err, "db migration problem")
+}
+
+func (ts *CreateStationUploadTxTestSuite) TearDownTest() {
+	err := testMigration.Down()
+	require.NoError(ts.T(), err, "reverse db migration problem")
+}
+
+func (ts *CreateStationUploadTxTestSuite) TestCreateStationUploadTx() {
+	t := ts.T()
+	station := createRandomStation(t, nil)
+	timestamp := pgtype.Timestamptz{Time: time.Now().Truncate(time.Second), Valid: true}
+	temp := pgtype.Float4{Float32: util.RandomFloat[float32](16.0, 38.0), Valid: true}
+
+	arg := CreateStationUploadTxParams{
+		Observation: CreateStationObservationIfNotExistsParams{
+			StationID: station.ID,
+			Temp:      temp,
+			Timestamp: timestamp,
+		},
+		Current: CreateCurrentObservationParams{
+			StationID: station.ID,
+			Temp:      temp,
+			Timestamp: timestamp,
+		},
+	}
+
+	result, err := testStore.CreateStationUploadTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.IsCreated)
+	require.NotZero(t, result.Observation.ID)
+	require.Equal(t, temp, result.Observation.Temp)
+	require.NotZero(t, result.Current.ID)
+	require.Equal(t, temp, result.Current.Temp)
+
+	dup, err := testStore.CreateStationUploadTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, dup.IsCreated)
+	require.Zero(t, dup.Observation.ID)
+	require.Zero(t, dup.Current.ID)
+
+	count, err := testStore.CountStationObservations(context.Background(), CountStationObservationsParams{
+		StationID: station.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
@@ -1,4 +1,5 @@
-// Package api Code generated by swaggo/swag. DO NOT EDIT
+// Code generated by swaggo/swag. DO NOT EDIT.
+
 package api
 
 import "github.com/swaggo/swag"
@@ -18,32 +19,74 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/glabs": {
+        "/admin/jobs": {
             "get": {
-                "consumes": [
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "jobs"
+                ],
+                "summary": "List scheduled jobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Job"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/jobs/runs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "globelabs"
+                    "jobs"
                 ],
-                "summary": "Globe Labs opt-in",
+                "summary": "List job runs, latest first",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "access_token",
+                        "name": "job_name",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "code",
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "RUNNING",
+                            "SUCCESS",
+                            "FAILED"
+                        ],
                         "type": "string",
-                        "name": "subscriber_number",
+                        "name": "status",
                         "in": "query"
                     }
                 ],
@@ -51,104 +94,125 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/GlobeLabsOptInResponse"
+                            "$ref": "#/definitions/PaginatedJobRuns"
                         }
                     }
                 }
-            },
-            "post": {
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/admin/jobs/{name}/pause": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "globelabs"
+                    "jobs"
+                ],
+                "summary": "Pause a scheduled job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Globe Labs unsubscribe",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Job"
+                        }
                     }
                 }
             }
         },
-        "/glabs/load": {
-            "post": {
-                "consumes": [
-                    "application/json"
+        "/admin/jobs/{name}/resume": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "globelabs"
+                    "jobs"
+                ],
+                "summary": "Resume a paused job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Create Globe Labs entry",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/GlobeLabsLoadResponse"
+                            "$ref": "#/definitions/Job"
                         }
                     }
                 }
             }
         },
-        "/lufft/{station_id}/logs": {
-            "get": {
-                "consumes": [
-                    "application/json"
+        "/admin/jobs/{name}/run": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "lufft"
+                    "jobs"
                 ],
-                "summary": "Lufft Message Logs",
+                "summary": "Run a job now",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 30,
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "per_page",
-                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/handlers.paginatedLufftMsgLogs"
+                            "$ref": "#/definitions/JobRun"
                         }
                     }
                 }
             }
         },
-        "/observations": {
+        "/admin/raw-messages": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
+                    "sms"
                 ],
-                "summary": "list station observation",
+                "summary": "List the archived inbound messages, latest first",
                 "parameters": [
                     {
                         "type": "string",
@@ -158,7 +222,6 @@ const docTemplate = `{
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "page number",
                         "name": "page",
                         "in": "query"
                     },
@@ -166,18 +229,35 @@ const docTemplate = `{
                         "maximum": 30,
                         "minimum": 1,
                         "type": "integer",
-                        "description": "limit",
                         "name": "per_page",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "sender",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "name": "start_date",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "RECEIVED",
+                            "STORED",
+                            "DUPLICATE",
+                            "REJECTED",
+                            "FAILED",
+                            "QUERY"
+                        ],
                         "type": "string",
-                        "name": "station_ids",
+                        "name": "status",
                         "in": "query"
                     }
                 ],
@@ -185,36 +265,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/PaginatedStationObservations"
-                        }
-                    }
-                }
-            }
-        },
-        "/observations/latest": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "observations"
-                ],
-                "summary": "list latest observation",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/LatestObservation"
-                            }
+                            "$ref": "#/definitions/PaginatedRawMessages"
                         }
                     }
                 }
             }
         },
-        "/ptexter": {
+        "/admin/raw-messages/replay": {
             "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -222,17 +285,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "promotexter"
+                    "sms"
                 ],
-                "summary": "Store Lufft observation and health",
+                "summary": "Store archived station messages again through the current parser",
                 "parameters": [
                     {
-                        "description": "Promo Texter parameters",
+                        "description": "Replay raw messages parameters",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/LufftSMSParams"
+                            "$ref": "#/definitions/ReplayRawMessagesParams"
                         }
                     }
                 ],
@@ -240,30 +303,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/LufftResponse"
+                            "$ref": "#/definitions/ReplayRawMessagesResponse"
                         }
                     }
                 }
             }
         },
-        "/roles": {
+        "/admin/sims": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "sims"
                 ],
-                "summary": "List roles",
+                "summary": "List station SIM cards",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "name": "flagged",
+                        "in": "query"
+                    },
                     {
                         "minimum": 1,
                         "type": "integer",
@@ -282,14 +347,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/PaginatedRoles"
+                            "$ref": "#/definitions/PaginatedSimCards"
                         }
                     }
                 }
-            }
-        },
-        "/roles/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -302,181 +365,104 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "sims"
                 ],
-                "summary": "Get role",
+                "summary": "Add a station SIM card",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Role ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Create SIM card parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSimCardParams"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/Role"
+                            "$ref": "#/definitions/SimCard"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/sims/at-risk": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "sims"
                 ],
-                "summary": "Update role",
+                "summary": "List the stations whose SIM load has run out or runs out within a duration",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Role ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update role parameters",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/UpdateRoleParams"
-                        }
+                        "type": "string",
+                        "name": "within",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Role"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/StationAtRisk"
+                            }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/sims/load-requests": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "sims"
                 ],
-                "summary": "Create role",
+                "summary": "List the load top-up requests, latest first",
                 "parameters": [
-                    {
-                        "description": "Create role parameters",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateRoleParams"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/Role"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "roles"
-                ],
-                "summary": "Delete role",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Role ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            }
-        },
-        "/stations": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "stations"
-                ],
-                "summary": "List stations",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "name": "bbox",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "circle",
+                        "name": "mobile_number",
                         "in": "query"
                     },
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "page number",
                         "name": "page",
                         "in": "query"
                     },
                     {
+                        "maximum": 30,
                         "minimum": 1,
                         "type": "integer",
-                        "description": "limit",
                         "name": "per_page",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "PENDING",
+                            "REQUESTED",
+                            "SUCCESS",
+                            "FAILED"
+                        ],
                         "type": "string",
                         "name": "status",
                         "in": "query"
@@ -486,95 +472,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/PaginatedStations"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "stations"
-                ],
-                "summary": "Create station",
-                "parameters": [
-                    {
-                        "description": "Create station parameters",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateStationReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/Station"
+                            "$ref": "#/definitions/PaginatedLoadRequests"
                         }
                     }
                 }
             }
         },
-        "/stations/nearest/observations/latest": {
+        "/admin/sims/{mobile_number}": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "observations"
-                ],
-                "summary": "Get nearest latest station observation",
-                "parameters": [
+                "security": [
                     {
-                        "type": "string",
-                        "name": "pt",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/LatestObservation"
-                        }
+                        "BearerAuth": []
                     }
-                }
-            }
-        },
-        "/stations/{station_id}": {
-            "get": {
-                "consumes": [
-                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stations"
+                    "sims"
                 ],
-                "summary": "Get station",
+                "summary": "Get a station SIM card",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
+                        "type": "string",
+                        "description": "Mobile number",
+                        "name": "mobile_number",
                         "in": "path",
                         "required": true
                     }
@@ -583,7 +505,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Station"
+                            "$ref": "#/definitions/SimCard"
                         }
                     }
                 }
@@ -601,24 +523,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "stations"
+                    "sims"
                 ],
-                "summary": "Update station",
+                "summary": "Update a station SIM card",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
+                        "type": "string",
+                        "description": "Mobile number",
+                        "name": "mobile_number",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update station parameters",
+                        "description": "Update SIM card parameters",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateStationReq"
+                            "$ref": "#/definitions/UpdateSimCardParams"
                         }
                     }
                 ],
@@ -626,7 +548,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Station"
+                            "$ref": "#/definitions/SimCard"
                         }
                     }
                 }
@@ -637,21 +559,15 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "stations"
+                    "sims"
                 ],
-                "summary": "Delete station",
+                "summary": "Delete a station SIM card",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
+                        "type": "string",
+                        "description": "Mobile number",
+                        "name": "mobile_number",
                         "in": "path",
                         "required": true
                     }
@@ -663,35 +579,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/stations/{station_id}/observations": {
+        "/admin/sims/{mobile_number}/loads": {
             "get": {
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
+                    "sims"
                 ],
-                "summary": "List station observations",
+                "summary": "List the prepaid loads of a SIM card, latest first",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
+                        "type": "string",
+                        "description": "Mobile number",
+                        "name": "mobile_number",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "name": "end_date",
-                        "in": "query"
-                    },
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "page number",
                         "name": "page",
                         "in": "query"
                     },
@@ -699,138 +611,172 @@ const docTemplate = `{
                         "maximum": 30,
                         "minimum": 1,
                         "type": "integer",
-                        "description": "limit",
                         "name": "per_page",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "start_date",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/PaginatedStationObservations"
+                            "$ref": "#/definitions/PaginatedGlobeLabsLoads"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/sims/{mobile_number}/top-up": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
+                    "sims"
                 ],
-                "summary": "Create station observation",
+                "summary": "Request prepaid load for a SIM card through Globe Labs rewards",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
+                        "type": "string",
+                        "description": "Mobile number",
+                        "name": "mobile_number",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Create station observation parameters",
-                        "name": "stnObs",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateStationObservationReq"
-                        }
+                        "type": "string",
+                        "description": "Key under which the request is made at most once",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "Request already made under the key",
+                        "schema": {
+                            "$ref": "#/definitions/LoadRequest"
+                        }
+                    },
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/StationObservation"
+                            "$ref": "#/definitions/LoadRequest"
                         }
                     }
                 }
             }
         },
-        "/stations/{station_id}/observations/latest": {
+        "/admin/sms/messages": {
             "get": {
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
+                    "sms"
                 ],
-                "summary": "Get latest station observation",
+                "summary": "List outbound SMS and their delivery status, latest first",
                 "parameters": [
                     {
+                        "type": "string",
+                        "name": "mobile_number",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
-                        "in": "path",
-                        "required": true
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "QUEUED",
+                            "SENDING",
+                            "SENT",
+                            "FAILED",
+                            "CANCELLED"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/LatestObservation"
+                            "$ref": "#/definitions/PaginatedSmsMessages"
                         }
                     }
                 }
             }
         },
-        "/stations/{station_id}/observations/{id}": {
+        "/admin/sms/queries": {
             "get": {
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
+                    "sms"
                 ],
-                "summary": "Get station observation",
+                "summary": "List the keyword queries texted by subscribers, latest first",
                 "parameters": [
                     {
+                        "type": "string",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "mobile_number",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
-                        "in": "path",
-                        "required": true
+                        "name": "page",
+                        "in": "query"
                     },
                     {
+                        "maximum": 30,
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Station Observation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "per_page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/StationObservation"
+                            "$ref": "#/definitions/PaginatedSmsQueries"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/sms/subscriptions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -840,49 +786,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
+                    "sms"
                 ],
-                "summary": "Update station observation",
+                "summary": "List the stations followed by SMS subscribers",
                 "parameters": [
                     {
+                        "type": "string",
+                        "name": "mobile_number",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
-                        "in": "path",
-                        "required": true
+                        "name": "page",
+                        "in": "query"
                     },
                     {
+                        "maximum": 30,
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Station Observation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "per_page",
+                        "in": "query"
                     },
                     {
-                        "description": "Update station observation parameters",
-                        "name": "stnObs",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/UpdateStationObservationParams"
-                        }
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "station_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/StationObservation"
+                            "$ref": "#/definitions/PaginatedSmsSubscriptions"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "The subscriber must have opted in through Globe Labs. Alerts and daily summaries are on by default.",
                 "consumes": [
                     "application/json"
                 ],
@@ -890,133 +838,86 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "observations"
-                ],
-                "summary": "Delete station observation",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Station ID",
-                        "name": "station_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Station Observation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            }
-        },
-        "/tokens/renew": {
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "users"
+                    "sms"
                 ],
-                "summary": "Renew access token",
+                "summary": "Make an SMS subscriber follow a station",
                 "parameters": [
                     {
-                        "description": "Renew access token parameters",
+                        "description": "Create SMS subscription parameters",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/RenewAccessTokenParams"
+                            "$ref": "#/definitions/CreateSmsSubscriptionParams"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/SmsSubscription"
+                        }
                     }
                 }
             }
         },
-        "/users": {
-            "get": {
+        "/admin/sms/subscriptions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "users"
+                    "sms"
                 ],
-                "summary": "List users",
+                "summary": "Stop an SMS subscriber from following a station",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 30,
-                        "minimum": 1,
                         "type": "integer",
-                        "name": "per_page",
-                        "in": "query"
+                        "description": "SMS subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/PaginatedUsers"
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/users/auth": {
+        "/admin/webhooks/rejections": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "sms"
                 ],
-                "summary": "Get Auth User",
+                "summary": "Count the webhook requests rejected since the server started, by provider and reason",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookRejection"
+                            }
                         }
                     }
                 }
             }
         },
-        "/users/login": {
-            "post": {
+        "/glabs": {
+            "get": {
                 "consumes": [
                     "application/json"
                 ],
@@ -1024,28 +925,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "globelabs"
                 ],
-                "summary": "User login",
+                "summary": "Globe Labs opt-in",
                 "parameters": [
                     {
-                        "description": "Login user parameters",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/LoginUserParams"
-                        }
+                        "type": "string",
+                        "name": "access_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "subscriber_number",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GlobeLabsOptInResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/users/logout": {
+            },
             "post": {
                 "consumes": [
                     "application/json"
@@ -1054,9 +962,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "globelabs"
                 ],
-                "summary": "User logout",
+                "summary": "Globe Labs unsubscribe",
                 "responses": {
                     "204": {
                         "description": "No Content"
@@ -1064,7 +972,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/register": {
+        "/glabs/inbound": {
             "post": {
                 "consumes": [
                     "application/json"
@@ -1073,34 +981,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "globelabs"
                 ],
-                "summary": "Register user",
+                "summary": "Store Lufft observation and health from Globe Labs inbound SMS",
                 "parameters": [
                     {
-                        "description": "Register user parameters",
+                        "description": "Globe Labs inbound SMS notification",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/RegisterUserParams"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SmsInboundResponse"
+                        }
                     }
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
+        "/glabs/load": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -1108,33 +1014,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
-                ],
-                "summary": "Get user",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "globelabs"
                 ],
+                "summary": "Create Globe Labs entry",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/GlobeLabsLoadResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
+            }
+        },
+        "/lufft/{station_id}/logs": {
+            "get": {
                 "consumes": [
                     "application/json"
                 ],
@@ -1142,42 +1036,112 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "lufft"
                 ],
-                "summary": "Update user",
+                "summary": "Lufft Message Logs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Station ID",
+                        "name": "station_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update user parameters",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/UpdateUserParams"
+                            "$ref": "#/definitions/handlers.paginatedLufftMsgLogs"
                         }
                     }
+                }
+            }
+        },
+        "/observations": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "list station observation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "station_ids",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "geojson",
+                            "cf"
+                        ],
+                        "type": "string",
+                        "description": "Export format, streams the full range when not json",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/PaginatedStationObservations"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/observations/batch": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Stores up to 10000 observations, each of the station given by its station_id or mobile_number, and reports the outcome of each row. Rows already stored are reported as duplicates, so a failed batch can be sent again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1185,17 +1149,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "observations"
                 ],
-                "summary": "Create user",
+                "summary": "Create a batch of observations of several stations",
                 "parameters": [
                     {
-                        "description": "Create user parameters",
+                        "description": "Create observation batch parameters",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateUserParams"
+                            "$ref": "#/definitions/CreateStationObservationBatchParams"
                         }
                     }
                 ],
@@ -1203,12 +1167,69 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/StationObservationBatchResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/observations/latest": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "list latest observation",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/LatestObservation"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ptexter": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotexter"
+                ],
+                "summary": "Store Lufft observation and health",
+                "parameters": [
+                    {
+                        "description": "Promo Texter parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/LufftSMSParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/LufftResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1221,76 +1242,2508 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "roles"
                 ],
-                "summary": "Delete user",
+                "summary": "List roles",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "User ID",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedRoles"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Get role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Role"
+                        }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "CreateRoleParams": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Update role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update role parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateRoleParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Role"
+                        }
+                    }
                 }
-            }
-        },
-        "CreateStationObservationReq": {
-            "type": "object",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Create role",
+                "parameters": [
+                    {
+                        "description": "Create role parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateRoleParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Role"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Delete role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/sms/{provider}": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sms"
+                ],
+                "summary": "Store Lufft observation and health from an SMS gateway, or answer keyword queries such as WEATHER, RAIN and HELP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SMS gateway, e.g. GLABS, PROMOTEXTER or HTTP",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inbound SMS payload of the gateway",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SmsInboundResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "List stations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "circle",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedStations"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Create station",
+                "parameters": [
+                    {
+                        "description": "Create station parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateStationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/Station"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/nearest/observations/latest": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Get nearest latest station observation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "pt",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/LatestObservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Get station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Station"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Update station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update station parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateStationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Station"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Delete station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/credentials": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Set the WeatherLink v2 credential of a station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Station credential parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateStationCredentialParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationCredential"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Delete the credential of a station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/health": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "List station health",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "start_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedStationHealths"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/health/alerts": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "List station health alerts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "OPEN",
+                            "ACKNOWLEDGED",
+                            "RESOLVED"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedStationHealthAlerts"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/health/alerts/{id}/acknowledge": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Acknowledge an open station health alert",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationHealthAlert"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/health/alerts/{id}/resolve": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Resolve an active station health alert",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationHealthAlert"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/health/latest": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Get latest station health",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationHealth"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/mo-observations": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mo observations"
+                ],
+                "summary": "List station MO observations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "start_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedMoObservations"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mo observations"
+                ],
+                "summary": "Create station MO observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create MO observation parameters",
+                        "name": "moObs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateMoObservationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/MoObservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/mo-observations/latest": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mo observations"
+                ],
+                "summary": "Get latest station MO observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MoObservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/mo-observations/{id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mo observations"
+                ],
+                "summary": "Get station MO observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "MO Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MoObservation"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mo observations"
+                ],
+                "summary": "Update station MO observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "MO Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update MO observation parameters",
+                        "name": "moObs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateMoObservationParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MoObservation"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mo observations"
+                ],
+                "summary": "Delete station MO observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "MO Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "List station observations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "geojson",
+                            "cf"
+                        ],
+                        "type": "string",
+                        "description": "Export format, streams the full range when not json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedStationObservations"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Create station observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create station observation parameters",
+                        "name": "stnObs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateStationObservationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/StationObservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stores up to 10000 observations, e.g. buffered by a gateway during an outage, and reports the outcome of each row. Rows already stored are reported as duplicates, so a failed batch can be sent again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Create a batch of station observations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create station observation batch parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateStationObservationBatchParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationObservationBatchResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations/daily": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "List station daily observations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "start_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedDerivedObservations"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations/hourly": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "List station hourly observations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "start_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedDerivedObservations"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations/latest": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Get latest station observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/LatestObservation"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations/{id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Get station observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Station Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationObservation"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Update station observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Station Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update station observation parameters",
+                        "name": "stnObs",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateStationObservationParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationObservation"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Delete station observation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Station Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/observations/{id}/qc": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Get station observation quality-control flags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Station Observation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationObservationQc"
+                        }
+                    }
+                }
+            }
+        },
+        "/stations/{station_id}/upload-key": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Set the upload ID and key of a station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Station upload key parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateStationUploadKeyParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/StationUploadKey"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stations"
+                ],
+                "summary": "Delete the upload key of a station",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Station ID",
+                        "name": "station_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/tokens/renew": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Renew access token",
+                "parameters": [
+                    {
+                        "description": "Renew access token parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RenewAccessTokenParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/upload/ecowitt": {
+            "post": {
+                "description": "Compatible with the customized uploads of Ecowitt stations. The upload ID and key of the station are sent as the ID and PASSWORD query parameters of the path set on the station. The PASSKEY of the Ecowitt protocol is not supported: it is derived from the MAC address of the station, so it cannot serve as a key.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Upload an observation with the Ecowitt protocol",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "ID",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload key",
+                        "name": "PASSWORD",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/upload/wunderground/updateweatherstation.php": {
+            "get": {
+                "description": "Compatible with the updateweatherstation.php requests of consumer stations. ID and PASSWORD are the upload ID and key of the station. Values are in imperial units; dateutc is in UTC, or \"now\".",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "observations"
+                ],
+                "summary": "Upload an observation with the Wunderground protocol",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "ID",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload key",
+                        "name": "PASSWORD",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Observation time",
+                        "name": "dateutc",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "per_page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PaginatedUsers"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/auth": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get Auth User",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "Login user parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/LoginUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "User logout",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/users/register": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register user",
+                "parameters": [
+                    {
+                        "description": "Register user parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RegisterUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create user",
+                "parameters": [
+                    {
+                        "description": "Create user parameters",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "CreateMoObservationReq": {
+            "type": "object",
+            "properties": {
+                "cdd": {
+                    "type": "number"
+                },
+                "et": {
+                    "type": "number"
+                },
+                "hdd": {
+                    "type": "number"
+                },
+                "hi": {
+                    "type": "number"
+                },
+                "pres": {
+                    "type": "number"
+                },
+                "qc_level": {
+                    "type": "integer"
+                },
+                "rain": {
+                    "type": "number"
+                },
+                "rh": {
+                    "type": "number"
+                },
+                "rr": {
+                    "type": "number"
+                },
+                "senergy": {
+                    "type": "number"
+                },
+                "srad": {
+                    "type": "number"
+                },
+                "sradx": {
+                    "type": "number"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "td": {
+                    "type": "number"
+                },
+                "temp": {
+                    "type": "number"
+                },
+                "thswi": {
+                    "type": "number"
+                },
+                "thwi": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "tn": {
+                    "type": "number"
+                },
+                "tx": {
+                    "type": "number"
+                },
+                "uvdose": {
+                    "type": "number"
+                },
+                "uvi": {
+                    "type": "number"
+                },
+                "uvx": {
+                    "type": "number"
+                },
+                "wchill": {
+                    "type": "number"
+                },
+                "wdir": {
+                    "type": "number"
+                },
+                "wdirx": {
+                    "type": "number"
+                },
+                "wrun": {
+                    "type": "number"
+                },
+                "wspd": {
+                    "type": "number"
+                },
+                "wspdx": {
+                    "type": "number"
+                }
+            }
+        },
+        "CreateRoleParams": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateSimCardParams": {
+            "type": "object",
+            "required": [
+                "mobile_number"
+            ],
+            "properties": {
+                "mobile_number": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "CreateSmsSubscriptionParams": {
+            "type": "object",
+            "required": [
+                "mobile_number",
+                "station_id"
+            ],
+            "properties": {
+                "alerts": {
+                    "type": "boolean"
+                },
+                "daily_summary": {
+                    "type": "boolean"
+                },
+                "mobile_number": {
+                    "type": "string"
+                },
+                "station_id": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "CreateStationObservationBatchParams": {
+            "type": "object",
+            "required": [
+                "observations"
+            ],
+            "properties": {
+                "observations": {
+                    "description": "Rows are decoded one by one, so that a malformed row is reported\nwithout rejecting the others.",
+                    "type": "array",
+                    "maxItems": 10000,
+                    "minItems": 1,
+                    "items": {
+                        "type": "object"
+                    }
+                }
+            }
+        },
+        "CreateStationObservationReq": {
+            "type": "object",
+            "properties": {
+                "hi": {
+                    "type": "number"
+                },
+                "mslp": {
+                    "type": "number"
+                },
+                "pres": {
+                    "type": "number"
+                },
+                "qc_level": {
+                    "type": "integer"
+                },
+                "rh": {
+                    "type": "number"
+                },
+                "rr": {
+                    "type": "number"
+                },
+                "srad": {
+                    "type": "number"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "td": {
+                    "type": "number"
+                },
+                "temp": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "wchill": {
+                    "type": "number"
+                },
+                "wdir": {
+                    "type": "number"
+                },
+                "wspd": {
+                    "type": "number"
+                },
+                "wspdx": {
+                    "type": "number"
+                }
+            }
+        },
+        "CreateStationReq": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "date_installed": {
+                    "type": "string"
+                },
+                "elevation": {
+                    "type": "number"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "mobile_number": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "province": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "station_type": {
+                    "type": "string"
+                },
+                "station_type2": {
+                    "type": "string"
+                },
+                "station_url": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateUserParams": {
+            "type": "object",
+            "required": [
+                "email",
+                "full_name",
+                "password",
+                "username"
+            ],
             "properties": {
-                "hi": {
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "GlobeLabsLoadResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "mobile_number": {
+                    "type": "string"
+                },
+                "promo": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "GlobeLabsOptInResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "is_created": {
+                    "type": "boolean"
+                },
+                "mobile_number": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "Job": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "next_run": {
+                    "type": "string"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "running": {
+                    "type": "boolean"
+                },
+                "schedule": {
+                    "type": "string"
+                }
+            }
+        },
+        "JobRun": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "count_success": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_name": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "trigger": {
+                    "type": "string"
+                }
+            }
+        },
+        "LatestObservation": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "elevation": {
                     "type": "number"
                 },
-                "mslp": {
+                "id": {
+                    "type": "integer"
+                },
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "obs": {
+                    "$ref": "#/definitions/handlers.latestObsRes"
+                }
+            }
+        },
+        "LoadRequest": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "glabs_load_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "idempotency_key": {
+                    "type": "string"
+                },
+                "mobile_number": {
+                    "type": "string"
+                },
+                "promo": {
+                    "type": "string"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                },
+                "trigger": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "LoginUserParams": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "LufftResponse": {
+            "type": "object",
+            "properties": {
+                "health": {
+                    "$ref": "#/definitions/StationHealth"
+                },
+                "observation": {
+                    "$ref": "#/definitions/StationObservation"
+                },
+                "station": {
+                    "$ref": "#/definitions/Station"
+                }
+            }
+        },
+        "LufftSMSParams": {
+            "type": "object",
+            "properties": {
+                "msg": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string"
+                }
+            }
+        },
+        "MoObservation": {
+            "type": "object",
+            "properties": {
+                "cdd": {
+                    "type": "number"
+                },
+                "et": {
+                    "type": "number"
+                },
+                "hdd": {
+                    "type": "number"
+                },
+                "hi": {
                     "type": "number"
                 },
+                "id": {
+                    "type": "integer"
+                },
                 "pres": {
                     "type": "number"
                 },
                 "qc_level": {
                     "type": "integer"
                 },
+                "rain": {
+                    "type": "number"
+                },
                 "rh": {
                     "type": "number"
                 },
-                "rr": {
+                "rr": {
+                    "type": "number"
+                },
+                "senergy": {
+                    "type": "number"
+                },
+                "srad": {
+                    "type": "number"
+                },
+                "sradx": {
+                    "type": "number"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "td": {
+                    "type": "number"
+                },
+                "temp": {
+                    "type": "number"
+                },
+                "thswi": {
+                    "type": "number"
+                },
+                "thwi": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "tn": {
                     "type": "number"
                 },
-                "srad": {
+                "tx": {
                     "type": "number"
                 },
-                "station_id": {
-                    "type": "integer"
-                },
-                "td": {
+                "uvdose": {
                     "type": "number"
                 },
-                "temp": {
+                "uvi": {
                     "type": "number"
                 },
-                "timestamp": {
-                    "type": "string"
+                "uvx": {
+                    "type": "number"
                 },
                 "wchill": {
                     "type": "number"
@@ -1298,6 +3751,12 @@ const docTemplate = `{
                 "wdir": {
                     "type": "number"
                 },
+                "wdirx": {
+                    "type": "number"
+                },
+                "wrun": {
+                    "type": "number"
+                },
                 "wspd": {
                     "type": "number"
                 },
@@ -1306,62 +3765,105 @@ const docTemplate = `{
                 }
             }
         },
-        "CreateStationReq": {
+        "PaginatedDerivedObservations": {
+            "type": "object"
+        },
+        "PaginatedGlobeLabsLoads": {
+            "type": "object"
+        },
+        "PaginatedJobRuns": {
+            "type": "object"
+        },
+        "PaginatedLoadRequests": {
+            "type": "object"
+        },
+        "PaginatedMoObservations": {
+            "type": "object"
+        },
+        "PaginatedRawMessages": {
+            "type": "object"
+        },
+        "PaginatedRoles": {
+            "type": "object"
+        },
+        "PaginatedSimCards": {
+            "type": "object"
+        },
+        "PaginatedSmsMessages": {
+            "type": "object"
+        },
+        "PaginatedSmsQueries": {
+            "type": "object"
+        },
+        "PaginatedSmsSubscriptions": {
+            "type": "object"
+        },
+        "PaginatedStationHealthAlerts": {
+            "type": "object"
+        },
+        "PaginatedStationHealths": {
+            "type": "object"
+        },
+        "PaginatedStationObservations": {
+            "type": "object"
+        },
+        "PaginatedStations": {
+            "type": "object"
+        },
+        "PaginatedUsers": {
+            "type": "object"
+        },
+        "QcFlag": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "date_installed": {
-                    "type": "string"
-                },
-                "elevation": {
-                    "type": "number"
-                },
-                "lat": {
-                    "type": "number"
-                },
-                "lon": {
-                    "type": "number"
-                },
-                "mobile_number": {
+                "check": {
                     "type": "string"
                 },
-                "name": {
+                "created_at": {
                     "type": "string"
                 },
-                "province": {
-                    "type": "string"
+                "level": {
+                    "type": "integer"
                 },
-                "region": {
+                "reason": {
                     "type": "string"
                 },
-                "station_type": {
+                "variable": {
                     "type": "string"
+                }
+            }
+        },
+        "RawMessageReplayResult": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/LufftResponse"
                 },
-                "station_type2": {
+                "error": {
                     "type": "string"
                 },
-                "station_url": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "CreateUserParams": {
+        "RegisterUserParams": {
             "type": "object",
             "required": [
+                "confirm_password",
                 "email",
                 "full_name",
                 "password",
                 "username"
             ],
             "properties": {
+                "confirm_password": {
+                    "type": "string",
+                    "minLength": 6
+                },
                 "email": {
                     "type": "string"
                 },
@@ -1372,188 +3874,155 @@ const docTemplate = `{
                     "type": "string",
                     "minLength": 6
                 },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
                 "username": {
                     "type": "string"
                 }
             }
         },
-        "GlobeLabsLoadResponse": {
+        "RenewAccessTokenParams": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "mobile_number": {
-                    "type": "string"
-                },
-                "promo": {
-                    "type": "string"
-                },
-                "status": {
+                "refresh_token": {
                     "type": "string"
+                }
+            }
+        },
+        "ReplayRawMessagesParams": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "ReplayRawMessagesResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "transaction_id": {
+                "count_success": {
                     "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RawMessageReplayResult"
+                    }
                 }
             }
         },
-        "GlobeLabsOptInResponse": {
+        "Role": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "created_at": {
                     "type": "string"
                 },
-                "is_created": {
-                    "type": "boolean"
+                "description": {
+                    "type": "string"
                 },
-                "mobile_number": {
+                "name": {
                     "type": "string"
                 },
-                "type": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "LatestObservation": {
+        "SimCard": {
             "type": "object",
             "properties": {
-                "address": {
+                "created_at": {
                     "type": "string"
                 },
-                "elevation": {
-                    "type": "number"
+                "load_expires_at": {
+                    "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "load_flagged_at": {
+                    "type": "string"
                 },
-                "lat": {
-                    "type": "number"
+                "mobile_number": {
+                    "type": "string"
                 },
-                "lon": {
-                    "type": "number"
+                "station_id": {
+                    "type": "integer"
                 },
-                "name": {
+                "station_name": {
                     "type": "string"
                 },
-                "obs": {
-                    "$ref": "#/definitions/handlers.latestObsRes"
-                }
-            }
-        },
-        "LoginUserParams": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "type": "string",
-                    "minLength": 6
-                },
-                "username": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "LufftResponse": {
+        "SmsInboundResponse": {
             "type": "object",
             "properties": {
-                "health": {
-                    "$ref": "#/definitions/StationHealth"
+                "count": {
+                    "type": "integer"
                 },
-                "observation": {
-                    "$ref": "#/definitions/StationObservation"
+                "count_success": {
+                    "type": "integer"
                 },
-                "station": {
-                    "$ref": "#/definitions/Station"
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/SmsInboundResult"
+                    }
                 }
             }
         },
-        "LufftSMSParams": {
+        "SmsInboundResult": {
             "type": "object",
-            "required": [
-                "msg",
-                "number"
-            ],
             "properties": {
-                "msg": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/LufftResponse"
                 },
-                "number": {
+                "error": {
                     "type": "string"
-                }
-            }
-        },
-        "PaginatedRoles": {
-            "type": "object"
-        },
-        "PaginatedStationObservations": {
-            "type": "object"
-        },
-        "PaginatedStations": {
-            "type": "object"
-        },
-        "PaginatedUsers": {
-            "type": "object"
-        },
-        "RegisterUserParams": {
-            "type": "object",
-            "required": [
-                "confirm_password",
-                "email",
-                "full_name",
-                "password",
-                "username"
-            ],
-            "properties": {
-                "confirm_password": {
-                    "type": "string",
-                    "minLength": 6
                 },
-                "email": {
+                "message_id": {
                     "type": "string"
                 },
-                "full_name": {
+                "reply": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
-                },
-                "username": {
-                    "type": "string"
-                }
-            }
-        },
-        "RenewAccessTokenParams": {
-            "type": "object",
-            "properties": {
-                "refresh_token": {
+                "sender": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "integer"
                 }
             }
         },
-        "Role": {
+        "SmsSubscription": {
             "type": "object",
             "properties": {
+                "alerts": {
+                    "type": "boolean"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "daily_summary": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
                 },
-                "name": {
+                "mobile_number": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "station_id": {
+                    "type": "integer"
                 }
             }
         },
@@ -1601,6 +4070,49 @@ const docTemplate = `{
                 },
                 "status": {
                     "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "StationAtRisk": {
+            "type": "object",
+            "properties": {
+                "load_expires_at": {
+                    "type": "string"
+                },
+                "load_flagged_at": {
+                    "type": "string"
+                },
+                "mobile_number": {
+                    "type": "string"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "station_name": {
+                    "type": "string"
+                },
+                "station_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "StationCredential": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "type": "string"
+                },
+                "api_station_id": {
+                    "type": "string"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
@@ -1637,6 +4149,9 @@ const docTemplate = `{
                 "message": {
                     "type": "string"
                 },
+                "minutes_difference": {
+                    "type": "integer"
+                },
                 "rh_arq": {
                     "type": "number"
                 },
@@ -1660,6 +4175,47 @@ const docTemplate = `{
                 }
             }
         },
+        "StationHealthAlert": {
+            "type": "object",
+            "properties": {
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "string"
+                },
+                "health_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "opened_at": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "rule": {
+                    "type": "string"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
         "StationObservation": {
             "type": "object",
             "properties": {
@@ -1713,6 +4269,176 @@ const docTemplate = `{
                 }
             }
         },
+        "StationObservationBatchResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "count_accepted": {
+                    "type": "integer"
+                },
+                "count_duplicate": {
+                    "type": "integer"
+                },
+                "count_invalid": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/StationObservationBatchResult"
+                    }
+                }
+            }
+        },
+        "StationObservationBatchResult": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "index": {
+                    "description": "Index of the row in the request.",
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "StationObservationQc": {
+            "type": "object",
+            "properties": {
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/QcFlag"
+                    }
+                },
+                "observation_id": {
+                    "type": "integer"
+                },
+                "qc_level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "StationUploadKey": {
+            "type": "object",
+            "properties": {
+                "station_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "upload_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "UpdateMoObservationParams": {
+            "type": "object",
+            "properties": {
+                "cdd": {
+                    "type": "number"
+                },
+                "et": {
+                    "type": "number"
+                },
+                "hdd": {
+                    "type": "number"
+                },
+                "hi": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "pres": {
+                    "type": "number"
+                },
+                "qc_level": {
+                    "type": "integer"
+                },
+                "rain": {
+                    "type": "number"
+                },
+                "rh": {
+                    "type": "number"
+                },
+                "rr": {
+                    "type": "number"
+                },
+                "senergy": {
+                    "type": "number"
+                },
+                "srad": {
+                    "type": "number"
+                },
+                "sradx": {
+                    "type": "number"
+                },
+                "station_id": {
+                    "type": "integer"
+                },
+                "td": {
+                    "type": "number"
+                },
+                "temp": {
+                    "type": "number"
+                },
+                "thswi": {
+                    "type": "number"
+                },
+                "thwi": {
+                    "type": "number"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "tn": {
+                    "type": "number"
+                },
+                "tx": {
+                    "type": "number"
+                },
+                "uvdose": {
+                    "type": "number"
+                },
+                "uvi": {
+                    "type": "number"
+                },
+                "uvx": {
+                    "type": "number"
+                },
+                "wchill": {
+                    "type": "number"
+                },
+                "wdir": {
+                    "type": "number"
+                },
+                "wdirx": {
+                    "type": "number"
+                },
+                "wrun": {
+                    "type": "number"
+                },
+                "wspd": {
+                    "type": "number"
+                },
+                "wspdx": {
+                    "type": "number"
+                }
+            }
+        },
         "UpdateRoleParams": {
             "type": "object",
             "properties": {
@@ -1724,6 +4450,33 @@ const docTemplate = `{
                 }
             }
         },
+        "UpdateSimCardParams": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "UpdateStationCredentialParams": {
+            "type": "object",
+            "required": [
+                "api_key",
+                "api_secret"
+            ],
+            "properties": {
+                "api_key": {
+                    "type": "string"
+                },
+                "api_secret": {
+                    "type": "string"
+                },
+                "api_station_id": {
+                    "type": "string"
+                }
+            }
+        },
         "UpdateStationObservationParams": {
             "type": "object",
             "properties": {
@@ -1821,6 +4574,27 @@ const docTemplate = `{
                 },
                 "status": {
                     "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "UpdateStationUploadKeyParams": {
+            "type": "object",
+            "required": [
+                "upload_id",
+                "upload_key"
+            ],
+            "properties": {
+                "upload_id": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "upload_key": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 16
                 }
             }
         },
@@ -1871,6 +4645,20 @@ const docTemplate = `{
                 }
             }
         },
+        "WebhookRejection": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
         "handlers.latestObsRes": {
             "type": "object",
             "properties": {
@@ -0,0 +1,203 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// cfColumn is a variable of the CF layout. Its values are spooled to a temporary
+// file while rows are streamed and copied to the output on Close.
+type cfColumn struct {
+	name  string
+	dtype string
+	attrs map[string]string
+	value func(row db.StreamObservationsRow) string
+	file  *os.File
+	buf   *bufio.Writer
+}
+
+// CFWriter writes observations in a columnar JSON layout following the CF conventions
+// for discrete sampling geometries, with one "obs" dimension shared by all variables,
+// similar to a NetCDF file with an incomplete multidimensional time series.
+//
+// Columns are spooled to temporary files so memory use does not grow with the number of rows.
+// The files are unlinked as soon as they are created, so nothing is left behind when a
+// stream is abandoned before Close.
+type CFWriter struct {
+	w     io.Writer
+	cols  []*cfColumn
+	count int
+}
+
+// NewCFWriter creates a new CFWriter
+func NewCFWriter(w io.Writer) (*CFWriter, error) {
+	cols := []*cfColumn{
+		{
+			name:  "time",
+			dtype: "int64",
+			attrs: map[string]string{"standard_name": "time", "units": "seconds since 1970-01-01 00:00:00 UTC"},
+			value: func(row db.StreamObservationsRow) string {
+				if !row.ObservationsObservation.Timestamp.Valid {
+					return "null"
+				}
+				return strconv.FormatInt(row.ObservationsObservation.Timestamp.Time.Unix(), 10)
+			},
+		},
+		{
+			name:  "station_id",
+			dtype: "int64",
+			attrs: map[string]string{"cf_role": "timeseries_id", "long_name": "Station ID"},
+			value: func(row db.StreamObservationsRow) string {
+				return strconv.FormatInt(row.ObservationsObservation.StationID, 10)
+			},
+		},
+		{
+			name:  "station_name",
+			dtype: "string",
+			attrs: map[string]string{"long_name": "Station name"},
+			value: func(row db.StreamObservationsRow) string {
+				data, _ := json.Marshal(row.Name)
+				return string(data)
+			},
+		},
+		{
+			name:  "lat",
+			dtype: "float32",
+			attrs: map[string]string{"standard_name": "latitude", "units": "degrees_north"},
+			value: func(row db.StreamObservationsRow) string { return cfFloat4(row.Lat) },
+		},
+		{
+			name:  "lon",
+			dtype: "float32",
+			attrs: map[string]string{"standard_name": "longitude", "units": "degrees_east"},
+			value: func(row db.StreamObservationsRow) string { return cfFloat4(row.Lon) },
+		},
+		{
+			name:  "alt",
+			dtype: "float32",
+			attrs: map[string]string{"standard_name": "height", "units": "m", "positive": "up"},
+			value: func(row db.StreamObservationsRow) string { return cfFloat4(row.Elevation) },
+		},
+	}
+	for _, v := range variables {
+		v := v
+		attrs := map[string]string{
+			"long_name":   v.LongName,
+			"units":       v.Units,
+			"coordinates": "time lat lon alt station_id",
+		}
+		if v.StandardName != "" {
+			attrs["standard_name"] = v.StandardName
+		}
+		cols = append(cols, &cfColumn{
+			name:  v.Name,
+			dtype: "float32",
+			attrs: attrs,
+			value: func(row db.StreamObservationsRow) string { return cfFloat4(v.value(row.ObservationsObservation)) },
+		})
+	}
+	cols = append(cols, &cfColumn{
+		name:  "qc_level",
+		dtype: "int32",
+		attrs: map[string]string{"long_name": "Quality control level"},
+		value: func(row db.StreamObservationsRow) string {
+			return strconv.FormatInt(int64(row.ObservationsObservation.QcLevel), 10)
+		},
+	})
+
+	for i, col := range cols {
+		f, err := os.CreateTemp("", "cf-export-"+col.name+"-")
+		if err == nil {
+			err = os.Remove(f.Name())
+		}
+		if err != nil {
+			for _, c := range cols[:i] {
+				c.file.Close()
+			}
+			return nil, err
+		}
+		col.file = f
+		col.buf = bufio.NewWriter(f)
+	}
+
+	return &CFWriter{w: w, cols: cols}, nil
+}
+
+func (c *CFWriter) Write(row db.StreamObservationsRow) error {
+	for _, col := range c.cols {
+		if c.count > 0 {
+			if err := col.buf.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err := col.buf.WriteString(col.value(row)); err != nil {
+			return err
+		}
+	}
+	c.count++
+	return nil
+}
+
+func (c *CFWriter) Close() error {
+	defer func() {
+		for _, col := range c.cols {
+			col.file.Close()
+		}
+	}()
+
+	header := map[string]any{
+		"Conventions": "CF-1.8",
+		"featureType": "timeSeries",
+	}
+	data, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(c.w, `{"attributes":`+string(data)+`,"dimensions":{"obs":`+strconv.Itoa(c.count)+`},"variables":{`); err != nil {
+		return err
+	}
+
+	for i, col := range c.cols {
+		if err := col.buf.Flush(); err != nil {
+			return err
+		}
+		if _, err := col.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+
+		name, _ := json.Marshal(col.name)
+		attrs, err := json.Marshal(col.attrs)
+		if err != nil {
+			return err
+		}
+		prefix := string(name) + `:{"shape":["obs"],"type":"` + col.dtype + `","attributes":` + string(attrs) + `,"data":[`
+		if i > 0 {
+			prefix = "," + prefix
+		}
+		if _, err := io.WriteString(c.w, prefix); err != nil {
+			return err
+		}
+		if _, err := io.Copy(c.w, col.file); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(c.w, "]}"); err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(c.w, "}}")
+	return err
+}
+
+func cfFloat4(f pgtype.Float4) string {
+	if !f.Valid {
+		return "null"
+	}
+	return strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)
+}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// csvFlushEvery is the number of rows buffered before they are flushed to the stream.
+const csvFlushEvery = 500
+
+// CSVWriter writes observations as comma-separated values with a header row,
+// written even without any observation. Missing values are left empty.
+type CSVWriter struct {
+	w         *csv.Writer
+	hasHeader bool
+	count     int
+}
+
+// NewCSVWriter creates a new CSVWriter
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w)}
+}
+
+func (c *CSVWriter) writeHeader() error {
+	if c.hasHeader {
+		return nil
+	}
+
+	header := []string{"station_id", "station_name", "lat", "lon", "timestamp"}
+	for _, v := range variables {
+		header = append(header, v.Name)
+	}
+	header = append(header, "qc_level")
+	if err := c.w.Write(header); err != nil {
+		return err
+	}
+	c.hasHeader = true
+	return nil
+}
+
+func (c *CSVWriter) Write(row db.StreamObservationsRow) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	obs := row.ObservationsObservation
+	record := []string{
+		strconv.FormatInt(obs.StationID, 10),
+		row.Name,
+		formatFloat4(row.Lat),
+		formatFloat4(row.Lon),
+		formatTime(obs.Timestamp),
+	}
+	for _, v := range variables {
+		record = append(record, formatFloat4(v.value(obs)))
+	}
+	record = append(record, strconv.FormatInt(int64(obs.QcLevel), 10))
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+
+	c.count++
+	if c.count%csvFlushEvery == 0 {
+		c.w.Flush()
+		return c.w.Error()
+	}
+	return nil
+}
+
+func (c *CSVWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func formatFloat4(f pgtype.Float4) string {
+	if !f.Valid {
+		return ""
+	}
+	return strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)
+}
@@ -0,0 +1,95 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Format is an export output format.
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatCSV     Format = "csv"
+	FormatGeoJSON Format = "geojson"
+	FormatCF      Format = "cf"
+)
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv"
+	case FormatGeoJSON:
+		return "application/geo+json"
+	default:
+		return "application/json"
+	}
+}
+
+// Extension returns the file extension of the format.
+func (f Format) Extension() string {
+	switch f {
+	case FormatCSV:
+		return "csv"
+	case FormatGeoJSON:
+		return "geojson"
+	default:
+		return "json"
+	}
+}
+
+// Writer encodes observations to a stream one row at a time.
+type Writer interface {
+	Write(row db.StreamObservationsRow) error
+	// Close writes any trailing content. It does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter creates a Writer for the given format
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	case FormatGeoJSON:
+		return NewGeoJSONWriter(w), nil
+	case FormatCF:
+		return NewCFWriter(w)
+	}
+	return nil, fmt.Errorf("unsupported export format: %s", format)
+}
+
+// variable describes an observed quantity in the exports.
+type variable struct {
+	Name         string
+	StandardName string
+	LongName     string
+	Units        string
+	value        func(o db.ObservationsObservation) pgtype.Float4
+}
+
+var variables = []variable{
+	{"pres", "air_pressure", "Station pressure", "hPa", func(o db.ObservationsObservation) pgtype.Float4 { return o.Pres }},
+	{"rr", "rainfall_rate", "Rain rate", "mm h-1", func(o db.ObservationsObservation) pgtype.Float4 { return o.Rr }},
+	{"rh", "relative_humidity", "Relative humidity", "%", func(o db.ObservationsObservation) pgtype.Float4 { return o.Rh }},
+	{"temp", "air_temperature", "Air temperature", "degC", func(o db.ObservationsObservation) pgtype.Float4 { return o.Temp }},
+	{"td", "dew_point_temperature", "Dew point temperature", "degC", func(o db.ObservationsObservation) pgtype.Float4 { return o.Td }},
+	{"wdir", "wind_from_direction", "Wind direction", "degree", func(o db.ObservationsObservation) pgtype.Float4 { return o.Wdir }},
+	{"wspd", "wind_speed", "Wind speed", "m s-1", func(o db.ObservationsObservation) pgtype.Float4 { return o.Wspd }},
+	{"wspdx", "wind_speed_of_gust", "Wind gust", "m s-1", func(o db.ObservationsObservation) pgtype.Float4 { return o.Wspdx }},
+	{"srad", "surface_downwelling_shortwave_flux_in_air", "Solar radiation", "W m-2", func(o db.ObservationsObservation) pgtype.Float4 { return o.Srad }},
+	{"mslp", "air_pressure_at_mean_sea_level", "Mean sea level pressure", "hPa", func(o db.ObservationsObservation) pgtype.Float4 { return o.Mslp }},
+	{"hi", "", "Heat index", "degC", func(o db.ObservationsObservation) pgtype.Float4 { return o.Hi }},
+	{"wchill", "", "Wind chill", "degC", func(o db.ObservationsObservation) pgtype.Float4 { return o.Wchill }},
+}
+
+func formatTime(t pgtype.Timestamptz) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
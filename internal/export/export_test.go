@@ -0,0 +1,139 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func testRows() []db.StreamObservationsRow {
+	ts := time.Date(2023, 9, 1, 8, 0, 0, 0, time.UTC)
+	return []db.StreamObservationsRow{
+		{
+			ObservationsObservation: db.ObservationsObservation{
+				ID:        1,
+				StationID: 7,
+				Temp:      pgtype.Float4{Float32: 28.5, Valid: true},
+				Rh:        pgtype.Float4{Float32: 80, Valid: true},
+				Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+				QcLevel:   3,
+			},
+			Name: "Manila, \"Port\"",
+			Lat:  pgtype.Float4{Float32: 14.5, Valid: true},
+			Lon:  pgtype.Float4{Float32: 121, Valid: true},
+		},
+		{
+			ObservationsObservation: db.ObservationsObservation{
+				ID:        2,
+				StationID: 7,
+				Temp:      pgtype.Float4{Float32: 29, Valid: true},
+				Timestamp: pgtype.Timestamptz{Time: ts.Add(10 * time.Minute), Valid: true},
+			},
+			Name: "Manila, \"Port\"",
+		},
+	}
+}
+
+func writeAll(t *testing.T, format Format, rows []db.StreamObservationsRow) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf)
+	require.NoError(t, err)
+	for _, row := range rows {
+		require.NoError(t, w.Write(row))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestCSVWriter(t *testing.T) {
+	data := writeAll(t, FormatCSV, testRows())
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	header := records[0]
+	require.Equal(t, []string{"station_id", "station_name", "lat", "lon", "timestamp"}, header[:5])
+	require.Equal(t, "qc_level", header[len(header)-1])
+
+	col := make(map[string]int)
+	for i, h := range header {
+		col[h] = i
+	}
+	require.Equal(t, "Manila, \"Port\"", records[1][col["station_name"]])
+	require.Equal(t, "2023-09-01T08:00:00Z", records[1][col["timestamp"]])
+	require.Equal(t, "28.5", records[1][col["temp"]])
+	require.Equal(t, "", records[1][col["pres"]])
+	require.Equal(t, "", records[2][col["lat"]])
+	require.Equal(t, "0", records[2][col["qc_level"]])
+
+	records, err = csv.NewReader(bytes.NewReader(writeAll(t, FormatCSV, nil))).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{header}, records)
+}
+
+func TestGeoJSONWriter(t *testing.T) {
+	var got struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry *struct {
+				Type        string     `json:"type"`
+				Coordinates [2]float32 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+	}
+
+	err := json.Unmarshal(writeAll(t, FormatGeoJSON, testRows()), &got)
+	require.NoError(t, err)
+	require.Equal(t, "FeatureCollection", got.Type)
+	require.Len(t, got.Features, 2)
+	require.Equal(t, [2]float32{121, 14.5}, got.Features[0].Geometry.Coordinates)
+	require.Equal(t, 28.5, got.Features[0].Properties["temp"])
+	require.Nil(t, got.Features[0].Properties["pres"])
+	require.Nil(t, got.Features[1].Geometry)
+
+	err = json.Unmarshal(writeAll(t, FormatGeoJSON, nil), &got)
+	require.NoError(t, err)
+	require.Empty(t, got.Features)
+}
+
+func TestCFWriter(t *testing.T) {
+	var got struct {
+		Attributes map[string]string `json:"attributes"`
+		Dimensions map[string]int    `json:"dimensions"`
+		Variables  map[string]struct {
+			Shape      []string          `json:"shape"`
+			Attributes map[string]string `json:"attributes"`
+			Data       []any             `json:"data"`
+		} `json:"variables"`
+	}
+
+	err := json.Unmarshal(writeAll(t, FormatCF, testRows()), &got)
+	require.NoError(t, err)
+	require.Equal(t, "timeSeries", got.Attributes["featureType"])
+	require.Equal(t, 2, got.Dimensions["obs"])
+
+	temp := got.Variables["temp"]
+	require.Equal(t, []string{"obs"}, temp.Shape)
+	require.Equal(t, "air_temperature", temp.Attributes["standard_name"])
+	require.Equal(t, []any{28.5, 29.0}, temp.Data)
+	require.Equal(t, []any{80.0, nil}, got.Variables["rh"].Data)
+	require.Equal(t, []any{1693555200.0, 1693555800.0}, got.Variables["time"].Data)
+	require.Equal(t, []any{"Manila, \"Port\"", "Manila, \"Port\""}, got.Variables["station_name"].Data)
+
+	err = json.Unmarshal(writeAll(t, FormatCF, nil), &got)
+	require.NoError(t, err)
+	require.Equal(t, 0, got.Dimensions["obs"])
+}
+
+func TestNewWriterUnsupported(t *testing.T) {
+	_, err := NewWriter(FormatJSON, &bytes.Buffer{})
+	require.Error(t, err)
+}
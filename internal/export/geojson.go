@@ -0,0 +1,85 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+)
+
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float32 `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Type       string         `json:"type"`
+	Geometry   *geoJSONPoint  `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// GeoJSONWriter writes observations as a GeoJSON FeatureCollection, one Point feature
+// per observation located at its station.
+type GeoJSONWriter struct {
+	w     io.Writer
+	count int
+}
+
+// NewGeoJSONWriter creates a new GeoJSONWriter
+func NewGeoJSONWriter(w io.Writer) *GeoJSONWriter {
+	return &GeoJSONWriter{w: w}
+}
+
+func (g *GeoJSONWriter) Write(row db.StreamObservationsRow) error {
+	sep := ","
+	if g.count == 0 {
+		sep = `{"type":"FeatureCollection","features":[`
+	}
+
+	obs := row.ObservationsObservation
+	feature := geoJSONFeature{
+		Type: "Feature",
+		Properties: map[string]any{
+			"station_id":   obs.StationID,
+			"station_name": row.Name,
+			"timestamp":    formatTime(obs.Timestamp),
+			"qc_level":     obs.QcLevel,
+		},
+	}
+	if row.Lat.Valid && row.Lon.Valid {
+		feature.Geometry = &geoJSONPoint{
+			Type:        "Point",
+			Coordinates: [2]float32{row.Lon.Float32, row.Lat.Float32},
+		}
+	}
+	for _, v := range variables {
+		if f := v.value(obs); f.Valid {
+			feature.Properties[v.Name] = f.Float32
+		} else {
+			feature.Properties[v.Name] = nil
+		}
+	}
+
+	data, err := json.Marshal(feature)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(g.w, sep); err != nil {
+		return err
+	}
+	if _, err := g.w.Write(data); err != nil {
+		return err
+	}
+
+	g.count++
+	return nil
+}
+
+func (g *GeoJSONWriter) Close() error {
+	end := "]}"
+	if g.count == 0 {
+		end = `{"type":"FeatureCollection","features":[]}`
+	}
+	_, err := io.WriteString(g.w, end)
+	return err
+}
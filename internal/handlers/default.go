@@ -1,7 +1,12 @@
 package handlers
 
 import (
+	"github.com/emiliogozo/panahon-api-go/internal/alert"
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/emiliogozo/panahon-api-go/internal/qc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
@@ -11,13 +16,21 @@ import (
 )
 
 type DefaultHandler struct {
-	config     util.Config
-	store      db.Store
-	tokenMaker token.Maker
-	logger     *zerolog.Logger
+	config      util.Config
+	store       db.Store
+	tokenMaker  token.Maker
+	logger      *zerolog.Logger
+	qcChecker   *qc.Checker
+	alertEngine *alert.Engine
+	scheduler   *service.Scheduler
+	sms         *sms.Registry
+	notifier    *service.SmsNotifier
+	smsQuery    *service.SmsQuery
+	topUp       *service.LoadTopUp
+	webhooks    map[string]*mw.WebhookVerifier
 }
 
-func NewDefaultHandler(config util.Config, store db.Store, tokenMaker token.Maker, logger *zerolog.Logger) *DefaultHandler {
+func NewDefaultHandler(config util.Config, store db.Store, tokenMaker token.Maker, scheduler *service.Scheduler, svc service.Services, logger *zerolog.Logger) *DefaultHandler {
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("mobile_number", validMobileNumber)
 		v.RegisterValidation("fullname", validFullName)
@@ -26,10 +39,18 @@ func NewDefaultHandler(config util.Config, store db.Store, tokenMaker token.Make
 	}
 
 	return &DefaultHandler{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
-		logger:     logger,
+		config:      config,
+		store:       store,
+		tokenMaker:  tokenMaker,
+		logger:      logger,
+		qcChecker:   qc.NewDefaultChecker(),
+		alertEngine: alert.NewDefaultEngine(),
+		scheduler:   scheduler,
+		sms:         svc.Sms,
+		notifier:    svc.Notifier,
+		smsQuery:    service.NewSmsQuery(store, logger),
+		topUp:       svc.TopUp,
+		webhooks:    newWebhookVerifiers(config, logger),
 	}
 }
 
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type paginatedDerivedObservations = util.PaginatedList[models.DerivedObservation] //@name PaginatedDerivedObservations
+
+// ListStationHourlyObservations
+//
+//	@Summary	List station hourly observations
+//	@Tags		observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int					true	"Station ID"
+//	@Param		req			query		listStationObsReq	false	"List station observations parameters"
+//	@Success	200			{object}	paginatedDerivedObservations
+//	@Router		/stations/{station_id}/observations/hourly [get]
+func (h *DefaultHandler) ListStationHourlyObservations(ctx *gin.Context) {
+	var uri listStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationObsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListStationHourlyObservationsParams{
+		StationID: uri.StationID,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset:      offset,
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+	}
+
+	observations, err := h.store.ListStationHourlyObservations(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]models.DerivedObservation, len(observations))
+	for i, observation := range observations {
+		items[i] = models.NewDerivedObservation(observation)
+	}
+
+	count, err := h.store.CountStationHourlyObservations(ctx, db.CountStationHourlyObservationsParams{
+		StationID:   arg.StationID,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// ListStationDailyObservations
+//
+//	@Summary	List station daily observations
+//	@Tags		observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int					true	"Station ID"
+//	@Param		req			query		listStationObsReq	false	"List station observations parameters"
+//	@Success	200			{object}	paginatedDerivedObservations
+//	@Router		/stations/{station_id}/observations/daily [get]
+func (h *DefaultHandler) ListStationDailyObservations(ctx *gin.Context) {
+	var uri listStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationObsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListStationDailyObservationsParams{
+		StationID: uri.StationID,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset:      offset,
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+	}
+
+	observations, err := h.store.ListStationDailyObservations(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]models.DerivedObservation, len(observations))
+	for i, observation := range observations {
+		items[i] = models.NewDerivedObservation(observation)
+	}
+
+	count, err := h.store.CountStationDailyObservations(ctx, db.CountStationDailyObservationsParams{
+		StationID:   arg.StationID,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
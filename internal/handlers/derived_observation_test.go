@@ -0,0 +1,281 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStationHourlyObservationsAPI(t *testing.T) {
+	n := 5
+	stationID := int64(gofakeit.Number(1, 100))
+	obsSlice := make([]db.ObservationsDerivedhourly, n)
+	for i := range obsSlice {
+		obsSlice[i] = db.ObservationsDerivedhourly(randomDerivedObservation(stationID, time.Hour))
+	}
+
+	testCases := []struct {
+		name          string
+		query         listStationObsReq
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: listStationObsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationHourlyObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(args db.ListStationHourlyObservationsParams) bool {
+						return args.StationID == stationID && !args.IsStartDate && !args.IsEndDate
+					})).
+					Return(obsSlice, nil)
+				store.EXPECT().CountStationHourlyObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				want := make([]models.DerivedObservation, n)
+				for i, o := range obsSlice {
+					want[i] = models.NewDerivedObservation(o)
+				}
+				requireBodyMatchDerivedObservations(t, recorder.Body, want)
+			},
+		},
+		{
+			name: "WithStartAndEndDate",
+			query: listStationObsReq{
+				StartDate: "2023-09-01",
+				EndDate:   "2023-09-01T23:00:00",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationHourlyObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(args db.ListStationHourlyObservationsParams) bool {
+						return args.IsStartDate && args.IsEndDate
+					})).
+					Return(obsSlice, nil)
+				store.EXPECT().CountStationHourlyObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(args db.CountStationHourlyObservationsParams) bool {
+						return args.IsStartDate && args.IsEndDate
+					})).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: listStationObsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationHourlyObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidLimit",
+			query: listStationObsReq{
+				PerPage: 10000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationHourlyObservations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/observations/hourly", handler.ListStationHourlyObservations)
+
+			recorder := httptest.NewRecorder()
+
+			path := fmt.Sprintf("/%d/observations/hourly", stationID)
+			request, err := http.NewRequest(http.MethodGet, path, nil)
+			require.NoError(t, err)
+			request.URL.RawQuery = listStationObsQuery(tc.query)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListStationDailyObservationsAPI(t *testing.T) {
+	n := 3
+	stationID := int64(gofakeit.Number(1, 100))
+	obsSlice := make([]db.ObservationsDeriveddaily, n)
+	for i := range obsSlice {
+		obsSlice[i] = db.ObservationsDeriveddaily(randomDerivedObservation(stationID, 24*time.Hour))
+	}
+
+	testCases := []struct {
+		name          string
+		query         listStationObsReq
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: listStationObsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationDailyObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(args db.ListStationDailyObservationsParams) bool {
+						return args.StationID == stationID
+					})).
+					Return(obsSlice, nil)
+				store.EXPECT().CountStationDailyObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				want := make([]models.DerivedObservation, n)
+				for i, o := range obsSlice {
+					want[i] = models.NewDerivedObservation(o)
+				}
+				requireBodyMatchDerivedObservations(t, recorder.Body, want)
+			},
+		},
+		{
+			name:  "InternalError:CountStationDailyObservations",
+			query: listStationObsReq{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationDailyObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(obsSlice, nil)
+				store.EXPECT().CountStationDailyObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(0, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "WithInvalidStartDate",
+			query: listStationObsReq{
+				StartDate: "notdatetime",
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationDailyObservations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/observations/daily", handler.ListStationDailyObservations)
+
+			recorder := httptest.NewRecorder()
+
+			path := fmt.Sprintf("/%d/observations/daily", stationID)
+			request, err := http.NewRequest(http.MethodGet, path, nil)
+			require.NoError(t, err)
+			request.URL.RawQuery = listStationObsQuery(tc.query)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomDerivedObservation(stationID int64, period time.Duration) db.ObservationsDerivedhourly {
+	ts := gofakeit.Date().Truncate(period)
+	temp := gofakeit.Float32Range(25, 30)
+	tn := temp - 1
+	tx := temp + 1
+
+	return db.ObservationsDerivedhourly{
+		ID:          int64(gofakeit.Number(1, 1000)),
+		StationID:   stationID,
+		Temp:        util.ToFloat4(&temp),
+		Tn:          util.ToFloat4(&tn),
+		Tx:          util.ToFloat4(&tx),
+		DataCount:   6,
+		Timestamp:   pgtype.Timestamptz{Time: ts, Valid: true},
+		TnTimestamp: pgtype.Timestamptz{Time: ts.Add(10 * time.Minute), Valid: true},
+		TxTimestamp: pgtype.Timestamptz{Time: ts.Add(50 * time.Minute), Valid: true},
+	}
+}
+
+func listStationObsQuery(req listStationObsReq) string {
+	q := make(map[string][]string)
+	if req.Page != 0 {
+		q["page"] = []string{fmt.Sprintf("%d", req.Page)}
+	}
+	if req.PerPage != 0 {
+		q["per_page"] = []string{fmt.Sprintf("%d", req.PerPage)}
+	}
+	if len(req.StartDate) > 0 {
+		q["start_date"] = []string{req.StartDate}
+	}
+	if len(req.EndDate) > 0 {
+		q["end_date"] = []string{req.EndDate}
+	}
+	return url.Values(q).Encode()
+}
+
+func requireBodyMatchDerivedObservations(t *testing.T, body *bytes.Buffer, want []models.DerivedObservation) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got paginatedDerivedObservations
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Len(t, got.Items, len(want))
+	for i := range want {
+		require.Equal(t, want[i].StationID, got.Items[i].StationID)
+		require.Equal(t, want[i].Temp, got.Items[i].Temp)
+		require.WithinDuration(t, want[i].Timestamp, got.Items[i].Timestamp, time.Second)
+		require.WithinDuration(t, *want[i].TnTimestamp, *got.Items[i].TnTimestamp, time.Second)
+	}
+}
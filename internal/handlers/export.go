@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/export"
+	"github.com/gin-gonic/gin"
+)
+
+type exportReq struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv geojson cf"`
+}
+
+// exportFormat returns the format requested with the format query parameter,
+// falling back to the Accept header.
+func exportFormat(ctx *gin.Context, format string) export.Format {
+	if len(format) > 0 {
+		return export.Format(format)
+	}
+
+	switch ctx.NegotiateFormat(gin.MIMEJSON, "text/csv", "application/geo+json") {
+	case "text/csv":
+		return export.FormatCSV
+	case "application/geo+json":
+		return export.FormatGeoJSON
+	}
+	return export.FormatJSON
+}
+
+// exportResponse writes an export to the response, setting its headers on
+// the first write, so that an error before any is still sent as JSON.
+type exportResponse struct {
+	ctx     *gin.Context
+	format  export.Format
+	started bool
+}
+
+func (r *exportResponse) start() {
+	if r.started {
+		return
+	}
+	r.started = true
+	r.ctx.Header("Content-Type", r.format.ContentType())
+	r.ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="observations.%s"`, r.format.Extension()))
+	r.ctx.Status(http.StatusOK)
+}
+
+func (r *exportResponse) Write(p []byte) (int, error) {
+	r.start()
+	return r.ctx.Writer.Write(p)
+}
+
+// exportObservations streams all observations matching arg in the given format.
+func (h *DefaultHandler) exportObservations(ctx *gin.Context, format export.Format, arg db.StreamObservationsParams) {
+	res := &exportResponse{ctx: ctx, format: format}
+	w, err := export.NewWriter(format, res)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := h.store.StreamObservations(ctx, arg, w.Write); err != nil {
+		h.logger.Error().Err(err).
+			Str("format", string(format)).
+			Msg("[Export] Cannot stream observations")
+		if !res.started {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	if err := w.Close(); err != nil {
+		h.logger.Error().Err(err).
+			Str("format", string(format)).
+			Msg("[Export] Cannot write observations")
+	}
+	res.start()
+	ctx.Writer.WriteHeaderNow()
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportStationObservationsAPI(t *testing.T) {
+	stnObs := randomObservation(t)
+	rows := []db.StreamObservationsRow{
+		{ObservationsObservation: stnObs, Name: "Station"},
+		{ObservationsObservation: stnObs, Name: "Station"},
+	}
+	streamRows := func(_ context.Context, _ db.StreamObservationsParams, fn func(db.StreamObservationsRow) error) error {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		accept        string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "CSV",
+			query: "format=csv&start_date=2023-09-01",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().StreamObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(arg db.StreamObservationsParams) bool {
+						return len(arg.StationIds) == 1 && arg.StationIds[0] == stnObs.StationID && arg.IsStartDate && !arg.IsEndDate
+					}),
+					mock.Anything).
+					RunAndReturn(streamRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+				lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+				require.Len(t, lines, len(rows)+1)
+			},
+		},
+		{
+			name:   "GeoJSONFromAcceptHeader",
+			accept: "application/geo+json",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().StreamObservations(mock.AnythingOfType("*gin.Context"), mock.Anything, mock.Anything).
+					RunAndReturn(streamRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/geo+json", recorder.Header().Get("Content-Type"))
+
+				var got struct {
+					Features []any `json:"features"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Len(t, got.Features, len(rows))
+			},
+		},
+		{
+			name:  "InvalidFormat",
+			query: "format=xml",
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "StreamObservations", mock.Anything, mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "format=cf",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().StreamObservations(mock.AnythingOfType("*gin.Context"), mock.Anything, mock.Anything).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Type"), gin.MIMEJSON)
+				require.Empty(t, recorder.Header().Get("Content-Disposition"))
+			},
+		},
+		{
+			name:  "EmptyCSV",
+			query: "format=csv",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().StreamObservations(mock.AnythingOfType("*gin.Context"), mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+				require.Equal(t, `attachment; filename="observations.csv"`, recorder.Header().Get("Content-Disposition"))
+				lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+				require.Len(t, lines, 1)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/observations", handler.ListStationObservations)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/observations?%s", stnObs.StationID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+			if len(tc.accept) > 0 {
+				request.Header.Set("Accept", tc.accept)
+			}
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
@@ -6,10 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -161,9 +166,21 @@ func (h *DefaultHandler) GLabsUnsubscribe(ctx *gin.Context) {
 		return
 	}
 
+	// Messages still queued or in flight must not reach the subscriber.
+	cancelled, err := h.store.CancelPendingSmsMessages(ctx, db.CancelPendingSmsMessagesParams{
+		MobileNumber: mobileNumber,
+		Reason:       pgtype.Text{String: "unsubscribed", Valid: true},
+	})
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("mobile_number", mobileNumber).
+			Msg("[GLabs] Cannot cancel pending messages")
+	}
+
 	h.logger.Debug().
 		Str("mobile_number", mobileNumber).
 		Str("access_token_type", GLabsAccessTokenType).
+		Int64("cancelled_messages", cancelled).
 		Msg("[GLabs] Mobile number unregistered successfully")
 	ctx.JSON(http.StatusNoContent, nil)
 }
@@ -216,13 +233,49 @@ func newGLabsLoadResponse(g db.GlabsLoad) gLabsLoadRes {
 //	@Router		/glabs/load [post]
 func (h *DefaultHandler) CreateGLabsLoad(ctx *gin.Context) {
 	var req gLabsLoadReq
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	err := ctx.ShouldBindBodyWith(&req, binding.JSON)
+
+	// The notification is archived as received, so that a top up can be
+	// traced back to it.
+	var body []byte
+	if b, ok := ctx.Get(gin.BodyBytesKey); ok {
+		body, _ = b.([]byte)
+	}
+	arg := db.CreateRawMessageParams{
+		Provider: RawMessageProviderGLabsLoad,
+		Sender:   req.OutboundRewardRequest.Address,
+		Body:     string(body),
+		Status:   service.RawMessageStatusReceived,
+	}
+	if req.OutboundRewardRequest.TransactionID != 0 {
+		arg.MessageID = util.ToPgText(strconv.Itoa(req.OutboundRewardRequest.TransactionID))
+	}
+	raw, archived := h.archiveMessage(ctx, arg)
+
+	var gLabsLoad db.GlabsLoad
+	var stationID int64
+	status := http.StatusBadRequest
+	if err != nil {
 		h.logger.Error().Err(err).
 			Msg("[GLabsLoad] Bad request")
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	} else {
+		gLabsLoad, stationID, status, err = h.storeGLabsLoad(ctx, req)
+	}
+	if archived {
+		h.recordOutcome(ctx, raw, rawMessageStatus(status), stationID, err, false)
+	}
+	if err != nil {
+		ctx.JSON(status, errorResponse(err))
 		return
 	}
 
+	ctx.JSON(status, newGLabsLoadResponse(gLabsLoad))
+}
+
+// storeGLabsLoad stores the top up Globe Labs notified with req, and
+// completes the load request it answers, if any. It also returns the station
+// of the topped up number, if known, and the HTTP status of the outcome.
+func (h *DefaultHandler) storeGLabsLoad(ctx *gin.Context, req gLabsLoadReq) (db.GlabsLoad, int64, int, error) {
 	h.logger.Debug().
 		Str("subscriber", req.OutboundRewardRequest.Address).
 		Str("promo", req.OutboundRewardRequest.Promo).
@@ -235,11 +288,10 @@ func (h *DefaultHandler) CreateGLabsLoad(ctx *gin.Context) {
 		h.logger.Error().Err(err).
 			Str("mobile_number", req.OutboundRewardRequest.Address).
 			Msg("[GLabsLoad] Invalid number")
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
-		return
+		return db.GlabsLoad{}, 0, http.StatusBadRequest, err
 	}
 
-	_, err := h.store.GetStationByMobileNumber(ctx,
+	station, err := h.store.GetStationByMobileNumber(ctx,
 		pgtype.Text{
 			String: mobileNumber,
 			Valid:  true,
@@ -276,11 +328,55 @@ func (h *DefaultHandler) CreateGLabsLoad(ctx *gin.Context) {
 			Str("promo", req.OutboundRewardRequest.Promo).
 			Str("status", req.OutboundRewardRequest.Status).
 			Msg("[GLabsLoad] Error occured")
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.GlabsLoad{}, station.ID, http.StatusInternalServerError, err
+	}
+
+	h.completeLoadRequest(ctx, gLabsLoad)
+
+	return gLabsLoad, station.ID, http.StatusCreated, nil
+}
+
+// completeLoadRequest records the outcome of the top-up request Globe Labs
+// reported with g. Loads not requested by the app are left alone.
+func (h *DefaultHandler) completeLoadRequest(ctx *gin.Context, g db.GlabsLoad) {
+	status := service.LoadStatusFailed
+	if strings.EqualFold(g.Status.String, service.LoadStatusSuccess) {
+		status = service.LoadStatusSuccess
+	}
+
+	req, err := h.store.CompleteLoadRequest(ctx, db.CompleteLoadRequestParams{
+		Status:        status,
+		GlabsLoadID:   pgtype.Int8{Int64: g.ID, Valid: true},
+		TransactionID: g.TransactionID,
+	})
+	if err != nil {
+		if !errors.Is(err, db.ErrRecordNotFound) {
+			h.logger.Error().Err(err).
+				Str("mobile_number", g.MobileNumber).
+				Int32("transaction_id", g.TransactionID.Int32).
+				Msg("[GLabsLoad] Cannot complete load request")
+		}
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, newGLabsLoadResponse(gLabsLoad))
+	h.logger.Info().
+		Str("mobile_number", req.MobileNumber).
+		Int32("transaction_id", g.TransactionID.Int32).
+		Str("status", req.Status).
+		Msg("[GLabsLoad] Load request completed")
+}
+
+// GLabsInbound
+//
+//	@Summary	Store Lufft observation and health from Globe Labs inbound SMS
+//	@Tags		globelabs
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body		object	true	"Globe Labs inbound SMS notification"
+//	@Success	200	{object}	smsInboundRes
+//	@Router		/glabs/inbound [post]
+func (h *DefaultHandler) GLabsInbound(ctx *gin.Context) {
+	h.receiveSms(ctx, sms.GLabsKey, "GLabs")
 }
 
 type fetchGLabsAccessTokenReq struct {
@@ -8,12 +8,17 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jarcoal/httpmock"
@@ -220,6 +225,30 @@ func TestGLabsUnsubscribeApi(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().DeleteSimAccessToken(mock.AnythingOfType("*gin.Context"), simAccessToken.AccessToken).
 					Return(nil)
+				store.EXPECT().CancelPendingSmsMessages(mock.AnythingOfType("*gin.Context"), db.CancelPendingSmsMessagesParams{
+					MobileNumber: simAccessToken.MobileNumber,
+					Reason:       pgtype.Text{String: "unsubscribed", Valid: true},
+				}).Return(2, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "CancelPendingMessagesError",
+			body: gin.H{
+				"unsubscribed": gin.H{
+					"subscriber_number": simAccessToken.MobileNumber[2:],
+					"access_token":      simAccessToken.AccessToken,
+					"time_stamp":        time.Now(),
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteSimAccessToken(mock.AnythingOfType("*gin.Context"), simAccessToken.AccessToken).
+					Return(nil)
+				store.EXPECT().CancelPendingSmsMessages(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(0, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -291,6 +320,9 @@ func TestGLabsUnsubscribeApi(t *testing.T) {
 
 func TestCreateGLabsLoadApi(t *testing.T) {
 	gLabsLoad := randomGLabsLoad()
+	station := db.ObservationsStation{ID: util.RandomInt[int64](1, 1000)}
+	raw := randomRawMessage(gLabsLoad.MobileNumber[2:])
+	raw.Provider = RawMessageProviderGLabsLoad
 
 	testCases := []struct {
 		name          string
@@ -321,9 +353,25 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 						Valid:  true,
 					},
 				).
-					Return(db.ObservationsStation{}, nil)
+					Return(station, nil)
 				store.EXPECT().CreateGLabsLoad(mock.AnythingOfType("*gin.Context"), arg).
 					Return(gLabsLoad, nil)
+				store.EXPECT().CompleteLoadRequest(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.LoadRequest{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+					return arg.Provider == RawMessageProviderGLabsLoad &&
+						arg.Sender == gLabsLoad.MobileNumber[2:] &&
+						arg.MessageID.String == strconv.Itoa(int(gLabsLoad.TransactionID.Int32)) &&
+						strings.Contains(arg.Body, "outboundRewardRequest") &&
+						arg.Status == service.RawMessageStatusReceived
+				})).
+					Return(raw, nil)
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), db.UpdateRawMessageOutcomeParams{
+					ID:        raw.ID,
+					Status:    service.RawMessageStatusStored,
+					StationID: pgtype.Int8{Int64: station.ID, Valid: true},
+				}).
+					Return(raw, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -331,6 +379,40 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 				requireBodyMatchGLabsLoad(t, recorder.Body, gLabsLoad)
 			},
 		},
+		{
+			name: "LoadRequested",
+			body: gin.H{
+				"outboundRewardRequest": gin.H{
+					"transaction_id": gLabsLoad.TransactionID,
+					"status":         "SUCCESS",
+					"promo":          gLabsLoad.Promo,
+					"address":        gLabsLoad.MobileNumber[2:],
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				stubRawMessages(store)
+				load := gLabsLoad
+				load.Status = pgtype.Text{String: "SUCCESS", Valid: true}
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, nil)
+				store.EXPECT().CreateGLabsLoad(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(load, nil)
+				store.EXPECT().CompleteLoadRequest(mock.AnythingOfType("*gin.Context"), db.CompleteLoadRequestParams{
+					Status:        service.LoadStatusSuccess,
+					GlabsLoadID:   pgtype.Int8{Int64: load.ID, Valid: true},
+					TransactionID: load.TransactionID,
+				}).
+					Return(db.LoadRequest{
+						MobileNumber:  load.MobileNumber,
+						Status:        service.LoadStatusSuccess,
+						TransactionID: load.TransactionID,
+					}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
 		{
 			name: "UnknownMobileNumber",
 			body: gin.H{
@@ -342,6 +424,7 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 				},
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				stubRawMessages(store)
 				arg := db.CreateGLabsLoadParams{
 					TransactionID: gLabsLoad.TransactionID,
 					Status:        gLabsLoad.Status,
@@ -357,6 +440,8 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
 				store.EXPECT().CreateGLabsLoad(mock.AnythingOfType("*gin.Context"), arg).
 					Return(gLabsLoad, nil)
+				store.EXPECT().CompleteLoadRequest(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.LoadRequest{}, db.ErrRecordNotFound)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
@@ -364,6 +449,22 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 				requireBodyMatchGLabsLoad(t, recorder.Body, gLabsLoad)
 			},
 		},
+		{
+			name: "InvalidBody",
+			body: gin.H{"outboundRewardRequest": "invalid"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(raw, nil)
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+					return arg.ID == raw.ID && arg.Status == service.RawMessageStatusRejected && arg.Error.Valid
+				})).
+					Return(raw, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -395,6 +496,164 @@ func TestCreateGLabsLoadApi(t *testing.T) {
 	}
 }
 
+func TestGLabsInboundApi(t *testing.T) {
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	unknownNum := gofakeit.Regex("639[0-9]{9}")
+	var lufft sensor.Lufft
+	gofakeit.Struct(&lufft)
+
+	inboundBody := func(msgs ...gin.H) gin.H {
+		return gin.H{
+			"inboundSMSMessageList": gin.H{
+				"inboundSMSMessage":           msgs,
+				"numberOfMessagesInThisBatch": len(msgs),
+			},
+		}
+	}
+	inboundMsg := func(sender, msg string) gin.H {
+		return gin.H{
+			"dateTime":           "Fri Nov 22 2013 12:12:13 GMT+0000 (UTC)",
+			"destinationAddress": "tel:21581234",
+			"messageId":          gofakeit.UUID(),
+			"message":            msg,
+			"senderAddress":      "tel:+" + sender,
+		}
+	}
+	stubStore := func(store *mockdb.MockStore) {
+		store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.CreateStationReadingTxResult{IsCreated: true, Health: db.ObservationsStationhealth{ID: 1}}, nil)
+		store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return([]db.ObservationsObservation{}, nil)
+		store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.UpdateObservationQcTxResult{}, nil)
+		store.EXPECT().ListActiveStationHealthAlerts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return([]db.ObservationsStationhealthAlert{}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: inboundBody(inboundMsg(mobileNum, lufft.String(23))),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: mobileNum, Valid: true}).
+					Return(db.ObservationsStation{}, nil)
+				stubStore(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 1, res.Count)
+				require.Equal(t, 1, res.CountSuccess)
+				require.Equal(t, "+"+mobileNum, res.Results[0].Sender)
+				require.Equal(t, http.StatusCreated, res.Results[0].Status)
+				require.NotNil(t, res.Results[0].Data)
+			},
+		},
+		{
+			name: "MultipleMessages",
+			body: inboundBody(
+				inboundMsg(mobileNum, lufft.String(23)),
+				inboundMsg(unknownNum, lufft.String(23)),
+				inboundMsg(mobileNum, "invalid"),
+			),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: mobileNum, Valid: true}).
+					Return(db.ObservationsStation{}, nil).Twice()
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: unknownNum, Valid: true}).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				stubStore(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 3, res.Count)
+				require.Equal(t, 1, res.CountSuccess)
+				require.Len(t, res.Results, 3)
+				require.Equal(t, http.StatusCreated, res.Results[0].Status)
+				require.Empty(t, res.Results[0].Error)
+				require.Equal(t, http.StatusNotFound, res.Results[1].Status)
+				require.NotEmpty(t, res.Results[1].Error)
+				require.Nil(t, res.Results[1].Data)
+				require.Equal(t, http.StatusBadRequest, res.Results[2].Status)
+				require.NotEmpty(t, res.Results[2].Error)
+			},
+		},
+		{
+			name: "InternalError",
+			body: inboundBody(inboundMsg(mobileNum, lufft.String(23))),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 0, res.CountSuccess)
+				require.Equal(t, http.StatusInternalServerError, res.Results[0].Status)
+			},
+		},
+		{
+			name: "NoMessages",
+			body: inboundBody(),
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationByMobileNumber", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidPayload",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    lufft.String(23),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationByMobileNumber", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+			stubRawMessages(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("", handler.GLabsInbound)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
 func randomGlabsOptInRes(t *testing.T) gLabsOptInReq {
 	var g gLabsOptInReq
 	err := gofakeit.Struct(&g)
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errSchedulerNotRunning = errors.New("job scheduler is not running")
+
+type Job struct {
+	Name     string     `json:"name"`
+	Schedule string     `json:"schedule"`
+	Paused   bool       `json:"paused"`
+	Running  bool       `json:"running"`
+	NextRun  *time.Time `json:"next_run,omitempty"`
+} //@name Job
+
+func newJob(j service.JobInfo) Job {
+	res := Job{
+		Name:     j.Name,
+		Schedule: j.Schedule,
+		Paused:   j.Paused,
+		Running:  j.Running,
+	}
+	if !j.NextRun.IsZero() {
+		res.NextRun = &j.NextRun
+	}
+	return res
+}
+
+type JobRun struct {
+	ID           int64              `json:"id"`
+	JobName      string             `json:"job_name"`
+	Trigger      string             `json:"trigger"`
+	Status       string             `json:"status"`
+	Count        *int32             `json:"count,omitempty"`
+	CountSuccess *int32             `json:"count_success,omitempty"`
+	Error        string             `json:"error,omitempty"`
+	StartedAt    pgtype.Timestamptz `json:"started_at"`
+	FinishedAt   pgtype.Timestamptz `json:"finished_at"`
+} //@name JobRun
+
+func newJobRun(r db.JobRun) JobRun {
+	res := JobRun{
+		ID:         r.ID,
+		JobName:    r.JobName,
+		Trigger:    r.Trigger,
+		Status:     r.Status,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+	if r.Count.Valid {
+		res.Count = &r.Count.Int32
+	}
+	if r.CountSuccess.Valid {
+		res.CountSuccess = &r.CountSuccess.Int32
+	}
+	if r.Error.Valid {
+		res.Error = r.Error.String
+	}
+	return res
+}
+
+// ListJobs
+//
+//	@Summary	List scheduled jobs
+//	@Tags		jobs
+//	@Produce	json
+//	@Success	200	{array}	Job
+//	@Security	BearerAuth
+//	@Router		/admin/jobs [get]
+func (h *DefaultHandler) ListJobs(ctx *gin.Context) {
+	if h.scheduler == nil {
+		ctx.JSON(http.StatusServiceUnavailable, errorResponse(errSchedulerNotRunning))
+		return
+	}
+
+	jobs := h.scheduler.Jobs()
+	res := make([]Job, len(jobs))
+	for i := range jobs {
+		res[i] = newJob(jobs[i])
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type listJobRunsReq struct {
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	JobName string `form:"job_name" binding:"omitempty,alphanum"`
+	Status  string `form:"status" binding:"omitempty,oneof=RUNNING SUCCESS FAILED"`
+} //@name ListJobRunsParams
+
+type paginatedJobRuns = util.PaginatedList[JobRun] //@name PaginatedJobRuns
+
+// ListJobRuns
+//
+//	@Summary	List job runs, latest first
+//	@Tags		jobs
+//	@Produce	json
+//	@Param		req	query		listJobRunsReq	false	"List job runs parameters"
+//	@Success	200	{object}	paginatedJobRuns
+//	@Security	BearerAuth
+//	@Router		/admin/jobs/runs [get]
+func (h *DefaultHandler) ListJobRuns(ctx *gin.Context) {
+	var req listJobRunsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListJobRunsParams{
+		IsJobName: len(req.JobName) > 0,
+		JobName:   req.JobName,
+		IsStatus:  len(req.Status) > 0,
+		Status:    req.Status,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	runs, err := h.store.ListJobRuns(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]JobRun, len(runs))
+	for i := range runs {
+		items[i] = newJobRun(runs[i])
+	}
+
+	count, err := h.store.CountJobRuns(ctx, db.CountJobRunsParams{
+		IsJobName: arg.IsJobName,
+		JobName:   arg.JobName,
+		IsStatus:  arg.IsStatus,
+		Status:    arg.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type jobUri struct {
+	Name string `uri:"name" binding:"required,alphanum"`
+}
+
+// RunJob
+//
+//	@Summary	Run a job now
+//	@Tags		jobs
+//	@Produce	json
+//	@Param		name	path		string	true	"Job name"
+//	@Success	202		{object}	JobRun
+//	@Security	BearerAuth
+//	@Router		/admin/jobs/{name}/run [post]
+func (h *DefaultHandler) RunJob(ctx *gin.Context) {
+	if h.scheduler == nil {
+		ctx.JSON(http.StatusServiceUnavailable, errorResponse(errSchedulerNotRunning))
+		return
+	}
+
+	var uri jobUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	run, err := h.scheduler.RunJob(uri.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJobNotFound):
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, service.ErrAlreadyRunning):
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, newJobRun(run))
+}
+
+// PauseJob
+//
+//	@Summary	Pause a scheduled job
+//	@Tags		jobs
+//	@Produce	json
+//	@Param		name	path		string	true	"Job name"
+//	@Success	200		{object}	Job
+//	@Security	BearerAuth
+//	@Router		/admin/jobs/{name}/pause [put]
+func (h *DefaultHandler) PauseJob(ctx *gin.Context) {
+	h.setJobPaused(ctx, true)
+}
+
+// ResumeJob
+//
+//	@Summary	Resume a paused job
+//	@Tags		jobs
+//	@Produce	json
+//	@Param		name	path		string	true	"Job name"
+//	@Success	200		{object}	Job
+//	@Security	BearerAuth
+//	@Router		/admin/jobs/{name}/resume [put]
+func (h *DefaultHandler) ResumeJob(ctx *gin.Context) {
+	h.setJobPaused(ctx, false)
+}
+
+func (h *DefaultHandler) setJobPaused(ctx *gin.Context, paused bool) {
+	if h.scheduler == nil {
+		ctx.JSON(http.StatusServiceUnavailable, errorResponse(errSchedulerNotRunning))
+		return
+	}
+
+	var uri jobUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	setPaused := h.scheduler.ResumeJob
+	if paused {
+		setPaused = h.scheduler.PauseJob
+	}
+	job, err := setPaused(uri.Name)
+	if err != nil {
+		if errors.Is(err, service.ErrJobNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newJob(job))
+}
@@ -0,0 +1,397 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testJobName  = "TestJob"
+	testInstance = "test-instance"
+)
+
+// newTestScheduler returns a scheduler, not started, with a single job
+// running fn.
+func newTestScheduler(t *testing.T, store db.Store, fn service.JobFunc) *service.Scheduler {
+	logger := zerolog.Nop()
+	s := service.NewScheduler(context.Background(), store, testInstance, &logger)
+	require.NoError(t, s.Add(testJobName, "0 0 1 1 *", fn))
+	return s
+}
+
+func TestListJobsAPI(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	handler := newTestHandler(store, nil)
+	handler.scheduler = newTestScheduler(t, store, func(ctx context.Context, store db.Store, logger *zerolog.Logger) (service.JobStats, error) {
+		return service.JobStats{}, nil
+	})
+
+	router := gin.Default()
+	router.GET("/admin/jobs", handler.ListJobs)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/admin/jobs", nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var got []Job
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+	require.Len(t, got, 1)
+	require.Equal(t, testJobName, got[0].Name)
+	require.Equal(t, "0 0 1 1 *", got[0].Schedule)
+	require.False(t, got[0].Paused)
+}
+
+func TestListJobRunsAPI(t *testing.T) {
+	n := 5
+	runs := make([]db.JobRun, n)
+	for i := range runs {
+		runs[i] = randomJobRun(testJobName)
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListJobRuns(mock.AnythingOfType("*gin.Context"), db.ListJobRunsParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(runs, nil)
+				store.EXPECT().CountJobRuns(mock.AnythingOfType("*gin.Context"), db.CountJobRunsParams{}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobRuns(t, recorder.Body, runs)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: fmt.Sprintf("?job_name=%s&status=FAILED&page=2&per_page=2", testJobName),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListJobRuns(mock.AnythingOfType("*gin.Context"), db.ListJobRunsParams{
+					IsJobName: true,
+					JobName:   testJobName,
+					IsStatus:  true,
+					Status:    service.JobStatusFailed,
+					Limit:     pgtype.Int4{Int32: 2, Valid: true},
+					Offset:    2,
+				}).Return(runs[2:4], nil)
+				store.EXPECT().CountJobRuns(mock.AnythingOfType("*gin.Context"), db.CountJobRunsParams{
+					IsJobName: true,
+					JobName:   testJobName,
+					IsStatus:  true,
+					Status:    service.JobStatusFailed,
+				}).Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobRuns(t, recorder.Body, runs[2:4])
+			},
+		},
+		{
+			name:       "InvalidStatus",
+			query:      "?status=DONE",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListJobRuns", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListJobRuns(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.JobRun{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/jobs/runs", handler.ListJobRuns)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/jobs/runs"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestRunJobAPI(t *testing.T) {
+	run := randomJobRun(testJobName)
+	run.Trigger = service.JobTriggerManual
+	run.Status = service.JobStatusRunning
+
+	testCases := []struct {
+		name          string
+		jobName       string
+		jobErr        error
+		buildStubs    func(store *mockdb.MockStore, finished chan<- db.FinishJobRunParams)
+		checkResponse func(recorder *httptest.ResponseRecorder, finished <-chan db.FinishJobRunParams)
+	}{
+		{
+			name:    "OK",
+			jobName: testJobName,
+			buildStubs: func(store *mockdb.MockStore, finished chan<- db.FinishJobRunParams) {
+				store.EXPECT().CreateJobRun(mock.Anything, db.CreateJobRunParams{
+					JobName:  testJobName,
+					Trigger:  service.JobTriggerManual,
+					Instance: testInstance,
+				}).Return(run, nil)
+				store.EXPECT().FinishJobRun(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, arg db.FinishJobRunParams) { finished <- arg }).
+					Return(db.JobRun{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, finished <-chan db.FinishJobRunParams) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+				var got JobRun
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+				require.Equal(t, newJobRun(run), got)
+
+				arg := requireJobRunFinished(t, finished)
+				require.Equal(t, run.ID, arg.ID)
+				require.Equal(t, service.JobStatusSuccess, arg.Status)
+				require.Equal(t, int32(3), arg.Count.Int32)
+				require.Equal(t, int32(2), arg.CountSuccess.Int32)
+			},
+		},
+		{
+			name:    "JobError",
+			jobName: testJobName,
+			jobErr:  errors.New("api error"),
+			buildStubs: func(store *mockdb.MockStore, finished chan<- db.FinishJobRunParams) {
+				store.EXPECT().CreateJobRun(mock.Anything, mock.Anything).Return(run, nil)
+				store.EXPECT().FinishJobRun(mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, arg db.FinishJobRunParams) { finished <- arg }).
+					Return(db.JobRun{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, finished <-chan db.FinishJobRunParams) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+
+				arg := requireJobRunFinished(t, finished)
+				require.Equal(t, service.JobStatusFailed, arg.Status)
+				require.Equal(t, "api error", arg.Error.String)
+			},
+		},
+		{
+			name:       "NotFound",
+			jobName:    "UnknownJob",
+			buildStubs: func(store *mockdb.MockStore, finished chan<- db.FinishJobRunParams) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, finished <-chan db.FinishJobRunParams) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:    "InternalError",
+			jobName: testJobName,
+			buildStubs: func(store *mockdb.MockStore, finished chan<- db.FinishJobRunParams) {
+				store.EXPECT().CreateJobRun(mock.Anything, mock.Anything).Return(db.JobRun{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, finished <-chan db.FinishJobRunParams) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			finished := make(chan db.FinishJobRunParams, 1)
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store, finished)
+
+			handler := newTestHandler(store, nil)
+			handler.scheduler = newTestScheduler(t, store, func(ctx context.Context, store db.Store, logger *zerolog.Logger) (service.JobStats, error) {
+				return service.JobStats{Count: 3, CountSuccess: 2}, tc.jobErr
+			})
+
+			router := gin.Default()
+			router.POST("/admin/jobs/:name/run", handler.RunJob)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/admin/jobs/%s/run", tc.jobName), nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, finished)
+		})
+	}
+}
+
+func TestRunJobAlreadyRunningAPI(t *testing.T) {
+	run := randomJobRun(testJobName)
+	release := make(chan struct{})
+	finished := make(chan db.FinishJobRunParams, 1)
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().CreateJobRun(mock.Anything, mock.Anything).Return(run, nil).Once()
+	store.EXPECT().FinishJobRun(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, arg db.FinishJobRunParams) { finished <- arg }).
+		Return(db.JobRun{}, nil)
+
+	handler := newTestHandler(store, nil)
+	handler.scheduler = newTestScheduler(t, store, func(ctx context.Context, store db.Store, logger *zerolog.Logger) (service.JobStats, error) {
+		<-release
+		return service.JobStats{}, nil
+	})
+
+	router := gin.Default()
+	router.POST("/admin/jobs/:name/run", handler.RunJob)
+
+	url := fmt.Sprintf("/admin/jobs/%s/run", testJobName)
+	for _, code := range []int{http.StatusAccepted, http.StatusConflict} {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(http.MethodPost, url, nil)
+		require.NoError(t, err)
+
+		router.ServeHTTP(recorder, request)
+		require.Equal(t, code, recorder.Code)
+	}
+
+	close(release)
+	requireJobRunFinished(t, finished)
+}
+
+func TestPauseResumeJobAPI(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	handler := newTestHandler(store, nil)
+	handler.scheduler = newTestScheduler(t, store, func(ctx context.Context, store db.Store, logger *zerolog.Logger) (service.JobStats, error) {
+		return service.JobStats{}, nil
+	})
+
+	router := gin.Default()
+	router.PUT("/admin/jobs/:name/pause", handler.PauseJob)
+	router.PUT("/admin/jobs/:name/resume", handler.ResumeJob)
+
+	testCases := []struct {
+		name       string
+		url        string
+		wantCode   int
+		wantPaused bool
+	}{
+		{name: "Pause", url: "/admin/jobs/" + testJobName + "/pause", wantCode: http.StatusOK, wantPaused: true},
+		{name: "Resume", url: "/admin/jobs/" + testJobName + "/resume", wantCode: http.StatusOK, wantPaused: false},
+		{name: "NotFound", url: "/admin/jobs/UnknownJob/pause", wantCode: http.StatusNotFound},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPut, tc.url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.wantCode, recorder.Code)
+			if tc.wantCode != http.StatusOK {
+				return
+			}
+			var got Job
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&got))
+			require.Equal(t, tc.wantPaused, got.Paused)
+
+			info, err := handler.scheduler.Job(testJobName)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantPaused, info.Paused)
+		})
+	}
+}
+
+func TestJobSchedulerNotRunningAPI(t *testing.T) {
+	handler := newTestHandler(mockdb.NewMockStore(t), nil)
+
+	router := gin.Default()
+	router.GET("/admin/jobs", handler.ListJobs)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/admin/jobs", nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
+
+func randomJobRun(jobName string) db.JobRun {
+	startedAt := time.Now().Add(-time.Duration(util.RandomInt(60, 3600)) * time.Second).Truncate(time.Second).UTC()
+	return db.JobRun{
+		ID:           util.RandomInt[int64](1, 1000),
+		JobName:      jobName,
+		Trigger:      service.JobTriggerSchedule,
+		Status:       service.JobStatusSuccess,
+		Count:        pgtype.Int4{Int32: int32(util.RandomInt(10, 20)), Valid: true},
+		CountSuccess: pgtype.Int4{Int32: int32(util.RandomInt(0, 10)), Valid: true},
+		StartedAt:    pgtype.Timestamptz{Time: startedAt, Valid: true},
+		FinishedAt:   pgtype.Timestamptz{Time: startedAt.Add(time.Minute), Valid: true},
+	}
+}
+
+func requireJobRunFinished(t *testing.T, finished <-chan db.FinishJobRunParams) db.FinishJobRunParams {
+	select {
+	case arg := <-finished:
+		return arg
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "job run not finished")
+		return db.FinishJobRunParams{}
+	}
+}
+
+func requireBodyMatchJobRuns(t *testing.T, body io.Reader, runs []db.JobRun) {
+	var got paginatedJobRuns
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Items, len(runs))
+	for i := range runs {
+		require.Equal(t, newJobRun(runs[i]), got.Items[i])
+	}
+}
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errTopUpNotConfigured = errors.New("load top-up not configured")
+
+type LoadRequest struct {
+	ID             int64              `json:"id"`
+	IdempotencyKey string             `json:"idempotency_key"`
+	MobileNumber   string             `json:"mobile_number"`
+	StationID      *int64             `json:"station_id,omitempty"`
+	Trigger        string             `json:"trigger"`
+	Promo          string             `json:"promo"`
+	Cost           int32              `json:"cost"`
+	Status         string             `json:"status"`
+	TransactionID  *int32             `json:"transaction_id,omitempty"`
+	GlabsLoadID    *int64             `json:"glabs_load_id,omitempty"`
+	Error          string             `json:"error,omitempty"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+} //@name LoadRequest
+
+func newLoadRequest(r db.LoadRequest) LoadRequest {
+	res := LoadRequest{
+		ID:             r.ID,
+		IdempotencyKey: r.IdempotencyKey,
+		MobileNumber:   r.MobileNumber,
+		Trigger:        r.Trigger,
+		Promo:          r.Promo,
+		Cost:           r.Cost,
+		Status:         r.Status,
+		Error:          r.Error.String,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+	if r.StationID.Valid {
+		res.StationID = &r.StationID.Int64
+	}
+	if r.TransactionID.Valid {
+		res.TransactionID = &r.TransactionID.Int32
+	}
+	if r.GlabsLoadID.Valid {
+		res.GlabsLoadID = &r.GlabsLoadID.Int64
+	}
+	return res
+}
+
+// TopUpSimCard
+//
+//	@Summary	Request prepaid load for a SIM card through Globe Labs rewards
+//	@Tags		sims
+//	@Produce	json
+//	@Param		mobile_number	path		string	true	"Mobile number"
+//	@Param		Idempotency-Key	header		string	false	"Key under which the request is made at most once"
+//	@Success	201				{object}	LoadRequest
+//	@Success	200				{object}	LoadRequest	"Request already made under the key"
+//	@Security	BearerAuth
+//	@Router		/admin/sims/{mobile_number}/top-up [post]
+func (h *DefaultHandler) TopUpSimCard(ctx *gin.Context) {
+	if h.topUp == nil {
+		ctx.JSON(http.StatusServiceUnavailable, errorResponse(errTopUpNotConfigured))
+		return
+	}
+
+	var uri simCardUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := uri.parse()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := h.store.GetSimCard(ctx, mobileNumber); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errSimCardNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	arg := service.LoadTopUpParams{
+		IdempotencyKey: ctx.GetHeader("Idempotency-Key"),
+		MobileNumber:   mobileNumber,
+		Trigger:        service.LoadTriggerManual,
+	}
+	if len(arg.IdempotencyKey) == 0 {
+		arg.IdempotencyKey = fmt.Sprintf("manual:%s:%s", mobileNumber, uuid.NewString())
+	}
+	station, err := h.store.GetStationByMobileNumber(ctx, util.ToPgText(mobileNumber))
+	if err == nil {
+		arg.StationID = pgtype.Int8{Int64: station.ID, Valid: true}
+	} else if !errors.Is(err, db.ErrRecordNotFound) {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	req, created, err := h.topUp.Request(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrLoadBudgetExceeded) {
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+			return
+		}
+		if sms.IsTransient(err) {
+			ctx.JSON(http.StatusServiceUnavailable, errorResponse(err))
+			return
+		}
+		h.logger.Error().Err(err).
+			Str("mobile_number", mobileNumber).
+			Msg("[LoadTopUp] Cannot request load")
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	switch {
+	case !created:
+		ctx.JSON(http.StatusOK, newLoadRequest(req))
+	case req.Status == service.LoadStatusFailed:
+		ctx.JSON(http.StatusBadGateway, newLoadRequest(req))
+	default:
+		ctx.JSON(http.StatusCreated, newLoadRequest(req))
+	}
+}
+
+type listLoadRequestsReq struct {
+	Page         int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage      int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	MobileNumber string `form:"mobile_number"`
+	Status       string `form:"status" binding:"omitempty,oneof=PENDING REQUESTED SUCCESS FAILED"`
+} //@name ListLoadRequestsParams
+
+type paginatedLoadRequests = util.PaginatedList[LoadRequest] //@name PaginatedLoadRequests
+
+// ListLoadRequests
+//
+//	@Summary	List the load top-up requests, latest first
+//	@Tags		sims
+//	@Produce	json
+//	@Param		req	query		listLoadRequestsReq	false	"List load requests parameters"
+//	@Success	200	{object}	paginatedLoadRequests
+//	@Security	BearerAuth
+//	@Router		/admin/sims/load-requests [get]
+func (h *DefaultHandler) ListLoadRequests(ctx *gin.Context) {
+	var req listLoadRequestsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := parseOptionalMobileNumber(req.MobileNumber)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListLoadRequestsParams{
+		IsMobileNumber: len(mobileNumber) > 0,
+		MobileNumber:   mobileNumber,
+		IsStatus:       len(req.Status) > 0,
+		Status:         req.Status,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	loadRequests, err := h.store.ListLoadRequests(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]LoadRequest, len(loadRequests))
+	for i := range loadRequests {
+		items[i] = newLoadRequest(loadRequests[i])
+	}
+
+	count, err := h.store.CountLoadRequests(ctx, db.CountLoadRequestsParams{
+		IsMobileNumber: arg.IsMobileNumber,
+		MobileNumber:   arg.MobileNumber,
+		IsStatus:       arg.IsStatus,
+		Status:         arg.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,375 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopUpSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+	stationID := util.RandomInt[int64](1, 1000)
+	key := "manual-key"
+	conf := util.Config{
+		GlabsRewardsToken: "tkn",
+		GlabsLoadPromo:    "LOAD 50",
+		GlabsLoadCost:     50,
+		GlabsLoadBudget:   500,
+	}
+	loadReq := randomLoadRequest(sim.MobileNumber)
+	loadReq.IdempotencyKey = key
+	loadReq.StationID = pgtype.Int8{Int64: stationID, Valid: true}
+
+	createdStub := func(store *mockdb.MockStore) {
+		store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+			Return(sim, nil)
+		store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), util.ToPgText(sim.MobileNumber)).
+			Return(db.ObservationsStation{ID: stationID}, nil)
+		store.EXPECT().CreateLoadRequestTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateLoadRequestTxParams) bool {
+			return arg.IdempotencyKey == key &&
+				arg.MobileNumber == sim.MobileNumber &&
+				arg.StationID.Int64 == stationID &&
+				arg.Trigger == service.LoadTriggerManual &&
+				arg.Promo == conf.GlabsLoadPromo &&
+				arg.Cost == int32(conf.GlabsLoadCost) &&
+				arg.Budget == int64(conf.GlabsLoadBudget) &&
+				arg.BudgetSince.Time.Day() == 1
+		})).Return(db.CreateLoadRequestTxResult{LoadRequest: loadReq, IsCreated: true}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		mobileNumber  string
+		configured    bool
+		failures      []int
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub)
+	}{
+		{
+			name:         "Created",
+			mobileNumber: sim.MobileNumber,
+			configured:   true,
+			buildStubs: func(store *mockdb.MockStore) {
+				createdStub(store)
+				store.EXPECT().UpdateLoadRequestSent(mock.AnythingOfType("*gin.Context"), db.UpdateLoadRequestSentParams{
+					ID:            loadReq.ID,
+					Status:        service.LoadStatusRequested,
+					TransactionID: pgtype.Int4{Int32: 1, Valid: true},
+				}).RunAndReturn(func(_ context.Context, arg db.UpdateLoadRequestSentParams) (db.LoadRequest, error) {
+					res := loadReq
+					res.Status = arg.Status
+					res.TransactionID = arg.TransactionID
+					return res, nil
+				})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				got := requireBodyLoadRequest(t, recorder.Body)
+				require.Equal(t, service.LoadStatusRequested, got.Status)
+				require.NotNil(t, got.TransactionID)
+				require.Equal(t, int32(1), *got.TransactionID)
+				require.Len(t, stub.Rewards(), 1)
+				require.Equal(t, sim.MobileNumber[2:], stub.Rewards()[0].Address)
+			},
+		},
+		{
+			name:         "Refused",
+			mobileNumber: sim.MobileNumber,
+			configured:   true,
+			failures:     []int{http.StatusBadRequest},
+			buildStubs: func(store *mockdb.MockStore) {
+				createdStub(store)
+				store.EXPECT().UpdateLoadRequestSent(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateLoadRequestSentParams) bool {
+					return arg.Status == service.LoadStatusFailed && arg.Error.Valid && !arg.TransactionID.Valid
+				})).RunAndReturn(func(_ context.Context, arg db.UpdateLoadRequestSentParams) (db.LoadRequest, error) {
+					res := loadReq
+					res.Status = arg.Status
+					res.Error = arg.Error
+					return res, nil
+				})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusBadGateway, recorder.Code)
+				got := requireBodyLoadRequest(t, recorder.Body)
+				require.Equal(t, service.LoadStatusFailed, got.Status)
+				require.NotEmpty(t, got.Error)
+				require.Empty(t, stub.Rewards())
+			},
+		},
+		{
+			name:         "Unavailable",
+			mobileNumber: sim.MobileNumber,
+			configured:   true,
+			failures:     []int{http.StatusServiceUnavailable},
+			buildStubs: func(store *mockdb.MockStore) {
+				createdStub(store)
+				store.EXPECT().DeleteLoadRequest(mock.Anything, loadReq.ID).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateLoadRequestSent", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				require.Empty(t, stub.Rewards())
+			},
+		},
+		{
+			name:         "Duplicate",
+			mobileNumber: sim.MobileNumber,
+			configured:   true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateLoadRequestTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateLoadRequestTxResult{LoadRequest: loadReq}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateLoadRequestSent", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, newLoadRequest(loadReq), requireBodyLoadRequest(t, recorder.Body))
+				require.Empty(t, stub.Rewards())
+			},
+		},
+		{
+			name:         "BudgetExceeded",
+			mobileNumber: sim.MobileNumber,
+			configured:   true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{ID: stationID}, nil)
+				store.EXPECT().CreateLoadRequestTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateLoadRequestTxResult{}, db.ErrLoadBudgetExceeded)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+				require.Empty(t, stub.Rewards())
+			},
+		},
+		{
+			name:         "SimCardNotFound",
+			mobileNumber: sim.MobileNumber,
+			configured:   true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCard{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:         "InvalidMobileNumber",
+			mobileNumber: "123",
+			configured:   true,
+			buildStubs:   func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertNotCalled(t, "GetSimCard", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:         "NotConfigured",
+			mobileNumber: sim.MobileNumber,
+			buildStubs:   func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore, stub *sms.GLabsStub) {
+				store.AssertNotCalled(t, "GetSimCard", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			stub := sms.NewGLabsStub()
+			stub.FailNext(tc.failures...)
+			srv := httptest.NewServer(stub)
+			defer srv.Close()
+
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+			if tc.configured {
+				c := conf
+				c.GlabsAPIURL = srv.URL
+				handler.topUp = service.NewLoadTopUp(c, store, handler.logger)
+			}
+
+			router := gin.Default()
+			router.POST("/admin/sims/:mobile_number/top-up", handler.TopUpSimCard)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/admin/sims/%s/top-up", tc.mobileNumber)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			request.Header.Set("Idempotency-Key", key)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store, stub)
+		})
+	}
+}
+
+func TestListLoadRequestsAPI(t *testing.T) {
+	n := 5
+	mobileNumber := randomSimCard().MobileNumber
+	loadReqs := make([]db.LoadRequest, n)
+	for i := range loadReqs {
+		loadReqs[i] = randomLoadRequest(mobileNumber)
+	}
+	loadReqs[1].Status = service.LoadStatusSuccess
+	loadReqs[1].GlabsLoadID = pgtype.Int8{Int64: util.RandomInt[int64](1, 1000), Valid: true}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListLoadRequests(mock.AnythingOfType("*gin.Context"), db.ListLoadRequestsParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(loadReqs, nil)
+				store.EXPECT().CountLoadRequests(mock.AnythingOfType("*gin.Context"), db.CountLoadRequestsParams{}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchLoadRequests(t, recorder.Body, loadReqs)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: fmt.Sprintf("?mobile_number=%s&status=SUCCESS&page=2", mobileNumber),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListLoadRequests(mock.AnythingOfType("*gin.Context"), db.ListLoadRequestsParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsStatus:       true,
+					Status:         service.LoadStatusSuccess,
+					Limit:          pgtype.Int4{Int32: 5, Valid: true},
+					Offset:         5,
+				}).Return(loadReqs[1:2], nil)
+				store.EXPECT().CountLoadRequests(mock.AnythingOfType("*gin.Context"), db.CountLoadRequestsParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsStatus:       true,
+					Status:         service.LoadStatusSuccess,
+				}).Return(int64(6), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchLoadRequests(t, recorder.Body, loadReqs[1:2])
+			},
+		},
+		{
+			name:       "InvalidStatus",
+			query:      "?status=DONE",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListLoadRequests", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListLoadRequests(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.LoadRequest{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sims/load-requests", handler.ListLoadRequests)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sims/load-requests"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomLoadRequest(mobileNumber string) db.LoadRequest {
+	createdAt := time.Now().Add(-time.Duration(util.RandomInt(60, 3600)) * time.Second).Truncate(time.Second).UTC()
+	return db.LoadRequest{
+		ID:             util.RandomInt[int64](1, 1000),
+		IdempotencyKey: util.RandomString(16),
+		MobileNumber:   mobileNumber,
+		Trigger:        service.LoadTriggerSchedule,
+		Promo:          "LOAD 50",
+		Cost:           50,
+		Status:         service.LoadStatusPending,
+		CreatedAt:      pgtype.Timestamptz{Time: createdAt, Valid: true},
+		UpdatedAt:      pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+}
+
+func requireBodyLoadRequest(t *testing.T, body io.Reader) LoadRequest {
+	var got LoadRequest
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+	return got
+}
+
+func requireBodyMatchLoadRequests(t *testing.T, body io.Reader, loadReqs []db.LoadRequest) {
+	var got paginatedLoadRequests
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Items, len(loadReqs))
+	for i := range loadReqs {
+		require.Equal(t, newLoadRequest(loadReqs[i]), got.Items[i])
+	}
+}
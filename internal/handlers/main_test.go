@@ -4,6 +4,7 @@ import (
 	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
@@ -20,5 +21,5 @@ func newTestHandler(store db.Store, tokenMaker token.Maker) *DefaultHandler {
 
 	gin.SetMode(gin.TestMode)
 
-	return NewDefaultHandler(config, store, tokenMaker, logger)
+	return NewDefaultHandler(config, store, tokenMaker, nil, service.NewServices(config, store, logger), logger)
 }
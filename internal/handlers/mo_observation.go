@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CreateStationMoObservation
+//
+//	@Summary	Create station MO observation
+//	@Tags		mo observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		moObs		body	models.CreateMoObsReq	true	"Create MO observation parameters"
+//	@Security	BearerAuth
+//	@Success	201	{object}	models.MoObservation
+//	@Router		/stations/{station_id}/mo-observations [post]
+func (h *DefaultHandler) CreateStationMoObservation(ctx *gin.Context) {
+	var uri createStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req models.CreateMoObsReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	req.StationID = uri.StationID
+
+	obs, err := h.store.CreateStationMoObservation(ctx, req.Transform())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	obs = h.applyMoQc(ctx, obs)
+
+	ctx.JSON(http.StatusCreated, models.NewMoObservation(obs))
+}
+
+type paginatedMoObservations = util.PaginatedList[models.MoObservation] //@name PaginatedMoObservations
+
+// ListStationMoObservations
+//
+//	@Summary	List station MO observations
+//	@Tags		mo observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int					true	"Station ID"
+//	@Param		req			query		listStationObsReq	false	"List station observations parameters"
+//	@Success	200			{object}	paginatedMoObservations
+//	@Router		/stations/{station_id}/mo-observations [get]
+func (h *DefaultHandler) ListStationMoObservations(ctx *gin.Context) {
+	var uri listStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationObsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListStationMoObservationsParams{
+		StationID: uri.StationID,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset:      offset,
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+	}
+
+	observations, err := h.store.ListStationMoObservations(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]models.MoObservation, len(observations))
+	for i, observation := range observations {
+		items[i] = models.NewMoObservation(observation)
+	}
+
+	count, err := h.store.CountStationMoObservations(ctx, db.CountStationMoObservationsParams{
+		StationID:   arg.StationID,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// GetLatestStationMoObservation
+//
+//	@Summary	Get latest station MO observation
+//	@Tags		mo observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Success	200			{object}	models.MoObservation
+//	@Router		/stations/{station_id}/mo-observations/latest [get]
+func (h *DefaultHandler) GetLatestStationMoObservation(ctx *gin.Context) {
+	var uri listStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	obs, err := h.store.GetLatestStationMoObservation(ctx, uri.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station observation not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewMoObservation(obs))
+}
+
+// GetStationMoObservation
+//
+//	@Summary	Get station MO observation
+//	@Tags		mo observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Param		id			path		int	true	"MO Observation ID"
+//	@Success	200			{object}	models.MoObservation
+//	@Router		/stations/{station_id}/mo-observations/{id} [get]
+func (h *DefaultHandler) GetStationMoObservation(ctx *gin.Context) {
+	var req getStationObsReq
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	obs, err := h.store.GetStationMoObservation(ctx, db.GetStationMoObservationParams{
+		StationID: req.StationID,
+		ID:        req.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station observation not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewMoObservation(obs))
+}
+
+// UpdateStationMoObservation
+//
+//	@Summary	Update station MO observation
+//	@Tags		mo observations
+//	@Produce	json
+//	@Param		station_id	path	int						true	"Station ID"
+//	@Param		id			path	int						true	"MO Observation ID"
+//	@Param		moObs		body	models.UpdateMoObsReq	true	"Update MO observation parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	models.MoObservation
+//	@Router		/stations/{station_id}/mo-observations/{id} [put]
+func (h *DefaultHandler) UpdateStationMoObservation(ctx *gin.Context) {
+	var uri updateStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req models.UpdateMoObsReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	req.ID = uri.ID
+	req.StationID = uri.StationID
+
+	obs, err := h.store.UpdateStationMoObservation(ctx, req.Transform())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station observation not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, models.NewMoObservation(obs))
+}
+
+// DeleteStationMoObservation
+//
+//	@Summary	Delete station MO observation
+//	@Tags		mo observations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Param		id			path	int	true	"MO Observation ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/mo-observations/{id} [delete]
+func (h *DefaultHandler) DeleteStationMoObservation(ctx *gin.Context) {
+	var req deleteStationObsReq
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := h.store.DeleteStationMoObservation(ctx, db.DeleteStationMoObservationParams{
+		ID:        req.ID,
+		StationID: req.StationID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/qc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStationMoObservationAPI(t *testing.T) {
+	moObs := randomMoObservation(t)
+	ts := time.Now().UTC().Truncate(time.Minute)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"pres": moObs.Pres.Float32,
+				"temp": moObs.Temp.Float32,
+				"uvi":  moObs.Uvi.Float32,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationMoObservation(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(arg db.CreateStationMoObservationParams) bool {
+						return arg.StationID == moObs.StationID && arg.Uvi.Valid && !arg.Et.Valid
+					})).
+					Return(moObs, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchMoObservation(t, recorder.Body, moObs)
+			},
+		},
+		{
+			name: "QcApplied",
+			body: gin.H{
+				"temp":      60,
+				"timestamp": ts,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				created := moObs
+				created.Temp = pgtype.Float4{Float32: 60, Valid: true}
+				created.Timestamp = pgtype.Timestamptz{Time: ts, Valid: true}
+				store.EXPECT().CreateStationMoObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(created, nil)
+				store.EXPECT().ListPreviousStationMoObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsMoObservation{}, nil)
+				store.EXPECT().ListStationMoObservationsForQc(mock.AnythingOfType("*gin.Context"), db.ListStationMoObservationsForQcParams{
+					StationID: moObs.StationID,
+					StartDate: created.Timestamp,
+					EndDate:   created.Timestamp,
+				}).Return([]db.ObservationsMoObservation{created}, nil)
+				store.EXPECT().BulkUpdateMoObservationQc(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg []db.UpdateObservationQcTxParams) bool {
+					return len(arg) == 1 && arg[0].ObservationID == moObs.ID && arg[0].QcLevel == qc.LevelNone
+				})).Return(nil)
+				store.EXPECT().GetStationMoObservation(mock.AnythingOfType("*gin.Context"), db.GetStationMoObservationParams{
+					StationID: moObs.StationID,
+					ID:        moObs.ID,
+				}).Return(created, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidParam",
+			body: gin.H{
+				"temp": "32.7",
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"pres": moObs.Pres.Float32,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationMoObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsMoObservation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST(":station_id/mo-observations", handler.CreateStationMoObservation)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%d/mo-observations", moObs.StationID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListStationMoObservationsAPI(t *testing.T) {
+	n := 5
+	stationID := int64(gofakeit.Number(1, 100))
+	moObsSlice := make([]db.ObservationsMoObservation, n)
+	for i := range moObsSlice {
+		moObsSlice[i] = randomMoObservation(t)
+		moObsSlice[i].StationID = stationID
+	}
+
+	testCases := []struct {
+		name          string
+		stationID     int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:      "OK",
+			stationID: stationID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationMoObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(arg db.ListStationMoObservationsParams) bool {
+						return arg.StationID == stationID && arg.Limit.Int32 == 5
+					})).
+					Return(moObsSlice, nil)
+				store.EXPECT().CountStationMoObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got paginatedMoObservations
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+
+				want := make([]models.MoObservation, n)
+				for i, o := range moObsSlice {
+					want[i] = models.NewMoObservation(o)
+				}
+				require.Equal(t, want, got.Items)
+			},
+		},
+		{
+			name:      "InternalError",
+			stationID: stationID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationMoObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidStationID",
+			stationID:  0,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationMoObservations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/mo-observations", handler.ListStationMoObservations)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/mo-observations", tc.stationID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGetStationMoObservationAPI(t *testing.T) {
+	moObs := randomMoObservation(t)
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			id:   moObs.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMoObservation(mock.AnythingOfType("*gin.Context"), db.GetStationMoObservationParams{
+					StationID: moObs.StationID,
+					ID:        moObs.ID,
+				}).
+					Return(moObs, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchMoObservation(t, recorder.Body, moObs)
+			},
+		},
+		{
+			name: "NotFound",
+			id:   moObs.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationMoObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsMoObservation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidID",
+			id:         0,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationMoObservation", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/mo-observations/:id", handler.GetStationMoObservation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/mo-observations/%d", moObs.StationID, tc.id)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteStationMoObservationAPI(t *testing.T) {
+	moObs := randomMoObservation(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteStationMoObservation(mock.AnythingOfType("*gin.Context"), db.DeleteStationMoObservationParams{
+					StationID: moObs.StationID,
+					ID:        moObs.ID,
+				}).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteStationMoObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE(":station_id/mo-observations/:id", handler.DeleteStationMoObservation)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/mo-observations/%d", moObs.StationID, moObs.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomMoObservation(t *testing.T) db.ObservationsMoObservation {
+	var o models.MoObservation
+	err := gofakeit.Struct(&o)
+	require.NoError(t, err)
+
+	return db.ObservationsMoObservation{
+		ID:        o.ID,
+		StationID: o.StationID,
+		Pres:      util.ToFloat4(o.Pres),
+		Temp:      util.ToFloat4(o.Temp),
+		Uvi:       util.ToFloat4(o.Uvi),
+	}
+}
+
+func requireBodyMatchMoObservation(t *testing.T, body *bytes.Buffer, moObs db.ObservationsMoObservation) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got models.MoObservation
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, models.NewMoObservation(moObs), got)
+}
@@ -13,6 +13,7 @@ import (
 	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
 	"github.com/emiliogozo/panahon-api-go/internal/sensor"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -37,16 +38,91 @@ func TestPromoTexterStoreLufft(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
 					Return(db.ObservationsStation{}, nil)
-				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.Anything).
-					Return(db.ObservationsObservation{}, nil)
-				store.EXPECT().CreateStationHealth(mock.AnythingOfType("*gin.Context"), mock.Anything).
-					Return(db.ObservationsStationhealth{}, nil)
+				store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateStationReadingTxResult{IsCreated: true, Health: db.ObservationsStationhealth{ID: 1}}, nil)
+				store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsObservation{}, nil)
+				store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateObservationQcTxResult{}, nil)
+				store.EXPECT().ListActiveStationHealthAlerts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStationhealthAlert{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
 				require.Equal(t, http.StatusCreated, recorder.Code)
 			},
 		},
+		{
+			name: "Duplicate",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    lufft.String(23),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, nil)
+				store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationReadingTxParams) bool {
+					return arg.Health != nil && arg.Observation.Timestamp.Time.Equal(arg.Health.Timestamp.Time)
+				})).Return(db.CreateStationReadingTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateObservationQcTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				store.AssertNotCalled(t, "ListActiveStationHealthAlerts", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "StoreError",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    lufft.String(23),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, nil)
+				store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateStationReadingTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateObservationQcTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				store.AssertNotCalled(t, "ListActiveStationHealthAlerts", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownSmsSystemType",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    lufft.String(23),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{SmsSystemType: pgtype.Text{String: "UNKNOWN", Valid: true}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationReadingTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "LoggerVersionMismatch",
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    lufft.String(23),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{LoggerVersion: pgtype.Text{String: sensor.Lufft19Key, Valid: true}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationReadingTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "NotFound",
 			body: gin.H{
@@ -85,6 +161,7 @@ func TestPromoTexterStoreLufft(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			store := mockdb.NewMockStore(t)
 			tc.buildStubs(store)
+			stubRawMessages(store)
 
 			handler := newTestHandler(store, nil)
 
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/gin-gonic/gin"
+)
+
+type qcFlag struct {
+	Check     string    `json:"check"`
+	Variable  string    `json:"variable"`
+	Level     int32     `json:"level"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+} //@name QcFlag
+
+func newQcFlag(f db.ObservationsQcFlag) qcFlag {
+	return qcFlag{
+		Check:     f.CheckName,
+		Variable:  f.Variable,
+		Level:     f.Level,
+		Reason:    f.Reason,
+		CreatedAt: f.CreatedAt.Time,
+	}
+}
+
+type stationObsQcRes struct {
+	ObservationID int64    `json:"observation_id"`
+	QcLevel       int32    `json:"qc_level"`
+	Flags         []qcFlag `json:"flags"`
+} //@name StationObservationQc
+
+// applyQc runs the quality-control checks on a stored observation.
+// Errors are logged and the observation is returned unchanged.
+func (h *DefaultHandler) applyQc(ctx context.Context, obs db.ObservationsObservation) db.ObservationsObservation {
+	res, err := h.qcChecker.Apply(ctx, h.store, obs)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("observation_id", obs.ID).
+			Msg("[QC] Cannot apply quality control")
+		return obs
+	}
+	return res.Observation
+}
+
+// applyQcBatch runs the quality-control checks on stored observations with a
+// fixed number of queries per station. Errors are logged; the observations
+// are then checked again by the recheck job.
+func (h *DefaultHandler) applyQcBatch(ctx context.Context, obs []db.ObservationsObservation) {
+	if len(obs) == 0 {
+		return
+	}
+	if err := h.qcChecker.ApplyBatch(ctx, h.store, obs); err != nil {
+		h.logger.Error().Err(err).
+			Int("count", len(obs)).
+			Msg("[QC] Cannot apply quality control")
+	}
+}
+
+// applyMoQc runs the quality-control checks on a stored MO observation.
+// Errors are logged and the observation is returned unchanged.
+func (h *DefaultHandler) applyMoQc(ctx context.Context, obs db.ObservationsMoObservation) db.ObservationsMoObservation {
+	if !obs.Timestamp.Valid {
+		return obs
+	}
+	ts := obs.Timestamp.Time
+	if err := h.qcChecker.ApplyMoRange(ctx, h.store, obs.StationID, ts, ts); err != nil {
+		h.logger.Error().Err(err).
+			Int64("mo_observation_id", obs.ID).
+			Msg("[QC] Cannot apply quality control")
+		return obs
+	}
+
+	res, err := h.store.GetStationMoObservation(ctx, db.GetStationMoObservationParams{
+		StationID: obs.StationID,
+		ID:        obs.ID,
+	})
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("mo_observation_id", obs.ID).
+			Msg("[QC] Cannot get checked observation")
+		return obs
+	}
+	return res
+}
+
+type getStationObsQcUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+	ID        int64 `uri:"id" binding:"required,min=1"`
+}
+
+// GetStationObservationQc
+//
+//	@Summary	Get station observation quality-control flags
+//	@Tags		observations
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Param		id			path		int	true	"Station Observation ID"
+//	@Success	200			{object}	stationObsQcRes
+//	@Router		/stations/{station_id}/observations/{id}/qc [get]
+func (h *DefaultHandler) GetStationObservationQc(ctx *gin.Context) {
+	var uri getStationObsQcUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	obs, err := h.store.GetStationObservation(ctx, db.GetStationObservationParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station observation not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	flags, err := h.store.ListObservationQcFlags(ctx, obs.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := stationObsQcRes{
+		ObservationID: obs.ID,
+		QcLevel:       obs.QcLevel,
+		Flags:         make([]qcFlag, len(flags)),
+	}
+	for i, f := range flags {
+		res.Flags[i] = newQcFlag(f)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
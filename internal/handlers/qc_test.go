@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStationObservationQcAPI(t *testing.T) {
+	stnObs := randomObservation(t)
+	stnObs.QcLevel = 1
+	flags := []db.ObservationsQcFlag{
+		{
+			ID:            1,
+			ObservationID: stnObs.ID,
+			CheckName:     "step",
+			Variable:      "temp",
+			Level:         2,
+			Reason:        "changed by 8.00",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		params        db.GetStationObservationParams
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			params: db.GetStationObservationParams{
+				ID:        stnObs.ID,
+				StationID: stnObs.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationObservation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.GetStationObservationParams")).
+					Return(stnObs, nil)
+				store.EXPECT().ListObservationQcFlags(mock.AnythingOfType("*gin.Context"), stnObs.ID).
+					Return(flags, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got stationObsQcRes
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, stnObs.ID, got.ObservationID)
+				require.Equal(t, stnObs.QcLevel, got.QcLevel)
+				require.Len(t, got.Flags, 1)
+				require.Equal(t, "step", got.Flags[0].Check)
+				require.Equal(t, "temp", got.Flags[0].Variable)
+			},
+		},
+		{
+			name: "NotFound",
+			params: db.GetStationObservationParams{
+				ID:        stnObs.ID,
+				StationID: stnObs.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationObservation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.GetStationObservationParams")).
+					Return(db.ObservationsObservation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			params: db.GetStationObservationParams{
+				ID:        stnObs.ID,
+				StationID: stnObs.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationObservation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.GetStationObservationParams")).
+					Return(stnObs, nil)
+				store.EXPECT().ListObservationQcFlags(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidID",
+			params: db.GetStationObservationParams{
+				ID:        0,
+				StationID: stnObs.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationObservation", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET(":station_id/observations/:id/qc", handler.GetStationObservationQc)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/%d/observations/%d/qc", tc.params.StationID, tc.params.ID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RawMessageProviderGLabsLoad is the provider of the top up notifications of
+// Globe Labs, archived along the inbound messages.
+const RawMessageProviderGLabsLoad = "GLABSLOAD"
+
+// Providers of the inbound requests archived along the messages of the SMS
+// gateways. They have no sender, so they are not replayed.
+const (
+	RawMessageProviderUpload = "UPLOAD"
+	RawMessageProviderBatch  = "BATCH"
+)
+
+// maxInboundBody is the size limit of the body of an inbound request.
+const maxInboundBody = 1 << 20
+
+var errRawMessageNotFound = errors.New("raw message not found")
+
+type RawMessage struct {
+	ID          int64              `json:"id"`
+	Provider    string             `json:"provider"`
+	MessageID   string             `json:"message_id,omitempty"`
+	Sender      string             `json:"sender"`
+	Recipient   string             `json:"recipient,omitempty"`
+	Body        string             `json:"body"`
+	SentAt      pgtype.Timestamptz `json:"sent_at"`
+	ReceivedAt  pgtype.Timestamptz `json:"received_at"`
+	StationID   *int64             `json:"station_id,omitempty"`
+	Status      string             `json:"status"`
+	Error       string             `json:"error,omitempty"`
+	ReplayCount int32              `json:"replay_count"`
+	ReplayedAt  pgtype.Timestamptz `json:"replayed_at"`
+} //@name RawMessage
+
+func newRawMessage(m db.RawMessage) RawMessage {
+	res := RawMessage{
+		ID:          m.ID,
+		Provider:    m.Provider,
+		MessageID:   m.MessageID.String,
+		Sender:      m.Sender,
+		Recipient:   m.Recipient.String,
+		Body:        m.Body,
+		SentAt:      m.SentAt,
+		ReceivedAt:  m.ReceivedAt,
+		Status:      m.Status,
+		Error:       m.Error.String,
+		ReplayCount: m.ReplayCount,
+		ReplayedAt:  m.ReplayedAt,
+	}
+	if m.StationID.Valid {
+		res.StationID = &m.StationID.Int64
+	}
+	return res
+}
+
+// rawMessageStatus is the outcome of a station message stored with the HTTP
+// status httpStatus.
+func rawMessageStatus(httpStatus int) string {
+	switch {
+	case httpStatus == http.StatusCreated:
+		return service.RawMessageStatusStored
+	case httpStatus == http.StatusConflict:
+		return service.RawMessageStatusDuplicate
+	case httpStatus >= 500:
+		return service.RawMessageStatusFailed
+	default:
+		return service.RawMessageStatusRejected
+	}
+}
+
+// parseInbound decodes the messages of an inbound gateway request. A request
+// that cannot be decoded, or whose body is over maxInboundBody, is archived
+// as rejected; inboundErrorStatus gives the status to answer it with.
+func (h *DefaultHandler) parseInbound(ctx *gin.Context, provider string, adapter sms.InboundAdapter) ([]sms.Message, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxInboundBody))
+	if err != nil {
+		h.archiveRejected(ctx, provider, string(body), err)
+		return nil, err
+	}
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	msgs, err := adapter.ParseInbound(ctx.Request)
+	if err != nil {
+		h.archiveRejected(ctx, provider, string(body), err)
+	}
+	return msgs, err
+}
+
+// inboundErrorStatus is the HTTP status of a request rejected with err.
+func inboundErrorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+// archiveRejected records an inbound request of provider rejected with err.
+func (h *DefaultHandler) archiveRejected(ctx *gin.Context, provider, body string, err error) {
+	h.archiveMessage(ctx, db.CreateRawMessageParams{
+		Provider: strings.ToUpper(provider),
+		Body:     body,
+		Status:   service.RawMessageStatusRejected,
+		Error:    util.ToPgText(err.Error()),
+	})
+}
+
+// archiveSms records msg as received through provider.
+func (h *DefaultHandler) archiveSms(ctx *gin.Context, provider string, msg sms.Message) (db.RawMessage, bool) {
+	arg := db.CreateRawMessageParams{
+		Provider:  strings.ToUpper(provider),
+		MessageID: util.ToPgText(msg.ID),
+		Sender:    msg.From,
+		Recipient: util.ToPgText(msg.To),
+		Body:      msg.Text,
+		Status:    service.RawMessageStatusReceived,
+	}
+	if !msg.Time.IsZero() {
+		arg.SentAt = pgtype.Timestamptz{Time: msg.Time, Valid: true}
+	}
+	return h.archiveMessage(ctx, arg)
+}
+
+// archiveMessage records an inbound message. Archiving never fails the
+// request; errors are only logged.
+func (h *DefaultHandler) archiveMessage(ctx *gin.Context, arg db.CreateRawMessageParams) (db.RawMessage, bool) {
+	raw, err := h.store.CreateRawMessage(ctx, arg)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("provider", arg.Provider).
+			Str("sender", arg.Sender).
+			Str("msg", arg.Body).
+			Msg("[RawMessage] Cannot archive message")
+		return raw, false
+	}
+	return raw, true
+}
+
+// recordOutcome records the outcome of an archived message. stationID is
+// zero if unknown.
+func (h *DefaultHandler) recordOutcome(ctx *gin.Context, raw db.RawMessage, status string, stationID int64, outcomeErr error, isReplay bool) (db.RawMessage, error) {
+	arg := db.UpdateRawMessageOutcomeParams{
+		ID:       raw.ID,
+		Status:   status,
+		IsReplay: isReplay,
+	}
+	if stationID > 0 {
+		arg.StationID = pgtype.Int8{Int64: stationID, Valid: true}
+	}
+	if outcomeErr != nil {
+		arg.Error = util.ToPgText(outcomeErr.Error())
+	}
+
+	updated, err := h.store.UpdateRawMessageOutcome(ctx, arg)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", raw.ID).
+			Str("status", status).
+			Msg("[RawMessage] Cannot record outcome")
+	}
+	return updated, err
+}
+
+// storeArchivedStationSms archives a station message, stores it and records
+// the outcome.
+func (h *DefaultHandler) storeArchivedStationSms(ctx *gin.Context, provider, tag string, msg sms.Message) (lufftRes, int, error) {
+	raw, archived := h.archiveSms(ctx, provider, msg)
+
+	data, status, err := h.storeStationSms(ctx, tag, msg.From, msg.Text, time.Now())
+	if archived {
+		h.recordOutcome(ctx, raw, rawMessageStatus(status), data.Station.ID, err, false)
+	}
+	return data, status, err
+}
+
+type listRawMessagesReq struct {
+	Page      int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage   int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	Provider  string `form:"provider" binding:"omitempty,alphanum"`
+	Sender    string `form:"sender"`
+	Status    string `form:"status" binding:"omitempty,oneof=RECEIVED STORED DUPLICATE REJECTED FAILED QUERY"`
+	StartDate string `form:"start_date" binding:"omitempty,date_time"`
+	EndDate   string `form:"end_date" binding:"omitempty,date_time"`
+} //@name ListRawMessagesParams
+
+type paginatedRawMessages = util.PaginatedList[RawMessage] //@name PaginatedRawMessages
+
+// ListRawMessages
+//
+//	@Summary	List the archived inbound messages, latest first
+//	@Tags		sms
+//	@Produce	json
+//	@Param		req	query		listRawMessagesReq	false	"List raw messages parameters"
+//	@Success	200	{object}	paginatedRawMessages
+//	@Security	BearerAuth
+//	@Router		/admin/raw-messages [get]
+func (h *DefaultHandler) ListRawMessages(ctx *gin.Context) {
+	var req listRawMessagesReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListRawMessagesParams{
+		IsProvider:  len(req.Provider) > 0,
+		Provider:    strings.ToUpper(req.Provider),
+		IsSender:    len(req.Sender) > 0,
+		Sender:      req.Sender,
+		IsStatus:    len(req.Status) > 0,
+		Status:      req.Status,
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	msgs, err := h.store.ListRawMessages(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]RawMessage, len(msgs))
+	for i := range msgs {
+		items[i] = newRawMessage(msgs[i])
+	}
+
+	count, err := h.store.CountRawMessages(ctx, db.CountRawMessagesParams{
+		IsProvider:  arg.IsProvider,
+		Provider:    arg.Provider,
+		IsSender:    arg.IsSender,
+		Sender:      arg.Sender,
+		IsStatus:    arg.IsStatus,
+		Status:      arg.Status,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type replayRawMessagesReq struct {
+	IDs []int64 `json:"ids" binding:"required,min=1,max=100,dive,min=1"`
+} //@name ReplayRawMessagesParams
+
+type rawMessageReplayResult struct {
+	ID     int64     `json:"id"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+	Data   *lufftRes `json:"data,omitempty"`
+} //@name RawMessageReplayResult
+
+type replayRawMessagesRes struct {
+	Count        int                      `json:"count"`
+	CountSuccess int                      `json:"count_success"`
+	Results      []rawMessageReplayResult `json:"results"`
+} //@name ReplayRawMessagesResponse
+
+// ReplayRawMessages
+//
+//	@Summary	Store archived station messages again through the current parser
+//	@Tags		sms
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body		replayRawMessagesReq	true	"Replay raw messages parameters"
+//	@Success	200	{object}	replayRawMessagesRes
+//	@Security	BearerAuth
+//	@Router		/admin/raw-messages/replay [post]
+func (h *DefaultHandler) ReplayRawMessages(ctx *gin.Context) {
+	var req replayRawMessagesReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	msgs, err := h.store.ListRawMessagesByIDs(ctx, req.IDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	byID := make(map[int64]db.RawMessage, len(msgs))
+	for _, m := range msgs {
+		byID[m.ID] = m
+	}
+
+	res := replayRawMessagesRes{
+		Count:   len(req.IDs),
+		Results: make([]rawMessageReplayResult, len(req.IDs)),
+	}
+	for i, id := range req.IDs {
+		result := rawMessageReplayResult{ID: id}
+
+		raw, ok := byID[id]
+		switch {
+		case !ok:
+			result.Error = errRawMessageNotFound.Error()
+		case raw.Status == service.RawMessageStatusQuery || h.smsQuery.Match(raw.Body) || raw.Provider == RawMessageProviderGLabsLoad || len(raw.Sender) == 0:
+			// Queries are not replayed to avoid texting the sender again.
+			// Top up notifications, uploads, batches, MQTT messages and
+			// payloads that could not be decoded have no station message.
+			result.Status = raw.Status
+			result.Error = "not a station message"
+		default:
+			// An observation already stored is reported as a duplicate, so a
+			// message can be replayed any number of times. The message is
+			// decoded as when it was received, for the same observation.
+			data, status, err := h.storeStationSms(ctx, "Replay", raw.Sender, raw.Body, raw.ReceivedAt.Time)
+			result.Status = rawMessageStatus(status)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Data = &data
+			}
+			if result.Status == service.RawMessageStatusStored || result.Status == service.RawMessageStatusDuplicate {
+				res.CountSuccess++
+			}
+			h.recordOutcome(ctx, raw, result.Status, data.Station.ID, err, true)
+		}
+		res.Results[i] = result
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,475 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveInboundMessages(t *testing.T) {
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	station := db.ObservationsStation{ID: util.RandomInt[int64](1, 1000)}
+	var lufft sensor.Lufft
+	gofakeit.Struct(&lufft)
+	lufftMsg := lufft.String(23)
+	raw := db.RawMessage{ID: util.RandomInt[int64](1, 1000)}
+
+	storeStubs := func(store *mockdb.MockStore, isCreated bool) {
+		store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(station, nil)
+		if !isCreated {
+			store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+				Return(db.CreateStationReadingTxResult{}, nil)
+			return
+		}
+		store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.CreateStationReadingTxResult{
+				Observation: db.ObservationsObservation{StationID: station.ID},
+				Health:      db.ObservationsStationhealth{ID: 1, StationID: station.ID},
+				IsCreated:   true,
+			}, nil)
+		store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return([]db.ObservationsObservation{}, nil)
+		store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.UpdateObservationQcTxResult{}, nil)
+		store.EXPECT().ListActiveStationHealthAlerts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return([]db.ObservationsStationhealthAlert{}, nil)
+	}
+	archiveStub := func(store *mockdb.MockStore, body string) {
+		store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), db.CreateRawMessageParams{
+			Provider: sms.PromoTexterKey,
+			Sender:   mobileNum,
+			Body:     body,
+			Status:   service.RawMessageStatusReceived,
+		}).Return(raw, nil)
+	}
+	outcomeStub := func(store *mockdb.MockStore, status string, stationID int64, hasError bool) {
+		store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+			return arg.ID == raw.ID &&
+				arg.Status == status &&
+				arg.StationID.Int64 == stationID &&
+				arg.Error.Valid == hasError &&
+				!arg.IsReplay
+		})).Return(raw, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		body          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "Stored",
+			body: fmt.Sprintf(`{"number":"%s","msg":"%s"}`, mobileNum, lufftMsg),
+			buildStubs: func(store *mockdb.MockStore) {
+				archiveStub(store, lufftMsg)
+				storeStubs(store, true)
+				outcomeStub(store, service.RawMessageStatusStored, station.ID, false)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate",
+			body: fmt.Sprintf(`{"number":"%s","msg":"%s"}`, mobileNum, lufftMsg),
+			buildStubs: func(store *mockdb.MockStore) {
+				archiveStub(store, lufftMsg)
+				storeStubs(store, false)
+				outcomeStub(store, service.RawMessageStatusDuplicate, station.ID, true)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidString",
+			body: fmt.Sprintf(`{"number":"%s","msg":"hello"}`, mobileNum),
+			buildStubs: func(store *mockdb.MockStore) {
+				archiveStub(store, "hello")
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(station, nil)
+				outcomeStub(store, service.RawMessageStatusRejected, 0, true)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidPayload",
+			body: `{"sender":"me"}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+					return arg.Provider == sms.PromoTexterKey &&
+						arg.Body == `{"sender":"me"}` &&
+						arg.Status == service.RawMessageStatusRejected &&
+						arg.Error.Valid
+				})).Return(raw, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TooLarge",
+			body: fmt.Sprintf(`{"number":"%s","msg":"%s"}`, mobileNum, strings.Repeat("x", maxInboundBody)),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+					return arg.Provider == sms.PromoTexterKey &&
+						len(arg.Body) == maxInboundBody &&
+						arg.Status == service.RawMessageStatusRejected &&
+						arg.Error.Valid
+				})).Return(raw, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+			},
+		},
+		{
+			name: "ArchiveError",
+			body: fmt.Sprintf(`{"number":"%s","msg":"%s"}`, mobileNum, lufftMsg),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.RawMessage{}, sql.ErrConnDone)
+				storeStubs(store, true)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "UpdateRawMessageOutcome", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/ptexter", handler.PromoTexterStoreLufft)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/ptexter", bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListRawMessagesAPI(t *testing.T) {
+	n := 5
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	msgs := make([]db.RawMessage, n)
+	for i := range msgs {
+		msgs[i] = randomRawMessage(mobileNum)
+	}
+	msgs[1].Status = service.RawMessageStatusRejected
+	msgs[1].Error = pgtype.Text{String: "invalid string", Valid: true}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRawMessages(mock.AnythingOfType("*gin.Context"), db.ListRawMessagesParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(msgs, nil)
+				store.EXPECT().CountRawMessages(mock.AnythingOfType("*gin.Context"), db.CountRawMessagesParams{}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchRawMessages(t, recorder.Body, msgs)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: fmt.Sprintf("?provider=glabs&sender=%s&status=REJECTED&start_date=2024-01-02", mobileNum),
+			buildStubs: func(store *mockdb.MockStore) {
+				startDate := pgtype.Timestamptz{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.FixedZone("", 8*3600)), Valid: true}
+				store.EXPECT().ListRawMessages(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.ListRawMessagesParams) bool {
+					return arg.IsProvider && arg.Provider == sms.GLabsKey &&
+						arg.IsSender && arg.Sender == mobileNum &&
+						arg.IsStatus && arg.Status == service.RawMessageStatusRejected &&
+						arg.IsStartDate && arg.StartDate.Time.Equal(startDate.Time) &&
+						!arg.IsEndDate
+				})).Return(msgs[1:2], nil)
+				store.EXPECT().CountRawMessages(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(1), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchRawMessages(t, recorder.Body, msgs[1:2])
+			},
+		},
+		{
+			name:       "InvalidStatus",
+			query:      "?status=LOST",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListRawMessages", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRawMessages(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.RawMessage{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/raw-messages", handler.ListRawMessages)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/raw-messages"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestReplayRawMessagesAPI(t *testing.T) {
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	station := db.ObservationsStation{ID: util.RandomInt[int64](1, 1000)}
+	var lufft sensor.Lufft
+	gofakeit.Struct(&lufft)
+
+	rejected := randomRawMessage(mobileNum)
+	rejected.Status = service.RawMessageStatusRejected
+	rejected.Body = lufft.String(23)
+	stored := randomRawMessage(mobileNum)
+	stored.ID = rejected.ID + 1
+	stored.Body = lufft.String(23)
+	query := randomRawMessage(mobileNum)
+	query.ID = rejected.ID + 2
+	// A query delivered again by the gateway is archived as a duplicate.
+	query.Status = service.RawMessageStatusDuplicate
+	query.Body = "WEATHER"
+	missingID := rejected.ID + 3
+	// A message received long ago is decoded as when it was received.
+	old := randomRawMessage(mobileNum)
+	old.ReceivedAt.Time = old.ReceivedAt.Time.AddDate(0, 0, -200)
+	oldTimestamp := old.ReceivedAt.Time.Add(-10 * time.Minute)
+	old.Body = sensor.RandomLufft(oldTimestamp).String(23)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"ids": []int64{rejected.ID, stored.ID, query.ID, missingID}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRawMessagesByIDs(mock.AnythingOfType("*gin.Context"), []int64{rejected.ID, stored.ID, query.ID, missingID}).
+					Return([]db.RawMessage{rejected, stored, query}, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), util.ToPgText(mobileNum)).
+					Return(station, nil)
+				store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateStationReadingTxResult{
+						Observation: db.ObservationsObservation{StationID: station.ID},
+						Health:      db.ObservationsStationhealth{ID: 1, StationID: station.ID},
+						IsCreated:   true,
+					}, nil).Once()
+				store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateStationReadingTxResult{}, nil).Once()
+				store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsObservation{}, nil)
+				store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.UpdateObservationQcTxResult{}, nil)
+				store.EXPECT().ListActiveStationHealthAlerts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ObservationsStationhealthAlert{}, nil)
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), db.UpdateRawMessageOutcomeParams{
+					ID:        rejected.ID,
+					Status:    service.RawMessageStatusStored,
+					StationID: pgtype.Int8{Int64: station.ID, Valid: true},
+					IsReplay:  true,
+				}).Return(rejected, nil)
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), db.UpdateRawMessageOutcomeParams{
+					ID:        stored.ID,
+					Status:    service.RawMessageStatusDuplicate,
+					StationID: pgtype.Int8{Int64: station.ID, Valid: true},
+					Error:     util.ToPgText(errObservationExists.Error()),
+					IsReplay:  true,
+				}).Return(stored, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got replayRawMessagesRes
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Equal(t, 4, got.Count)
+				require.Equal(t, 2, got.CountSuccess)
+				require.Len(t, got.Results, 4)
+				require.Equal(t, service.RawMessageStatusStored, got.Results[0].Status)
+				require.NotNil(t, got.Results[0].Data)
+				require.Equal(t, service.RawMessageStatusDuplicate, got.Results[1].Status)
+				require.Equal(t, service.RawMessageStatusDuplicate, got.Results[2].Status)
+				require.NotEmpty(t, got.Results[2].Error)
+				require.Equal(t, missingID, got.Results[3].ID)
+				require.Equal(t, errRawMessageNotFound.Error(), got.Results[3].Error)
+			},
+		},
+		{
+			name: "ReceivedLongAgo",
+			body: gin.H{"ids": []int64{old.ID}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRawMessagesByIDs(mock.AnythingOfType("*gin.Context"), []int64{old.ID}).
+					Return([]db.RawMessage{old}, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), util.ToPgText(mobileNum)).
+					Return(station, nil)
+				store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationReadingTxParams) bool {
+					return arg.Observation.Timestamp.Time.Equal(oldTimestamp) && arg.Health != nil &&
+						arg.Health.MinutesDifference.Int32 == 10 && !arg.Health.ErrorMsg.Valid
+				})).Return(db.CreateStationReadingTxResult{}, nil)
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(old, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "NoIDs",
+			body:       gin.H{"ids": []int64{}},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListRawMessagesByIDs", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"ids": []int64{rejected.ID}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRawMessagesByIDs(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.RawMessage{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/admin/raw-messages/replay", handler.ReplayRawMessages)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/raw-messages/replay", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+// stubRawMessages lets the inbound handlers archive their messages.
+func stubRawMessages(store *mockdb.MockStore) {
+	store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.Anything).
+		Return(db.RawMessage{}, nil).Maybe()
+	store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.Anything).
+		Return(db.RawMessage{}, nil).Maybe()
+}
+
+func randomRawMessage(sender string) db.RawMessage {
+	receivedAt := time.Now().Add(-time.Duration(util.RandomInt(60, 3600)) * time.Second).Truncate(time.Second).UTC()
+	return db.RawMessage{
+		ID:         util.RandomInt[int64](1, 1000),
+		Provider:   sms.GLabsKey,
+		Sender:     sender,
+		Body:       gofakeit.Sentence(4),
+		ReceivedAt: pgtype.Timestamptz{Time: receivedAt, Valid: true},
+		Status:     service.RawMessageStatusStored,
+	}
+}
+
+func requireBodyMatchRawMessages(t *testing.T, body io.Reader, msgs []db.RawMessage) {
+	var got paginatedRawMessages
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Items, len(msgs))
+	for i := range msgs {
+		require.Equal(t, newRawMessage(msgs[i]), got.Items[i])
+	}
+}
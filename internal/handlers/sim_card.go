@@ -0,0 +1,420 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errSimCardNotFound = errors.New("sim card not found")
+
+type SimCard struct {
+	MobileNumber  string             `json:"mobile_number"`
+	Type          string             `json:"type,omitempty"`
+	StationID     *int64             `json:"station_id,omitempty"`
+	StationName   string             `json:"station_name,omitempty"`
+	LoadExpiresAt pgtype.Timestamptz `json:"load_expires_at"`
+	LoadFlaggedAt pgtype.Timestamptz `json:"load_flagged_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+} //@name SimCard
+
+func newSimCard(s db.SimCard, station *db.ObservationsStation) SimCard {
+	res := SimCard{
+		MobileNumber:  s.MobileNumber,
+		Type:          s.Type.String,
+		LoadExpiresAt: s.LoadExpiresAt,
+		LoadFlaggedAt: s.LoadFlaggedAt,
+		CreatedAt:     s.CreatedAt,
+	}
+	if station != nil {
+		res.StationID = &station.ID
+		res.StationName = station.Name
+	}
+	return res
+}
+
+func newSimCardFromRow(s db.ListSimCardsRow) SimCard {
+	res := SimCard{
+		MobileNumber:  s.MobileNumber,
+		Type:          s.Type.String,
+		StationName:   s.StationName.String,
+		LoadExpiresAt: s.LoadExpiresAt,
+		LoadFlaggedAt: s.LoadFlaggedAt,
+		CreatedAt:     s.CreatedAt,
+	}
+	if s.StationID.Valid {
+		res.StationID = &s.StationID.Int64
+	}
+	return res
+}
+
+type simCardUri struct {
+	MobileNumber string `uri:"mobile_number" binding:"required"`
+}
+
+// parse returns the normalized mobile number of the uri.
+func (u simCardUri) parse() (string, error) {
+	mobileNumber, ok := util.ParseMobileNumber(u.MobileNumber)
+	if !ok {
+		return "", fmt.Errorf("invalid mobile number: %s", u.MobileNumber)
+	}
+	return mobileNumber, nil
+}
+
+type listSimCardsReq struct {
+	Page    int32 `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage int32 `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	Flagged bool  `form:"flagged"`
+} //@name ListSimCardsParams
+
+type paginatedSimCards = util.PaginatedList[SimCard] //@name PaginatedSimCards
+
+// ListSimCards
+//
+//	@Summary	List station SIM cards
+//	@Tags		sims
+//	@Produce	json
+//	@Param		req	query		listSimCardsReq	false	"List SIM cards parameters"
+//	@Success	200	{object}	paginatedSimCards
+//	@Security	BearerAuth
+//	@Router		/admin/sims [get]
+func (h *DefaultHandler) ListSimCards(ctx *gin.Context) {
+	var req listSimCardsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListSimCardsParams{
+		IsFlagged: req.Flagged,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	sims, err := h.store.ListSimCards(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]SimCard, len(sims))
+	for i := range sims {
+		items[i] = newSimCardFromRow(sims[i])
+	}
+
+	count, err := h.store.CountSimCards(ctx, req.Flagged)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type createSimCardReq struct {
+	MobileNumber string `json:"mobile_number" binding:"required"`
+	Type         string `json:"type" binding:"omitempty,max=50"`
+} //@name CreateSimCardParams
+
+// CreateSimCard
+//
+//	@Summary	Add a station SIM card
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		req	body		createSimCardReq	true	"Create SIM card parameters"
+//	@Success	201	{object}	SimCard
+//	@Security	BearerAuth
+//	@Router		/admin/sims [post]
+func (h *DefaultHandler) CreateSimCard(ctx *gin.Context) {
+	var req createSimCardReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, ok := util.ParseMobileNumber(req.MobileNumber)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid mobile number: %s", req.MobileNumber)))
+		return
+	}
+
+	sim, err := h.store.CreateSimCard(ctx, db.CreateSimCardParams{
+		MobileNumber: mobileNumber,
+		Type:         util.ToPgText(req.Type),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("sim card already exists")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, h.simCardWithStation(ctx, sim))
+}
+
+// GetSimCard
+//
+//	@Summary	Get a station SIM card
+//	@Tags		sims
+//	@Produce	json
+//	@Param		mobile_number	path		string	true	"Mobile number"
+//	@Success	200				{object}	SimCard
+//	@Security	BearerAuth
+//	@Router		/admin/sims/{mobile_number} [get]
+func (h *DefaultHandler) GetSimCard(ctx *gin.Context) {
+	var uri simCardUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := uri.parse()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sim, err := h.store.GetSimCard(ctx, mobileNumber)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errSimCardNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.simCardWithStation(ctx, sim))
+}
+
+type updateSimCardReq struct {
+	Type string `json:"type" binding:"omitempty,max=50"`
+} //@name UpdateSimCardParams
+
+// UpdateSimCard
+//
+//	@Summary	Update a station SIM card
+//	@Tags		sims
+//	@Accept		json
+//	@Produce	json
+//	@Param		mobile_number	path		string				true	"Mobile number"
+//	@Param		req				body		updateSimCardReq	true	"Update SIM card parameters"
+//	@Success	200				{object}	SimCard
+//	@Security	BearerAuth
+//	@Router		/admin/sims/{mobile_number} [put]
+func (h *DefaultHandler) UpdateSimCard(ctx *gin.Context) {
+	var uri simCardUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := uri.parse()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateSimCardReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sim, err := h.store.UpdateSimCard(ctx, db.UpdateSimCardParams{
+		MobileNumber: mobileNumber,
+		Type:         util.ToPgText(req.Type),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errSimCardNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.simCardWithStation(ctx, sim))
+}
+
+// DeleteSimCard
+//
+//	@Summary	Delete a station SIM card
+//	@Tags		sims
+//	@Param		mobile_number	path	string	true	"Mobile number"
+//	@Success	204
+//	@Security	BearerAuth
+//	@Router		/admin/sims/{mobile_number} [delete]
+func (h *DefaultHandler) DeleteSimCard(ctx *gin.Context) {
+	var uri simCardUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := uri.parse()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = h.store.DeleteSimCard(ctx, mobileNumber)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type listSimCardLoadsReq struct {
+	Page    int32 `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage int32 `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+} //@name ListSimCardLoadsParams
+
+type paginatedGLabsLoads = util.PaginatedList[gLabsLoadRes] //@name PaginatedGlobeLabsLoads
+
+// ListSimCardLoads
+//
+//	@Summary	List the prepaid loads of a SIM card, latest first
+//	@Tags		sims
+//	@Produce	json
+//	@Param		mobile_number	path		string				true	"Mobile number"
+//	@Param		req				query		listSimCardLoadsReq	false	"List loads parameters"
+//	@Success	200				{object}	paginatedGLabsLoads
+//	@Security	BearerAuth
+//	@Router		/admin/sims/{mobile_number}/loads [get]
+func (h *DefaultHandler) ListSimCardLoads(ctx *gin.Context) {
+	var uri simCardUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := uri.parse()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listSimCardLoadsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	loads, err := h.store.ListGLabsLoads(ctx, db.ListGLabsLoadsParams{
+		MobileNumber: mobileNumber,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]gLabsLoadRes, len(loads))
+	for i := range loads {
+		items[i] = newGLabsLoadResponse(loads[i])
+	}
+
+	count, err := h.store.CountGLabsLoads(ctx, mobileNumber)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type StationAtRisk struct {
+	StationID     int64              `json:"station_id"`
+	StationName   string             `json:"station_name"`
+	StationStatus string             `json:"station_status,omitempty"`
+	MobileNumber  string             `json:"mobile_number"`
+	LoadExpiresAt pgtype.Timestamptz `json:"load_expires_at"`
+	LoadFlaggedAt pgtype.Timestamptz `json:"load_flagged_at"`
+} //@name StationAtRisk
+
+type listStationsAtRiskReq struct {
+	Within string `form:"within,default=72h"`
+} //@name ListStationsAtRiskParams
+
+// ListStationsAtRisk
+//
+//	@Summary	List the stations whose SIM load has run out or runs out within a duration
+//	@Tags		sims
+//	@Produce	json
+//	@Param		req	query	listStationsAtRiskReq	false	"List stations at risk parameters"
+//	@Success	200	{array}	StationAtRisk
+//	@Security	BearerAuth
+//	@Router		/admin/sims/at-risk [get]
+func (h *DefaultHandler) ListStationsAtRisk(ctx *gin.Context) {
+	var req listStationsAtRiskReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	within, err := time.ParseDuration(req.Within)
+	if err != nil || within < 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid duration: %s", req.Within)))
+		return
+	}
+
+	rows, err := h.store.ListStationsAtRisk(ctx, pgtype.Timestamptz{
+		Time:  time.Now().Add(within),
+		Valid: true,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]StationAtRisk, len(rows))
+	for i, r := range rows {
+		res[i] = StationAtRisk{
+			StationID:     r.StationID,
+			StationName:   r.StationName,
+			StationStatus: r.StationStatus.String,
+			MobileNumber:  r.MobileNumber,
+			LoadExpiresAt: r.LoadExpiresAt,
+			LoadFlaggedAt: r.LoadFlaggedAt,
+		}
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// simCardWithStation looks up the station using sim. A failed lookup only
+// leaves the station out.
+func (h *DefaultHandler) simCardWithStation(ctx *gin.Context, sim db.SimCard) SimCard {
+	station, err := h.store.GetStationByMobileNumber(ctx, pgtype.Text{
+		String: sim.MobileNumber,
+		Valid:  true,
+	})
+	if err != nil {
+		if !errors.Is(err, db.ErrRecordNotFound) {
+			h.logger.Error().Err(err).
+				Str("mobile_number", sim.MobileNumber).
+				Msg("[SIM] Cannot get station")
+		}
+		return newSimCard(sim, nil)
+	}
+	return newSimCard(sim, &station)
+}
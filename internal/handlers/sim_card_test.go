@@ -0,0 +1,592 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSimCardsAPI(t *testing.T) {
+	n := 5
+	sims := make([]db.ListSimCardsRow, n)
+	for i := range sims {
+		sim := randomSimCard()
+		sims[i] = db.ListSimCardsRow{
+			MobileNumber:  sim.MobileNumber,
+			Type:          sim.Type,
+			LoadExpiresAt: sim.LoadExpiresAt,
+			CreatedAt:     sim.CreatedAt,
+		}
+	}
+	sims[1].StationID = pgtype.Int8{Int64: util.RandomInt[int64](1, 1000), Valid: true}
+	sims[1].StationName = pgtype.Text{String: gofakeit.City(), Valid: true}
+	sims[1].LoadFlaggedAt = pgtype.Timestamptz{Time: time.Now().Truncate(time.Second).UTC(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSimCards(mock.AnythingOfType("*gin.Context"), db.ListSimCardsParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(sims, nil)
+				store.EXPECT().CountSimCards(mock.AnythingOfType("*gin.Context"), false).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedSimCards
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, n)
+				for i := range sims {
+					require.Equal(t, newSimCardFromRow(sims[i]), got.Items[i])
+				}
+				require.Equal(t, sims[1].StationID.Int64, *got.Items[1].StationID)
+			},
+		},
+		{
+			name:  "Flagged",
+			query: "?flagged=true&page=2&per_page=1",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSimCards(mock.AnythingOfType("*gin.Context"), db.ListSimCardsParams{
+					IsFlagged: true,
+					Limit:     pgtype.Int4{Int32: 1, Valid: true},
+					Offset:    1,
+				}).Return(sims[1:2], nil)
+				store.EXPECT().CountSimCards(mock.AnythingOfType("*gin.Context"), true).
+					Return(int64(2), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSimCards(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.ListSimCardsRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sims", handler.ListSimCards)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sims"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+	station := db.ObservationsStation{
+		ID:           util.RandomInt[int64](1, 1000),
+		Name:         gofakeit.City(),
+		MobileNumber: pgtype.Text{String: sim.MobileNumber, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"mobile_number": "0" + sim.MobileNumber[2:],
+				"type":          sim.Type.String,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSimCard(mock.AnythingOfType("*gin.Context"), db.CreateSimCardParams{
+					MobileNumber: sim.MobileNumber,
+					Type:         sim.Type,
+				}).Return(sim, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), station.MobileNumber).
+					Return(station, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchSimCard(t, recorder.Body, newSimCard(sim, &station))
+			},
+		},
+		{
+			name: "InvalidMobileNumber",
+			body: gin.H{
+				"mobile_number": "123",
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateSimCard", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate",
+			body: gin.H{
+				"mobile_number": sim.MobileNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSimCard(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SimCard{}, db.ErrUniqueViolation)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/admin/sims", handler.CreateSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/sims", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGetSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+
+	testCases := []struct {
+		name          string
+		mobileNumber  string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:         "OK",
+			mobileNumber: sim.MobileNumber,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sim, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSimCard(t, recorder.Body, newSimCard(sim, nil))
+			},
+		},
+		{
+			name:         "NotFound",
+			mobileNumber: sim.MobileNumber,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(db.SimCard{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:         "InvalidMobileNumber",
+			mobileNumber: "abc",
+			buildStubs:   func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetSimCard", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sims/:mobile_number", handler.GetSimCard)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sims/"+tc.mobileNumber, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUpdateSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"type": sim.Type.String},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSimCard(mock.AnythingOfType("*gin.Context"), db.UpdateSimCardParams{
+					MobileNumber: sim.MobileNumber,
+					Type:         sim.Type,
+				}).Return(sim, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSimCard(t, recorder.Body, newSimCard(sim, nil))
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"type": sim.Type.String},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSimCard(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SimCard{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/admin/sims/:mobile_number", handler.UpdateSimCard)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPut, "/admin/sims/"+sim.MobileNumber, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteSimCardAPI(t *testing.T) {
+	sim := randomSimCard()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteSimCard(mock.AnythingOfType("*gin.Context"), sim.MobileNumber).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE("/admin/sims/:mobile_number", handler.DeleteSimCard)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodDelete, "/admin/sims/"+sim.MobileNumber, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListSimCardLoadsAPI(t *testing.T) {
+	mobileNumber := util.RandomMobileNumber()
+	n := 3
+	loads := make([]db.GlabsLoad, n)
+	for i := range loads {
+		loads[i] = randomGLabsLoad()
+		loads[i].MobileNumber = mobileNumber
+		loads[i].CreatedAt = pgtype.Timestamptz{Time: time.Now().Truncate(time.Second).UTC(), Valid: true}
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "OK",
+			query: "?per_page=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListGLabsLoads(mock.AnythingOfType("*gin.Context"), db.ListGLabsLoadsParams{
+					MobileNumber: mobileNumber,
+					Limit:        pgtype.Int4{Int32: 10, Valid: true},
+				}).Return(loads, nil)
+				store.EXPECT().CountGLabsLoads(mock.AnythingOfType("*gin.Context"), mobileNumber).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paginatedGLabsLoads
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, n)
+				for i := range loads {
+					require.Equal(t, newGLabsLoadResponse(loads[i]), got.Items[i])
+				}
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListGLabsLoads(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.GlabsLoad{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sims/:mobile_number/loads", handler.ListSimCardLoads)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/admin/sims/%s/loads%s", mobileNumber, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListStationsAtRiskAPI(t *testing.T) {
+	rows := []db.ListStationsAtRiskRow{
+		{
+			StationID:     util.RandomInt[int64](1, 1000),
+			StationName:   gofakeit.City(),
+			MobileNumber:  util.RandomMobileNumber(),
+			LoadExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour).Truncate(time.Second).UTC(), Valid: true},
+			LoadFlaggedAt: pgtype.Timestamptz{Time: time.Now().Truncate(time.Second).UTC(), Valid: true},
+		},
+		{
+			StationID:     util.RandomInt[int64](1, 1000),
+			StationName:   gofakeit.City(),
+			StationStatus: pgtype.Text{String: "ONLINE", Valid: true},
+			MobileNumber:  util.RandomMobileNumber(),
+			LoadExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(time.Hour).Truncate(time.Second).UTC(), Valid: true},
+		},
+	}
+
+	expiresBefore := func(d time.Duration) any {
+		return mock.MatchedBy(func(ts pgtype.Timestamptz) bool {
+			return ts.Valid && time.Until(ts.Time) > d-time.Minute && time.Until(ts.Time) <= d
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsAtRisk(mock.AnythingOfType("*gin.Context"), expiresBefore(72*time.Hour)).
+					Return(rows, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []StationAtRisk
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got, len(rows))
+				require.Equal(t, rows[0].StationID, got[0].StationID)
+				require.Equal(t, rows[0].LoadFlaggedAt, got[0].LoadFlaggedAt)
+				require.Equal(t, "ONLINE", got[1].StationStatus)
+			},
+		},
+		{
+			name:  "Within",
+			query: "?within=24h",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationsAtRisk(mock.AnythingOfType("*gin.Context"), expiresBefore(24*time.Hour)).
+					Return(rows[:1], nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidWithin",
+			query:      "?within=3days",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationsAtRisk", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sims/at-risk", handler.ListStationsAtRisk)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sims/at-risk"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomSimCard() db.SimCard {
+	return db.SimCard{
+		MobileNumber:  util.RandomMobileNumber(),
+		Type:          pgtype.Text{String: gofakeit.RandomString([]string{"GLOBE", "SMART"}), Valid: true},
+		LoadExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC(), Valid: true},
+		CreatedAt:     pgtype.Timestamptz{Time: time.Now().Truncate(time.Second).UTC(), Valid: true},
+	}
+}
+
+func requireBodyMatchSimCard(t *testing.T, body io.Reader, sim SimCard) {
+	var got SimCard
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+	require.Equal(t, sim, got)
+}
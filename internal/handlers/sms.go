@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	errSmsAdapterNotFound = errors.New("sms provider not found")
+	errSmsQueryAnswered   = errors.New("query already answered")
+)
+
+type smsInboundUri struct {
+	Provider string `uri:"provider" binding:"required,alphanum"`
+}
+
+type smsInboundResult struct {
+	MessageID string    `json:"message_id"`
+	Sender    string    `json:"sender"`
+	Status    int       `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Data      *lufftRes `json:"data,omitempty"`
+	Reply     string    `json:"reply,omitempty"`
+} //@name SmsInboundResult
+
+type smsInboundRes struct {
+	Count        int                `json:"count"`
+	CountSuccess int                `json:"count_success"`
+	Results      []smsInboundResult `json:"results"`
+} //@name SmsInboundResponse
+
+// SmsInbound
+//
+//	@Summary	Store Lufft observation and health from an SMS gateway, or answer keyword queries such as WEATHER, RAIN and HELP
+//	@Tags		sms
+//	@Accept		json
+//	@Produce	json
+//	@Param		provider	path		string	true	"SMS gateway, e.g. GLABS, PROMOTEXTER or HTTP"
+//	@Param		req			body		object	true	"Inbound SMS payload of the gateway"
+//	@Success	200			{object}	smsInboundRes
+//	@Router		/sms/{provider} [post]
+func (h *DefaultHandler) SmsInbound(ctx *gin.Context) {
+	var uri smsInboundUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	h.receiveSms(ctx, uri.Provider, "SMS:"+uri.Provider)
+}
+
+// receiveSms stores the station messages of an inbound gateway request,
+// answers the keyword queries and reports the outcome of each message. Every
+// message is archived with its outcome.
+func (h *DefaultHandler) receiveSms(ctx *gin.Context, provider, tag string) {
+	adapter, ok := h.sms.Inbound(provider)
+	if !ok {
+		ctx.JSON(http.StatusNotFound, errorResponse(errSmsAdapterNotFound))
+		return
+	}
+
+	msgs, err := h.parseInbound(ctx, provider, adapter)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Msgf("[%s] Bad request", tag)
+		ctx.JSON(inboundErrorStatus(err), errorResponse(err))
+		return
+	}
+
+	res := smsInboundRes{
+		Count:   len(msgs),
+		Results: make([]smsInboundResult, len(msgs)),
+	}
+	for i, msg := range msgs {
+		result := smsInboundResult{
+			MessageID: msg.ID,
+			Sender:    msg.From,
+		}
+
+		if h.smsQuery.Match(msg.Text) {
+			if !h.claimSmsQuery(ctx, provider, msg) {
+				result.Status = http.StatusConflict
+				result.Error = errSmsQueryAnswered.Error()
+				res.Results[i] = result
+				continue
+			}
+			reply, status, err := h.answerSmsQuery(ctx, provider, tag, msg)
+			result.Status = status
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Reply = reply
+				res.CountSuccess++
+			}
+			res.Results[i] = result
+			continue
+		}
+
+		data, status, err := h.storeArchivedStationSms(ctx, provider, tag, msg)
+		result.Status = status
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Data = &data
+			res.CountSuccess++
+		}
+		res.Results[i] = result
+	}
+
+	// The gateway only needs to know the request was received; the outcome
+	// of each message is reported in the body.
+	ctx.JSON(http.StatusOK, res)
+}
+
+// claimSmsQuery archives a keyword query. It reports false if the gateway
+// already delivered the same message, e.g. on a retry, so that the sender is
+// answered only once. Messages without an ID are always answered.
+func (h *DefaultHandler) claimSmsQuery(ctx *gin.Context, provider string, msg sms.Message) bool {
+	raw, ok := h.archiveSms(ctx, provider, msg)
+	if !ok {
+		return true
+	}
+
+	// At most one archived message per provider and message ID has the
+	// QUERY status.
+	_, err := h.store.UpdateRawMessageOutcome(ctx, db.UpdateRawMessageOutcomeParams{
+		ID:     raw.ID,
+		Status: service.RawMessageStatusQuery,
+	})
+	if db.ErrorCode(err) == db.UniqueViolation {
+		h.recordOutcome(ctx, raw, service.RawMessageStatusDuplicate, 0, errSmsQueryAnswered, false)
+		return false
+	}
+	if err != nil {
+		h.logger.Error().Err(err).
+			Int64("id", raw.ID).
+			Str("status", service.RawMessageStatusQuery).
+			Msg("[RawMessage] Cannot record outcome")
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SmsQuery struct {
+	ID           int64              `json:"id"`
+	MobileNumber string             `json:"mobile_number"`
+	Provider     string             `json:"provider"`
+	Keyword      string             `json:"keyword"`
+	Message      string             `json:"message"`
+	Reply        string             `json:"reply"`
+	Parts        int32              `json:"parts"`
+	Status       string             `json:"status"`
+	Error        string             `json:"error,omitempty"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+} //@name SmsQuery
+
+func newSmsQuery(q db.SmsQuery) SmsQuery {
+	res := SmsQuery{
+		ID:           q.ID,
+		MobileNumber: q.MobileNumber,
+		Provider:     q.Provider,
+		Keyword:      q.Keyword,
+		Message:      q.Message,
+		Reply:        q.Reply,
+		Parts:        q.Parts,
+		Status:       q.Status,
+		CreatedAt:    q.CreatedAt,
+	}
+	if q.Error.Valid {
+		res.Error = q.Error.String
+	}
+	return res
+}
+
+// answerSmsQuery replies to a keyword query through the gateway that received
+// it. It returns the reply and the HTTP status that best describes the outcome.
+func (h *DefaultHandler) answerSmsQuery(ctx *gin.Context, provider, tag string, msg sms.Message) (string, int, error) {
+	mobileNumber, ok := util.ParseMobileNumber(msg.From)
+	if !ok {
+		err := fmt.Errorf("invalid mobile number: %s", msg.From)
+		h.logger.Error().Err(err).
+			Str("sender", msg.From).
+			Str("msg", msg.Text).
+			Msgf("[%s] Invalid mobile number", tag)
+		return "", http.StatusBadRequest, err
+	}
+	msg.From = mobileNumber
+
+	sender, _ := h.sms.Outbound(provider)
+	q, err := h.smsQuery.Answer(ctx, provider, sender, msg)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("sender", msg.From).
+			Str("msg", msg.Text).
+			Msgf("[%s] Cannot answer query", tag)
+		return "", http.StatusInternalServerError, err
+	}
+	if q.Status != service.SmsQueryStatusSent {
+		return q.Reply, http.StatusBadGateway, fmt.Errorf("reply not delivered: %s", q.Error.String)
+	}
+
+	h.logger.Info().
+		Str("sender", msg.From).
+		Str("keyword", q.Keyword).
+		Int32("parts", q.Parts).
+		Msgf("[%s] Query answered", tag)
+	return q.Reply, http.StatusOK, nil
+}
+
+type listSmsQueriesReq struct {
+	Page         int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage      int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	MobileNumber string `form:"mobile_number"`
+	Keyword      string `form:"keyword" binding:"omitempty,alphanum"`
+} //@name ListSmsQueriesParams
+
+type paginatedSmsQueries = util.PaginatedList[SmsQuery] //@name PaginatedSmsQueries
+
+// ListSmsQueries
+//
+//	@Summary	List the keyword queries texted by subscribers, latest first
+//	@Tags		sms
+//	@Produce	json
+//	@Param		req	query		listSmsQueriesReq	false	"List SMS queries parameters"
+//	@Success	200	{object}	paginatedSmsQueries
+//	@Security	BearerAuth
+//	@Router		/admin/sms/queries [get]
+func (h *DefaultHandler) ListSmsQueries(ctx *gin.Context) {
+	var req listSmsQueriesReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := parseOptionalMobileNumber(req.MobileNumber)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListSmsQueriesParams{
+		IsMobileNumber: len(mobileNumber) > 0,
+		MobileNumber:   mobileNumber,
+		IsKeyword:      len(req.Keyword) > 0,
+		Keyword:        strings.ToUpper(req.Keyword),
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	queries, err := h.store.ListSmsQueries(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]SmsQuery, len(queries))
+	for i := range queries {
+		items[i] = newSmsQuery(queries[i])
+	}
+
+	count, err := h.store.CountSmsQueries(ctx, db.CountSmsQueriesParams{
+		IsMobileNumber: arg.IsMobileNumber,
+		MobileNumber:   arg.MobileNumber,
+		IsKeyword:      arg.IsKeyword,
+		Keyword:        arg.Keyword,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,400 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmsInboundQueryAPI(t *testing.T) {
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	station := db.ObservationsStation{
+		ID:   100,
+		Name: "Science Garden",
+	}
+	obs := db.GetLatestStationObservationRow{
+		ID:   station.ID,
+		Name: station.Name,
+		ObservationsCurrent: db.ObservationsCurrent{
+			StationID: station.ID,
+			Temp:      pgtype.Float4{Float32: 28.5, Valid: true},
+			Rh:        pgtype.Float4{Float32: 80, Valid: true},
+			Wspd:      pgtype.Float4{Float32: 3.2, Valid: true},
+			Wdir:      pgtype.Float4{Float32: 50, Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+		},
+	}
+
+	n := 8
+	ids := make([]int64, n)
+	latest := make([]db.ListLatestObservationsRow, n+1)
+	for i := range latest {
+		latest[i] = db.ListLatestObservationsRow{
+			ID:        int64(i + 1),
+			Name:      fmt.Sprintf("Benguet Station %c", 'A'+i),
+			Rain:      pgtype.Float4{Float32: util.RandomFloat[float32](0, 20), Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true},
+		}
+		if i < n {
+			ids[i] = latest[i].ID
+		}
+	}
+	// Not in the province.
+	latest[n].Name = "Ifugao Station"
+
+	echoQuery := func(ctx context.Context, arg db.CreateSmsQueryParams) (db.SmsQuery, error) {
+		return db.SmsQuery{
+			ID:           util.RandomInt[int64](1, 1000),
+			MobileNumber: arg.MobileNumber,
+			Provider:     arg.Provider,
+			Keyword:      arg.Keyword,
+			Message:      arg.Message,
+			Reply:        arg.Reply,
+			Parts:        arg.Parts,
+			Status:       arg.Status,
+			Error:        arg.Error,
+		}, nil
+	}
+
+	testCases := []struct {
+		name          string
+		provider      string
+		text          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake)
+	}{
+		{
+			name:     "Weather",
+			provider: sms.FakeKey,
+			text:     "weather science garden",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FindStation(mock.AnythingOfType("*gin.Context"), "science garden").
+					Return(station, nil)
+				store.EXPECT().GetLatestStationObservation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(obs, nil)
+				store.EXPECT().CreateSmsQuery(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateSmsQueryParams) bool {
+					return arg.MobileNumber == mobileNum && arg.Provider == sms.FakeKey &&
+						arg.Keyword == "WEATHER" && arg.Parts == 1 && arg.Status == service.SmsQueryStatusSent
+				})).RunAndReturn(echoQuery)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 1, res.CountSuccess)
+				require.Equal(t, http.StatusOK, res.Results[0].Status)
+				require.Contains(t, res.Results[0].Reply, "Science Garden")
+				require.Contains(t, res.Results[0].Reply, "temp 28.5C")
+				require.Contains(t, res.Results[0].Reply, "wind 3.2m/s NE")
+				require.Nil(t, res.Results[0].Data)
+
+				sent := fake.Sent()
+				require.Len(t, sent, 1)
+				require.Equal(t, mobileNum, sent[0].To)
+				require.Equal(t, res.Results[0].Reply, sent[0].Text)
+			},
+		},
+		{
+			name:     "WeatherStationNotFound",
+			provider: sms.FakeKey,
+			text:     "WEATHER Atlantis",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FindStation(mock.AnythingOfType("*gin.Context"), "Atlantis").
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateSmsQuery(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					RunAndReturn(echoQuery)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, http.StatusOK, res.Results[0].Status)
+				require.Contains(t, res.Results[0].Reply, "No station matches Atlantis")
+				require.Len(t, fake.Sent(), 1)
+			},
+		},
+		{
+			name:     "RainSplit",
+			provider: sms.FakeKey,
+			text:     "RAIN Benguet",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListProvinceStationIDs(mock.AnythingOfType("*gin.Context"), "Benguet").
+					Return(ids, nil)
+				store.EXPECT().ListLatestObservations(mock.AnythingOfType("*gin.Context")).
+					Return(latest, nil)
+				store.EXPECT().CreateSmsQuery(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateSmsQueryParams) bool {
+					return arg.Keyword == "RAIN" && arg.Parts > 1
+				})).RunAndReturn(echoQuery)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, http.StatusOK, res.Results[0].Status)
+				require.NotContains(t, res.Results[0].Reply, latest[n].Name)
+
+				sent := fake.Sent()
+				require.Greater(t, len(sent), 1)
+				for i, msg := range sent {
+					require.LessOrEqual(t, len([]rune(msg.Text)), sms.MaxLength)
+					require.True(t, strings.HasPrefix(msg.Text, fmt.Sprintf("%d/%d ", i+1, len(sent))))
+				}
+			},
+		},
+		{
+			name:     "Help",
+			provider: sms.FakeKey,
+			text:     "help",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsQuery(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					RunAndReturn(echoQuery)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, http.StatusOK, res.Results[0].Status)
+				require.Contains(t, res.Results[0].Reply, "WEATHER <station>")
+				require.Contains(t, res.Results[0].Reply, "RAIN <province>")
+			},
+		},
+		{
+			name:     "NoSender",
+			provider: "inbound",
+			text:     "HELP",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateSmsQuery(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateSmsQueryParams) bool {
+					return arg.Status == service.SmsQueryStatusFailed && arg.Parts == 0 && arg.Error.Valid
+				})).RunAndReturn(echoQuery)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 0, res.CountSuccess)
+				require.Equal(t, http.StatusBadGateway, res.Results[0].Status)
+				require.Empty(t, fake.Sent())
+			},
+		},
+		{
+			name:     "AlreadyAnswered",
+			provider: sms.FakeKey,
+			text:     "HELP",
+			buildStubs: func(store *mockdb.MockStore) {
+				// The gateway retried a message that was already answered.
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+					return arg.Status == service.RawMessageStatusQuery
+				})).
+					Return(db.RawMessage{}, db.ErrUniqueViolation).
+					Once()
+				store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+					return arg.Status == service.RawMessageStatusDuplicate && arg.Error.Valid
+				})).
+					Return(db.RawMessage{}, nil).
+					Once()
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateSmsQuery", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 0, res.CountSuccess)
+				require.Equal(t, http.StatusConflict, res.Results[0].Status)
+				require.Empty(t, res.Results[0].Reply)
+				require.Empty(t, fake.Sent())
+			},
+		},
+		{
+			name:     "InternalError",
+			provider: sms.FakeKey,
+			text:     "WEATHER Manila",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FindStation(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore, fake *sms.Fake) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateSmsQuery", mock.Anything, mock.Anything)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, http.StatusInternalServerError, res.Results[0].Status)
+				require.Empty(t, fake.Sent())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+			stubRawMessages(store)
+
+			fake := sms.NewFake()
+			handler := newTestHandler(store, nil)
+			handler.sms.RegisterInbound(sms.FakeKey, fake)
+			handler.sms.RegisterOutbound(sms.FakeKey, fake)
+			handler.sms.RegisterInbound("INBOUND", fake)
+
+			router := gin.Default()
+			router.POST("/:provider", handler.SmsInbound)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"id": "1", "from": mobileNum, "text": tc.text})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%s", tc.provider)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder, store, fake)
+		})
+	}
+}
+
+func TestListSmsQueriesAPI(t *testing.T) {
+	mobileNumber := util.RandomMobileNumber()
+	n := 5
+	queries := make([]db.SmsQuery, n)
+	for i := range queries {
+		queries[i] = randomSmsQuery(int64(i+1), mobileNumber)
+	}
+	queries[1].Status = service.SmsQueryStatusFailed
+	queries[1].Error = pgtype.Text{String: sms.ErrNoSender.Error(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsQueries(mock.AnythingOfType("*gin.Context"), db.ListSmsQueriesParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(queries, nil)
+				store.EXPECT().CountSmsQueries(mock.AnythingOfType("*gin.Context"), db.CountSmsQueriesParams{}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsQueries(t, recorder.Body, queries)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: fmt.Sprintf("?mobile_number=%s&keyword=weather", mobileNumber),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsQueries(mock.AnythingOfType("*gin.Context"), db.ListSmsQueriesParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsKeyword:      true,
+					Keyword:        "WEATHER",
+					Limit:          pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(queries, nil)
+				store.EXPECT().CountSmsQueries(mock.AnythingOfType("*gin.Context"), db.CountSmsQueriesParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsKeyword:      true,
+					Keyword:        "WEATHER",
+				}).Return(int64(n), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsQueries(t, recorder.Body, queries)
+			},
+		},
+		{
+			name:       "InvalidMobileNumber",
+			query:      "?mobile_number=123",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListSmsQueries", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsQueries(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.SmsQuery{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sms/queries", handler.ListSmsQueries)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sms/queries"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(t, recorder, store)
+		})
+	}
+}
+
+func randomSmsQuery(id int64, mobileNumber string) db.SmsQuery {
+	createdAt := time.Now().Add(-time.Duration(util.RandomInt(60, 3600)) * time.Second).Truncate(time.Second).UTC()
+	return db.SmsQuery{
+		ID:           id,
+		MobileNumber: mobileNumber,
+		Provider:     sms.GLabsKey,
+		Keyword:      "WEATHER",
+		Message:      "WEATHER " + gofakeit.City(),
+		Reply:        gofakeit.Sentence(8),
+		Parts:        1,
+		Status:       service.SmsQueryStatusSent,
+		CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+}
+
+func requireBodyMatchSmsQueries(t *testing.T, body io.Reader, queries []db.SmsQuery) {
+	var got paginatedSmsQueries
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Items, len(queries))
+	for i := range queries {
+		require.Equal(t, newSmsQuery(queries[i]), got.Items[i])
+	}
+}
@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SmsSubscription struct {
+	ID           int64              `json:"id"`
+	MobileNumber string             `json:"mobile_number"`
+	StationID    int64              `json:"station_id"`
+	Alerts       bool               `json:"alerts"`
+	DailySummary bool               `json:"daily_summary"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+} //@name SmsSubscription
+
+func newSmsSubscription(s db.SmsSubscription) SmsSubscription {
+	return SmsSubscription{
+		ID:           s.ID,
+		MobileNumber: s.MobileNumber,
+		StationID:    s.StationID,
+		Alerts:       s.Alerts,
+		DailySummary: s.DailySummary,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+type SmsMessage struct {
+	ID           int64              `json:"id"`
+	MobileNumber string             `json:"mobile_number"`
+	StationID    *int64             `json:"station_id,omitempty"`
+	Kind         string             `json:"kind"`
+	Message      string             `json:"message"`
+	Status       string             `json:"status"`
+	Attempts     int32              `json:"attempts"`
+	Error        string             `json:"error,omitempty"`
+	SentAt       pgtype.Timestamptz `json:"sent_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+} //@name SmsMessage
+
+func newSmsMessage(m db.SmsMessage) SmsMessage {
+	res := SmsMessage{
+		ID:           m.ID,
+		MobileNumber: m.MobileNumber,
+		Kind:         m.Kind,
+		Message:      m.Message,
+		Status:       m.Status,
+		Attempts:     m.Attempts,
+		SentAt:       m.SentAt,
+		CreatedAt:    m.CreatedAt,
+	}
+	if m.StationID.Valid {
+		res.StationID = &m.StationID.Int64
+	}
+	if m.Error.Valid {
+		res.Error = m.Error.String
+	}
+	return res
+}
+
+type listSmsSubscriptionsReq struct {
+	Page         int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage      int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	MobileNumber string `form:"mobile_number"`
+	StationID    int64  `form:"station_id" binding:"omitempty,min=1"`
+} //@name ListSmsSubscriptionsParams
+
+type paginatedSmsSubscriptions = util.PaginatedList[SmsSubscription] //@name PaginatedSmsSubscriptions
+
+// ListSmsSubscriptions
+//
+//	@Summary	List the stations followed by SMS subscribers
+//	@Tags		sms
+//	@Produce	json
+//	@Param		req	query		listSmsSubscriptionsReq	false	"List SMS subscriptions parameters"
+//	@Success	200	{object}	paginatedSmsSubscriptions
+//	@Security	BearerAuth
+//	@Router		/admin/sms/subscriptions [get]
+func (h *DefaultHandler) ListSmsSubscriptions(ctx *gin.Context) {
+	var req listSmsSubscriptionsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := parseOptionalMobileNumber(req.MobileNumber)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListSmsSubscriptionsParams{
+		IsMobileNumber: len(mobileNumber) > 0,
+		MobileNumber:   mobileNumber,
+		IsStationID:    req.StationID > 0,
+		StationID:      req.StationID,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	subs, err := h.store.ListSmsSubscriptions(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]SmsSubscription, len(subs))
+	for i := range subs {
+		items[i] = newSmsSubscription(subs[i])
+	}
+
+	count, err := h.store.CountSmsSubscriptions(ctx, db.CountSmsSubscriptionsParams{
+		IsMobileNumber: arg.IsMobileNumber,
+		MobileNumber:   arg.MobileNumber,
+		IsStationID:    arg.IsStationID,
+		StationID:      arg.StationID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type createSmsSubscriptionReq struct {
+	MobileNumber string `json:"mobile_number" binding:"required"`
+	StationID    int64  `json:"station_id" binding:"required,min=1"`
+	Alerts       *bool  `json:"alerts"`
+	DailySummary *bool  `json:"daily_summary"`
+} //@name CreateSmsSubscriptionParams
+
+// CreateSmsSubscription
+//
+//	@Summary		Make an SMS subscriber follow a station
+//	@Description	The subscriber must have opted in through Globe Labs. Alerts and daily summaries are on by default.
+//	@Tags			sms
+//	@Accept			json
+//	@Produce		json
+//	@Param			req	body		createSmsSubscriptionReq	true	"Create SMS subscription parameters"
+//	@Success		201	{object}	SmsSubscription
+//	@Security		BearerAuth
+//	@Router			/admin/sms/subscriptions [post]
+func (h *DefaultHandler) CreateSmsSubscription(ctx *gin.Context) {
+	var req createSmsSubscriptionReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, ok := util.ParseMobileNumber(req.MobileNumber)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("invalid mobile number: %s", req.MobileNumber)))
+		return
+	}
+
+	arg := db.UpsertSmsSubscriptionParams{
+		MobileNumber: mobileNumber,
+		StationID:    req.StationID,
+		Alerts:       req.Alerts == nil || *req.Alerts,
+		DailySummary: req.DailySummary == nil || *req.DailySummary,
+	}
+
+	sub, err := h.store.UpsertSmsSubscription(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("subscriber or station not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newSmsSubscription(sub))
+}
+
+type deleteSmsSubscriptionReq struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// DeleteSmsSubscription
+//
+//	@Summary	Stop an SMS subscriber from following a station
+//	@Tags		sms
+//	@Param		id	path	int	true	"SMS subscription ID"
+//	@Success	204
+//	@Security	BearerAuth
+//	@Router		/admin/sms/subscriptions/{id} [delete]
+func (h *DefaultHandler) DeleteSmsSubscription(ctx *gin.Context) {
+	var req deleteSmsSubscriptionReq
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := h.store.DeleteSmsSubscription(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type listSmsMessagesReq struct {
+	Page         int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage      int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	MobileNumber string `form:"mobile_number"`
+	Status       string `form:"status" binding:"omitempty,oneof=QUEUED SENDING SENT FAILED CANCELLED"`
+} //@name ListSmsMessagesParams
+
+type paginatedSmsMessages = util.PaginatedList[SmsMessage] //@name PaginatedSmsMessages
+
+// ListSmsMessages
+//
+//	@Summary	List outbound SMS and their delivery status, latest first
+//	@Tags		sms
+//	@Produce	json
+//	@Param		req	query		listSmsMessagesReq	false	"List SMS messages parameters"
+//	@Success	200	{object}	paginatedSmsMessages
+//	@Security	BearerAuth
+//	@Router		/admin/sms/messages [get]
+func (h *DefaultHandler) ListSmsMessages(ctx *gin.Context) {
+	var req listSmsMessagesReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	mobileNumber, err := parseOptionalMobileNumber(req.MobileNumber)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListSmsMessagesParams{
+		IsMobileNumber: len(mobileNumber) > 0,
+		MobileNumber:   mobileNumber,
+		IsStatus:       len(req.Status) > 0,
+		Status:         req.Status,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	msgs, err := h.store.ListSmsMessages(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]SmsMessage, len(msgs))
+	for i := range msgs {
+		items[i] = newSmsMessage(msgs[i])
+	}
+
+	count, err := h.store.CountSmsMessages(ctx, db.CountSmsMessagesParams{
+		IsMobileNumber: arg.IsMobileNumber,
+		MobileNumber:   arg.MobileNumber,
+		IsStatus:       arg.IsStatus,
+		Status:         arg.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func parseOptionalMobileNumber(s string) (string, error) {
+	if len(s) == 0 {
+		return "", nil
+	}
+	mobileNumber, ok := util.ParseMobileNumber(s)
+	if !ok {
+		return "", fmt.Errorf("invalid mobile number: %s", s)
+	}
+	return mobileNumber, nil
+}
+
+// notifyStationAlert queues an opened alert for the subscribers of the
+// station, texted by the workers of the notifier.
+func (h *DefaultHandler) notifyStationAlert(a db.ObservationsStationhealthAlert) {
+	if h.notifier == nil {
+		return
+	}
+	if !h.notifier.QueueStationAlert(a.StationID, a.Rule, a.Message) {
+		h.logger.Warn().
+			Int64("station_id", a.StationID).
+			Str("rule", a.Rule).
+			Msg("[SMS] Alert queue full, station alert dropped")
+	}
+}
@@ -0,0 +1,471 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSmsSubscriptionsAPI(t *testing.T) {
+	mobileNumber := util.RandomMobileNumber()
+	n := 5
+	subs := make([]db.SmsSubscription, n)
+	for i := range subs {
+		subs[i] = randomSmsSubscription(mobileNumber)
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsSubscriptions(mock.AnythingOfType("*gin.Context"), db.ListSmsSubscriptionsParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(subs, nil)
+				store.EXPECT().CountSmsSubscriptions(mock.AnythingOfType("*gin.Context"), db.CountSmsSubscriptionsParams{}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsSubscriptions(t, recorder.Body, subs)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: fmt.Sprintf("?mobile_number=0%s&station_id=%d&page=2&per_page=2", mobileNumber[2:], subs[2].StationID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsSubscriptions(mock.AnythingOfType("*gin.Context"), db.ListSmsSubscriptionsParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsStationID:    true,
+					StationID:      subs[2].StationID,
+					Limit:          pgtype.Int4{Int32: 2, Valid: true},
+					Offset:         2,
+				}).Return(subs[2:3], nil)
+				store.EXPECT().CountSmsSubscriptions(mock.AnythingOfType("*gin.Context"), db.CountSmsSubscriptionsParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsStationID:    true,
+					StationID:      subs[2].StationID,
+				}).Return(int64(1), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsSubscriptions(t, recorder.Body, subs[2:3])
+			},
+		},
+		{
+			name:       "InvalidMobileNumber",
+			query:      "?mobile_number=123",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListSmsSubscriptions", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsSubscriptions(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.SmsSubscription{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sms/subscriptions", handler.ListSmsSubscriptions)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sms/subscriptions"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateSmsSubscriptionAPI(t *testing.T) {
+	sub := randomSmsSubscription(util.RandomMobileNumber())
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "Default",
+			body: gin.H{
+				"mobile_number": "+" + sub.MobileNumber,
+				"station_id":    sub.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertSmsSubscription(mock.AnythingOfType("*gin.Context"), db.UpsertSmsSubscriptionParams{
+					MobileNumber: sub.MobileNumber,
+					StationID:    sub.StationID,
+					Alerts:       true,
+					DailySummary: true,
+				}).Return(sub, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchSmsSubscription(t, recorder.Body, sub)
+			},
+		},
+		{
+			name: "AlertsOnly",
+			body: gin.H{
+				"mobile_number": sub.MobileNumber,
+				"station_id":    sub.StationID,
+				"daily_summary": false,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertSmsSubscription(mock.AnythingOfType("*gin.Context"), db.UpsertSmsSubscriptionParams{
+					MobileNumber: sub.MobileNumber,
+					StationID:    sub.StationID,
+					Alerts:       true,
+					DailySummary: false,
+				}).Return(sub, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{
+				"mobile_number": sub.MobileNumber,
+				"station_id":    sub.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertSmsSubscription(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SmsSubscription{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidMobileNumber",
+			body: gin.H{
+				"mobile_number": "123",
+				"station_id":    sub.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpsertSmsSubscription", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"mobile_number": sub.MobileNumber,
+				"station_id":    sub.StationID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertSmsSubscription(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.SmsSubscription{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/admin/sms/subscriptions", handler.CreateSmsSubscription)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/sms/subscriptions", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteSmsSubscriptionAPI(t *testing.T) {
+	sub := randomSmsSubscription(util.RandomMobileNumber())
+
+	testCases := []struct {
+		name          string
+		id            int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			id:   sub.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteSmsSubscription(mock.AnythingOfType("*gin.Context"), sub.ID).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidID",
+			id:         0,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "DeleteSmsSubscription", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			id:   sub.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteSmsSubscription(mock.AnythingOfType("*gin.Context"), sub.ID).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.DELETE("/admin/sms/subscriptions/:id", handler.DeleteSmsSubscription)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/admin/sms/subscriptions/%d", tc.id)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListSmsMessagesAPI(t *testing.T) {
+	mobileNumber := util.RandomMobileNumber()
+	n := 5
+	msgs := make([]db.SmsMessage, n)
+	for i := range msgs {
+		msgs[i] = randomSmsMessage(mobileNumber)
+	}
+	msgs[1].Status = service.SmsStatusFailed
+	msgs[1].Error = pgtype.Text{String: "sms gateway responded with 503", Valid: true}
+	msgs[1].SentAt = pgtype.Timestamptz{}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:  "Default",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsMessages(mock.AnythingOfType("*gin.Context"), db.ListSmsMessagesParams{
+					Limit: pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(msgs, nil)
+				store.EXPECT().CountSmsMessages(mock.AnythingOfType("*gin.Context"), db.CountSmsMessagesParams{}).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsMessages(t, recorder.Body, msgs)
+			},
+		},
+		{
+			name:  "Filtered",
+			query: fmt.Sprintf("?mobile_number=%s&status=FAILED", mobileNumber),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsMessages(mock.AnythingOfType("*gin.Context"), db.ListSmsMessagesParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsStatus:       true,
+					Status:         service.SmsStatusFailed,
+					Limit:          pgtype.Int4{Int32: 5, Valid: true},
+				}).Return(msgs[1:2], nil)
+				store.EXPECT().CountSmsMessages(mock.AnythingOfType("*gin.Context"), db.CountSmsMessagesParams{
+					IsMobileNumber: true,
+					MobileNumber:   mobileNumber,
+					IsStatus:       true,
+					Status:         service.SmsStatusFailed,
+				}).Return(int64(1), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSmsMessages(t, recorder.Body, msgs[1:2])
+			},
+		},
+		{
+			name:       "InvalidStatus",
+			query:      "?status=DELIVERED",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListSmsMessages", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListSmsMessages(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return([]db.SmsMessage{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/admin/sms/messages", handler.ListSmsMessages)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, "/admin/sms/messages"+tc.query, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomSmsSubscription(mobileNumber string) db.SmsSubscription {
+	return db.SmsSubscription{
+		ID:           util.RandomInt[int64](1, 1000),
+		MobileNumber: mobileNumber,
+		StationID:    util.RandomInt[int64](1, 1000),
+		Alerts:       gofakeit.Bool(),
+		DailySummary: gofakeit.Bool(),
+		CreatedAt:    pgtype.Timestamptz{Time: time.Now().Truncate(time.Second).UTC(), Valid: true},
+	}
+}
+
+func randomSmsMessage(mobileNumber string) db.SmsMessage {
+	createdAt := time.Now().Add(-time.Duration(util.RandomInt(60, 3600)) * time.Second).Truncate(time.Second).UTC()
+	return db.SmsMessage{
+		ID:           util.RandomInt[int64](1, 1000),
+		MobileNumber: mobileNumber,
+		StationID:    pgtype.Int8{Int64: util.RandomInt[int64](1, 1000), Valid: true},
+		Kind:         service.SmsKindAlert,
+		Message:      gofakeit.Sentence(8),
+		Status:       service.SmsStatusSent,
+		Attempts:     1,
+		SentAt:       pgtype.Timestamptz{Time: createdAt.Add(time.Second), Valid: true},
+		CreatedAt:    pgtype.Timestamptz{Time: createdAt, Valid: true},
+	}
+}
+
+func requireBodyMatchSmsSubscription(t *testing.T, body io.Reader, sub db.SmsSubscription) {
+	var got SmsSubscription
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+	require.Equal(t, newSmsSubscription(sub), got)
+}
+
+func requireBodyMatchSmsSubscriptions(t *testing.T, body io.Reader, subs []db.SmsSubscription) {
+	var got paginatedSmsSubscriptions
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Items, len(subs))
+	for i := range subs {
+		require.Equal(t, newSmsSubscription(subs[i]), got.Items[i])
+	}
+}
+
+func requireBodyMatchSmsMessages(t *testing.T, body io.Reader, msgs []db.SmsMessage) {
+	var got paginatedSmsMessages
+	err := json.NewDecoder(body).Decode(&got)
+	require.NoError(t, err)
+
+	require.Len(t, got.Items, len(msgs))
+	for i := range msgs {
+		require.Equal(t, newSmsMessage(msgs[i]), got.Items[i])
+	}
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmsInboundApi(t *testing.T) {
+	mobileNum := gofakeit.Regex("639[0-9]{9}")
+	unknownNum := gofakeit.Regex("639[0-9]{9}")
+	var lufft sensor.Lufft
+	gofakeit.Struct(&lufft)
+
+	stubStore := func(store *mockdb.MockStore) {
+		store.EXPECT().CreateStationReadingTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.CreateStationReadingTxResult{IsCreated: true, Health: db.ObservationsStationhealth{ID: 1}}, nil)
+		store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return([]db.ObservationsObservation{}, nil)
+		store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return(db.UpdateObservationQcTxResult{}, nil)
+		store.EXPECT().ListActiveStationHealthAlerts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+			Return([]db.ObservationsStationhealthAlert{}, nil)
+	}
+
+	testCases := []struct {
+		name          string
+		provider      string
+		body          any
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:     "OK",
+			provider: "fake",
+			body: []gin.H{
+				{"id": "1", "from": mobileNum, "text": lufft.String(23)},
+				{"id": "2", "from": unknownNum, "text": lufft.String(23)},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: mobileNum, Valid: true}).
+					Return(db.ObservationsStation{}, nil)
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: unknownNum, Valid: true}).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+				stubStore(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 2, res.Count)
+				require.Equal(t, 1, res.CountSuccess)
+				require.Equal(t, "1", res.Results[0].MessageID)
+				require.Equal(t, http.StatusCreated, res.Results[0].Status)
+				require.Equal(t, http.StatusNotFound, res.Results[1].Status)
+			},
+		},
+		{
+			name:     "PromoTexter",
+			provider: sms.PromoTexterKey,
+			body: gin.H{
+				"number": mobileNum,
+				"msg":    lufft.String(23),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: mobileNum, Valid: true}).
+					Return(db.ObservationsStation{}, nil)
+				stubStore(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				res := requireBodySmsInbound(t, recorder.Body)
+				require.Equal(t, 1, res.CountSuccess)
+			},
+		},
+		{
+			name:     "UnknownProvider",
+			provider: "unknown",
+			body:     gin.H{"from": mobileNum, "text": lufft.String(23)},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidPayload",
+			provider: "fake",
+			body:     gin.H{"number": mobileNum, "msg": lufft.String(23)},
+			buildStubs: func(store *mockdb.MockStore) {
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationByMobileNumber", mock.Anything, mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+			stubRawMessages(store)
+
+			handler := newTestHandler(store, nil)
+			handler.sms.RegisterInbound(sms.FakeKey, sms.NewFake())
+
+			router := gin.Default()
+			router.POST("/:provider", handler.SmsInbound)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%s", tc.provider)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func requireBodySmsInbound(t *testing.T, body *bytes.Buffer) smsInboundRes {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var res smsInboundRes
+	err = json.Unmarshal(data, &res)
+	require.NoError(t, err)
+
+	return res
+}
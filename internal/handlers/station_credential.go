@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StationCredential is the cloud service credential of a station. The api secret is never returned.
+type StationCredential struct {
+	StationID    int64              `json:"station_id"`
+	ApiKey       string             `json:"api_key"`
+	ApiStationID string             `json:"api_station_id,omitempty"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+} //@name StationCredential
+
+func newStationCredential(c db.ObservationsStationcredential) StationCredential {
+	return StationCredential{
+		StationID:    c.StationID,
+		ApiKey:       c.ApiKey,
+		ApiStationID: c.ApiStationID.String,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
+
+type stationCredentialUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type updateStationCredentialReq struct {
+	ApiKey       string `json:"api_key" binding:"required"`
+	ApiSecret    string `json:"api_secret" binding:"required"`
+	ApiStationID string `json:"api_station_id" binding:"omitempty,numeric"`
+} //@name UpdateStationCredentialParams
+
+// UpdateStationCredential
+//
+//	@Summary	Set the WeatherLink v2 credential of a station
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int							true	"Station ID"
+//	@Param		req			body	updateStationCredentialReq	true	"Station credential parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	StationCredential
+//	@Router		/stations/{station_id}/credentials [put]
+func (h *DefaultHandler) UpdateStationCredential(ctx *gin.Context) {
+	var uri stationCredentialUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateStationCredentialReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	cred, err := h.store.UpsertStationCredential(ctx, db.UpsertStationCredentialParams{
+		StationID: uri.StationID,
+		ApiKey:    req.ApiKey,
+		ApiSecret: req.ApiSecret,
+		ApiStationID: pgtype.Text{
+			String: req.ApiStationID,
+			Valid:  len(req.ApiStationID) > 0,
+		},
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationCredential(cred))
+}
+
+// DeleteStationCredential
+//
+//	@Summary	Delete the credential of a station
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/credentials [delete]
+func (h *DefaultHandler) DeleteStationCredential(ctx *gin.Context) {
+	var uri stationCredentialUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := h.store.DeleteStationCredential(ctx, uri.StationID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
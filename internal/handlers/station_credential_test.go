@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateStationCredentialAPI(t *testing.T) {
+	cred := db.ObservationsStationcredential{
+		ID:           1,
+		StationID:    int64(gofakeit.Number(1, 100)),
+		ApiKey:       gofakeit.LetterN(32),
+		ApiSecret:    gofakeit.LetterN(32),
+		ApiStationID: pgtype.Text{String: "1234", Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"api_key":        cred.ApiKey,
+				"api_secret":     cred.ApiSecret,
+				"api_station_id": cred.ApiStationID.String,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertStationCredential(mock.AnythingOfType("*gin.Context"), db.UpsertStationCredentialParams{
+					StationID:    cred.StationID,
+					ApiKey:       cred.ApiKey,
+					ApiSecret:    cred.ApiSecret,
+					ApiStationID: cred.ApiStationID,
+				}).
+					Return(cred, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				require.NotContains(t, string(data), cred.ApiSecret)
+
+				var got StationCredential
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, newStationCredential(cred), got)
+			},
+		},
+		{
+			name: "MissingSecret",
+			body: gin.H{
+				"api_key": cred.ApiKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpsertStationCredential", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"api_key":    cred.ApiKey,
+				"api_secret": cred.ApiSecret,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertStationCredential(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationcredential{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/stations/:station_id/credentials", handler.UpdateStationCredential)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/credentials", cred.StationID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteStationCredentialAPI(t *testing.T) {
+	stationID := int64(gofakeit.Number(1, 100))
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().DeleteStationCredential(mock.AnythingOfType("*gin.Context"), stationID).Return(nil)
+
+	handler := newTestHandler(store, nil)
+
+	router := gin.Default()
+	router.DELETE("/stations/:station_id/credentials", handler.DeleteStationCredential)
+
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/stations/%d/credentials", stationID)
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(recorder, request)
+
+	store.AssertExpectations(t)
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+}
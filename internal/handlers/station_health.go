@@ -1,28 +1,34 @@
 package handlers
 
 import (
+	"errors"
+	"net/http"
+
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type StationHealth struct {
-	ID         int64              `json:"id"`
-	Vb1        float32            `json:"vb1"`
-	Vb2        float32            `json:"vb2"`
-	Curr       float32            `json:"curr"`
-	Bp1        float32            `json:"bp1"`
-	Bp2        float32            `json:"bp2"`
-	Cm         string             `json:"cm"`
-	Ss         int32              `json:"ss"`
-	RhArq      float32            `json:"rh_arq"`
-	TempArq    float32            `json:"temp_arq"`
-	Fpm        string             `json:"fpm"`
-	ErrorMsg   string             `json:"error_msg"`
-	Message    string             `json:"message"`
-	DataCount  int32              `json:"data_count"`
-	DataStatus string             `json:"data_status"`
-	Timestamp  pgtype.Timestamptz `json:"timestamp"`
-	StationID  int64              `json:"station_id"`
+	ID                int64              `json:"id"`
+	Vb1               float32            `json:"vb1"`
+	Vb2               float32            `json:"vb2"`
+	Curr              float32            `json:"curr"`
+	Bp1               float32            `json:"bp1"`
+	Bp2               float32            `json:"bp2"`
+	Cm                string             `json:"cm"`
+	Ss                int32              `json:"ss"`
+	RhArq             float32            `json:"rh_arq"`
+	TempArq           float32            `json:"temp_arq"`
+	Fpm               string             `json:"fpm"`
+	ErrorMsg          string             `json:"error_msg"`
+	Message           string             `json:"message"`
+	DataCount         int32              `json:"data_count"`
+	DataStatus        string             `json:"data_status"`
+	MinutesDifference int32              `json:"minutes_difference"`
+	Timestamp         pgtype.Timestamptz `json:"timestamp"`
+	StationID         int64              `json:"station_id"`
 } //@name StationHealth
 
 func newStationHealth(h db.ObservationsStationhealth) StationHealth {
@@ -65,5 +71,136 @@ func newStationHealth(h db.ObservationsStationhealth) StationHealth {
 	if h.ErrorMsg.Valid {
 		res.ErrorMsg = h.ErrorMsg.String
 	}
+	if h.Message.Valid {
+		res.Message = h.Message.String
+	}
+	if h.DataCount.Valid {
+		res.DataCount = h.DataCount.Int32
+	}
+	if h.DataStatus.Valid {
+		res.DataStatus = h.DataStatus.String
+	}
+	if h.MinutesDifference.Valid {
+		res.MinutesDifference = h.MinutesDifference.Int32
+	}
 	return res
 }
+
+type listStationHealthUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+type listStationHealthReq struct {
+	Page      int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage   int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	StartDate string `form:"start_date" binding:"omitempty,date_time"`
+	EndDate   string `form:"end_date" binding:"omitempty,date_time"`
+} //@name ListStationHealthParams
+
+type paginatedStationHealths = util.PaginatedList[StationHealth] //@name PaginatedStationHealths
+
+// ListStationHealths
+//
+//	@Summary	List station health
+//	@Tags		health
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int						true	"Station ID"
+//	@Param		req			query		listStationHealthReq	false	"List station health parameters"
+//	@Success	200			{object}	paginatedStationHealths
+//	@Router		/stations/{station_id}/health [get]
+func (h *DefaultHandler) ListStationHealths(ctx *gin.Context) {
+	var uri listStationHealthUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationHealthReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	startDate, isStartDate := util.ParseDateTime(req.StartDate)
+	endDate, isEndDate := util.ParseDateTime(req.EndDate)
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListStationHealthsByDateParams{
+		StationID: uri.StationID,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset:      offset,
+		IsStartDate: isStartDate,
+		StartDate: pgtype.Timestamptz{
+			Time:  startDate,
+			Valid: !startDate.IsZero(),
+		},
+		IsEndDate: isEndDate,
+		EndDate: pgtype.Timestamptz{
+			Time:  endDate,
+			Valid: !endDate.IsZero(),
+		},
+	}
+
+	healths, err := h.store.ListStationHealthsByDate(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]StationHealth, len(healths))
+	for i := range healths {
+		items[i] = newStationHealth(healths[i])
+	}
+
+	count, err := h.store.CountStationHealths(ctx, db.CountStationHealthsParams{
+		StationID:   arg.StationID,
+		IsStartDate: arg.IsStartDate,
+		StartDate:   arg.StartDate,
+		IsEndDate:   arg.IsEndDate,
+		EndDate:     arg.EndDate,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type getLatestStationHealthReq struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+}
+
+// GetLatestStationHealth
+//
+//	@Summary	Get latest station health
+//	@Tags		health
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Success	200			{object}	StationHealth
+//	@Router		/stations/{station_id}/health/latest [get]
+func (h *DefaultHandler) GetLatestStationHealth(ctx *gin.Context) {
+	var req getLatestStationHealthReq
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	health, err := h.store.GetLatestStationHealth(ctx, req.StationID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station health not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationHealth(health))
+}
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/alert"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/token"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type StationHealthAlert struct {
+	ID             int64              `json:"id"`
+	StationID      int64              `json:"station_id"`
+	HealthID       *int64             `json:"health_id,omitempty"`
+	Rule           string             `json:"rule"`
+	Message        string             `json:"message"`
+	Value          *float32           `json:"value,omitempty"`
+	Threshold      *float32           `json:"threshold,omitempty"`
+	Status         string             `json:"status"`
+	OpenedAt       pgtype.Timestamptz `json:"opened_at"`
+	AcknowledgedBy string             `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt pgtype.Timestamptz `json:"acknowledged_at"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+} //@name StationHealthAlert
+
+func newStationHealthAlert(a db.ObservationsStationhealthAlert) StationHealthAlert {
+	res := StationHealthAlert{
+		ID:             a.ID,
+		StationID:      a.StationID,
+		Rule:           a.Rule,
+		Message:        a.Message,
+		Status:         a.Status,
+		OpenedAt:       a.OpenedAt,
+		AcknowledgedAt: a.AcknowledgedAt,
+		ResolvedAt:     a.ResolvedAt,
+	}
+	if a.HealthID.Valid {
+		res.HealthID = &a.HealthID.Int64
+	}
+	if a.Value.Valid {
+		res.Value = &a.Value.Float32
+	}
+	if a.Threshold.Valid {
+		res.Threshold = &a.Threshold.Float32
+	}
+	if a.AcknowledgedBy.Valid {
+		res.AcknowledgedBy = a.AcknowledgedBy.String
+	}
+	return res
+}
+
+// applyHealthAlerts updates the alert events of the station from a new health record.
+// Errors are only logged so that they never fail the ingestion of the record.
+func (h *DefaultHandler) applyHealthAlerts(ctx *gin.Context, health db.ObservationsStationhealth) {
+	alerts, err := h.alertEngine.Apply(ctx, h.store, health)
+	if err != nil {
+		h.logger.Error().Err(err).Int64("station_id", health.StationID).Msg("[Alert] Cannot apply health alert rules")
+		return
+	}
+	for _, a := range alerts {
+		h.logger.Info().
+			Int64("station_id", a.StationID).
+			Str("rule", a.Rule).
+			Str("status", a.Status).
+			Msg("[Alert] " + a.Message)
+		if a.Status == alert.StatusOpen {
+			h.notifyStationAlert(a)
+		}
+	}
+}
+
+type listStationHealthAlertsReq struct {
+	Page    int32  `form:"page,default=1" binding:"omitempty,min=1"`
+	PerPage int32  `form:"per_page,default=5" binding:"omitempty,min=1,max=30"`
+	Status  string `form:"status" binding:"omitempty,oneof=OPEN ACKNOWLEDGED RESOLVED"`
+} //@name ListStationHealthAlertsParams
+
+type paginatedStationHealthAlerts = util.PaginatedList[StationHealthAlert] //@name PaginatedStationHealthAlerts
+
+// ListStationHealthAlerts
+//
+//	@Summary	List station health alerts
+//	@Tags		health
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int							true	"Station ID"
+//	@Param		req			query		listStationHealthAlertsReq	false	"List station health alerts parameters"
+//	@Success	200			{object}	paginatedStationHealthAlerts
+//	@Router		/stations/{station_id}/health/alerts [get]
+func (h *DefaultHandler) ListStationHealthAlerts(ctx *gin.Context) {
+	var uri listStationHealthUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req listStationHealthAlertsReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	offset := (req.Page - 1) * req.PerPage
+	arg := db.ListStationHealthAlertsParams{
+		StationID: uri.StationID,
+		IsStatus:  len(req.Status) > 0,
+		Status:    req.Status,
+		Limit: pgtype.Int4{
+			Int32: req.PerPage,
+			Valid: true,
+		},
+		Offset: offset,
+	}
+
+	alerts, err := h.store.ListStationHealthAlerts(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	items := make([]StationHealthAlert, len(alerts))
+	for i := range alerts {
+		items[i] = newStationHealthAlert(alerts[i])
+	}
+
+	count, err := h.store.CountStationHealthAlerts(ctx, db.CountStationHealthAlertsParams{
+		StationID: arg.StationID,
+		IsStatus:  arg.IsStatus,
+		Status:    arg.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := util.NewPaginatedList(req.Page, req.PerPage, int32(count), items)
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type stationHealthAlertUri struct {
+	StationID int64 `uri:"station_id" binding:"required,min=1"`
+	ID        int64 `uri:"id" binding:"required,min=1"`
+}
+
+// AcknowledgeStationHealthAlert
+//
+//	@Summary	Acknowledge an open station health alert
+//	@Tags		health
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Param		id			path		int	true	"Alert ID"
+//	@Success	200			{object}	StationHealthAlert
+//	@Security	BearerAuth
+//	@Router		/stations/{station_id}/health/alerts/{id}/acknowledge [put]
+func (h *DefaultHandler) AcknowledgeStationHealthAlert(ctx *gin.Context) {
+	var uri stationHealthAlertUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payload, exists := ctx.Get(models.AuthPayloadKey)
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("authorization payload is not provided")))
+		return
+	}
+	authPayload := payload.(*token.Payload)
+
+	a, err := h.store.AcknowledgeStationHealthAlert(ctx, db.AcknowledgeStationHealthAlertParams{
+		StationID: uri.StationID,
+		ID:        uri.ID,
+		AcknowledgedBy: pgtype.Text{
+			String: authPayload.User.Username,
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("open alert not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationHealthAlert(a))
+}
+
+// ResolveStationHealthAlert
+//
+//	@Summary	Resolve an active station health alert
+//	@Tags		health
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path		int	true	"Station ID"
+//	@Param		id			path		int	true	"Alert ID"
+//	@Success	200			{object}	StationHealthAlert
+//	@Security	BearerAuth
+//	@Router		/stations/{station_id}/health/alerts/{id}/resolve [put]
+func (h *DefaultHandler) ResolveStationHealthAlert(ctx *gin.Context) {
+	var uri stationHealthAlertUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	a, err := h.store.ResolveStationHealthAlert(ctx, db.ResolveStationHealthAlertParams{
+		StationID:  uri.StationID,
+		ID:         uri.ID,
+		ResolvedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("active alert not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationHealthAlert(a))
+}
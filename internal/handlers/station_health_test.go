@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/emiliogozo/panahon-api-go/internal/alert"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/token"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStationHealthsAPI(t *testing.T) {
+	n := 5
+	stationID := int64(gofakeit.Number(1, 100))
+	healths := make([]db.ObservationsStationhealth, n)
+	for i := range healths {
+		healths[i] = randomStationHealth(stationID)
+	}
+
+	testCases := []struct {
+		name          string
+		stationID     int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:      "OK",
+			stationID: stationID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationHealthsByDate(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(args db.ListStationHealthsByDateParams) bool {
+						return args.StationID == stationID && args.Offset == 0 && args.Limit.Int32 == 5
+					})).
+					Return(healths, nil)
+				store.EXPECT().CountStationHealths(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(n), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got paginatedStationHealths
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, n)
+				require.Equal(t, healths[0].Vb1.Float32, got.Items[0].Vb1)
+			},
+		},
+		{
+			name:      "InternalError",
+			stationID: stationID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationHealthsByDate(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidStationID",
+			stationID:  0,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationHealthsByDate", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/health", handler.ListStationHealths)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/health", tc.stationID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestGetLatestStationHealthAPI(t *testing.T) {
+	stationID := int64(gofakeit.Number(1, 100))
+	health := randomStationHealth(stationID)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestStationHealth(mock.AnythingOfType("*gin.Context"), stationID).
+					Return(health, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got StationHealth
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, health.ID, got.ID)
+				require.Equal(t, health.MinutesDifference.Int32, got.MinutesDifference)
+			},
+		},
+		{
+			name: "NotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestStationHealth(mock.AnythingOfType("*gin.Context"), stationID).
+					Return(db.ObservationsStationhealth{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/health/latest", handler.GetLatestStationHealth)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/health/latest", stationID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestListStationHealthAlertsAPI(t *testing.T) {
+	stationID := int64(gofakeit.Number(1, 100))
+	alerts := []db.ObservationsStationhealthAlert{
+		randomStationHealthAlert(stationID, alert.StatusOpen),
+		randomStationHealthAlert(stationID, alert.StatusOpen),
+	}
+
+	testCases := []struct {
+		name          string
+		status        string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:   "OK",
+			status: alert.StatusOpen,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListStationHealthAlerts(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(args db.ListStationHealthAlertsParams) bool {
+						return args.StationID == stationID && args.IsStatus && args.Status == alert.StatusOpen
+					})).
+					Return(alerts, nil)
+				store.EXPECT().CountStationHealthAlerts(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(int64(len(alerts)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got paginatedStationHealthAlerts
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, len(alerts))
+				require.Equal(t, alerts[0].Rule, got.Items[0].Rule)
+			},
+		},
+		{
+			name:       "InvalidStatus",
+			status:     "CLOSED",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "ListStationHealthAlerts", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/stations/:station_id/health/alerts", handler.ListStationHealthAlerts)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/health/alerts?status=%s", stationID, tc.status)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestAcknowledgeStationHealthAlertAPI(t *testing.T) {
+	stationID := int64(gofakeit.Number(1, 100))
+	username := gofakeit.Username()
+	a := randomStationHealthAlert(stationID, alert.StatusAcknowledged)
+	a.AcknowledgedBy = pgtype.Text{String: username, Valid: true}
+
+	testCases := []struct {
+		name          string
+		alertID       int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:    "OK",
+			alertID: a.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AcknowledgeStationHealthAlert(
+					mock.AnythingOfType("*gin.Context"),
+					db.AcknowledgeStationHealthAlertParams{
+						StationID:      stationID,
+						ID:             a.ID,
+						AcknowledgedBy: pgtype.Text{String: username, Valid: true},
+					}).
+					Return(a, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got StationHealthAlert
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, alert.StatusAcknowledged, got.Status)
+				require.Equal(t, username, got.AcknowledgedBy)
+			},
+		},
+		{
+			name:    "NotFound",
+			alertID: a.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AcknowledgeStationHealthAlert(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationhealthAlert{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidID",
+			alertID:    0,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "AcknowledgeStationHealthAlert", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/stations/:station_id/health/alerts/:id/acknowledge", func(ctx *gin.Context) {
+				ctx.Set(models.AuthPayloadKey, &token.Payload{User: token.User{Username: username}})
+			}, handler.AcknowledgeStationHealthAlert)
+
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/stations/%d/health/alerts/%d/acknowledge", stationID, tc.alertID)
+			request, err := http.NewRequest(http.MethodPut, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func randomStationHealth(stationID int64) db.ObservationsStationhealth {
+	return db.ObservationsStationhealth{
+		ID:                util.RandomInt[int64](1, 1000),
+		StationID:         stationID,
+		Vb1:               pgtype.Float4{Float32: util.RandomFloat[float32](10, 14), Valid: true},
+		Curr:              pgtype.Float4{Float32: util.RandomFloat[float32](0, 1), Valid: true},
+		Ss:                pgtype.Int4{Int32: int32(gofakeit.Number(0, 31)), Valid: true},
+		MinutesDifference: pgtype.Int4{Int32: int32(gofakeit.Number(-60, 60)), Valid: true},
+		Timestamp:         pgtype.Timestamptz{Time: gofakeit.PastDate().Truncate(time.Minute), Valid: true},
+	}
+}
+
+func randomStationHealthAlert(stationID int64, status string) db.ObservationsStationhealthAlert {
+	return db.ObservationsStationhealthAlert{
+		ID:        util.RandomInt[int64](1, 1000),
+		StationID: stationID,
+		Rule:      "vb1_low",
+		Message:   gofakeit.Sentence(5),
+		Value:     pgtype.Float4{Float32: util.RandomFloat[float32](10, 11.5), Valid: true},
+		Threshold: pgtype.Float4{Float32: 11.5, Valid: true},
+		Status:    status,
+		OpenedAt:  pgtype.Timestamptz{Time: gofakeit.PastDate(), Valid: true},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/export"
 	"github.com/emiliogozo/panahon-api-go/internal/models"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/gin-gonic/gin"
@@ -51,6 +52,7 @@ func (h *DefaultHandler) CreateStationObservation(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	obs = h.applyQc(ctx, obs)
 
 	res := models.NewStationObservation(obs)
 	ctx.JSON(http.StatusCreated, res)
@@ -77,6 +79,7 @@ type paginatedStationObservations = util.PaginatedList[models.StationObservation
 //	@Produce	json
 //	@Param		station_id	path		int					true	"Station ID"
 //	@Param		req			query		listStationObsReq	false	"List station observations parameters"
+//	@Param		format		query		string				false	"Export format, streams the full range when not json"	Enums(json, csv, geojson, cf)
 //	@Success	200			{object}	paginatedStationObservations
 //	@Router		/stations/{station_id}/observations [get]
 func (h *DefaultHandler) ListStationObservations(ctx *gin.Context) {
@@ -90,10 +93,32 @@ func (h *DefaultHandler) ListStationObservations(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	var expReq exportReq
+	if err := ctx.ShouldBindQuery(&expReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
 
 	startDate, isStartDate := util.ParseDateTime(req.StartDate)
 	endDate, isEndDate := util.ParseDateTime(req.EndDate)
 
+	if format := exportFormat(ctx, expReq.Format); format != export.FormatJSON {
+		h.exportObservations(ctx, format, db.StreamObservationsParams{
+			StationIds:  []int64{uri.StationID},
+			IsStartDate: isStartDate,
+			StartDate: pgtype.Timestamptz{
+				Time:  startDate,
+				Valid: !startDate.IsZero(),
+			},
+			IsEndDate: isEndDate,
+			EndDate: pgtype.Timestamptz{
+				Time:  endDate,
+				Valid: !endDate.IsZero(),
+			},
+		})
+		return
+	}
+
 	offset := (req.Page - 1) * req.PerPage
 	arg := db.ListStationObservationsParams{
 		StationID: uri.StationID,
@@ -281,8 +306,9 @@ type listObservationsReq struct {
 //	@Summary	list station observation
 //	@Tags		observations
 //	@Produce	json
-//	@Param		req	query		listObservationsReq	false	"List observations parameters"
-//	@Success	200	{object}	paginatedStationObservations
+//	@Param		req		query		listObservationsReq	false	"List observations parameters"
+//	@Param		format	query		string				false	"Export format, streams the full range when not json"	Enums(json, csv, geojson, cf)
+//	@Success	200		{object}	paginatedStationObservations
 //	@Router		/observations [get]
 func (h *DefaultHandler) ListObservations(ctx *gin.Context) {
 	var req listObservationsReq
@@ -290,6 +316,11 @@ func (h *DefaultHandler) ListObservations(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	var expReq exportReq
+	if err := ctx.ShouldBindQuery(&expReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
 
 	var stationIDs []int64
 	if len(req.StationIDs) == 0 {
@@ -319,6 +350,23 @@ func (h *DefaultHandler) ListObservations(ctx *gin.Context) {
 	startDate, isStartDate := util.ParseDateTime(req.StartDate)
 	endDate, isEndDate := util.ParseDateTime(req.EndDate)
 
+	if format := exportFormat(ctx, expReq.Format); format != export.FormatJSON {
+		h.exportObservations(ctx, format, db.StreamObservationsParams{
+			StationIds:  stationIDs,
+			IsStartDate: isStartDate,
+			StartDate: pgtype.Timestamptz{
+				Time:  startDate,
+				Valid: !startDate.IsZero(),
+			},
+			IsEndDate: isEndDate,
+			EndDate: pgtype.Timestamptz{
+				Time:  endDate,
+				Valid: !endDate.IsZero(),
+			},
+		})
+		return
+	}
+
 	offset := (req.Page - 1) * req.PerPage
 	arg := db.ListObservationsParams{
 		StationIds: stationIDs,
@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	ObsBatchStatusAccepted  = "ACCEPTED"
+	ObsBatchStatusDuplicate = "DUPLICATE"
+	ObsBatchStatusInvalid   = "INVALID"
+
+	// obsBatchChunkSize is the number of rows inserted per database batch.
+	obsBatchChunkSize = 1000
+	// obsBatchMaxClockSkew is how far in the future a row may be timestamped.
+	obsBatchMaxClockSkew = time.Hour
+)
+
+// stationObsBatchRow is a row of a batch of observations. In a multi-station
+// batch, its station is picked by StationID, or else by MobileNumber. Its
+// quality-control level is set by the checks run once it is stored.
+type stationObsBatchRow struct {
+	StationID    int64  `json:"station_id"`
+	MobileNumber string `json:"mobile_number"`
+	models.BaseStationObs
+} //@name StationObservationBatchRow
+
+type createStationObsBatchReq struct {
+	// Rows are decoded one by one, so that a malformed row is reported
+	// without rejecting the others.
+	Observations []json.RawMessage `json:"observations" binding:"required,min=1,max=10000" swaggertype:"array,object"`
+} //@name CreateStationObservationBatchParams
+
+type stationObsBatchResult struct {
+	// Index of the row in the request.
+	Index     int    `json:"index"`
+	StationID int64  `json:"station_id,omitempty"`
+	ID        int64  `json:"id,omitempty"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+} //@name StationObservationBatchResult
+
+type stationObsBatchRes struct {
+	Count          int                     `json:"count"`
+	CountAccepted  int                     `json:"count_accepted"`
+	CountDuplicate int                     `json:"count_duplicate"`
+	CountInvalid   int                     `json:"count_invalid"`
+	Results        []stationObsBatchResult `json:"results"`
+} //@name StationObservationBatchResponse
+
+// obsBatchStationResolver returns the station of a row, or the reason the row
+// has none. An error aborts the batch.
+type obsBatchStationResolver func(row stationObsBatchRow) (stationID int64, reason string, err error)
+
+// CreateStationObservationBatch
+//
+//	@Summary		Create a batch of station observations
+//	@Description	Stores up to 10000 observations, e.g. buffered by a gateway during an outage, and reports the outcome of each row. Rows already stored are reported as duplicates, so a failed batch can be sent again.
+//	@Tags			observations
+//	@Accept			json
+//	@Produce		json
+//	@Param			station_id	path	int							true	"Station ID"
+//	@Param			req			body	createStationObsBatchReq	true	"Create station observation batch parameters"
+//	@Security		BearerAuth
+//	@Success		200	{object}	stationObsBatchRes
+//	@Router			/stations/{station_id}/observations/batch [post]
+func (h *DefaultHandler) CreateStationObservationBatch(ctx *gin.Context) {
+	var uri createStationObsUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createStationObsBatchReq
+	bindErr := ctx.ShouldBindBodyWith(&req, binding.JSON)
+	done := h.archiveObservationBatch(ctx)
+	if bindErr != nil {
+		done(service.RawMessageStatusRejected, 0, bindErr)
+		ctx.JSON(http.StatusBadRequest, errorResponse(bindErr))
+		return
+	}
+
+	if _, err := h.store.GetStation(ctx, uri.StationID); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			err = errors.New("station not found")
+			done(service.RawMessageStatusRejected, 0, err)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		done(service.RawMessageStatusFailed, 0, err)
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	h.storeObservationBatch(ctx, req.Observations, done, func(stationObsBatchRow) (int64, string, error) {
+		return uri.StationID, "", nil
+	})
+}
+
+// CreateObservationBatch
+//
+//	@Summary		Create a batch of observations of several stations
+//	@Description	Stores up to 10000 observations, each of the station given by its station_id or mobile_number, and reports the outcome of each row. Rows already stored are reported as duplicates, so a failed batch can be sent again.
+//	@Tags			observations
+//	@Accept			json
+//	@Produce		json
+//	@Param			req	body	createStationObsBatchReq	true	"Create observation batch parameters"
+//	@Security		BearerAuth
+//	@Success		200	{object}	stationObsBatchRes
+//	@Router			/observations/batch [post]
+func (h *DefaultHandler) CreateObservationBatch(ctx *gin.Context) {
+	var req createStationObsBatchReq
+	bindErr := ctx.ShouldBindBodyWith(&req, binding.JSON)
+	done := h.archiveObservationBatch(ctx)
+	if bindErr != nil {
+		done(service.RawMessageStatusRejected, 0, bindErr)
+		ctx.JSON(http.StatusBadRequest, errorResponse(bindErr))
+		return
+	}
+
+	// Stations are looked up once per request; 0 marks an unknown one.
+	byID := make(map[int64]int64)
+	byNumber := make(map[string]int64)
+	h.storeObservationBatch(ctx, req.Observations, done, func(row stationObsBatchRow) (int64, string, error) {
+		switch {
+		case row.StationID > 0:
+			id, ok := byID[row.StationID]
+			if !ok {
+				station, err := h.store.GetStation(ctx, row.StationID)
+				if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+					return 0, "", err
+				}
+				id = station.ID
+				byID[row.StationID] = id
+			}
+			if id == 0 {
+				return 0, "station not found", nil
+			}
+			return id, "", nil
+		case len(row.MobileNumber) > 0:
+			mobileNumber, ok := util.ParseMobileNumber(row.MobileNumber)
+			if !ok {
+				return 0, "invalid mobile number", nil
+			}
+			id, ok := byNumber[mobileNumber]
+			if !ok {
+				station, err := h.store.GetStationByMobileNumber(ctx, pgtype.Text{
+					String: mobileNumber,
+					Valid:  true,
+				})
+				if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+					return 0, "", err
+				}
+				id = station.ID
+				byNumber[mobileNumber] = id
+			}
+			if id == 0 {
+				return 0, "station not found", nil
+			}
+			return id, "", nil
+		default:
+			return 0, "missing station_id or mobile_number", nil
+		}
+	})
+}
+
+// obsBatchOutcome records the outcome of an archived batch request. stationID
+// is zero if the batch is not of a single known station.
+type obsBatchOutcome func(status string, stationID int64, err error)
+
+// archiveObservationBatch archives the body of a batch request, once bound,
+// and returns the function recording its outcome.
+func (h *DefaultHandler) archiveObservationBatch(ctx *gin.Context) obsBatchOutcome {
+	var body []byte
+	if b, ok := ctx.Get(gin.BodyBytesKey); ok {
+		body, _ = b.([]byte)
+	}
+	raw, archived := h.archiveMessage(ctx, db.CreateRawMessageParams{
+		Provider: RawMessageProviderBatch,
+		Body:     string(body),
+		Status:   service.RawMessageStatusReceived,
+	})
+	return func(status string, stationID int64, err error) {
+		if archived {
+			h.recordOutcome(ctx, raw, status, stationID, err, false)
+		}
+	}
+}
+
+// storeObservationBatch validates the rows, inserts the valid ones in chunks,
+// runs the quality-control checks on the inserted ones in a single pass and
+// writes the result of each row. Chunks inserted before a database error stay
+// stored; their quality control is left to the recheck job.
+func (h *DefaultHandler) storeObservationBatch(ctx *gin.Context, rows []json.RawMessage, done obsBatchOutcome, resolve obsBatchStationResolver) {
+	type obsKey struct {
+		stationID int64
+		timestamp int64
+	}
+
+	res := stationObsBatchRes{
+		Count:   len(rows),
+		Results: make([]stationObsBatchResult, len(rows)),
+	}
+	var (
+		args     []db.BatchCreateStationObservationsParams
+		argRows  []int
+		accepted []db.ObservationsObservation
+	)
+	seen := make(map[obsKey]bool, len(rows))
+	maxTimestamp := time.Now().Add(obsBatchMaxClockSkew)
+	for i, raw := range rows {
+		result := &res.Results[i]
+		result.Index = i
+		result.Status = ObsBatchStatusInvalid
+
+		var row stationObsBatchRow
+		if err := json.Unmarshal(raw, &row); err != nil {
+			result.Reason = err.Error()
+			continue
+		}
+		if err := binding.Validator.ValidateStruct(&row); err != nil {
+			result.Reason = err.Error()
+			continue
+		}
+
+		stationID, reason, err := resolve(row)
+		if err != nil {
+			done(service.RawMessageStatusFailed, 0, err)
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if len(reason) > 0 {
+			result.Reason = reason
+			continue
+		}
+		result.StationID = stationID
+
+		switch {
+		case row.Timestamp.IsZero():
+			result.Reason = "missing timestamp"
+			continue
+		case row.Timestamp.After(maxTimestamp):
+			result.Reason = "timestamp in the future"
+			continue
+		}
+
+		key := obsKey{stationID: stationID, timestamp: row.Timestamp.UnixMicro()}
+		if seen[key] {
+			result.Status = ObsBatchStatusDuplicate
+			result.Reason = "repeated in batch"
+			continue
+		}
+		seen[key] = true
+
+		req := models.CreateStationObsReq{StationID: stationID, BaseStationObs: row.BaseStationObs}
+		args = append(args, db.BatchCreateStationObservationsParams(req.Transform()))
+		argRows = append(argRows, i)
+	}
+
+	for start := 0; start < len(args); start += obsBatchChunkSize {
+		end := min(start+obsBatchChunkSize, len(args))
+		ids, err := h.store.BulkCreateStationObservations(ctx, args[start:end])
+		if err != nil {
+			h.logger.Error().Err(err).
+				Int("stored", start).
+				Int("count", len(args)).
+				Msg("[ObsBatch] Cannot store observations")
+			done(service.RawMessageStatusFailed, 0, err)
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		for j, id := range ids {
+			result := &res.Results[argRows[start+j]]
+			if id == 0 {
+				result.Status = ObsBatchStatusDuplicate
+				result.Reason = "already stored"
+				continue
+			}
+			result.ID = id
+			result.Status = ObsBatchStatusAccepted
+			accepted = append(accepted, batchObservation(id, args[start+j]))
+		}
+	}
+	h.applyQcBatch(ctx, accepted)
+
+	var stationID int64
+	for _, result := range res.Results {
+		switch result.Status {
+		case ObsBatchStatusAccepted:
+			res.CountAccepted++
+		case ObsBatchStatusDuplicate:
+			res.CountDuplicate++
+		default:
+			res.CountInvalid++
+		}
+		if result.StationID > 0 && stationID >= 0 {
+			if stationID == 0 {
+				stationID = result.StationID
+			} else if stationID != result.StationID {
+				// several stations
+				stationID = -1
+			}
+		}
+	}
+
+	var outcomeErr error
+	if res.CountInvalid > 0 {
+		outcomeErr = fmt.Errorf("%d of %d rows invalid", res.CountInvalid, res.Count)
+	}
+	switch {
+	case res.CountAccepted > 0:
+		done(service.RawMessageStatusStored, max(stationID, 0), outcomeErr)
+	case res.CountDuplicate > 0 && res.CountInvalid == 0:
+		done(service.RawMessageStatusDuplicate, max(stationID, 0), outcomeErr)
+	default:
+		done(service.RawMessageStatusRejected, max(stationID, 0), outcomeErr)
+	}
+
+	h.logger.Info().
+		Int("count", res.Count).
+		Int("accepted", res.CountAccepted).
+		Int("duplicate", res.CountDuplicate).
+		Int("invalid", res.CountInvalid).
+		Msg("[ObsBatch] Stored observations")
+	ctx.JSON(http.StatusOK, res)
+}
+
+// batchObservation returns the observation stored by arg as id.
+func batchObservation(id int64, arg db.BatchCreateStationObservationsParams) db.ObservationsObservation {
+	return db.ObservationsObservation{
+		ID:        id,
+		StationID: arg.StationID,
+		Pres:      arg.Pres,
+		Rr:        arg.Rr,
+		Rh:        arg.Rh,
+		Temp:      arg.Temp,
+		Td:        arg.Td,
+		Wdir:      arg.Wdir,
+		Wspd:      arg.Wspd,
+		Wspdx:     arg.Wspdx,
+		Srad:      arg.Srad,
+		Mslp:      arg.Mslp,
+		Hi:        arg.Hi,
+		Wchill:    arg.Wchill,
+		Timestamp: arg.Timestamp,
+		QcLevel:   arg.QcLevel,
+	}
+}
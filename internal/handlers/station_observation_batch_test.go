@@ -0,0 +1,393 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStationObservationBatchAPI(t *testing.T) {
+	station := randomStation(t)
+	timeNow := time.Now().Truncate(time.Minute)
+	ts := func(minutes int) string {
+		return timeNow.Add(-time.Duration(minutes) * time.Minute).Format(time.RFC3339)
+	}
+
+	testCases := []struct {
+		name          string
+		body          any
+		outcome       string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"observations": []any{
+				gin.H{"temp": 27.5, "timestamp": ts(10), "qc_level": 3},
+				gin.H{"temp": 27.1, "timestamp": ts(20)},
+				gin.H{"temp": 27.5, "timestamp": ts(10)},
+				gin.H{"temp": 26.8},
+				gin.H{"temp": "hot", "timestamp": ts(30)},
+				gin.H{"temp": 26.8, "timestamp": timeNow.Add(2 * time.Hour).Format(time.RFC3339)},
+				gin.H{"station_id": station.ID + 1, "temp": 26.5, "timestamp": ts(40)},
+			}},
+			outcome: service.RawMessageStatusStored,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().BulkCreateStationObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(arg []db.BatchCreateStationObservationsParams) bool {
+						if len(arg) != 3 {
+							return false
+						}
+						for _, a := range arg {
+							if a.StationID != station.ID || !a.Timestamp.Valid || !a.Temp.Valid || a.QcLevel != 0 {
+								return false
+							}
+						}
+						return true
+					})).
+					Return([]int64{10, 0, 11}, nil)
+				stubObsBatchQc(store, 1, 10, 11)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := requireBodyMatchStationObsBatch(t, recorder.Body, 7, 2, 2, 3)
+				require.Equal(t, stationObsBatchResult{Index: 0, StationID: station.ID, ID: 10, Status: ObsBatchStatusAccepted}, res.Results[0])
+				require.Equal(t, ObsBatchStatusDuplicate, res.Results[1].Status)
+				require.Equal(t, "already stored", res.Results[1].Reason)
+				require.Equal(t, ObsBatchStatusDuplicate, res.Results[2].Status)
+				require.Equal(t, "repeated in batch", res.Results[2].Reason)
+				require.Equal(t, "missing timestamp", res.Results[3].Reason)
+				require.Equal(t, ObsBatchStatusInvalid, res.Results[4].Status)
+				require.NotEmpty(t, res.Results[4].Reason)
+				require.Equal(t, "timestamp in the future", res.Results[5].Reason)
+				require.Equal(t, ObsBatchStatusAccepted, res.Results[6].Status)
+				require.Equal(t, station.ID, res.Results[6].StationID)
+			},
+		},
+		{
+			name: "Chunks",
+			body: func() gin.H {
+				rows := make([]any, obsBatchChunkSize+500)
+				for i := range rows {
+					rows[i] = gin.H{"temp": 27.5, "timestamp": ts(i + 1)}
+				}
+				return gin.H{"observations": rows}
+			}(),
+			outcome: service.RawMessageStatusStored,
+			buildStubs: func(store *mockdb.MockStore) {
+				var nextID int64
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().BulkCreateStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					RunAndReturn(func(_ context.Context, arg []db.BatchCreateStationObservationsParams) ([]int64, error) {
+						ids := make([]int64, len(arg))
+						for i := range ids {
+							nextID++
+							ids[i] = nextID
+						}
+						return ids, nil
+					}).
+					Times(2)
+				ids := make([]int64, obsBatchChunkSize+500)
+				for i := range ids {
+					ids[i] = int64(i + 1)
+				}
+				stubObsBatchQc(store, 1, ids...)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchStationObsBatch(t, recorder.Body, obsBatchChunkSize+500, obsBatchChunkSize+500, 0, 0)
+			},
+		},
+		{
+			name:    "AllInvalid",
+			body:    gin.H{"observations": []any{gin.H{"temp": 27.5}, "row"}},
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchStationObsBatch(t, recorder.Body, 2, 0, 0, 2)
+			},
+		},
+		{
+			name:       "EmptyBatch",
+			body:       gin.H{"observations": []any{}},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "NotAnArray",
+			body:       gin.H{"observations": gin.H{"temp": 27.5}},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "StationNotFound",
+			body:    gin.H{"observations": []any{gin.H{"temp": 27.5, "timestamp": ts(10)}}},
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:    "StoreError",
+			body:    gin.H{"observations": []any{gin.H{"temp": 27.5, "timestamp": ts(10)}}},
+			outcome: service.RawMessageStatusFailed,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil)
+				store.EXPECT().BulkCreateStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			stubObsBatchArchive(store, tc.outcome)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST(":station_id/observations/batch", handler.CreateStationObservationBatch)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/%d/observations/batch", station.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestCreateObservationBatchAPI(t *testing.T) {
+	station := randomStation(t)
+	station2 := randomStation(t)
+	station2.ID = station.ID + 1
+	mobileNumber := util.RandomMobileNumber()
+	timestamp := time.Now().Add(-time.Hour).Truncate(time.Minute).Format(time.RFC3339)
+
+	testCases := []struct {
+		name          string
+		body          any
+		outcome       string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"observations": []any{
+				gin.H{"station_id": station.ID, "temp": 27.5, "timestamp": timestamp},
+				gin.H{"mobile_number": mobileNumber, "temp": 27.1, "timestamp": timestamp},
+				gin.H{"station_id": station.ID, "temp": 27.5, "timestamp": timestamp},
+				gin.H{"station_id": station.ID + 100, "temp": 27.5, "timestamp": timestamp},
+				gin.H{"station_id": station.ID + 100, "temp": 27.5, "timestamp": timestamp},
+				gin.H{"mobile_number": "12345", "temp": 27.5, "timestamp": timestamp},
+				gin.H{"temp": 27.5, "timestamp": timestamp},
+			}},
+			outcome: service.RawMessageStatusStored,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(station, nil).
+					Once()
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID+100).
+					Return(db.ObservationsStation{}, db.ErrRecordNotFound).
+					Once()
+				store.EXPECT().GetStationByMobileNumber(mock.AnythingOfType("*gin.Context"), pgtype.Text{String: mobileNumber, Valid: true}).
+					Return(station2, nil).
+					Once()
+				store.EXPECT().BulkCreateStationObservations(
+					mock.AnythingOfType("*gin.Context"),
+					mock.MatchedBy(func(arg []db.BatchCreateStationObservationsParams) bool {
+						return len(arg) == 2 && arg[0].StationID == station.ID && arg[1].StationID == station2.ID
+					})).
+					Return([]int64{10, 11}, nil)
+				stubObsBatchQc(store, 2, 10, 11)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				res := requireBodyMatchStationObsBatch(t, recorder.Body, 7, 2, 1, 4)
+				require.Equal(t, station2.ID, res.Results[1].StationID)
+				require.Equal(t, "repeated in batch", res.Results[2].Reason)
+				require.Equal(t, "station not found", res.Results[3].Reason)
+				require.Equal(t, "station not found", res.Results[4].Reason)
+				require.Equal(t, "invalid mobile number", res.Results[5].Reason)
+				require.Equal(t, "missing station_id or mobile_number", res.Results[6].Reason)
+			},
+		},
+		{
+			name: "StationError",
+			body: gin.H{"observations": []any{
+				gin.H{"station_id": station.ID, "temp": 27.5, "timestamp": timestamp},
+			}},
+			outcome: service.RawMessageStatusFailed,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStation(mock.AnythingOfType("*gin.Context"), station.ID).
+					Return(db.ObservationsStation{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:       "MissingObservations",
+			body:       gin.H{},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			stubObsBatchArchive(store, tc.outcome)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.POST("/observations/batch", handler.CreateObservationBatch)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/observations/batch", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func requireBodyMatchStationObsBatch(t *testing.T, body *bytes.Buffer, count, accepted, duplicate, invalid int) stationObsBatchRes {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var res stationObsBatchRes
+	err = json.Unmarshal(data, &res)
+	require.NoError(t, err)
+
+	require.Equal(t, count, res.Count)
+	require.Equal(t, accepted, res.CountAccepted)
+	require.Equal(t, duplicate, res.CountDuplicate)
+	require.Equal(t, invalid, res.CountInvalid)
+	require.Len(t, res.Results, count)
+	for i, r := range res.Results {
+		require.Equal(t, i, r.Index)
+	}
+	return res
+}
+
+// stubObsBatchArchive expects the batch request to be archived with the
+// outcome status.
+func stubObsBatchArchive(store *mockdb.MockStore, status string) {
+	raw := db.RawMessage{ID: 1, Provider: RawMessageProviderBatch}
+	store.EXPECT().CreateRawMessage(
+		mock.AnythingOfType("*gin.Context"),
+		mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+			return arg.Provider == RawMessageProviderBatch && len(arg.Body) > 0
+		})).
+		Return(raw, nil).
+		Once()
+	store.EXPECT().UpdateRawMessageOutcome(
+		mock.AnythingOfType("*gin.Context"),
+		mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+			return arg.ID == raw.ID && arg.Status == status
+		})).
+		Return(raw, nil).
+		Once()
+}
+
+// stubObsBatchQc expects a single quality-control pass over the observations
+// ids, reading the history of each of the stations once.
+func stubObsBatchQc(store *mockdb.MockStore, stations int, ids ...int64) {
+	store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.Anything).
+		Return([]db.ObservationsObservation{}, nil).
+		Times(stations)
+	store.EXPECT().ListStationObservationsForQc(mock.AnythingOfType("*gin.Context"), mock.Anything).
+		Return([]db.ObservationsObservation{}, nil).
+		Times(stations)
+	store.EXPECT().BulkUpdateObservationQc(
+		mock.AnythingOfType("*gin.Context"),
+		mock.MatchedBy(func(arg []db.UpdateObservationQcTxParams) bool {
+			if len(arg) != len(ids) {
+				return false
+			}
+			got := make(map[int64]bool, len(arg))
+			for _, a := range arg {
+				got[a.ObservationID] = true
+			}
+			for _, id := range ids {
+				if !got[id] {
+					return false
+				}
+			}
+			return true
+		})).
+		Return(nil).
+		Once()
+}
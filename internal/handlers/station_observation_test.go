@@ -41,6 +41,29 @@ func TestCreateStationObservationAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.CreateStationObservationParams")).
 					Return(stnObs, nil)
+				store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.ListPreviousStationObservationsParams")).
+					Return([]db.ObservationsObservation{}, nil)
+				store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.UpdateObservationQcTxParams")).
+					Return(db.UpdateObservationQcTxResult{Observation: stnObs}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchStationObservation(t, recorder.Body, stnObs)
+			},
+		},
+		{
+			name: "QcError",
+			body: gin.H{
+				"station_id": stnObs.StationID,
+				"pres":       stnObs.Pres,
+				"temp":       stnObs.Temp,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationObservation(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.CreateStationObservationParams")).
+					Return(stnObs, nil)
+				store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.ListPreviousStationObservationsParams")).
+					Return(nil, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
 				store.AssertExpectations(t)
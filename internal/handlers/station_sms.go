@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/models"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var errObservationExists = errors.New("observation already stored")
+
+// storeStationSms parses an SMS sent by a station and stores the resulting
+// observation and health in one transaction. It returns the HTTP status that
+// best describes the outcome; tag is the log prefix of the calling SMS
+// gateway. The times of the message are checked against receivedAt, or now
+// when zero. A re-delivered message, whose observation is already stored,
+// gives http.StatusConflict and stores nothing.
+func (h *DefaultHandler) storeStationSms(ctx *gin.Context, tag, number, msg string, receivedAt time.Time) (lufftRes, int, error) {
+	mobileNumber, ok := util.ParseMobileNumber(number)
+	if !ok {
+		err := fmt.Errorf("invalid mobile number: %s", number)
+		h.logger.Error().Err(err).
+			Str("sender", number).
+			Str("msg", msg).
+			Msgf("[%s] Invalid mobile number", tag)
+		return lufftRes{}, http.StatusBadRequest, err
+	}
+
+	station, err := h.store.GetStationByMobileNumber(ctx, pgtype.Text{
+		String: mobileNumber,
+		Valid:  true,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			h.logger.Error().Err(err).
+				Str("sender", number).
+				Str("msg", msg).
+				Msgf("[%s] No station found", tag)
+			return lufftRes{}, http.StatusNotFound, errors.New("station not found")
+		}
+		h.logger.Error().Err(err).
+			Str("sender", number).
+			Str("msg", msg).
+			Msgf("[%s] AN error occured", tag)
+		return lufftRes{}, http.StatusInternalServerError, err
+	}
+
+	driverKey := station.SmsSystemType.String
+	if len(driverKey) == 0 {
+		driverKey = sensor.LufftKey
+	}
+	driver, ok := sensor.Lookup(driverKey)
+	if !ok {
+		err := fmt.Errorf("no sensor driver for sms system type: %s", driverKey)
+		h.logger.Error().Err(err).
+			Str("sender", number).
+			Str("msg", msg).
+			Msgf("[%s] Unknown sms system type", tag)
+		return lufftRes{}, http.StatusBadRequest, err
+	}
+
+	var reading *sensor.Reading
+	loc := sensor.Location(station.Timezone)
+	if p, ok := driver.(sensor.LayoutParser); ok && len(station.LoggerVersion.String) > 0 {
+		reading, err = p.ParseLayout(msg, station.LoggerVersion.String, loc, receivedAt)
+	} else {
+		reading, err = driver.Parse(msg, loc, receivedAt)
+	}
+	if err != nil {
+		logEvent := h.logger.Error().Err(err).
+			Str("sender", number).
+			Str("msg", msg)
+		var parseErr *sensor.LufftParseError
+		if errors.As(err, &parseErr) && len(parseErr.Fields) > 0 {
+			logEvent = logEvent.Interface("fields", parseErr.Fields)
+		}
+		logEvent.Msgf("[%s] Invalid string", tag)
+		return lufftRes{}, http.StatusBadRequest, err
+	}
+
+	arg := db.CreateStationReadingTxParams{
+		Observation: db.CreateStationObservationIfNotExistsParams{
+			StationID: station.ID,
+			Pres:      util.ToFloat4(reading.Obs.Pres),
+			Rr:        util.ToFloat4(reading.Obs.Rr),
+			Rh:        util.ToFloat4(reading.Obs.Rh),
+			Temp:      util.ToFloat4(reading.Obs.Temp),
+			Td:        util.ToFloat4(reading.Obs.Td),
+			Wdir:      util.ToFloat4(reading.Obs.Wdir),
+			Wspd:      util.ToFloat4(reading.Obs.Wspd),
+			Wspdx:     util.ToFloat4(reading.Obs.Wspdx),
+			Srad:      util.ToFloat4(reading.Obs.Srad),
+			Mslp:      util.ToFloat4(reading.Obs.Mslp),
+			Hi:        util.ToFloat4(reading.Obs.Hi),
+			Wchill:    util.ToFloat4(reading.Obs.Wchill),
+			Timestamp: pgtype.Timestamptz{
+				Time:  reading.Obs.Timestamp,
+				Valid: true,
+			},
+		},
+	}
+	if reading.Health != nil {
+		arg.Health = &db.CreateStationHealthIfNotExistsParams{
+			StationID:         station.ID,
+			Vb1:               util.ToFloat4(reading.Health.Vb1),
+			Vb2:               util.ToFloat4(reading.Health.Vb2),
+			Curr:              util.ToFloat4(reading.Health.Curr),
+			Bp1:               util.ToFloat4(reading.Health.Bp1),
+			Bp2:               util.ToFloat4(reading.Health.Bp2),
+			Cm:                util.ToPgText(reading.Health.Cm),
+			Ss:                util.ToInt4(reading.Health.Ss),
+			TempArq:           util.ToFloat4(reading.Health.TempArq),
+			RhArq:             util.ToFloat4(reading.Health.RhArq),
+			Fpm:               util.ToPgText(reading.Health.Fpm),
+			MinutesDifference: util.ToInt4(&reading.Health.MinutesDifference),
+			DataCount:         util.ToInt4(&reading.Health.DataCount),
+			DataStatus:        util.ToPgText(reading.Health.DataStatus),
+			Timestamp: pgtype.Timestamptz{
+				Time:  reading.Health.Timestamp,
+				Valid: true,
+			},
+			Message:  util.ToPgText(reading.Health.Message),
+			ErrorMsg: util.ToPgText(reading.Health.ErrorMsg),
+		}
+	}
+
+	result, err := h.store.CreateStationReadingTx(ctx, arg)
+	if err != nil {
+		h.logger.Error().Err(err).
+			Str("sender", number).
+			Str("msg", msg).
+			Msgf("[%s] Cannot store station reading", tag)
+		return lufftRes{}, http.StatusInternalServerError, err
+	}
+	if !result.IsCreated {
+		h.logger.Warn().
+			Str("sender", number).
+			Str("msg", msg).
+			Msgf("[%s] Observation already stored", tag)
+		return lufftRes{Station: models.NewStation(station, false)}, http.StatusConflict, errObservationExists
+	}
+
+	obs := h.applyQc(ctx, result.Observation)
+	health := result.Health
+	if health.ID != 0 {
+		h.applyHealthAlerts(ctx, health)
+	}
+
+	h.logger.Debug().
+		Str("sender", number).
+		Str("msg", msg).
+		Msgf("[%s] Data saved successfully", tag)
+
+	return newLufftResponse(station, obs, health), http.StatusCreated, nil
+}
@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Responses of the uploads, as read by Wunderground clients.
+const (
+	uploadSuccess     = "success"
+	uploadInvalidKey  = "INVALIDPASSWORDID|Password or key and/or id are incorrect"
+	uploadServerError = "ERROR|Server error"
+)
+
+// StationUploadKey identifies the uploads of a station. The upload key is
+// never returned.
+type StationUploadKey struct {
+	StationID int64              `json:"station_id"`
+	UploadID  string             `json:"upload_id"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+} //@name StationUploadKey
+
+func newStationUploadKey(k db.ObservationsStationuploadkey) StationUploadKey {
+	return StationUploadKey{
+		StationID: k.StationID,
+		UploadID:  k.UploadID,
+		UpdatedAt: k.UpdatedAt,
+	}
+}
+
+// hashUploadKey hashes an upload key for storage. Upload keys are long random
+// strings checked on every upload, so a fast hash is enough.
+func hashUploadKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+type updateStationUploadKeyReq struct {
+	UploadID  string `json:"upload_id" binding:"required,alphanum,max=64"`
+	UploadKey string `json:"upload_key" binding:"required,min=16,max=64"`
+} //@name UpdateStationUploadKeyParams
+
+// UpdateStationUploadKey
+//
+//	@Summary	Set the upload ID and key of a station
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int							true	"Station ID"
+//	@Param		req			body	updateStationUploadKeyReq	true	"Station upload key parameters"
+//	@Security	BearerAuth
+//	@Success	200	{object}	StationUploadKey
+//	@Router		/stations/{station_id}/upload-key [put]
+func (h *DefaultHandler) UpdateStationUploadKey(ctx *gin.Context) {
+	var uri stationCredentialUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateStationUploadKeyReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	key, err := h.store.UpsertStationUploadKey(ctx, db.UpsertStationUploadKeyParams{
+		StationID: uri.StationID,
+		UploadID:  req.UploadID,
+		KeyHash:   hashUploadKey(req.UploadKey),
+	})
+	if err != nil {
+		switch db.ErrorCode(err) {
+		case db.UniqueViolation:
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("upload id already in use")))
+		case db.ForeignKeyViolation:
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("station not found")))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newStationUploadKey(key))
+}
+
+// DeleteStationUploadKey
+//
+//	@Summary	Delete the upload key of a station
+//	@Tags		stations
+//	@Accept		json
+//	@Produce	json
+//	@Param		station_id	path	int	true	"Station ID"
+//	@Security	BearerAuth
+//	@Success	204
+//	@Router		/stations/{station_id}/upload-key [delete]
+func (h *DefaultHandler) DeleteStationUploadKey(ctx *gin.Context) {
+	var uri stationCredentialUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := h.store.DeleteStationUploadKey(ctx, uri.StationID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// UploadWunderground
+//
+//	@Summary		Upload an observation with the Wunderground protocol
+//	@Description	Compatible with the updateweatherstation.php requests of consumer stations. ID and PASSWORD are the upload ID and key of the station. Values are in imperial units; dateutc is in UTC, or "now".
+//	@Tags			observations
+//	@Produce		plain
+//	@Param			ID			query		string	true	"Upload ID"
+//	@Param			PASSWORD	query		string	true	"Upload key"
+//	@Param			dateutc		query		string	false	"Observation time"
+//	@Success		200			{string}	string	"success"
+//	@Router			/upload/wunderground/updateweatherstation.php [get]
+func (h *DefaultHandler) UploadWunderground(ctx *gin.Context) {
+	h.storeUpload(ctx)
+}
+
+// UploadEcowitt
+//
+//	@Summary		Upload an observation with the Ecowitt protocol
+//	@Description	Compatible with the customized uploads of Ecowitt stations. The upload ID and key of the station are sent as the ID and PASSWORD query parameters of the path set on the station. The PASSKEY of the Ecowitt protocol is not supported: it is derived from the MAC address of the station, so it cannot serve as a key.
+//	@Tags			observations
+//	@Accept			x-www-form-urlencoded
+//	@Produce		plain
+//	@Param			ID			query		string	true	"Upload ID"
+//	@Param			PASSWORD	query		string	true	"Upload key"
+//	@Success		200			{string}	string	"success"
+//	@Router			/upload/ecowitt [post]
+func (h *DefaultHandler) UploadEcowitt(ctx *gin.Context) {
+	h.storeUpload(ctx)
+}
+
+// storeUpload archives an upload, stores its observation and records the
+// outcome. The upload key is left out of the archive.
+func (h *DefaultHandler) storeUpload(ctx *gin.Context) {
+	if err := ctx.Request.ParseForm(); err != nil {
+		h.archiveRejected(ctx, RawMessageProviderUpload, "", err)
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	values := ctx.Request.Form
+
+	archived := make(url.Values, len(values))
+	for k, v := range values {
+		if k != "PASSWORD" && k != "PASSKEY" {
+			archived[k] = v
+		}
+	}
+	raw, ok := h.archiveMessage(ctx, db.CreateRawMessageParams{
+		Provider: RawMessageProviderUpload,
+		Body:     archived.Encode(),
+		Status:   service.RawMessageStatusReceived,
+	})
+
+	stationID, status, err := h.storeUploadValues(ctx, values)
+	if ok {
+		h.recordOutcome(ctx, raw, rawMessageStatus(status), stationID, err, false)
+	}
+
+	switch {
+	case status == http.StatusCreated || status == http.StatusConflict:
+		ctx.String(http.StatusOK, uploadSuccess)
+	case status == http.StatusUnauthorized:
+		ctx.String(status, uploadInvalidKey)
+	case status >= 500:
+		ctx.String(status, uploadServerError)
+	default:
+		ctx.String(status, err.Error())
+	}
+}
+
+// storeUploadValues stores the observation of the query and form values of
+// an upload, once its upload ID and key are checked. It returns the station
+// of the upload and http.StatusCreated, or http.StatusConflict if the
+// observation is already stored at its timestamp. The PASSKEY of Ecowitt
+// uploads is ignored, as anyone knowing the MAC address of the station can
+// compute it.
+func (h *DefaultHandler) storeUploadValues(ctx *gin.Context, values url.Values) (int64, int, error) {
+	uploadID := values.Get("ID")
+	stationID, err := h.authenticateUpload(ctx, uploadID, values.Get("PASSWORD"))
+	if err != nil {
+		if errors.Is(err, errInvalidUploadKey) {
+			h.logger.Warn().Str("upload_id", uploadID).Msg("[Upload] Invalid upload id or key")
+			return 0, http.StatusUnauthorized, err
+		}
+		h.logger.Error().Err(err).Str("upload_id", uploadID).Msg("[Upload] Cannot get upload key")
+		return 0, http.StatusInternalServerError, err
+	}
+
+	obs, err := sensor.NewUploadObservation(values, time.Now())
+	if err != nil {
+		return stationID, http.StatusBadRequest, err
+	}
+	if obs.Timestamp.Time.After(time.Now().Add(obsBatchMaxClockSkew)) {
+		return stationID, http.StatusBadRequest, errUploadInFuture
+	}
+
+	res, err := h.store.CreateStationUploadTx(ctx, db.CreateStationUploadTxParams{
+		Observation: db.CreateStationObservationIfNotExistsParams{
+			StationID: stationID,
+			Pres:      obs.Pres,
+			Rr:        obs.Rain,
+			Rh:        obs.Rh,
+			Temp:      obs.Temp,
+			Td:        obs.Td,
+			Wdir:      obs.Wdir,
+			Wspd:      obs.Wspd,
+			Wspdx:     obs.Gust,
+			Srad:      obs.Srad,
+			Mslp:      obs.Mslp,
+			Timestamp: obs.Timestamp,
+		},
+		Current: db.CreateCurrentObservationParams{
+			StationID: stationID,
+			Rain:      obs.Rain,
+			Temp:      obs.Temp,
+			Rh:        obs.Rh,
+			Td:        obs.Td,
+			Wdir:      obs.Wdir,
+			Wspd:      obs.Wspd,
+			Srad:      obs.Srad,
+			Mslp:      obs.Mslp,
+			Uvi:       obs.Uvi,
+			Gust:      obs.Gust,
+			RainAccum: obs.RainAccum,
+			Timestamp: obs.Timestamp,
+		},
+	})
+	if err != nil {
+		h.logger.Error().Err(err).Int64("station_id", stationID).Msg("[Upload] Cannot store upload")
+		return stationID, http.StatusInternalServerError, err
+	}
+	if !res.IsCreated {
+		return stationID, http.StatusConflict, nil
+	}
+
+	h.applyQc(ctx, res.Observation)
+	return stationID, http.StatusCreated, nil
+}
+
+var errUploadInFuture = errors.New("timestamp in the future")
+
+var errInvalidUploadKey = errors.New("invalid upload id or key")
+
+// authenticateUpload returns the station of an upload ID and key.
+func (h *DefaultHandler) authenticateUpload(ctx *gin.Context, uploadID, uploadKey string) (int64, error) {
+	if len(uploadID) == 0 || len(uploadKey) == 0 {
+		return 0, errInvalidUploadKey
+	}
+
+	key, err := h.store.GetStationUploadKey(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return 0, errInvalidUploadKey
+		}
+		return 0, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashUploadKey(uploadKey)), []byte(key.KeyHash)) != 1 {
+		return 0, errInvalidUploadKey
+	}
+	return key.StationID, nil
+}
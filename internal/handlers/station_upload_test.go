@@ -0,0 +1,467 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateStationUploadKeyAPI(t *testing.T) {
+	uploadKey := gofakeit.LetterN(32)
+	key := db.ObservationsStationuploadkey{
+		ID:        1,
+		StationID: int64(gofakeit.Number(1, 100)),
+		UploadID:  "IPANAHON12",
+		KeyHash:   hashUploadKey(uploadKey),
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"upload_id":  key.UploadID,
+				"upload_key": uploadKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertStationUploadKey(mock.AnythingOfType("*gin.Context"), db.UpsertStationUploadKeyParams{
+					StationID: key.StationID,
+					UploadID:  key.UploadID,
+					KeyHash:   key.KeyHash,
+				}).
+					Return(key, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				require.NotContains(t, string(data), key.KeyHash)
+
+				var got StationUploadKey
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, newStationUploadKey(key), got)
+			},
+		},
+		{
+			name: "ShortKey",
+			body: gin.H{
+				"upload_id":  key.UploadID,
+				"upload_key": "secret",
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpsertStationUploadKey", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidUploadID",
+			body: gin.H{
+				"upload_id":  "station 12",
+				"upload_key": uploadKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "UpsertStationUploadKey", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UploadIDInUse",
+			body: gin.H{
+				"upload_id":  key.UploadID,
+				"upload_key": uploadKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertStationUploadKey(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationuploadkey{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "StationNotFound",
+			body: gin.H{
+				"upload_id":  key.UploadID,
+				"upload_key": uploadKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertStationUploadKey(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationuploadkey{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"upload_id":  key.UploadID,
+				"upload_key": uploadKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertStationUploadKey(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.ObservationsStationuploadkey{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.PUT("/stations/:station_id/upload-key", handler.UpdateStationUploadKey)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/stations/%d/upload-key", key.StationID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestDeleteStationUploadKeyAPI(t *testing.T) {
+	stationID := int64(gofakeit.Number(1, 100))
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().DeleteStationUploadKey(mock.AnythingOfType("*gin.Context"), stationID).Return(nil)
+
+	handler := newTestHandler(store, nil)
+
+	router := gin.Default()
+	router.DELETE("/stations/:station_id/upload-key", handler.DeleteStationUploadKey)
+
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/stations/%d/upload-key", stationID)
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(recorder, request)
+
+	store.AssertExpectations(t)
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestUploadWundergroundAPI(t *testing.T) {
+	uploadKey := gofakeit.LetterN(32)
+	key := db.ObservationsStationuploadkey{
+		ID:        1,
+		StationID: int64(gofakeit.Number(1, 100)),
+		UploadID:  "IPANAHON12",
+		KeyHash:   hashUploadKey(uploadKey),
+	}
+	stnObs := db.ObservationsObservation{ID: 1, StationID: key.StationID}
+	timestamp := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+
+	query := func(id, password string, extra url.Values) url.Values {
+		q := url.Values{
+			"ID":           {id},
+			"PASSWORD":     {password},
+			"action":       {"updateraw"},
+			"dateutc":      {timestamp.Format("2006-01-02 15:04:05")},
+			"tempf":        {"82.4"},
+			"humidity":     {"75"},
+			"windspeedmph": {"10"},
+			"rainin":       {"0.1"},
+			"dailyrainin":  {"1.5"},
+			"baromin":      {"29.92"},
+			"dewptf":       {"75.2"},
+			"UV":           {"6"},
+		}
+		for k, v := range extra {
+			q[k] = v
+		}
+		return q
+	}
+	stubKey := func(store *mockdb.MockStore) {
+		store.EXPECT().GetStationUploadKey(mock.AnythingOfType("*gin.Context"), key.UploadID).
+			Return(key, nil)
+	}
+	raw := db.RawMessage{ID: 1}
+
+	testCases := []struct {
+		name          string
+		query         url.Values
+		outcome       string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, store *mockdb.MockStore)
+	}{
+		{
+			name:    "OK",
+			query:   query(key.UploadID, uploadKey, nil),
+			outcome: service.RawMessageStatusStored,
+			buildStubs: func(store *mockdb.MockStore) {
+				stubKey(store)
+				store.EXPECT().CreateStationUploadTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationUploadTxParams) bool {
+					obs, cur := arg.Observation, arg.Current
+					return obs.StationID == key.StationID && cur.StationID == key.StationID &&
+						obs.Timestamp.Time.Equal(timestamp) && cur.Timestamp.Time.Equal(timestamp) &&
+						obs.Temp.Float32 == cur.Temp.Float32 && obs.Temp.Float32 > 27.9 && obs.Temp.Float32 < 28.1 &&
+						obs.Rr.Float32 > 2.53 && obs.Rr.Float32 < 2.55 &&
+						cur.RainAccum.Float32 > 38.09 && cur.RainAccum.Float32 < 38.11 &&
+						obs.Mslp.Float32 > 1013.1 && obs.Mslp.Float32 < 1013.3 &&
+						obs.Td.Float32 == cur.Td.Float32 && cur.Td.Float32 > 23.9 && cur.Td.Float32 < 24.1 &&
+						cur.Uvi.Valid && cur.Uvi.Float32 == 6 &&
+						!obs.Wdir.Valid
+				})).
+					Return(db.CreateStationUploadTxResult{Observation: stnObs, IsCreated: true}, nil)
+				store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.ListPreviousStationObservationsParams")).
+					Return([]db.ObservationsObservation{}, nil)
+				store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.UpdateObservationQcTxParams")).
+					Return(db.UpdateObservationQcTxResult{Observation: stnObs}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, uploadSuccess, recorder.Body.String())
+			},
+		},
+		{
+			name:    "Duplicate",
+			query:   query(key.UploadID, uploadKey, url.Values{"dateutc": {"now"}}),
+			outcome: service.RawMessageStatusDuplicate,
+			buildStubs: func(store *mockdb.MockStore) {
+				stubKey(store)
+				store.EXPECT().CreateStationUploadTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateStationUploadTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "ListPreviousStationObservations", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, uploadSuccess, recorder.Body.String())
+			},
+		},
+		{
+			name:    "InvalidKey",
+			query:   query(key.UploadID, gofakeit.LetterN(32), nil),
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				stubKey(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				store.AssertNotCalled(t, "CreateStationUploadTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, uploadInvalidKey, recorder.Body.String())
+			},
+		},
+		{
+			name:    "UnknownUploadID",
+			query:   query("IUNKNOWN1", uploadKey, nil),
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetStationUploadKey(mock.AnythingOfType("*gin.Context"), "IUNKNOWN1").
+					Return(db.ObservationsStationuploadkey{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:       "MissingPassword",
+			query:      query(key.UploadID, "", nil),
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "GetStationUploadKey", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "NoValues",
+			query: url.Values{
+				"ID":       {key.UploadID},
+				"PASSWORD": {uploadKey},
+				"dateutc":  {"now"},
+				"tempf":    {"-9999"},
+			},
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				stubKey(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateStationUploadTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "FutureTimestamp",
+			query:   query(key.UploadID, uploadKey, url.Values{"dateutc": {time.Now().UTC().Add(2 * time.Hour).Format("2006-01-02 15:04:05")}}),
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				stubKey(store)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertNotCalled(t, "CreateStationUploadTx", mock.AnythingOfType("*gin.Context"), mock.Anything)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "InternalError",
+			query:   query(key.UploadID, uploadKey, nil),
+			outcome: service.RawMessageStatusFailed,
+			buildStubs: func(store *mockdb.MockStore) {
+				stubKey(store)
+				store.EXPECT().CreateStationUploadTx(mock.AnythingOfType("*gin.Context"), mock.Anything).
+					Return(db.CreateStationUploadTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, store *mockdb.MockStore) {
+				store.AssertExpectations(t)
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, uploadServerError, recorder.Body.String())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+				return arg.Provider == RawMessageProviderUpload &&
+					strings.Contains(arg.Body, "ID="+tc.query.Get("ID")) &&
+					!strings.Contains(arg.Body, "PASSWORD")
+			})).Return(raw, nil)
+			store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+				return arg.ID == raw.ID && arg.Status == tc.outcome
+			})).Return(raw, nil)
+			tc.buildStubs(store)
+
+			handler := newTestHandler(store, nil)
+
+			router := gin.Default()
+			router.GET("/upload/wunderground/updateweatherstation.php", handler.UploadWunderground)
+
+			recorder := httptest.NewRecorder()
+
+			url := "/upload/wunderground/updateweatherstation.php?" + tc.query.Encode()
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(recorder, request)
+
+			tc.checkResponse(recorder, store)
+		})
+	}
+}
+
+func TestUploadEcowittAPI(t *testing.T) {
+	uploadKey := gofakeit.LetterN(32)
+	key := db.ObservationsStationuploadkey{
+		ID:        1,
+		StationID: int64(gofakeit.Number(1, 100)),
+		UploadID:  "ecowitt12",
+		KeyHash:   hashUploadKey(uploadKey),
+	}
+	stnObs := db.ObservationsObservation{ID: 1, StationID: key.StationID}
+
+	form := url.Values{
+		"PASSKEY":      {"7A6D1F3E0C2B4A5968778695A4B3C2D1"},
+		"stationtype":  {"GW1100A_V2.1.4"},
+		"dateutc":      {"2024-01-02 03:04:05"},
+		"tempf":        {"82.4"},
+		"humidity":     {"75"},
+		"winddir":      {"180"},
+		"rainratein":   {"0.000"},
+		"dailyrainin":  {"0.000"},
+		"baromrelin":   {"29.920"},
+		"baromabsin":   {"29.800"},
+		"uv":           {"3"},
+		"model":        {"GW1100A"},
+		"freq":         {"868M"},
+		"windspeedmph": {"2.24"},
+	}
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+		return arg.Provider == RawMessageProviderUpload &&
+			strings.Contains(arg.Body, "stationtype=GW1100A") &&
+			!strings.Contains(arg.Body, "PASSKEY") && !strings.Contains(arg.Body, "PASSWORD")
+	})).Return(db.RawMessage{ID: 1}, nil)
+	store.EXPECT().UpdateRawMessageOutcome(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+		return arg.ID == 1 && arg.Status == service.RawMessageStatusStored && arg.StationID.Int64 == key.StationID
+	})).Return(db.RawMessage{ID: 1}, nil)
+	store.EXPECT().GetStationUploadKey(mock.AnythingOfType("*gin.Context"), key.UploadID).
+		Return(key, nil)
+	store.EXPECT().CreateStationUploadTx(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateStationUploadTxParams) bool {
+		obs, cur := arg.Observation, arg.Current
+		return obs.StationID == key.StationID &&
+			obs.Timestamp.Time.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) &&
+			obs.Wdir.Float32 == 180 && obs.Rr.Valid && obs.Rr.Float32 == 0 &&
+			obs.Pres.Float32 > 1009.1 && obs.Pres.Float32 < 1009.2 &&
+			obs.Wspd.Float32 > 1.0 && obs.Wspd.Float32 < 1.01 &&
+			cur.Uvi.Float32 == 3
+	})).
+		Return(db.CreateStationUploadTxResult{Observation: stnObs, IsCreated: true}, nil)
+	store.EXPECT().ListPreviousStationObservations(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.ListPreviousStationObservationsParams")).
+		Return([]db.ObservationsObservation{}, nil)
+	store.EXPECT().UpdateObservationQcTx(mock.AnythingOfType("*gin.Context"), mock.AnythingOfType("db.UpdateObservationQcTxParams")).
+		Return(db.UpdateObservationQcTxResult{Observation: stnObs}, nil)
+
+	handler := newTestHandler(store, nil)
+
+	router := gin.Default()
+	router.POST("/upload/ecowitt", handler.UploadEcowitt)
+
+	recorder := httptest.NewRecorder()
+
+	target := "/upload/ecowitt?" + url.Values{"ID": {key.UploadID}, "PASSWORD": {uploadKey}}.Encode()
+	request, err := http.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	router.ServeHTTP(recorder, request)
+
+	store.AssertExpectations(t)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, uploadSuccess, recorder.Body.String())
+}
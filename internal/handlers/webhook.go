@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+var errWebhookRejected = errors.New("webhook request rejected")
+
+// newWebhookVerifiers creates the verifiers of the webhooks of the SMS
+// gateways, sharing one nonce store.
+func newWebhookVerifiers(config util.Config, logger *zerolog.Logger) map[string]*mw.WebhookVerifier {
+	nonces := mw.NewMemoryNonceStore()
+	confs := map[string]mw.WebhookConfig{
+		sms.PromoTexterKey: {
+			Secret:        config.PtexterWebhookSecret,
+			AllowedIPs:    config.PtexterWebhookIPs,
+			AllowUnsigned: config.PtexterAllowUnsigned,
+			MaxSkew:       config.WebhookMaxSkew,
+		},
+		sms.GLabsKey: {
+			Secret:        config.GlabsWebhookSecret,
+			AllowedIPs:    config.GlabsWebhookIPs,
+			AllowUnsigned: config.GlabsAllowUnsigned,
+			MaxSkew:       config.WebhookMaxSkew,
+		},
+		sms.HTTPKey: {
+			Secret:        config.SmsHTTPWebhookSecret,
+			AllowedIPs:    config.SmsHTTPWebhookIPs,
+			AllowUnsigned: config.SmsHTTPAllowUnsigned,
+			MaxSkew:       config.WebhookMaxSkew,
+		},
+	}
+
+	verifiers := make(map[string]*mw.WebhookVerifier, len(confs))
+	for provider, conf := range confs {
+		v, err := mw.NewWebhookVerifier(provider, conf, nonces, logger)
+		if err != nil {
+			logger.Fatal().Err(err).Str("provider", provider).Msg("[Webhook] Invalid config")
+		}
+		verifiers[provider] = v
+	}
+	return verifiers
+}
+
+// VerifyWebhook returns the middleware verifying the webhook requests of
+// provider. An empty provider is read from the provider path parameter.
+// Requests of unknown providers are rejected. Requests failing verification
+// are archived as rejected.
+func (h *DefaultHandler) VerifyWebhook(provider string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		p := provider
+		if len(p) == 0 {
+			p = strings.ToUpper(ctx.Param("provider"))
+		}
+		v, ok := h.webhooks[p]
+		if !ok {
+			h.logger.Warn().Str("provider", p).Str("path", ctx.Request.URL.Path).Msg("[Webhook] Unknown provider")
+			ctx.AbortWithStatusJSON(http.StatusNotFound, errorResponse(errors.New("unknown webhook provider")))
+			return
+		}
+		v.Middleware()(ctx)
+		if ctx.IsAborted() {
+			// The body is read again if verification already read it.
+			var body []byte
+			if ctx.Request.Body != nil {
+				body, _ = io.ReadAll(io.LimitReader(ctx.Request.Body, maxInboundBody))
+			}
+			err := errWebhookRejected
+			if e := ctx.Errors.Last(); e != nil {
+				err = e.Err
+			}
+			h.archiveRejected(ctx, p, string(body), err)
+		}
+	}
+}
+
+type WebhookRejection struct {
+	Provider string `json:"provider"`
+	Reason   string `json:"reason"`
+	Count    int64  `json:"count"`
+} //@name WebhookRejection
+
+// ListWebhookRejections
+//
+//	@Summary	Count the webhook requests rejected since the server started, by provider and reason
+//	@Tags		sms
+//	@Produce	json
+//	@Success	200	{array}	WebhookRejection
+//	@Security	BearerAuth
+//	@Router		/admin/webhooks/rejections [get]
+func (h *DefaultHandler) ListWebhookRejections(ctx *gin.Context) {
+	res := make([]WebhookRejection, 0)
+	for provider, v := range h.webhooks {
+		for reason, count := range v.Rejections() {
+			res = append(res, WebhookRejection{
+				Provider: provider,
+				Reason:   reason,
+				Count:    count,
+			})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Provider != res[j].Provider {
+			return res[i].Provider < res[j].Provider
+		}
+		return res[i].Reason < res[j].Reason
+	})
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	handler := newTestHandler(store, nil)
+	handler.webhooks = newWebhookVerifiers(util.Config{
+		PtexterWebhookSecret: util.RandomString(32),
+		GlabsWebhookIPs:      "10.0.0.1",
+	}, handler.logger)
+
+	rejected := make(map[string]int)
+	store.EXPECT().CreateRawMessage(mock.AnythingOfType("*gin.Context"), mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+		return arg.Status == service.RawMessageStatusRejected && arg.Error.Valid
+	})).
+		RunAndReturn(func(_ context.Context, arg db.CreateRawMessageParams) (db.RawMessage, error) {
+			rejected[arg.Provider]++
+			return db.RawMessage{ID: 1, Provider: arg.Provider, Status: arg.Status}, nil
+		}).
+		Times(4)
+
+	ok := func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{})
+	}
+	router := gin.Default()
+	router.POST("/sms/:provider", handler.VerifyWebhook(""), ok)
+	router.POST("/glabs/load", handler.VerifyWebhook(sms.GLabsKey), ok)
+	router.GET("/admin/webhooks/rejections", handler.ListWebhookRejections)
+
+	send := func(method, url string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(method, url, nil)
+		require.NoError(t, err)
+		request.RemoteAddr = "192.168.1.10:4321"
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	require.Equal(t, http.StatusUnauthorized, send(http.MethodPost, "/sms/promotexter").Code)
+	require.Equal(t, http.StatusUnauthorized, send(http.MethodPost, "/sms/PROMOTEXTER").Code)
+	// No secret set, and unsigned requests not allowed.
+	require.Equal(t, http.StatusUnauthorized, send(http.MethodPost, "/sms/HTTP").Code)
+	require.Equal(t, http.StatusNotFound, send(http.MethodPost, "/sms/UNKNOWN").Code)
+	require.Equal(t, http.StatusForbidden, send(http.MethodPost, "/glabs/load").Code)
+	require.Equal(t, map[string]int{sms.GLabsKey: 1, sms.HTTPKey: 1, sms.PromoTexterKey: 2}, rejected)
+
+	recorder := send(http.MethodGet, "/admin/webhooks/rejections")
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var got []WebhookRejection
+	err := json.NewDecoder(recorder.Body).Decode(&got)
+	require.NoError(t, err)
+	require.Equal(t, []WebhookRejection{
+		{Provider: sms.GLabsKey, Reason: mw.WebhookRejectedIP, Count: 1},
+		{Provider: sms.HTTPKey, Reason: mw.WebhookRejectedSignature, Count: 1},
+		{Provider: sms.PromoTexterKey, Reason: mw.WebhookRejectedSignature, Count: 2},
+	}, got)
+}
+
+func TestVerifyWebhookAllowUnsigned(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	handler := newTestHandler(store, nil)
+	handler.webhooks = newWebhookVerifiers(util.Config{
+		SmsHTTPAllowUnsigned: true,
+		SmsHTTPWebhookIPs:    "192.168.1.10",
+	}, handler.logger)
+
+	router := gin.Default()
+	router.POST("/sms/:provider", handler.VerifyWebhook(""), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/sms/HTTP", nil)
+	require.NoError(t, err)
+	request.RemoteAddr = "192.168.1.10:4321"
+	router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -0,0 +1,324 @@
+package middlewares
+
+import (
+	"bytes"
+	"container/heap"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+const (
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookNonceHeader     = "X-Webhook-Nonce"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+
+	// DefaultWebhookMaxSkew is how far the timestamp of a signed request may
+	// be from the server time.
+	DefaultWebhookMaxSkew = 5 * time.Minute
+
+	webhookMaxBody = 1 << 20
+)
+
+// Reasons a webhook request is rejected.
+const (
+	WebhookRejectedIP        = "ip"
+	WebhookRejectedSignature = "signature"
+	WebhookRejectedTimestamp = "timestamp"
+	WebhookRejectedReplay    = "replay"
+)
+
+// NonceStore remembers the nonces of signed webhook requests.
+type NonceStore interface {
+	// Add records nonce until expiresAt. It returns false if nonce is
+	// already recorded.
+	Add(nonce string, expiresAt time.Time) bool
+}
+
+// MemoryNonceStore is a NonceStore kept in memory. Replay protection with it
+// only holds within a single server instance: behind a load balancer, a
+// request replayed to another instance is accepted once there. Several
+// instances need a shared NonceStore instead.
+type MemoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]time.Time
+	// expiries orders the nonces by expiry, to drop the expired ones
+	// without going through all of them.
+	expiries nonceExpiries
+	now      func() time.Time
+}
+
+// NewMemoryNonceStore creates a new MemoryNonceStore.
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{
+		nonces: make(map[string]time.Time),
+		now:    time.Now,
+	}
+}
+
+// Add implements NonceStore. Expired nonces are dropped on the way.
+func (s *MemoryNonceStore) Add(nonce string, expiresAt time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for len(s.expiries) > 0 && !s.expiries[0].expiresAt.After(now) {
+		e := heap.Pop(&s.expiries).(nonceExpiry)
+		delete(s.nonces, e.nonce)
+	}
+	if _, ok := s.nonces[nonce]; ok {
+		return false
+	}
+	s.nonces[nonce] = expiresAt
+	heap.Push(&s.expiries, nonceExpiry{nonce: nonce, expiresAt: expiresAt})
+	return true
+}
+
+type nonceExpiry struct {
+	nonce     string
+	expiresAt time.Time
+}
+
+// nonceExpiries is a min-heap of nonces by expiry.
+type nonceExpiries []nonceExpiry
+
+func (h nonceExpiries) Len() int           { return len(h) }
+func (h nonceExpiries) Less(i, j int) bool { return h[i].expiresAt.Before(h[j].expiresAt) }
+func (h nonceExpiries) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *nonceExpiries) Push(x any)        { *h = append(*h, x.(nonceExpiry)) }
+
+func (h *nonceExpiries) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// WebhookConfig is the verification of the webhook requests of a provider.
+type WebhookConfig struct {
+	// Secret signs the requests. Without one, every request is rejected
+	// unless AllowUnsigned is set.
+	Secret string
+	// AllowUnsigned accepts unsigned requests when there is no Secret, for
+	// providers that cannot sign them. AllowedIPs should be set then.
+	AllowUnsigned bool
+	// AllowedIPs is a comma-separated list of the IPs and CIDRs requests may
+	// come from. Any IP is allowed if empty.
+	AllowedIPs string
+	// MaxSkew is DefaultWebhookMaxSkew if zero.
+	MaxSkew time.Duration
+}
+
+// WebhookVerifier checks that webhook requests come from their provider: from
+// an allowed IP, and signed with the shared secret. A signed request carries
+// the unix time it was sent in X-Webhook-Timestamp, a unique X-Webhook-Nonce,
+// and in X-Webhook-Signature the hex HMAC-SHA256 of
+// "<timestamp>.<nonce>.<body>". Requests that are too old or replayed are
+// rejected, replays as far as the NonceStore is shared. Rejected requests are
+// logged and counted by reason.
+type WebhookVerifier struct {
+	provider   string
+	secret     []byte
+	unsigned   bool
+	allowed    []netip.Prefix
+	maxSkew    time.Duration
+	nonces     NonceStore
+	logger     *zerolog.Logger
+	now        func() time.Time
+	mu         sync.Mutex
+	rejections map[string]int64
+}
+
+// NewWebhookVerifier creates a verifier of the webhook requests of provider.
+func NewWebhookVerifier(provider string, conf WebhookConfig, nonces NonceStore, logger *zerolog.Logger) (*WebhookVerifier, error) {
+	allowed, err := parseIPPrefixes(conf.AllowedIPs)
+	if err != nil {
+		return nil, fmt.Errorf("%s webhook: %w", provider, err)
+	}
+	maxSkew := conf.MaxSkew
+	if maxSkew <= 0 {
+		maxSkew = DefaultWebhookMaxSkew
+	}
+	unsigned := len(conf.Secret) == 0 && conf.AllowUnsigned
+	switch {
+	case unsigned:
+		logger.Warn().Str("provider", provider).Bool("ip_allowlist", len(allowed) > 0).
+			Msg("[Webhook] Unsigned requests are accepted")
+	case len(conf.Secret) == 0:
+		logger.Warn().Str("provider", provider).
+			Msg("[Webhook] No secret set, every request is rejected")
+	}
+	return &WebhookVerifier{
+		provider:   provider,
+		secret:     []byte(conf.Secret),
+		unsigned:   unsigned,
+		allowed:    allowed,
+		maxSkew:    maxSkew,
+		nonces:     nonces,
+		logger:     logger,
+		now:        time.Now,
+		rejections: make(map[string]int64),
+	}, nil
+}
+
+// Middleware returns the middleware rejecting the requests that fail
+// verification.
+func (v *WebhookVerifier) Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(v.allowed) > 0 && !v.isAllowed(ctx.ClientIP()) {
+			v.reject(ctx, http.StatusForbidden, WebhookRejectedIP, errors.New("source ip not allowed"))
+			return
+		}
+		if v.unsigned {
+			ctx.Next()
+			return
+		}
+		if len(v.secret) == 0 {
+			v.reject(ctx, http.StatusUnauthorized, WebhookRejectedSignature, errors.New("webhook secret not configured"))
+			return
+		}
+
+		timestamp := ctx.GetHeader(WebhookTimestampHeader)
+		nonce := ctx.GetHeader(WebhookNonceHeader)
+		signature := strings.TrimPrefix(ctx.GetHeader(WebhookSignatureHeader), "sha256=")
+		if len(timestamp) == 0 || len(nonce) == 0 || len(signature) == 0 {
+			v.reject(ctx, http.StatusUnauthorized, WebhookRejectedSignature, errors.New("missing webhook signature"))
+			return
+		}
+
+		sec, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			v.reject(ctx, http.StatusUnauthorized, WebhookRejectedTimestamp, errors.New("invalid webhook timestamp"))
+			return
+		}
+		sentAt := time.Unix(sec, 0)
+		if skew := v.now().Sub(sentAt); skew > v.maxSkew || skew < -v.maxSkew {
+			v.reject(ctx, http.StatusUnauthorized, WebhookRejectedTimestamp, errors.New("webhook timestamp out of range"))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, webhookMaxBody))
+		if err != nil {
+			status := http.StatusBadRequest
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				status = http.StatusRequestEntityTooLarge
+			}
+			_ = ctx.Error(err)
+			ctx.AbortWithStatusJSON(status, errorResponse(err))
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		got, err := hex.DecodeString(signature)
+		if err != nil || !hmac.Equal(got, signWebhook(v.secret, timestamp, nonce, body)) {
+			v.reject(ctx, http.StatusUnauthorized, WebhookRejectedSignature, errors.New("invalid webhook signature"))
+			return
+		}
+
+		// A nonce needs to be remembered only while its timestamp is accepted.
+		if !v.nonces.Add(v.provider+":"+nonce, sentAt.Add(v.maxSkew)) {
+			v.reject(ctx, http.StatusUnauthorized, WebhookRejectedReplay, errors.New("webhook request replayed"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// Rejections returns the number of rejected requests by reason.
+func (v *WebhookVerifier) Rejections() map[string]int64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	res := make(map[string]int64, len(v.rejections))
+	for reason, n := range v.rejections {
+		res[reason] = n
+	}
+	return res
+}
+
+func (v *WebhookVerifier) reject(ctx *gin.Context, status int, reason string, err error) {
+	v.mu.Lock()
+	v.rejections[reason]++
+	count := v.rejections[reason]
+	v.mu.Unlock()
+
+	v.logger.Warn().Err(err).
+		Str("provider", v.provider).
+		Str("reason", reason).
+		Str("client_ip", ctx.ClientIP()).
+		Str("path", ctx.Request.URL.Path).
+		Int64("count", count).
+		Msg("[Webhook] Request rejected")
+	_ = ctx.Error(err)
+	ctx.AbortWithStatusJSON(status, errorResponse(err))
+}
+
+func (v *WebhookVerifier) isAllowed(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range v.allowed {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignWebhook returns the X-Webhook-Signature of a request sent at timestamp
+// with nonce and body.
+func SignWebhook(secret, timestamp, nonce string, body []byte) string {
+	return hex.EncodeToString(signWebhook([]byte(secret), timestamp, nonce, body))
+}
+
+func signWebhook(secret []byte, timestamp, nonce string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// parseIPPrefixes parses a comma-separated list of IPs and CIDRs.
+func parseIPPrefixes(s string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		if strings.Contains(item, "/") {
+			p, err := netip.ParsePrefix(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed ip: %s", item)
+			}
+			prefixes = append(prefixes, p.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed ip: %s", item)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,259 @@
+package middlewares
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookVerifier(t *testing.T) {
+	secret := util.RandomString(32)
+	body := []byte(`{"number":"639171234567","msg":"hello"}`)
+	now := time.Now()
+
+	sign := func(request *http.Request, ts time.Time, nonce string) {
+		timestamp := strconv.FormatInt(ts.Unix(), 10)
+		request.Header.Set(WebhookTimestampHeader, timestamp)
+		request.Header.Set(WebhookNonceHeader, nonce)
+		request.Header.Set(WebhookSignatureHeader, SignWebhook(secret, timestamp, nonce, body))
+	}
+
+	testCases := []struct {
+		name        string
+		conf        WebhookConfig
+		remoteAddr  string
+		setupSign   func(request *http.Request)
+		wantStatus  int
+		wantReasons map[string]int64
+	}{
+		{
+			name:        "NotConfigured",
+			setupSign:   func(request *http.Request) {},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedSignature: 1},
+		},
+		{
+			name:       "AllowUnsigned",
+			conf:       WebhookConfig{AllowUnsigned: true},
+			setupSign:  func(request *http.Request) {},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "SecretOverAllowUnsigned",
+			conf:        WebhookConfig{Secret: secret, AllowUnsigned: true},
+			setupSign:   func(request *http.Request) {},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedSignature: 1},
+		},
+		{
+			name: "OK",
+			conf: WebhookConfig{Secret: secret},
+			setupSign: func(request *http.Request) {
+				sign(request, now, "n1")
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "PrefixedSignature",
+			conf: WebhookConfig{Secret: secret},
+			setupSign: func(request *http.Request) {
+				sign(request, now, "n1")
+				request.Header.Set(WebhookSignatureHeader, "sha256="+request.Header.Get(WebhookSignatureHeader))
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "NoSignature",
+			conf:        WebhookConfig{Secret: secret},
+			setupSign:   func(request *http.Request) {},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedSignature: 1},
+		},
+		{
+			name: "WrongSecret",
+			conf: WebhookConfig{Secret: secret},
+			setupSign: func(request *http.Request) {
+				timestamp := strconv.FormatInt(now.Unix(), 10)
+				request.Header.Set(WebhookTimestampHeader, timestamp)
+				request.Header.Set(WebhookNonceHeader, "n1")
+				request.Header.Set(WebhookSignatureHeader, SignWebhook("wrong", timestamp, "n1", body))
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedSignature: 1},
+		},
+		{
+			name: "TamperedNonce",
+			conf: WebhookConfig{Secret: secret},
+			setupSign: func(request *http.Request) {
+				sign(request, now, "n1")
+				request.Header.Set(WebhookNonceHeader, "n2")
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedSignature: 1},
+		},
+		{
+			name: "Expired",
+			conf: WebhookConfig{Secret: secret, MaxSkew: time.Minute},
+			setupSign: func(request *http.Request) {
+				sign(request, now.Add(-2*time.Minute), "n1")
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedTimestamp: 1},
+		},
+		{
+			name: "FromTheFuture",
+			conf: WebhookConfig{Secret: secret, MaxSkew: time.Minute},
+			setupSign: func(request *http.Request) {
+				sign(request, now.Add(2*time.Minute), "n1")
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantReasons: map[string]int64{WebhookRejectedTimestamp: 1},
+		},
+		{
+			name:       "AllowedIP",
+			conf:       WebhookConfig{AllowedIPs: "10.0.0.0/8, 192.168.1.10", AllowUnsigned: true},
+			remoteAddr: "192.168.1.10:4321",
+			setupSign:  func(request *http.Request) {},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "ForbiddenIP",
+			conf:        WebhookConfig{Secret: secret, AllowedIPs: "10.0.0.0/8"},
+			remoteAddr:  "192.168.1.10:4321",
+			setupSign:   func(request *http.Request) { sign(request, now, "n1") },
+			wantStatus:  http.StatusForbidden,
+			wantReasons: map[string]int64{WebhookRejectedIP: 1},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			logger := zerolog.Nop()
+			v, err := NewWebhookVerifier("PROMOTEXTER", tc.conf, NewMemoryNonceStore(), &logger)
+			require.NoError(t, err)
+
+			router := newWebhookRouter(t, v, body)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/hook", bytes.NewReader(body))
+			require.NoError(t, err)
+			if len(tc.remoteAddr) > 0 {
+				request.RemoteAddr = tc.remoteAddr
+			}
+			tc.setupSign(request)
+
+			router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.wantStatus, recorder.Code)
+			if tc.wantReasons == nil {
+				tc.wantReasons = map[string]int64{}
+			}
+			require.Equal(t, tc.wantReasons, v.Rejections())
+		})
+	}
+}
+
+func TestWebhookVerifierReplay(t *testing.T) {
+	secret := util.RandomString(32)
+	body := []byte(`{"number":"639171234567","msg":"hello"}`)
+	logger := zerolog.Nop()
+	v, err := NewWebhookVerifier("GLABS", WebhookConfig{Secret: secret}, NewMemoryNonceStore(), &logger)
+	require.NoError(t, err)
+	router := newWebhookRouter(t, v, body)
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	send := func(nonce string) int {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(http.MethodPost, "/hook", bytes.NewReader(body))
+		require.NoError(t, err)
+		request.Header.Set(WebhookTimestampHeader, timestamp)
+		request.Header.Set(WebhookNonceHeader, nonce)
+		request.Header.Set(WebhookSignatureHeader, SignWebhook(secret, timestamp, nonce, body))
+		router.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	require.Equal(t, http.StatusOK, send("n1"))
+	require.Equal(t, http.StatusUnauthorized, send("n1"))
+	require.Equal(t, http.StatusOK, send("n2"))
+	require.Equal(t, map[string]int64{WebhookRejectedReplay: 1}, v.Rejections())
+}
+
+func TestWebhookVerifierBodyTooLarge(t *testing.T) {
+	secret := util.RandomString(32)
+	body := bytes.Repeat([]byte("a"), webhookMaxBody+1)
+	logger := zerolog.Nop()
+	v, err := NewWebhookVerifier("GLABS", WebhookConfig{Secret: secret}, NewMemoryNonceStore(), &logger)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.POST("/hook", v.Middleware(), func(ctx *gin.Context) {
+		t.Error("handler called with a body over the limit")
+	})
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/hook", bytes.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set(WebhookTimestampHeader, timestamp)
+	request.Header.Set(WebhookNonceHeader, "n1")
+	request.Header.Set(WebhookSignatureHeader, SignWebhook(secret, timestamp, "n1", body))
+
+	router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	require.Empty(t, v.Rejections())
+}
+
+func TestNewWebhookVerifierInvalidIPs(t *testing.T) {
+	logger := zerolog.Nop()
+	_, err := NewWebhookVerifier("GLABS", WebhookConfig{AllowedIPs: "10.0.0.0/8,not-an-ip"}, NewMemoryNonceStore(), &logger)
+	require.Error(t, err)
+}
+
+func TestMemoryNonceStore(t *testing.T) {
+	now := time.Now()
+	s := NewMemoryNonceStore()
+	s.now = func() time.Time { return now }
+
+	require.True(t, s.Add("n1", now.Add(time.Minute)))
+	require.False(t, s.Add("n1", now.Add(time.Minute)))
+
+	require.True(t, s.Add("n2", now.Add(3*time.Minute)))
+	require.True(t, s.Add("n3", now.Add(2*time.Minute)))
+
+	now = now.Add(2 * time.Minute)
+	require.True(t, s.Add("n1", now.Add(time.Minute)))
+	// n3 expired along with the first n1.
+	require.Len(t, s.nonces, 2)
+	require.False(t, s.Add("n2", now.Add(time.Minute)))
+
+	now = now.Add(2 * time.Minute)
+	require.True(t, s.Add("n4", now.Add(time.Minute)))
+	require.Len(t, s.nonces, 1)
+	require.Len(t, s.expiries, 1)
+}
+
+// newWebhookRouter serves v at /hook with a handler checking the body is
+// still readable.
+func newWebhookRouter(t *testing.T, v *WebhookVerifier, body []byte) *gin.Engine {
+	router := gin.New()
+	router.POST("/hook", v.Middleware(), func(ctx *gin.Context) {
+		got, err := io.ReadAll(ctx.Request.Body)
+		require.NoError(t, err)
+		require.Equal(t, body, got)
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+	return router
+}
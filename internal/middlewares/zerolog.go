@@ -1,12 +1,32 @@
 package middlewares
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
 )
 
+// redactedParams are the query parameters kept out of the logs, such as the
+// upload keys of the Wunderground protocol.
+var redactedParams = []string{"PASSWORD"}
+
+func redactQuery(raw string) string {
+	q, _ := url.ParseQuery(raw)
+	redacted := false
+	for _, k := range redactedParams {
+		if q.Has(k) {
+			q.Set(k, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return raw
+	}
+	return q.Encode()
+}
+
 func Zerologger(logger *zerolog.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
@@ -21,7 +41,7 @@ func Zerologger(logger *zerolog.Logger) gin.HandlerFunc {
 		statusCode := c.Writer.Status()
 
 		if raw != "" {
-			path = path + "?" + raw
+			path = path + "?" + redactQuery(raw)
 		}
 
 		event := logger.Info()
@@ -0,0 +1,13 @@
+package middlewares
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactQuery(t *testing.T) {
+	require.Equal(t, "page=1&per_page=10", redactQuery("page=1&per_page=10"))
+	require.Equal(t, "ID=IPANAHON12&PASSWORD=REDACTED&tempf=86",
+		redactQuery("ID=IPANAHON12&PASSWORD=secret&tempf=86"))
+}
@@ -26,6 +26,284 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// AcknowledgeStationHealthAlert provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AcknowledgeStationHealthAlert(ctx context.Context, arg db.AcknowledgeStationHealthAlertParams) (db.ObservationsStationhealthAlert, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStationhealthAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.AcknowledgeStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.AcknowledgeStationHealthAlertParams) db.ObservationsStationhealthAlert); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealthAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.AcknowledgeStationHealthAlertParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_AcknowledgeStationHealthAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeStationHealthAlert'
+type MockStore_AcknowledgeStationHealthAlert_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeStationHealthAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.AcknowledgeStationHealthAlertParams
+func (_e *MockStore_Expecter) AcknowledgeStationHealthAlert(ctx interface{}, arg interface{}) *MockStore_AcknowledgeStationHealthAlert_Call {
+	return &MockStore_AcknowledgeStationHealthAlert_Call{Call: _e.mock.On("AcknowledgeStationHealthAlert", ctx, arg)}
+}
+
+func (_c *MockStore_AcknowledgeStationHealthAlert_Call) Run(run func(ctx context.Context, arg db.AcknowledgeStationHealthAlertParams)) *MockStore_AcknowledgeStationHealthAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.AcknowledgeStationHealthAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_AcknowledgeStationHealthAlert_Call) Return(_a0 db.ObservationsStationhealthAlert, _a1 error) *MockStore_AcknowledgeStationHealthAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_AcknowledgeStationHealthAlert_Call) RunAndReturn(run func(context.Context, db.AcknowledgeStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)) *MockStore_AcknowledgeStationHealthAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchCreateCurrentObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchCreateCurrentObservations(ctx context.Context, arg []db.BatchCreateCurrentObservationsParams) *db.BatchCreateCurrentObservationsBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchCreateCurrentObservationsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateCurrentObservationsParams) *db.BatchCreateCurrentObservationsBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchCreateCurrentObservationsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchCreateCurrentObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateCurrentObservations'
+type MockStore_BatchCreateCurrentObservations_Call struct {
+	*mock.Call
+}
+
+// BatchCreateCurrentObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateCurrentObservationsParams
+func (_e *MockStore_Expecter) BatchCreateCurrentObservations(ctx interface{}, arg interface{}) *MockStore_BatchCreateCurrentObservations_Call {
+	return &MockStore_BatchCreateCurrentObservations_Call{Call: _e.mock.On("BatchCreateCurrentObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BatchCreateCurrentObservations_Call) Run(run func(ctx context.Context, arg []db.BatchCreateCurrentObservationsParams)) *MockStore_BatchCreateCurrentObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateCurrentObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchCreateCurrentObservations_Call) Return(_a0 *db.BatchCreateCurrentObservationsBatchResults) *MockStore_BatchCreateCurrentObservations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchCreateCurrentObservations_Call) RunAndReturn(run func(context.Context, []db.BatchCreateCurrentObservationsParams) *db.BatchCreateCurrentObservationsBatchResults) *MockStore_BatchCreateCurrentObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchCreateMoObservationQcFlags provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchCreateMoObservationQcFlags(ctx context.Context, arg []db.BatchCreateMoObservationQcFlagsParams) *db.BatchCreateMoObservationQcFlagsBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchCreateMoObservationQcFlagsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateMoObservationQcFlagsParams) *db.BatchCreateMoObservationQcFlagsBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchCreateMoObservationQcFlagsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchCreateMoObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateMoObservationQcFlags'
+type MockStore_BatchCreateMoObservationQcFlags_Call struct {
+	*mock.Call
+}
+
+// BatchCreateMoObservationQcFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateMoObservationQcFlagsParams
+func (_e *MockStore_Expecter) BatchCreateMoObservationQcFlags(ctx interface{}, arg interface{}) *MockStore_BatchCreateMoObservationQcFlags_Call {
+	return &MockStore_BatchCreateMoObservationQcFlags_Call{Call: _e.mock.On("BatchCreateMoObservationQcFlags", ctx, arg)}
+}
+
+func (_c *MockStore_BatchCreateMoObservationQcFlags_Call) Run(run func(ctx context.Context, arg []db.BatchCreateMoObservationQcFlagsParams)) *MockStore_BatchCreateMoObservationQcFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateMoObservationQcFlagsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchCreateMoObservationQcFlags_Call) Return(_a0 *db.BatchCreateMoObservationQcFlagsBatchResults) *MockStore_BatchCreateMoObservationQcFlags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchCreateMoObservationQcFlags_Call) RunAndReturn(run func(context.Context, []db.BatchCreateMoObservationQcFlagsParams) *db.BatchCreateMoObservationQcFlagsBatchResults) *MockStore_BatchCreateMoObservationQcFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchCreateObservationQcFlags provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchCreateObservationQcFlags(ctx context.Context, arg []db.BatchCreateObservationQcFlagsParams) *db.BatchCreateObservationQcFlagsBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchCreateObservationQcFlagsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateObservationQcFlagsParams) *db.BatchCreateObservationQcFlagsBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchCreateObservationQcFlagsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchCreateObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateObservationQcFlags'
+type MockStore_BatchCreateObservationQcFlags_Call struct {
+	*mock.Call
+}
+
+// BatchCreateObservationQcFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateObservationQcFlagsParams
+func (_e *MockStore_Expecter) BatchCreateObservationQcFlags(ctx interface{}, arg interface{}) *MockStore_BatchCreateObservationQcFlags_Call {
+	return &MockStore_BatchCreateObservationQcFlags_Call{Call: _e.mock.On("BatchCreateObservationQcFlags", ctx, arg)}
+}
+
+func (_c *MockStore_BatchCreateObservationQcFlags_Call) Run(run func(ctx context.Context, arg []db.BatchCreateObservationQcFlagsParams)) *MockStore_BatchCreateObservationQcFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateObservationQcFlagsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchCreateObservationQcFlags_Call) Return(_a0 *db.BatchCreateObservationQcFlagsBatchResults) *MockStore_BatchCreateObservationQcFlags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchCreateObservationQcFlags_Call) RunAndReturn(run func(context.Context, []db.BatchCreateObservationQcFlagsParams) *db.BatchCreateObservationQcFlagsBatchResults) *MockStore_BatchCreateObservationQcFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchCreateStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchCreateStationMoObservations(ctx context.Context, arg []db.BatchCreateStationMoObservationsParams) *db.BatchCreateStationMoObservationsBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchCreateStationMoObservationsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateStationMoObservationsParams) *db.BatchCreateStationMoObservationsBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchCreateStationMoObservationsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchCreateStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateStationMoObservations'
+type MockStore_BatchCreateStationMoObservations_Call struct {
+	*mock.Call
+}
+
+// BatchCreateStationMoObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateStationMoObservationsParams
+func (_e *MockStore_Expecter) BatchCreateStationMoObservations(ctx interface{}, arg interface{}) *MockStore_BatchCreateStationMoObservations_Call {
+	return &MockStore_BatchCreateStationMoObservations_Call{Call: _e.mock.On("BatchCreateStationMoObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BatchCreateStationMoObservations_Call) Run(run func(ctx context.Context, arg []db.BatchCreateStationMoObservationsParams)) *MockStore_BatchCreateStationMoObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateStationMoObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchCreateStationMoObservations_Call) Return(_a0 *db.BatchCreateStationMoObservationsBatchResults) *MockStore_BatchCreateStationMoObservations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchCreateStationMoObservations_Call) RunAndReturn(run func(context.Context, []db.BatchCreateStationMoObservationsParams) *db.BatchCreateStationMoObservationsBatchResults) *MockStore_BatchCreateStationMoObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchCreateStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchCreateStationObservations(ctx context.Context, arg []db.BatchCreateStationObservationsParams) *db.BatchCreateStationObservationsBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchCreateStationObservationsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateStationObservationsParams) *db.BatchCreateStationObservationsBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchCreateStationObservationsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchCreateStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateStationObservations'
+type MockStore_BatchCreateStationObservations_Call struct {
+	*mock.Call
+}
+
+// BatchCreateStationObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateStationObservationsParams
+func (_e *MockStore_Expecter) BatchCreateStationObservations(ctx interface{}, arg interface{}) *MockStore_BatchCreateStationObservations_Call {
+	return &MockStore_BatchCreateStationObservations_Call{Call: _e.mock.On("BatchCreateStationObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BatchCreateStationObservations_Call) Run(run func(ctx context.Context, arg []db.BatchCreateStationObservationsParams)) *MockStore_BatchCreateStationObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateStationObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchCreateStationObservations_Call) Return(_a0 *db.BatchCreateStationObservationsBatchResults) *MockStore_BatchCreateStationObservations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchCreateStationObservations_Call) RunAndReturn(run func(context.Context, []db.BatchCreateStationObservationsParams) *db.BatchCreateStationObservationsBatchResults) *MockStore_BatchCreateStationObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BatchCreateUserRoles provides a mock function with given fields: ctx, arg
 func (_m *MockStore) BatchCreateUserRoles(ctx context.Context, arg []db.BatchCreateUserRolesParams) *db.BatchCreateUserRolesBatchResults {
 	ret := _m.Called(ctx, arg)
@@ -35,206 +313,5711 @@ func (_m *MockStore) BatchCreateUserRoles(ctx context.Context, arg []db.BatchCre
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*db.BatchCreateUserRolesBatchResults)
+			r0 = ret.Get(0).(*db.BatchCreateUserRolesBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchCreateUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateUserRoles'
+type MockStore_BatchCreateUserRoles_Call struct {
+	*mock.Call
+}
+
+// BatchCreateUserRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateUserRolesParams
+func (_e *MockStore_Expecter) BatchCreateUserRoles(ctx interface{}, arg interface{}) *MockStore_BatchCreateUserRoles_Call {
+	return &MockStore_BatchCreateUserRoles_Call{Call: _e.mock.On("BatchCreateUserRoles", ctx, arg)}
+}
+
+func (_c *MockStore_BatchCreateUserRoles_Call) Run(run func(ctx context.Context, arg []db.BatchCreateUserRolesParams)) *MockStore_BatchCreateUserRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateUserRolesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchCreateUserRoles_Call) Return(_a0 *db.BatchCreateUserRolesBatchResults) *MockStore_BatchCreateUserRoles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchCreateUserRoles_Call) RunAndReturn(run func(context.Context, []db.BatchCreateUserRolesParams) *db.BatchCreateUserRolesBatchResults) *MockStore_BatchCreateUserRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchDeleteMoObservationQcFlags provides a mock function with given fields: ctx, moObservationID
+func (_m *MockStore) BatchDeleteMoObservationQcFlags(ctx context.Context, moObservationID []int64) *db.BatchDeleteMoObservationQcFlagsBatchResults {
+	ret := _m.Called(ctx, moObservationID)
+
+	var r0 *db.BatchDeleteMoObservationQcFlagsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) *db.BatchDeleteMoObservationQcFlagsBatchResults); ok {
+		r0 = rf(ctx, moObservationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchDeleteMoObservationQcFlagsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchDeleteMoObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchDeleteMoObservationQcFlags'
+type MockStore_BatchDeleteMoObservationQcFlags_Call struct {
+	*mock.Call
+}
+
+// BatchDeleteMoObservationQcFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moObservationID []int64
+func (_e *MockStore_Expecter) BatchDeleteMoObservationQcFlags(ctx interface{}, moObservationID interface{}) *MockStore_BatchDeleteMoObservationQcFlags_Call {
+	return &MockStore_BatchDeleteMoObservationQcFlags_Call{Call: _e.mock.On("BatchDeleteMoObservationQcFlags", ctx, moObservationID)}
+}
+
+func (_c *MockStore_BatchDeleteMoObservationQcFlags_Call) Run(run func(ctx context.Context, moObservationID []int64)) *MockStore_BatchDeleteMoObservationQcFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchDeleteMoObservationQcFlags_Call) Return(_a0 *db.BatchDeleteMoObservationQcFlagsBatchResults) *MockStore_BatchDeleteMoObservationQcFlags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchDeleteMoObservationQcFlags_Call) RunAndReturn(run func(context.Context, []int64) *db.BatchDeleteMoObservationQcFlagsBatchResults) *MockStore_BatchDeleteMoObservationQcFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchDeleteObservationQcFlags provides a mock function with given fields: ctx, observationID
+func (_m *MockStore) BatchDeleteObservationQcFlags(ctx context.Context, observationID []int64) *db.BatchDeleteObservationQcFlagsBatchResults {
+	ret := _m.Called(ctx, observationID)
+
+	var r0 *db.BatchDeleteObservationQcFlagsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) *db.BatchDeleteObservationQcFlagsBatchResults); ok {
+		r0 = rf(ctx, observationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchDeleteObservationQcFlagsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchDeleteObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchDeleteObservationQcFlags'
+type MockStore_BatchDeleteObservationQcFlags_Call struct {
+	*mock.Call
+}
+
+// BatchDeleteObservationQcFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - observationID []int64
+func (_e *MockStore_Expecter) BatchDeleteObservationQcFlags(ctx interface{}, observationID interface{}) *MockStore_BatchDeleteObservationQcFlags_Call {
+	return &MockStore_BatchDeleteObservationQcFlags_Call{Call: _e.mock.On("BatchDeleteObservationQcFlags", ctx, observationID)}
+}
+
+func (_c *MockStore_BatchDeleteObservationQcFlags_Call) Run(run func(ctx context.Context, observationID []int64)) *MockStore_BatchDeleteObservationQcFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchDeleteObservationQcFlags_Call) Return(_a0 *db.BatchDeleteObservationQcFlagsBatchResults) *MockStore_BatchDeleteObservationQcFlags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchDeleteObservationQcFlags_Call) RunAndReturn(run func(context.Context, []int64) *db.BatchDeleteObservationQcFlagsBatchResults) *MockStore_BatchDeleteObservationQcFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchDeleteUserRoles provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchDeleteUserRoles(ctx context.Context, arg []db.BatchDeleteUserRolesParams) *db.BatchDeleteUserRolesBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchDeleteUserRolesBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchDeleteUserRolesParams) *db.BatchDeleteUserRolesBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchDeleteUserRolesBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchDeleteUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchDeleteUserRoles'
+type MockStore_BatchDeleteUserRoles_Call struct {
+	*mock.Call
+}
+
+// BatchDeleteUserRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchDeleteUserRolesParams
+func (_e *MockStore_Expecter) BatchDeleteUserRoles(ctx interface{}, arg interface{}) *MockStore_BatchDeleteUserRoles_Call {
+	return &MockStore_BatchDeleteUserRoles_Call{Call: _e.mock.On("BatchDeleteUserRoles", ctx, arg)}
+}
+
+func (_c *MockStore_BatchDeleteUserRoles_Call) Run(run func(ctx context.Context, arg []db.BatchDeleteUserRolesParams)) *MockStore_BatchDeleteUserRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchDeleteUserRolesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchDeleteUserRoles_Call) Return(_a0 *db.BatchDeleteUserRolesBatchResults) *MockStore_BatchDeleteUserRoles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchDeleteUserRoles_Call) RunAndReturn(run func(context.Context, []db.BatchDeleteUserRolesParams) *db.BatchDeleteUserRolesBatchResults) *MockStore_BatchDeleteUserRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchUpdateMoObservationQcLevel provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchUpdateMoObservationQcLevel(ctx context.Context, arg []db.BatchUpdateMoObservationQcLevelParams) *db.BatchUpdateMoObservationQcLevelBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchUpdateMoObservationQcLevelBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchUpdateMoObservationQcLevelParams) *db.BatchUpdateMoObservationQcLevelBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchUpdateMoObservationQcLevelBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchUpdateMoObservationQcLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUpdateMoObservationQcLevel'
+type MockStore_BatchUpdateMoObservationQcLevel_Call struct {
+	*mock.Call
+}
+
+// BatchUpdateMoObservationQcLevel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchUpdateMoObservationQcLevelParams
+func (_e *MockStore_Expecter) BatchUpdateMoObservationQcLevel(ctx interface{}, arg interface{}) *MockStore_BatchUpdateMoObservationQcLevel_Call {
+	return &MockStore_BatchUpdateMoObservationQcLevel_Call{Call: _e.mock.On("BatchUpdateMoObservationQcLevel", ctx, arg)}
+}
+
+func (_c *MockStore_BatchUpdateMoObservationQcLevel_Call) Run(run func(ctx context.Context, arg []db.BatchUpdateMoObservationQcLevelParams)) *MockStore_BatchUpdateMoObservationQcLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchUpdateMoObservationQcLevelParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchUpdateMoObservationQcLevel_Call) Return(_a0 *db.BatchUpdateMoObservationQcLevelBatchResults) *MockStore_BatchUpdateMoObservationQcLevel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchUpdateMoObservationQcLevel_Call) RunAndReturn(run func(context.Context, []db.BatchUpdateMoObservationQcLevelParams) *db.BatchUpdateMoObservationQcLevelBatchResults) *MockStore_BatchUpdateMoObservationQcLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchUpdateObservationQcLevel provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchUpdateObservationQcLevel(ctx context.Context, arg []db.BatchUpdateObservationQcLevelParams) *db.BatchUpdateObservationQcLevelBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchUpdateObservationQcLevelBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchUpdateObservationQcLevelParams) *db.BatchUpdateObservationQcLevelBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchUpdateObservationQcLevelBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchUpdateObservationQcLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUpdateObservationQcLevel'
+type MockStore_BatchUpdateObservationQcLevel_Call struct {
+	*mock.Call
+}
+
+// BatchUpdateObservationQcLevel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchUpdateObservationQcLevelParams
+func (_e *MockStore_Expecter) BatchUpdateObservationQcLevel(ctx interface{}, arg interface{}) *MockStore_BatchUpdateObservationQcLevel_Call {
+	return &MockStore_BatchUpdateObservationQcLevel_Call{Call: _e.mock.On("BatchUpdateObservationQcLevel", ctx, arg)}
+}
+
+func (_c *MockStore_BatchUpdateObservationQcLevel_Call) Run(run func(ctx context.Context, arg []db.BatchUpdateObservationQcLevelParams)) *MockStore_BatchUpdateObservationQcLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchUpdateObservationQcLevelParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchUpdateObservationQcLevel_Call) Return(_a0 *db.BatchUpdateObservationQcLevelBatchResults) *MockStore_BatchUpdateObservationQcLevel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchUpdateObservationQcLevel_Call) RunAndReturn(run func(context.Context, []db.BatchUpdateObservationQcLevelParams) *db.BatchUpdateObservationQcLevelBatchResults) *MockStore_BatchUpdateObservationQcLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchUpdateStationStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchUpdateStationStatus(ctx context.Context, arg []db.BatchUpdateStationStatusParams) *db.BatchUpdateStationStatusBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchUpdateStationStatusBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchUpdateStationStatusParams) *db.BatchUpdateStationStatusBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchUpdateStationStatusBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchUpdateStationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUpdateStationStatus'
+type MockStore_BatchUpdateStationStatus_Call struct {
+	*mock.Call
+}
+
+// BatchUpdateStationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchUpdateStationStatusParams
+func (_e *MockStore_Expecter) BatchUpdateStationStatus(ctx interface{}, arg interface{}) *MockStore_BatchUpdateStationStatus_Call {
+	return &MockStore_BatchUpdateStationStatus_Call{Call: _e.mock.On("BatchUpdateStationStatus", ctx, arg)}
+}
+
+func (_c *MockStore_BatchUpdateStationStatus_Call) Run(run func(ctx context.Context, arg []db.BatchUpdateStationStatusParams)) *MockStore_BatchUpdateStationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchUpdateStationStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchUpdateStationStatus_Call) Return(_a0 *db.BatchUpdateStationStatusBatchResults) *MockStore_BatchUpdateStationStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchUpdateStationStatus_Call) RunAndReturn(run func(context.Context, []db.BatchUpdateStationStatusParams) *db.BatchUpdateStationStatusBatchResults) *MockStore_BatchUpdateStationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchUpsertStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BatchUpsertStationMoObservations(ctx context.Context, arg []db.BatchUpsertStationMoObservationsParams) *db.BatchUpsertStationMoObservationsBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *db.BatchUpsertStationMoObservationsBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchUpsertStationMoObservationsParams) *db.BatchUpsertStationMoObservationsBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BatchUpsertStationMoObservationsBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BatchUpsertStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUpsertStationMoObservations'
+type MockStore_BatchUpsertStationMoObservations_Call struct {
+	*mock.Call
+}
+
+// BatchUpsertStationMoObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchUpsertStationMoObservationsParams
+func (_e *MockStore_Expecter) BatchUpsertStationMoObservations(ctx interface{}, arg interface{}) *MockStore_BatchUpsertStationMoObservations_Call {
+	return &MockStore_BatchUpsertStationMoObservations_Call{Call: _e.mock.On("BatchUpsertStationMoObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BatchUpsertStationMoObservations_Call) Run(run func(ctx context.Context, arg []db.BatchUpsertStationMoObservationsParams)) *MockStore_BatchUpsertStationMoObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchUpsertStationMoObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BatchUpsertStationMoObservations_Call) Return(_a0 *db.BatchUpsertStationMoObservationsBatchResults) *MockStore_BatchUpsertStationMoObservations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BatchUpsertStationMoObservations_Call) RunAndReturn(run func(context.Context, []db.BatchUpsertStationMoObservationsParams) *db.BatchUpsertStationMoObservationsBatchResults) *MockStore_BatchUpsertStationMoObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkCreateCurrentObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkCreateCurrentObservations(ctx context.Context, arg []db.BatchCreateCurrentObservationsParams) (int, []error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int
+	var r1 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateCurrentObservationsParams) (int, []error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateCurrentObservationsParams) int); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []db.BatchCreateCurrentObservationsParams) []error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockStore_BulkCreateCurrentObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateCurrentObservations'
+type MockStore_BulkCreateCurrentObservations_Call struct {
+	*mock.Call
+}
+
+// BulkCreateCurrentObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateCurrentObservationsParams
+func (_e *MockStore_Expecter) BulkCreateCurrentObservations(ctx interface{}, arg interface{}) *MockStore_BulkCreateCurrentObservations_Call {
+	return &MockStore_BulkCreateCurrentObservations_Call{Call: _e.mock.On("BulkCreateCurrentObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BulkCreateCurrentObservations_Call) Run(run func(ctx context.Context, arg []db.BatchCreateCurrentObservationsParams)) *MockStore_BulkCreateCurrentObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateCurrentObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkCreateCurrentObservations_Call) Return(n int, errs []error) *MockStore_BulkCreateCurrentObservations_Call {
+	_c.Call.Return(n, errs)
+	return _c
+}
+
+func (_c *MockStore_BulkCreateCurrentObservations_Call) RunAndReturn(run func(context.Context, []db.BatchCreateCurrentObservationsParams) (int, []error)) *MockStore_BulkCreateCurrentObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkCreateStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkCreateStationMoObservations(ctx context.Context, arg []db.BatchCreateStationMoObservationsParams) (int, []error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int
+	var r1 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateStationMoObservationsParams) (int, []error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateStationMoObservationsParams) int); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []db.BatchCreateStationMoObservationsParams) []error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockStore_BulkCreateStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateStationMoObservations'
+type MockStore_BulkCreateStationMoObservations_Call struct {
+	*mock.Call
+}
+
+// BulkCreateStationMoObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateStationMoObservationsParams
+func (_e *MockStore_Expecter) BulkCreateStationMoObservations(ctx interface{}, arg interface{}) *MockStore_BulkCreateStationMoObservations_Call {
+	return &MockStore_BulkCreateStationMoObservations_Call{Call: _e.mock.On("BulkCreateStationMoObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BulkCreateStationMoObservations_Call) Run(run func(ctx context.Context, arg []db.BatchCreateStationMoObservationsParams)) *MockStore_BulkCreateStationMoObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateStationMoObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkCreateStationMoObservations_Call) Return(n int, errs []error) *MockStore_BulkCreateStationMoObservations_Call {
+	_c.Call.Return(n, errs)
+	return _c
+}
+
+func (_c *MockStore_BulkCreateStationMoObservations_Call) RunAndReturn(run func(context.Context, []db.BatchCreateStationMoObservationsParams) (int, []error)) *MockStore_BulkCreateStationMoObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkCreateStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkCreateStationObservations(ctx context.Context, arg []db.BatchCreateStationObservationsParams) ([]int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateStationObservationsParams) ([]int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchCreateStationObservationsParams) []int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []db.BatchCreateStationObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_BulkCreateStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateStationObservations'
+type MockStore_BulkCreateStationObservations_Call struct {
+	*mock.Call
+}
+
+// BulkCreateStationObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchCreateStationObservationsParams
+func (_e *MockStore_Expecter) BulkCreateStationObservations(ctx interface{}, arg interface{}) *MockStore_BulkCreateStationObservations_Call {
+	return &MockStore_BulkCreateStationObservations_Call{Call: _e.mock.On("BulkCreateStationObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BulkCreateStationObservations_Call) Run(run func(ctx context.Context, arg []db.BatchCreateStationObservationsParams)) *MockStore_BulkCreateStationObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchCreateStationObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkCreateStationObservations_Call) Return(ids []int64, err error) *MockStore_BulkCreateStationObservations_Call {
+	_c.Call.Return(ids, err)
+	return _c
+}
+
+func (_c *MockStore_BulkCreateStationObservations_Call) RunAndReturn(run func(context.Context, []db.BatchCreateStationObservationsParams) ([]int64, error)) *MockStore_BulkCreateStationObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkCreateUserRoles provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkCreateUserRoles(ctx context.Context, arg []db.UserRolesParams) ([]db.UserRolesParams, []error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []db.UserRolesParams
+	var r1 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.UserRolesParams) ([]db.UserRolesParams, []error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []db.UserRolesParams) []db.UserRolesParams); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.UserRolesParams)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []db.UserRolesParams) []error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockStore_BulkCreateUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateUserRoles'
+type MockStore_BulkCreateUserRoles_Call struct {
+	*mock.Call
+}
+
+// BulkCreateUserRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.UserRolesParams
+func (_e *MockStore_Expecter) BulkCreateUserRoles(ctx interface{}, arg interface{}) *MockStore_BulkCreateUserRoles_Call {
+	return &MockStore_BulkCreateUserRoles_Call{Call: _e.mock.On("BulkCreateUserRoles", ctx, arg)}
+}
+
+func (_c *MockStore_BulkCreateUserRoles_Call) Run(run func(ctx context.Context, arg []db.UserRolesParams)) *MockStore_BulkCreateUserRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.UserRolesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkCreateUserRoles_Call) Return(ret []db.UserRolesParams, errs []error) *MockStore_BulkCreateUserRoles_Call {
+	_c.Call.Return(ret, errs)
+	return _c
+}
+
+func (_c *MockStore_BulkCreateUserRoles_Call) RunAndReturn(run func(context.Context, []db.UserRolesParams) ([]db.UserRolesParams, []error)) *MockStore_BulkCreateUserRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkDeleteUserRoles provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkDeleteUserRoles(ctx context.Context, arg []db.UserRolesParams) []error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.UserRolesParams) []error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BulkDeleteUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDeleteUserRoles'
+type MockStore_BulkDeleteUserRoles_Call struct {
+	*mock.Call
+}
+
+// BulkDeleteUserRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.UserRolesParams
+func (_e *MockStore_Expecter) BulkDeleteUserRoles(ctx interface{}, arg interface{}) *MockStore_BulkDeleteUserRoles_Call {
+	return &MockStore_BulkDeleteUserRoles_Call{Call: _e.mock.On("BulkDeleteUserRoles", ctx, arg)}
+}
+
+func (_c *MockStore_BulkDeleteUserRoles_Call) Run(run func(ctx context.Context, arg []db.UserRolesParams)) *MockStore_BulkDeleteUserRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.UserRolesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkDeleteUserRoles_Call) Return(_a0 []error) *MockStore_BulkDeleteUserRoles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BulkDeleteUserRoles_Call) RunAndReturn(run func(context.Context, []db.UserRolesParams) []error) *MockStore_BulkDeleteUserRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateMoObservationQc provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkUpdateMoObservationQc(ctx context.Context, arg []db.UpdateObservationQcTxParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.UpdateObservationQcTxParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_BulkUpdateMoObservationQc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateMoObservationQc'
+type MockStore_BulkUpdateMoObservationQc_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateMoObservationQc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.UpdateObservationQcTxParams
+func (_e *MockStore_Expecter) BulkUpdateMoObservationQc(ctx interface{}, arg interface{}) *MockStore_BulkUpdateMoObservationQc_Call {
+	return &MockStore_BulkUpdateMoObservationQc_Call{Call: _e.mock.On("BulkUpdateMoObservationQc", ctx, arg)}
+}
+
+func (_c *MockStore_BulkUpdateMoObservationQc_Call) Run(run func(ctx context.Context, arg []db.UpdateObservationQcTxParams)) *MockStore_BulkUpdateMoObservationQc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.UpdateObservationQcTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkUpdateMoObservationQc_Call) Return(_a0 error) *MockStore_BulkUpdateMoObservationQc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BulkUpdateMoObservationQc_Call) RunAndReturn(run func(context.Context, []db.UpdateObservationQcTxParams) error) *MockStore_BulkUpdateMoObservationQc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateObservationQc provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkUpdateObservationQc(ctx context.Context, arg []db.UpdateObservationQcTxParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.UpdateObservationQcTxParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_BulkUpdateObservationQc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateObservationQc'
+type MockStore_BulkUpdateObservationQc_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateObservationQc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.UpdateObservationQcTxParams
+func (_e *MockStore_Expecter) BulkUpdateObservationQc(ctx interface{}, arg interface{}) *MockStore_BulkUpdateObservationQc_Call {
+	return &MockStore_BulkUpdateObservationQc_Call{Call: _e.mock.On("BulkUpdateObservationQc", ctx, arg)}
+}
+
+func (_c *MockStore_BulkUpdateObservationQc_Call) Run(run func(ctx context.Context, arg []db.UpdateObservationQcTxParams)) *MockStore_BulkUpdateObservationQc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.UpdateObservationQcTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkUpdateObservationQc_Call) Return(_a0 error) *MockStore_BulkUpdateObservationQc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BulkUpdateObservationQc_Call) RunAndReturn(run func(context.Context, []db.UpdateObservationQcTxParams) error) *MockStore_BulkUpdateObservationQc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateStationStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkUpdateStationStatus(ctx context.Context, arg []db.BatchUpdateStationStatusParams) []error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchUpdateStationStatusParams) []error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BulkUpdateStationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateStationStatus'
+type MockStore_BulkUpdateStationStatus_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateStationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchUpdateStationStatusParams
+func (_e *MockStore_Expecter) BulkUpdateStationStatus(ctx interface{}, arg interface{}) *MockStore_BulkUpdateStationStatus_Call {
+	return &MockStore_BulkUpdateStationStatus_Call{Call: _e.mock.On("BulkUpdateStationStatus", ctx, arg)}
+}
+
+func (_c *MockStore_BulkUpdateStationStatus_Call) Run(run func(ctx context.Context, arg []db.BatchUpdateStationStatusParams)) *MockStore_BulkUpdateStationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchUpdateStationStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkUpdateStationStatus_Call) Return(_a0 []error) *MockStore_BulkUpdateStationStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BulkUpdateStationStatus_Call) RunAndReturn(run func(context.Context, []db.BatchUpdateStationStatusParams) []error) *MockStore_BulkUpdateStationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpsertStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) BulkUpsertStationMoObservations(ctx context.Context, arg []db.BatchUpsertStationMoObservationsParams) []error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchUpsertStationMoObservationsParams) []error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+// MockStore_BulkUpsertStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpsertStationMoObservations'
+type MockStore_BulkUpsertStationMoObservations_Call struct {
+	*mock.Call
+}
+
+// BulkUpsertStationMoObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []db.BatchUpsertStationMoObservationsParams
+func (_e *MockStore_Expecter) BulkUpsertStationMoObservations(ctx interface{}, arg interface{}) *MockStore_BulkUpsertStationMoObservations_Call {
+	return &MockStore_BulkUpsertStationMoObservations_Call{Call: _e.mock.On("BulkUpsertStationMoObservations", ctx, arg)}
+}
+
+func (_c *MockStore_BulkUpsertStationMoObservations_Call) Run(run func(ctx context.Context, arg []db.BatchUpsertStationMoObservationsParams)) *MockStore_BulkUpsertStationMoObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db.BatchUpsertStationMoObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_BulkUpsertStationMoObservations_Call) Return(_a0 []error) *MockStore_BulkUpsertStationMoObservations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_BulkUpsertStationMoObservations_Call) RunAndReturn(run func(context.Context, []db.BatchUpsertStationMoObservationsParams) []error) *MockStore_BulkUpsertStationMoObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelPendingSmsMessages provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CancelPendingSmsMessages(ctx context.Context, arg db.CancelPendingSmsMessagesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CancelPendingSmsMessagesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CancelPendingSmsMessagesParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CancelPendingSmsMessagesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CancelPendingSmsMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPendingSmsMessages'
+type MockStore_CancelPendingSmsMessages_Call struct {
+	*mock.Call
+}
+
+// CancelPendingSmsMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CancelPendingSmsMessagesParams
+func (_e *MockStore_Expecter) CancelPendingSmsMessages(ctx interface{}, arg interface{}) *MockStore_CancelPendingSmsMessages_Call {
+	return &MockStore_CancelPendingSmsMessages_Call{Call: _e.mock.On("CancelPendingSmsMessages", ctx, arg)}
+}
+
+func (_c *MockStore_CancelPendingSmsMessages_Call) Run(run func(ctx context.Context, arg db.CancelPendingSmsMessagesParams)) *MockStore_CancelPendingSmsMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CancelPendingSmsMessagesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CancelPendingSmsMessages_Call) Return(_a0 int64, _a1 error) *MockStore_CancelPendingSmsMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CancelPendingSmsMessages_Call) RunAndReturn(run func(context.Context, db.CancelPendingSmsMessagesParams) (int64, error)) *MockStore_CancelPendingSmsMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteLoadRequest provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CompleteLoadRequest(ctx context.Context, arg db.CompleteLoadRequestParams) (db.LoadRequest, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.LoadRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CompleteLoadRequestParams) (db.LoadRequest, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CompleteLoadRequestParams) db.LoadRequest); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.LoadRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CompleteLoadRequestParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CompleteLoadRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteLoadRequest'
+type MockStore_CompleteLoadRequest_Call struct {
+	*mock.Call
+}
+
+// CompleteLoadRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CompleteLoadRequestParams
+func (_e *MockStore_Expecter) CompleteLoadRequest(ctx interface{}, arg interface{}) *MockStore_CompleteLoadRequest_Call {
+	return &MockStore_CompleteLoadRequest_Call{Call: _e.mock.On("CompleteLoadRequest", ctx, arg)}
+}
+
+func (_c *MockStore_CompleteLoadRequest_Call) Run(run func(ctx context.Context, arg db.CompleteLoadRequestParams)) *MockStore_CompleteLoadRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CompleteLoadRequestParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CompleteLoadRequest_Call) Return(_a0 db.LoadRequest, _a1 error) *MockStore_CompleteLoadRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CompleteLoadRequest_Call) RunAndReturn(run func(context.Context, db.CompleteLoadRequestParams) (db.LoadRequest, error)) *MockStore_CompleteLoadRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountGLabsLoads provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) CountGLabsLoads(ctx context.Context, mobileNumber string) (int64, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountGLabsLoads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountGLabsLoads'
+type MockStore_CountGLabsLoads_Call struct {
+	*mock.Call
+}
+
+// CountGLabsLoads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) CountGLabsLoads(ctx interface{}, mobileNumber interface{}) *MockStore_CountGLabsLoads_Call {
+	return &MockStore_CountGLabsLoads_Call{Call: _e.mock.On("CountGLabsLoads", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_CountGLabsLoads_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_CountGLabsLoads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountGLabsLoads_Call) Return(_a0 int64, _a1 error) *MockStore_CountGLabsLoads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountGLabsLoads_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockStore_CountGLabsLoads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountJobRuns provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountJobRuns(ctx context.Context, arg db.CountJobRunsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountJobRunsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountJobRunsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountJobRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountJobRuns'
+type MockStore_CountJobRuns_Call struct {
+	*mock.Call
+}
+
+// CountJobRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountJobRunsParams
+func (_e *MockStore_Expecter) CountJobRuns(ctx interface{}, arg interface{}) *MockStore_CountJobRuns_Call {
+	return &MockStore_CountJobRuns_Call{Call: _e.mock.On("CountJobRuns", ctx, arg)}
+}
+
+func (_c *MockStore_CountJobRuns_Call) Run(run func(ctx context.Context, arg db.CountJobRunsParams)) *MockStore_CountJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountJobRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountJobRuns_Call) Return(_a0 int64, _a1 error) *MockStore_CountJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountJobRuns_Call) RunAndReturn(run func(context.Context, db.CountJobRunsParams) (int64, error)) *MockStore_CountJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountLoadRequests provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountLoadRequests(ctx context.Context, arg db.CountLoadRequestsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountLoadRequestsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountLoadRequestsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountLoadRequestsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountLoadRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLoadRequests'
+type MockStore_CountLoadRequests_Call struct {
+	*mock.Call
+}
+
+// CountLoadRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountLoadRequestsParams
+func (_e *MockStore_Expecter) CountLoadRequests(ctx interface{}, arg interface{}) *MockStore_CountLoadRequests_Call {
+	return &MockStore_CountLoadRequests_Call{Call: _e.mock.On("CountLoadRequests", ctx, arg)}
+}
+
+func (_c *MockStore_CountLoadRequests_Call) Run(run func(ctx context.Context, arg db.CountLoadRequestsParams)) *MockStore_CountLoadRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountLoadRequestsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountLoadRequests_Call) Return(_a0 int64, _a1 error) *MockStore_CountLoadRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountLoadRequests_Call) RunAndReturn(run func(context.Context, db.CountLoadRequestsParams) (int64, error)) *MockStore_CountLoadRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountLufftStationMsg provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) CountLufftStationMsg(ctx context.Context, stationID int64) (int64, error) {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLufftStationMsg'
+type MockStore_CountLufftStationMsg_Call struct {
+	*mock.Call
+}
+
+// CountLufftStationMsg is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) CountLufftStationMsg(ctx interface{}, stationID interface{}) *MockStore_CountLufftStationMsg_Call {
+	return &MockStore_CountLufftStationMsg_Call{Call: _e.mock.On("CountLufftStationMsg", ctx, stationID)}
+}
+
+func (_c *MockStore_CountLufftStationMsg_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_CountLufftStationMsg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountLufftStationMsg_Call) Return(_a0 int64, _a1 error) *MockStore_CountLufftStationMsg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountLufftStationMsg_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_CountLufftStationMsg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountObservations(ctx context.Context, arg db.CountObservationsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountObservations'
+type MockStore_CountObservations_Call struct {
+	*mock.Call
+}
+
+// CountObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountObservationsParams
+func (_e *MockStore_Expecter) CountObservations(ctx interface{}, arg interface{}) *MockStore_CountObservations_Call {
+	return &MockStore_CountObservations_Call{Call: _e.mock.On("CountObservations", ctx, arg)}
+}
+
+func (_c *MockStore_CountObservations_Call) Run(run func(ctx context.Context, arg db.CountObservationsParams)) *MockStore_CountObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountObservations_Call) RunAndReturn(run func(context.Context, db.CountObservationsParams) (int64, error)) *MockStore_CountObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountRawMessages provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountRawMessages(ctx context.Context, arg db.CountRawMessagesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountRawMessagesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountRawMessagesParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountRawMessagesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountRawMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRawMessages'
+type MockStore_CountRawMessages_Call struct {
+	*mock.Call
+}
+
+// CountRawMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountRawMessagesParams
+func (_e *MockStore_Expecter) CountRawMessages(ctx interface{}, arg interface{}) *MockStore_CountRawMessages_Call {
+	return &MockStore_CountRawMessages_Call{Call: _e.mock.On("CountRawMessages", ctx, arg)}
+}
+
+func (_c *MockStore_CountRawMessages_Call) Run(run func(ctx context.Context, arg db.CountRawMessagesParams)) *MockStore_CountRawMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountRawMessagesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountRawMessages_Call) Return(_a0 int64, _a1 error) *MockStore_CountRawMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountRawMessages_Call) RunAndReturn(run func(context.Context, db.CountRawMessagesParams) (int64, error)) *MockStore_CountRawMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountRoles provides a mock function with given fields: ctx
+func (_m *MockStore) CountRoles(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRoles'
+type MockStore_CountRoles_Call struct {
+	*mock.Call
+}
+
+// CountRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) CountRoles(ctx interface{}) *MockStore_CountRoles_Call {
+	return &MockStore_CountRoles_Call{Call: _e.mock.On("CountRoles", ctx)}
+}
+
+func (_c *MockStore_CountRoles_Call) Run(run func(ctx context.Context)) *MockStore_CountRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountRoles_Call) Return(_a0 int64, _a1 error) *MockStore_CountRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountRoles_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSimCards provides a mock function with given fields: ctx, isFlagged
+func (_m *MockStore) CountSimCards(ctx context.Context, isFlagged bool) (int64, error) {
+	ret := _m.Called(ctx, isFlagged)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (int64, error)); ok {
+		return rf(ctx, isFlagged)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) int64); ok {
+		r0 = rf(ctx, isFlagged)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, isFlagged)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountSimCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSimCards'
+type MockStore_CountSimCards_Call struct {
+	*mock.Call
+}
+
+// CountSimCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - isFlagged bool
+func (_e *MockStore_Expecter) CountSimCards(ctx interface{}, isFlagged interface{}) *MockStore_CountSimCards_Call {
+	return &MockStore_CountSimCards_Call{Call: _e.mock.On("CountSimCards", ctx, isFlagged)}
+}
+
+func (_c *MockStore_CountSimCards_Call) Run(run func(ctx context.Context, isFlagged bool)) *MockStore_CountSimCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountSimCards_Call) Return(_a0 int64, _a1 error) *MockStore_CountSimCards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountSimCards_Call) RunAndReturn(run func(context.Context, bool) (int64, error)) *MockStore_CountSimCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSmsMessages provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSmsMessages(ctx context.Context, arg db.CountSmsMessagesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsMessagesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsMessagesParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountSmsMessagesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountSmsMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSmsMessages'
+type MockStore_CountSmsMessages_Call struct {
+	*mock.Call
+}
+
+// CountSmsMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountSmsMessagesParams
+func (_e *MockStore_Expecter) CountSmsMessages(ctx interface{}, arg interface{}) *MockStore_CountSmsMessages_Call {
+	return &MockStore_CountSmsMessages_Call{Call: _e.mock.On("CountSmsMessages", ctx, arg)}
+}
+
+func (_c *MockStore_CountSmsMessages_Call) Run(run func(ctx context.Context, arg db.CountSmsMessagesParams)) *MockStore_CountSmsMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountSmsMessagesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountSmsMessages_Call) Return(_a0 int64, _a1 error) *MockStore_CountSmsMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountSmsMessages_Call) RunAndReturn(run func(context.Context, db.CountSmsMessagesParams) (int64, error)) *MockStore_CountSmsMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSmsQueries provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSmsQueries(ctx context.Context, arg db.CountSmsQueriesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsQueriesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsQueriesParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountSmsQueriesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountSmsQueries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSmsQueries'
+type MockStore_CountSmsQueries_Call struct {
+	*mock.Call
+}
+
+// CountSmsQueries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountSmsQueriesParams
+func (_e *MockStore_Expecter) CountSmsQueries(ctx interface{}, arg interface{}) *MockStore_CountSmsQueries_Call {
+	return &MockStore_CountSmsQueries_Call{Call: _e.mock.On("CountSmsQueries", ctx, arg)}
+}
+
+func (_c *MockStore_CountSmsQueries_Call) Run(run func(ctx context.Context, arg db.CountSmsQueriesParams)) *MockStore_CountSmsQueries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountSmsQueriesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountSmsQueries_Call) Return(_a0 int64, _a1 error) *MockStore_CountSmsQueries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountSmsQueries_Call) RunAndReturn(run func(context.Context, db.CountSmsQueriesParams) (int64, error)) *MockStore_CountSmsQueries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountSmsSubscriptions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountSmsSubscriptions(ctx context.Context, arg db.CountSmsSubscriptionsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsSubscriptionsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountSmsSubscriptionsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountSmsSubscriptionsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountSmsSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSmsSubscriptions'
+type MockStore_CountSmsSubscriptions_Call struct {
+	*mock.Call
+}
+
+// CountSmsSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountSmsSubscriptionsParams
+func (_e *MockStore_Expecter) CountSmsSubscriptions(ctx interface{}, arg interface{}) *MockStore_CountSmsSubscriptions_Call {
+	return &MockStore_CountSmsSubscriptions_Call{Call: _e.mock.On("CountSmsSubscriptions", ctx, arg)}
+}
+
+func (_c *MockStore_CountSmsSubscriptions_Call) Run(run func(ctx context.Context, arg db.CountSmsSubscriptionsParams)) *MockStore_CountSmsSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountSmsSubscriptionsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountSmsSubscriptions_Call) Return(_a0 int64, _a1 error) *MockStore_CountSmsSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountSmsSubscriptions_Call) RunAndReturn(run func(context.Context, db.CountSmsSubscriptionsParams) (int64, error)) *MockStore_CountSmsSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationDailyObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationDailyObservations(ctx context.Context, arg db.CountStationDailyObservationsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationDailyObservationsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationDailyObservationsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationDailyObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationDailyObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationDailyObservations'
+type MockStore_CountStationDailyObservations_Call struct {
+	*mock.Call
+}
+
+// CountStationDailyObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationDailyObservationsParams
+func (_e *MockStore_Expecter) CountStationDailyObservations(ctx interface{}, arg interface{}) *MockStore_CountStationDailyObservations_Call {
+	return &MockStore_CountStationDailyObservations_Call{Call: _e.mock.On("CountStationDailyObservations", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationDailyObservations_Call) Run(run func(ctx context.Context, arg db.CountStationDailyObservationsParams)) *MockStore_CountStationDailyObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationDailyObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationDailyObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationDailyObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationDailyObservations_Call) RunAndReturn(run func(context.Context, db.CountStationDailyObservationsParams) (int64, error)) *MockStore_CountStationDailyObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationHealthAlerts provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationHealthAlerts(ctx context.Context, arg db.CountStationHealthAlertsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationHealthAlertsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationHealthAlertsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationHealthAlertsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationHealthAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationHealthAlerts'
+type MockStore_CountStationHealthAlerts_Call struct {
+	*mock.Call
+}
+
+// CountStationHealthAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationHealthAlertsParams
+func (_e *MockStore_Expecter) CountStationHealthAlerts(ctx interface{}, arg interface{}) *MockStore_CountStationHealthAlerts_Call {
+	return &MockStore_CountStationHealthAlerts_Call{Call: _e.mock.On("CountStationHealthAlerts", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationHealthAlerts_Call) Run(run func(ctx context.Context, arg db.CountStationHealthAlertsParams)) *MockStore_CountStationHealthAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationHealthAlertsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationHealthAlerts_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationHealthAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationHealthAlerts_Call) RunAndReturn(run func(context.Context, db.CountStationHealthAlertsParams) (int64, error)) *MockStore_CountStationHealthAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationHealths provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationHealths(ctx context.Context, arg db.CountStationHealthsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationHealthsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationHealthsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationHealthsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationHealths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationHealths'
+type MockStore_CountStationHealths_Call struct {
+	*mock.Call
+}
+
+// CountStationHealths is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationHealthsParams
+func (_e *MockStore_Expecter) CountStationHealths(ctx interface{}, arg interface{}) *MockStore_CountStationHealths_Call {
+	return &MockStore_CountStationHealths_Call{Call: _e.mock.On("CountStationHealths", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationHealths_Call) Run(run func(ctx context.Context, arg db.CountStationHealthsParams)) *MockStore_CountStationHealths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationHealthsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationHealths_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationHealths_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationHealths_Call) RunAndReturn(run func(context.Context, db.CountStationHealthsParams) (int64, error)) *MockStore_CountStationHealths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationHourlyObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationHourlyObservations(ctx context.Context, arg db.CountStationHourlyObservationsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationHourlyObservationsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationHourlyObservationsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationHourlyObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationHourlyObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationHourlyObservations'
+type MockStore_CountStationHourlyObservations_Call struct {
+	*mock.Call
+}
+
+// CountStationHourlyObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationHourlyObservationsParams
+func (_e *MockStore_Expecter) CountStationHourlyObservations(ctx interface{}, arg interface{}) *MockStore_CountStationHourlyObservations_Call {
+	return &MockStore_CountStationHourlyObservations_Call{Call: _e.mock.On("CountStationHourlyObservations", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationHourlyObservations_Call) Run(run func(ctx context.Context, arg db.CountStationHourlyObservationsParams)) *MockStore_CountStationHourlyObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationHourlyObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationHourlyObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationHourlyObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationHourlyObservations_Call) RunAndReturn(run func(context.Context, db.CountStationHourlyObservationsParams) (int64, error)) *MockStore_CountStationHourlyObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationMoObservations(ctx context.Context, arg db.CountStationMoObservationsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMoObservationsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationMoObservationsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationMoObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationMoObservations'
+type MockStore_CountStationMoObservations_Call struct {
+	*mock.Call
+}
+
+// CountStationMoObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationMoObservationsParams
+func (_e *MockStore_Expecter) CountStationMoObservations(ctx interface{}, arg interface{}) *MockStore_CountStationMoObservations_Call {
+	return &MockStore_CountStationMoObservations_Call{Call: _e.mock.On("CountStationMoObservations", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationMoObservations_Call) Run(run func(ctx context.Context, arg db.CountStationMoObservationsParams)) *MockStore_CountStationMoObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationMoObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationMoObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationMoObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationMoObservations_Call) RunAndReturn(run func(context.Context, db.CountStationMoObservationsParams) (int64, error)) *MockStore_CountStationMoObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationObservations(ctx context.Context, arg db.CountStationObservationsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationObservations'
+type MockStore_CountStationObservations_Call struct {
+	*mock.Call
+}
+
+// CountStationObservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationObservationsParams
+func (_e *MockStore_Expecter) CountStationObservations(ctx interface{}, arg interface{}) *MockStore_CountStationObservations_Call {
+	return &MockStore_CountStationObservations_Call{Call: _e.mock.On("CountStationObservations", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationObservations_Call) Run(run func(ctx context.Context, arg db.CountStationObservationsParams)) *MockStore_CountStationObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationObservationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationObservations_Call) RunAndReturn(run func(context.Context, db.CountStationObservationsParams) (int64, error)) *MockStore_CountStationObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStations provides a mock function with given fields: ctx, status
+func (_m *MockStore) CountStations(ctx context.Context, status pgtype.Text) (int64, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (int64, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) int64); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStations'
+type MockStore_CountStations_Call struct {
+	*mock.Call
+}
+
+// CountStations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status pgtype.Text
+func (_e *MockStore_Expecter) CountStations(ctx interface{}, status interface{}) *MockStore_CountStations_Call {
+	return &MockStore_CountStations_Call{Call: _e.mock.On("CountStations", ctx, status)}
+}
+
+func (_c *MockStore_CountStations_Call) Run(run func(ctx context.Context, status pgtype.Text)) *MockStore_CountStations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStations_Call) RunAndReturn(run func(context.Context, pgtype.Text) (int64, error)) *MockStore_CountStations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationsWithinBBox provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationsWithinBBox(ctx context.Context, arg db.CountStationsWithinBBoxParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinBBoxParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinBBoxParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsWithinBBoxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationsWithinBBox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationsWithinBBox'
+type MockStore_CountStationsWithinBBox_Call struct {
+	*mock.Call
+}
+
+// CountStationsWithinBBox is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationsWithinBBoxParams
+func (_e *MockStore_Expecter) CountStationsWithinBBox(ctx interface{}, arg interface{}) *MockStore_CountStationsWithinBBox_Call {
+	return &MockStore_CountStationsWithinBBox_Call{Call: _e.mock.On("CountStationsWithinBBox", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationsWithinBBox_Call) Run(run func(ctx context.Context, arg db.CountStationsWithinBBoxParams)) *MockStore_CountStationsWithinBBox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationsWithinBBoxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationsWithinBBox_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationsWithinBBox_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationsWithinBBox_Call) RunAndReturn(run func(context.Context, db.CountStationsWithinBBoxParams) (int64, error)) *MockStore_CountStationsWithinBBox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStationsWithinRadius provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CountStationsWithinRadius(ctx context.Context, arg db.CountStationsWithinRadiusParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinRadiusParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinRadiusParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsWithinRadiusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountStationsWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationsWithinRadius'
+type MockStore_CountStationsWithinRadius_Call struct {
+	*mock.Call
+}
+
+// CountStationsWithinRadius is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountStationsWithinRadiusParams
+func (_e *MockStore_Expecter) CountStationsWithinRadius(ctx interface{}, arg interface{}) *MockStore_CountStationsWithinRadius_Call {
+	return &MockStore_CountStationsWithinRadius_Call{Call: _e.mock.On("CountStationsWithinRadius", ctx, arg)}
+}
+
+func (_c *MockStore_CountStationsWithinRadius_Call) Run(run func(ctx context.Context, arg db.CountStationsWithinRadiusParams)) *MockStore_CountStationsWithinRadius_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CountStationsWithinRadiusParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountStationsWithinRadius_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationsWithinRadius_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountStationsWithinRadius_Call) RunAndReturn(run func(context.Context, db.CountStationsWithinRadiusParams) (int64, error)) *MockStore_CountStationsWithinRadius_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUsers provides a mock function with given fields: ctx
+func (_m *MockStore) CountUsers(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
+type MockStore_CountUsers_Call struct {
+	*mock.Call
+}
+
+// CountUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) CountUsers(ctx interface{}) *MockStore_CountUsers_Call {
+	return &MockStore_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+}
+
+func (_c *MockStore_CountUsers_Call) Run(run func(ctx context.Context)) *MockStore_CountUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountUsers_Call) Return(_a0 int64, _a1 error) *MockStore_CountUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountUsers_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCurrentObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateCurrentObservation(ctx context.Context, arg db.CreateCurrentObservationParams) (db.ObservationsCurrent, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsCurrent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) db.ObservationsCurrent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsCurrent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateCurrentObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateCurrentObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCurrentObservation'
+type MockStore_CreateCurrentObservation_Call struct {
+	*mock.Call
+}
+
+// CreateCurrentObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateCurrentObservationParams
+func (_e *MockStore_Expecter) CreateCurrentObservation(ctx interface{}, arg interface{}) *MockStore_CreateCurrentObservation_Call {
+	return &MockStore_CreateCurrentObservation_Call{Call: _e.mock.On("CreateCurrentObservation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateCurrentObservation_Call) Run(run func(ctx context.Context, arg db.CreateCurrentObservationParams)) *MockStore_CreateCurrentObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateCurrentObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateCurrentObservation_Call) Return(_a0 db.ObservationsCurrent, _a1 error) *MockStore_CreateCurrentObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateCurrentObservation_Call) RunAndReturn(run func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)) *MockStore_CreateCurrentObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGLabsLoad provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateGLabsLoad(ctx context.Context, arg db.CreateGLabsLoadParams) (db.GlabsLoad, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.GlabsLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) db.GlabsLoad); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GlabsLoad)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateGLabsLoadParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateGLabsLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGLabsLoad'
+type MockStore_CreateGLabsLoad_Call struct {
+	*mock.Call
+}
+
+// CreateGLabsLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateGLabsLoadParams
+func (_e *MockStore_Expecter) CreateGLabsLoad(ctx interface{}, arg interface{}) *MockStore_CreateGLabsLoad_Call {
+	return &MockStore_CreateGLabsLoad_Call{Call: _e.mock.On("CreateGLabsLoad", ctx, arg)}
+}
+
+func (_c *MockStore_CreateGLabsLoad_Call) Run(run func(ctx context.Context, arg db.CreateGLabsLoadParams)) *MockStore_CreateGLabsLoad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateGLabsLoadParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateGLabsLoad_Call) Return(_a0 db.GlabsLoad, _a1 error) *MockStore_CreateGLabsLoad_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateGLabsLoad_Call) RunAndReturn(run func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)) *MockStore_CreateGLabsLoad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJobRun provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateJobRun(ctx context.Context, arg db.CreateJobRunParams) (db.JobRun, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateJobRunParams) (db.JobRun, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateJobRunParams) db.JobRun); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.JobRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateJobRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRun'
+type MockStore_CreateJobRun_Call struct {
+	*mock.Call
+}
+
+// CreateJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateJobRunParams
+func (_e *MockStore_Expecter) CreateJobRun(ctx interface{}, arg interface{}) *MockStore_CreateJobRun_Call {
+	return &MockStore_CreateJobRun_Call{Call: _e.mock.On("CreateJobRun", ctx, arg)}
+}
+
+func (_c *MockStore_CreateJobRun_Call) Run(run func(ctx context.Context, arg db.CreateJobRunParams)) *MockStore_CreateJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateJobRunParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateJobRun_Call) Return(_a0 db.JobRun, _a1 error) *MockStore_CreateJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateJobRun_Call) RunAndReturn(run func(context.Context, db.CreateJobRunParams) (db.JobRun, error)) *MockStore_CreateJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoadRequest provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateLoadRequest(ctx context.Context, arg db.CreateLoadRequestParams) (db.LoadRequest, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.LoadRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateLoadRequestParams) (db.LoadRequest, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateLoadRequestParams) db.LoadRequest); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.LoadRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateLoadRequestParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateLoadRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoadRequest'
+type MockStore_CreateLoadRequest_Call struct {
+	*mock.Call
+}
+
+// CreateLoadRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateLoadRequestParams
+func (_e *MockStore_Expecter) CreateLoadRequest(ctx interface{}, arg interface{}) *MockStore_CreateLoadRequest_Call {
+	return &MockStore_CreateLoadRequest_Call{Call: _e.mock.On("CreateLoadRequest", ctx, arg)}
+}
+
+func (_c *MockStore_CreateLoadRequest_Call) Run(run func(ctx context.Context, arg db.CreateLoadRequestParams)) *MockStore_CreateLoadRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateLoadRequestParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateLoadRequest_Call) Return(_a0 db.LoadRequest, _a1 error) *MockStore_CreateLoadRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateLoadRequest_Call) RunAndReturn(run func(context.Context, db.CreateLoadRequestParams) (db.LoadRequest, error)) *MockStore_CreateLoadRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLoadRequestTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateLoadRequestTx(ctx context.Context, arg db.CreateLoadRequestTxParams) (db.CreateLoadRequestTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.CreateLoadRequestTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateLoadRequestTxParams) (db.CreateLoadRequestTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateLoadRequestTxParams) db.CreateLoadRequestTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateLoadRequestTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateLoadRequestTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateLoadRequestTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoadRequestTx'
+type MockStore_CreateLoadRequestTx_Call struct {
+	*mock.Call
+}
+
+// CreateLoadRequestTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateLoadRequestTxParams
+func (_e *MockStore_Expecter) CreateLoadRequestTx(ctx interface{}, arg interface{}) *MockStore_CreateLoadRequestTx_Call {
+	return &MockStore_CreateLoadRequestTx_Call{Call: _e.mock.On("CreateLoadRequestTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateLoadRequestTx_Call) Run(run func(ctx context.Context, arg db.CreateLoadRequestTxParams)) *MockStore_CreateLoadRequestTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateLoadRequestTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateLoadRequestTx_Call) Return(_a0 db.CreateLoadRequestTxResult, _a1 error) *MockStore_CreateLoadRequestTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateLoadRequestTx_Call) RunAndReturn(run func(context.Context, db.CreateLoadRequestTxParams) (db.CreateLoadRequestTxResult, error)) *MockStore_CreateLoadRequestTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateObservationQcFlag provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateObservationQcFlag(ctx context.Context, arg db.CreateObservationQcFlagParams) (db.ObservationsQcFlag, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsQcFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateObservationQcFlagParams) (db.ObservationsQcFlag, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateObservationQcFlagParams) db.ObservationsQcFlag); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsQcFlag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateObservationQcFlagParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateObservationQcFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateObservationQcFlag'
+type MockStore_CreateObservationQcFlag_Call struct {
+	*mock.Call
+}
+
+// CreateObservationQcFlag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateObservationQcFlagParams
+func (_e *MockStore_Expecter) CreateObservationQcFlag(ctx interface{}, arg interface{}) *MockStore_CreateObservationQcFlag_Call {
+	return &MockStore_CreateObservationQcFlag_Call{Call: _e.mock.On("CreateObservationQcFlag", ctx, arg)}
+}
+
+func (_c *MockStore_CreateObservationQcFlag_Call) Run(run func(ctx context.Context, arg db.CreateObservationQcFlagParams)) *MockStore_CreateObservationQcFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateObservationQcFlagParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateObservationQcFlag_Call) Return(_a0 db.ObservationsQcFlag, _a1 error) *MockStore_CreateObservationQcFlag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateObservationQcFlag_Call) RunAndReturn(run func(context.Context, db.CreateObservationQcFlagParams) (db.ObservationsQcFlag, error)) *MockStore_CreateObservationQcFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRawMessage provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRawMessage(ctx context.Context, arg db.CreateRawMessageParams) (db.RawMessage, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.RawMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRawMessageParams) (db.RawMessage, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRawMessageParams) db.RawMessage); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.RawMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateRawMessageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRawMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRawMessage'
+type MockStore_CreateRawMessage_Call struct {
+	*mock.Call
+}
+
+// CreateRawMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateRawMessageParams
+func (_e *MockStore_Expecter) CreateRawMessage(ctx interface{}, arg interface{}) *MockStore_CreateRawMessage_Call {
+	return &MockStore_CreateRawMessage_Call{Call: _e.mock.On("CreateRawMessage", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRawMessage_Call) Run(run func(ctx context.Context, arg db.CreateRawMessageParams)) *MockStore_CreateRawMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateRawMessageParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRawMessage_Call) Return(_a0 db.RawMessage, _a1 error) *MockStore_CreateRawMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRawMessage_Call) RunAndReturn(run func(context.Context, db.CreateRawMessageParams) (db.RawMessage, error)) *MockStore_CreateRawMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRole provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRole(ctx context.Context, arg db.CreateRoleParams) (db.Role, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) (db.Role, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) db.Role); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateRoleParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockStore_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateRoleParams
+func (_e *MockStore_Expecter) CreateRole(ctx interface{}, arg interface{}) *MockStore_CreateRole_Call {
+	return &MockStore_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRole_Call) Run(run func(ctx context.Context, arg db.CreateRoleParams)) *MockStore_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_CreateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRole_Call) RunAndReturn(run func(context.Context, db.CreateRoleParams) (db.Role, error)) *MockStore_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) (db.Session, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) db.Session); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSessionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type MockStore_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSessionParams
+func (_e *MockStore_Expecter) CreateSession(ctx interface{}, arg interface{}) *MockStore_CreateSession_Call {
+	return &MockStore_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSession_Call) Run(run func(ctx context.Context, arg db.CreateSessionParams)) *MockStore_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSessionParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSession_Call) RunAndReturn(run func(context.Context, db.CreateSessionParams) (db.Session, error)) *MockStore_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSimAccessToken provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSimAccessToken(ctx context.Context, arg db.CreateSimAccessTokenParams) (db.SimAccessToken, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.SimAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) db.SimAccessToken); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimAccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimAccessTokenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimAccessToken'
+type MockStore_CreateSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// CreateSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSimAccessTokenParams
+func (_e *MockStore_Expecter) CreateSimAccessToken(ctx interface{}, arg interface{}) *MockStore_CreateSimAccessToken_Call {
+	return &MockStore_CreateSimAccessToken_Call{Call: _e.mock.On("CreateSimAccessToken", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSimAccessToken_Call) Run(run func(ctx context.Context, arg db.CreateSimAccessTokenParams)) *MockStore_CreateSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSimAccessTokenParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_CreateSimAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSimAccessToken_Call) RunAndReturn(run func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)) *MockStore_CreateSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSimCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSimCard(ctx context.Context, arg db.CreateSimCardParams) (db.SimCard, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.SimCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) (db.SimCard, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) db.SimCard); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimCardParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimCard'
+type MockStore_CreateSimCard_Call struct {
+	*mock.Call
+}
+
+// CreateSimCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSimCardParams
+func (_e *MockStore_Expecter) CreateSimCard(ctx interface{}, arg interface{}) *MockStore_CreateSimCard_Call {
+	return &MockStore_CreateSimCard_Call{Call: _e.mock.On("CreateSimCard", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSimCard_Call) Run(run func(ctx context.Context, arg db.CreateSimCardParams)) *MockStore_CreateSimCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSimCardParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_CreateSimCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSimCard_Call) RunAndReturn(run func(context.Context, db.CreateSimCardParams) (db.SimCard, error)) *MockStore_CreateSimCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSmsMessage provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSmsMessage(ctx context.Context, arg db.CreateSmsMessageParams) (db.SmsMessage, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.SmsMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsMessageParams) (db.SmsMessage, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsMessageParams) db.SmsMessage); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SmsMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSmsMessageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSmsMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmsMessage'
+type MockStore_CreateSmsMessage_Call struct {
+	*mock.Call
+}
+
+// CreateSmsMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSmsMessageParams
+func (_e *MockStore_Expecter) CreateSmsMessage(ctx interface{}, arg interface{}) *MockStore_CreateSmsMessage_Call {
+	return &MockStore_CreateSmsMessage_Call{Call: _e.mock.On("CreateSmsMessage", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSmsMessage_Call) Run(run func(ctx context.Context, arg db.CreateSmsMessageParams)) *MockStore_CreateSmsMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSmsMessageParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSmsMessage_Call) Return(_a0 db.SmsMessage, _a1 error) *MockStore_CreateSmsMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSmsMessage_Call) RunAndReturn(run func(context.Context, db.CreateSmsMessageParams) (db.SmsMessage, error)) *MockStore_CreateSmsMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSmsQuery provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSmsQuery(ctx context.Context, arg db.CreateSmsQueryParams) (db.SmsQuery, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.SmsQuery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsQueryParams) (db.SmsQuery, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSmsQueryParams) db.SmsQuery); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SmsQuery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSmsQueryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSmsQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSmsQuery'
+type MockStore_CreateSmsQuery_Call struct {
+	*mock.Call
+}
+
+// CreateSmsQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateSmsQueryParams
+func (_e *MockStore_Expecter) CreateSmsQuery(ctx interface{}, arg interface{}) *MockStore_CreateSmsQuery_Call {
+	return &MockStore_CreateSmsQuery_Call{Call: _e.mock.On("CreateSmsQuery", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSmsQuery_Call) Run(run func(ctx context.Context, arg db.CreateSmsQueryParams)) *MockStore_CreateSmsQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateSmsQueryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSmsQuery_Call) Return(_a0 db.SmsQuery, _a1 error) *MockStore_CreateSmsQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSmsQuery_Call) RunAndReturn(run func(context.Context, db.CreateSmsQueryParams) (db.SmsQuery, error)) *MockStore_CreateSmsQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStation(ctx context.Context, arg db.CreateStationParams) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) db.ObservationsStation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStation'
+type MockStore_CreateStation_Call struct {
+	*mock.Call
+}
+
+// CreateStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationParams
+func (_e *MockStore_Expecter) CreateStation(ctx interface{}, arg interface{}) *MockStore_CreateStation_Call {
+	return &MockStore_CreateStation_Call{Call: _e.mock.On("CreateStation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStation_Call) Run(run func(ctx context.Context, arg db.CreateStationParams)) *MockStore_CreateStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_CreateStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStation_Call) RunAndReturn(run func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)) *MockStore_CreateStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationHealth(ctx context.Context, arg db.CreateStationHealthParams) (db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationHealthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationHealth'
+type MockStore_CreateStationHealth_Call struct {
+	*mock.Call
+}
+
+// CreateStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationHealthParams
+func (_e *MockStore_Expecter) CreateStationHealth(ctx interface{}, arg interface{}) *MockStore_CreateStationHealth_Call {
+	return &MockStore_CreateStationHealth_Call{Call: _e.mock.On("CreateStationHealth", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationHealth_Call) Run(run func(ctx context.Context, arg db.CreateStationHealthParams)) *MockStore_CreateStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_CreateStationHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealth_Call) RunAndReturn(run func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_CreateStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationHealthAlert provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationHealthAlert(ctx context.Context, arg db.CreateStationHealthAlertParams) (db.ObservationsStationhealthAlert, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStationhealthAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthAlertParams) db.ObservationsStationhealthAlert); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealthAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationHealthAlertParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationHealthAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationHealthAlert'
+type MockStore_CreateStationHealthAlert_Call struct {
+	*mock.Call
+}
+
+// CreateStationHealthAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationHealthAlertParams
+func (_e *MockStore_Expecter) CreateStationHealthAlert(ctx interface{}, arg interface{}) *MockStore_CreateStationHealthAlert_Call {
+	return &MockStore_CreateStationHealthAlert_Call{Call: _e.mock.On("CreateStationHealthAlert", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationHealthAlert_Call) Run(run func(ctx context.Context, arg db.CreateStationHealthAlertParams)) *MockStore_CreateStationHealthAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationHealthAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealthAlert_Call) Return(_a0 db.ObservationsStationhealthAlert, _a1 error) *MockStore_CreateStationHealthAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealthAlert_Call) RunAndReturn(run func(context.Context, db.CreateStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)) *MockStore_CreateStationHealthAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationHealthIfNotExists provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationHealthIfNotExists(ctx context.Context, arg db.CreateStationHealthIfNotExistsParams) (db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthIfNotExistsParams) (db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthIfNotExistsParams) db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationHealthIfNotExistsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationHealthIfNotExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationHealthIfNotExists'
+type MockStore_CreateStationHealthIfNotExists_Call struct {
+	*mock.Call
+}
+
+// CreateStationHealthIfNotExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationHealthIfNotExistsParams
+func (_e *MockStore_Expecter) CreateStationHealthIfNotExists(ctx interface{}, arg interface{}) *MockStore_CreateStationHealthIfNotExists_Call {
+	return &MockStore_CreateStationHealthIfNotExists_Call{Call: _e.mock.On("CreateStationHealthIfNotExists", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationHealthIfNotExists_Call) Run(run func(ctx context.Context, arg db.CreateStationHealthIfNotExistsParams)) *MockStore_CreateStationHealthIfNotExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationHealthIfNotExistsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealthIfNotExists_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_CreateStationHealthIfNotExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationHealthIfNotExists_Call) RunAndReturn(run func(context.Context, db.CreateStationHealthIfNotExistsParams) (db.ObservationsStationhealth, error)) *MockStore_CreateStationHealthIfNotExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationMoObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationMoObservation(ctx context.Context, arg db.CreateStationMoObservationParams) (db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMoObservationParams) (db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationMoObservationParams) db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationMoObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationMoObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationMoObservation'
+type MockStore_CreateStationMoObservation_Call struct {
+	*mock.Call
+}
+
+// CreateStationMoObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationMoObservationParams
+func (_e *MockStore_Expecter) CreateStationMoObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationMoObservation_Call {
+	return &MockStore_CreateStationMoObservation_Call{Call: _e.mock.On("CreateStationMoObservation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationMoObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationMoObservationParams)) *MockStore_CreateStationMoObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationMoObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationMoObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_CreateStationMoObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationMoObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationMoObservationParams) (db.ObservationsMoObservation, error)) *MockStore_CreateStationMoObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationObservation(ctx context.Context, arg db.CreateStationObservationParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationObservation'
+type MockStore_CreateStationObservation_Call struct {
+	*mock.Call
+}
+
+// CreateStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationObservationParams
+func (_e *MockStore_Expecter) CreateStationObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationObservation_Call {
+	return &MockStore_CreateStationObservation_Call{Call: _e.mock.On("CreateStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationObservationParams)) *MockStore_CreateStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_CreateStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_CreateStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationObservationIfNotExists provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationObservationIfNotExists(ctx context.Context, arg db.CreateStationObservationIfNotExistsParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationIfNotExistsParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationIfNotExistsParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationObservationIfNotExistsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationObservationIfNotExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationObservationIfNotExists'
+type MockStore_CreateStationObservationIfNotExists_Call struct {
+	*mock.Call
+}
+
+// CreateStationObservationIfNotExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationObservationIfNotExistsParams
+func (_e *MockStore_Expecter) CreateStationObservationIfNotExists(ctx interface{}, arg interface{}) *MockStore_CreateStationObservationIfNotExists_Call {
+	return &MockStore_CreateStationObservationIfNotExists_Call{Call: _e.mock.On("CreateStationObservationIfNotExists", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationObservationIfNotExists_Call) Run(run func(ctx context.Context, arg db.CreateStationObservationIfNotExistsParams)) *MockStore_CreateStationObservationIfNotExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationObservationIfNotExistsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationObservationIfNotExists_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_CreateStationObservationIfNotExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationObservationIfNotExists_Call) RunAndReturn(run func(context.Context, db.CreateStationObservationIfNotExistsParams) (db.ObservationsObservation, error)) *MockStore_CreateStationObservationIfNotExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationReadingTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationReadingTx(ctx context.Context, arg db.CreateStationReadingTxParams) (db.CreateStationReadingTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.CreateStationReadingTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationReadingTxParams) (db.CreateStationReadingTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationReadingTxParams) db.CreateStationReadingTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateStationReadingTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationReadingTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationReadingTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationReadingTx'
+type MockStore_CreateStationReadingTx_Call struct {
+	*mock.Call
+}
+
+// CreateStationReadingTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationReadingTxParams
+func (_e *MockStore_Expecter) CreateStationReadingTx(ctx interface{}, arg interface{}) *MockStore_CreateStationReadingTx_Call {
+	return &MockStore_CreateStationReadingTx_Call{Call: _e.mock.On("CreateStationReadingTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationReadingTx_Call) Run(run func(ctx context.Context, arg db.CreateStationReadingTxParams)) *MockStore_CreateStationReadingTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationReadingTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationReadingTx_Call) Return(_a0 db.CreateStationReadingTxResult, _a1 error) *MockStore_CreateStationReadingTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationReadingTx_Call) RunAndReturn(run func(context.Context, db.CreateStationReadingTxParams) (db.CreateStationReadingTxResult, error)) *MockStore_CreateStationReadingTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStationUploadTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateStationUploadTx(ctx context.Context, arg db.CreateStationUploadTxParams) (db.CreateStationUploadTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.CreateStationUploadTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationUploadTxParams) (db.CreateStationUploadTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationUploadTxParams) db.CreateStationUploadTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.CreateStationUploadTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationUploadTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateStationUploadTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationUploadTx'
+type MockStore_CreateStationUploadTx_Call struct {
+	*mock.Call
+}
+
+// CreateStationUploadTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateStationUploadTxParams
+func (_e *MockStore_Expecter) CreateStationUploadTx(ctx interface{}, arg interface{}) *MockStore_CreateStationUploadTx_Call {
+	return &MockStore_CreateStationUploadTx_Call{Call: _e.mock.On("CreateStationUploadTx", ctx, arg)}
+}
+
+func (_c *MockStore_CreateStationUploadTx_Call) Run(run func(ctx context.Context, arg db.CreateStationUploadTxParams)) *MockStore_CreateStationUploadTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateStationUploadTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateStationUploadTx_Call) Return(_a0 db.CreateStationUploadTxResult, _a1 error) *MockStore_CreateStationUploadTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateStationUploadTx_Call) RunAndReturn(run func(context.Context, db.CreateStationUploadTxParams) (db.CreateStationUploadTxResult, error)) *MockStore_CreateStationUploadTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockStore_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CreateUserParams
+func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
+	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *MockStore_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *MockStore_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLoadRequest provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteLoadRequest(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteLoadRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLoadRequest'
+type MockStore_DeleteLoadRequest_Call struct {
+	*mock.Call
+}
+
+// DeleteLoadRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteLoadRequest(ctx interface{}, id interface{}) *MockStore_DeleteLoadRequest_Call {
+	return &MockStore_DeleteLoadRequest_Call{Call: _e.mock.On("DeleteLoadRequest", ctx, id)}
+}
+
+func (_c *MockStore_DeleteLoadRequest_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteLoadRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteLoadRequest_Call) Return(_a0 error) *MockStore_DeleteLoadRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteLoadRequest_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteLoadRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteObservationQcFlags provides a mock function with given fields: ctx, observationID
+func (_m *MockStore) DeleteObservationQcFlags(ctx context.Context, observationID int64) error {
+	ret := _m.Called(ctx, observationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, observationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteObservationQcFlags'
+type MockStore_DeleteObservationQcFlags_Call struct {
+	*mock.Call
+}
+
+// DeleteObservationQcFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - observationID int64
+func (_e *MockStore_Expecter) DeleteObservationQcFlags(ctx interface{}, observationID interface{}) *MockStore_DeleteObservationQcFlags_Call {
+	return &MockStore_DeleteObservationQcFlags_Call{Call: _e.mock.On("DeleteObservationQcFlags", ctx, observationID)}
+}
+
+func (_c *MockStore_DeleteObservationQcFlags_Call) Run(run func(ctx context.Context, observationID int64)) *MockStore_DeleteObservationQcFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteObservationQcFlags_Call) Return(_a0 error) *MockStore_DeleteObservationQcFlags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteObservationQcFlags_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteObservationQcFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteRole(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type MockStore_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteRole(ctx interface{}, id interface{}) *MockStore_DeleteRole_Call {
+	return &MockStore_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, id)}
+}
+
+func (_c *MockStore_DeleteRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRole_Call) Return(_a0 error) *MockStore_DeleteRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRole_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSession provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
+type MockStore_DeleteSession_Call struct {
+	*mock.Call
+}
+
+// DeleteSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteSession(ctx interface{}, id interface{}) *MockStore_DeleteSession_Call {
+	return &MockStore_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSession_Call) Return(_a0 error) *MockStore_DeleteSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSimAccessToken provides a mock function with given fields: ctx, accessToken
+func (_m *MockStore) DeleteSimAccessToken(ctx context.Context, accessToken string) error {
+	ret := _m.Called(ctx, accessToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSimAccessToken'
+type MockStore_DeleteSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// DeleteSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *MockStore_Expecter) DeleteSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_DeleteSimAccessToken_Call {
+	return &MockStore_DeleteSimAccessToken_Call{Call: _e.mock.On("DeleteSimAccessToken", ctx, accessToken)}
+}
+
+func (_c *MockStore_DeleteSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_DeleteSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSimAccessToken_Call) Return(_a0 error) *MockStore_DeleteSimAccessToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSimAccessToken_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_DeleteSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSimCard provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) DeleteSimCard(ctx context.Context, mobileNumber string) error {
+	ret := _m.Called(ctx, mobileNumber)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSimCard'
+type MockStore_DeleteSimCard_Call struct {
+	*mock.Call
+}
+
+// DeleteSimCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) DeleteSimCard(ctx interface{}, mobileNumber interface{}) *MockStore_DeleteSimCard_Call {
+	return &MockStore_DeleteSimCard_Call{Call: _e.mock.On("DeleteSimCard", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_DeleteSimCard_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_DeleteSimCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSimCard_Call) Return(_a0 error) *MockStore_DeleteSimCard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSimCard_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_DeleteSimCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSmsSubscription provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteSmsSubscription(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSmsSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSmsSubscription'
+type MockStore_DeleteSmsSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteSmsSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteSmsSubscription(ctx interface{}, id interface{}) *MockStore_DeleteSmsSubscription_Call {
+	return &MockStore_DeleteSmsSubscription_Call{Call: _e.mock.On("DeleteSmsSubscription", ctx, id)}
+}
+
+func (_c *MockStore_DeleteSmsSubscription_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteSmsSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSmsSubscription_Call) Return(_a0 error) *MockStore_DeleteSmsSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSmsSubscription_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteSmsSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteStation(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStation'
+type MockStore_DeleteStation_Call struct {
+	*mock.Call
+}
+
+// DeleteStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteStation(ctx interface{}, id interface{}) *MockStore_DeleteStation_Call {
+	return &MockStore_DeleteStation_Call{Call: _e.mock.On("DeleteStation", ctx, id)}
+}
+
+func (_c *MockStore_DeleteStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStation_Call) Return(_a0 error) *MockStore_DeleteStation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationCredential provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) DeleteStationCredential(ctx context.Context, stationID int64) error {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationCredential'
+type MockStore_DeleteStationCredential_Call struct {
+	*mock.Call
+}
+
+// DeleteStationCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) DeleteStationCredential(ctx interface{}, stationID interface{}) *MockStore_DeleteStationCredential_Call {
+	return &MockStore_DeleteStationCredential_Call{Call: _e.mock.On("DeleteStationCredential", ctx, stationID)}
+}
+
+func (_c *MockStore_DeleteStationCredential_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_DeleteStationCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationCredential_Call) Return(_a0 error) *MockStore_DeleteStationCredential_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationCredential_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteStationCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationHealth(ctx context.Context, arg db.DeleteStationHealthParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationHealthParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationHealth'
+type MockStore_DeleteStationHealth_Call struct {
+	*mock.Call
+}
+
+// DeleteStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationHealthParams
+func (_e *MockStore_Expecter) DeleteStationHealth(ctx interface{}, arg interface{}) *MockStore_DeleteStationHealth_Call {
+	return &MockStore_DeleteStationHealth_Call{Call: _e.mock.On("DeleteStationHealth", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationHealth_Call) Run(run func(ctx context.Context, arg db.DeleteStationHealthParams)) *MockStore_DeleteStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationHealth_Call) Return(_a0 error) *MockStore_DeleteStationHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationHealth_Call) RunAndReturn(run func(context.Context, db.DeleteStationHealthParams) error) *MockStore_DeleteStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationMoObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationMoObservation(ctx context.Context, arg db.DeleteStationMoObservationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationMoObservationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationMoObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationMoObservation'
+type MockStore_DeleteStationMoObservation_Call struct {
+	*mock.Call
+}
+
+// DeleteStationMoObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationMoObservationParams
+func (_e *MockStore_Expecter) DeleteStationMoObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationMoObservation_Call {
+	return &MockStore_DeleteStationMoObservation_Call{Call: _e.mock.On("DeleteStationMoObservation", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationMoObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationMoObservationParams)) *MockStore_DeleteStationMoObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationMoObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationMoObservation_Call) Return(_a0 error) *MockStore_DeleteStationMoObservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationMoObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationMoObservationParams) error) *MockStore_DeleteStationMoObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteStationObservation(ctx context.Context, arg db.DeleteStationObservationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationObservationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationObservation'
+type MockStore_DeleteStationObservation_Call struct {
+	*mock.Call
+}
+
+// DeleteStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.DeleteStationObservationParams
+func (_e *MockStore_Expecter) DeleteStationObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationObservation_Call {
+	return &MockStore_DeleteStationObservation_Call{Call: _e.mock.On("DeleteStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteStationObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationObservationParams)) *MockStore_DeleteStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.DeleteStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationObservation_Call) Return(_a0 error) *MockStore_DeleteStationObservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationObservationParams) error) *MockStore_DeleteStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStationUploadKey provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) DeleteStationUploadKey(ctx context.Context, stationID int64) error {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteStationUploadKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationUploadKey'
+type MockStore_DeleteStationUploadKey_Call struct {
+	*mock.Call
+}
+
+// DeleteStationUploadKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) DeleteStationUploadKey(ctx interface{}, stationID interface{}) *MockStore_DeleteStationUploadKey_Call {
+	return &MockStore_DeleteStationUploadKey_Call{Call: _e.mock.On("DeleteStationUploadKey", ctx, stationID)}
+}
+
+func (_c *MockStore_DeleteStationUploadKey_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_DeleteStationUploadKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteStationUploadKey_Call) Return(_a0 error) *MockStore_DeleteStationUploadKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteStationUploadKey_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteStationUploadKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteUser(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockStore_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
+	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) Return(_a0 error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailRunningJobRuns provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FailRunningJobRuns(ctx context.Context, arg db.FailRunningJobRunsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.FailRunningJobRunsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_FailRunningJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailRunningJobRuns'
+type MockStore_FailRunningJobRuns_Call struct {
+	*mock.Call
+}
+
+// FailRunningJobRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.FailRunningJobRunsParams
+func (_e *MockStore_Expecter) FailRunningJobRuns(ctx interface{}, arg interface{}) *MockStore_FailRunningJobRuns_Call {
+	return &MockStore_FailRunningJobRuns_Call{Call: _e.mock.On("FailRunningJobRuns", ctx, arg)}
+}
+
+func (_c *MockStore_FailRunningJobRuns_Call) Run(run func(ctx context.Context, arg db.FailRunningJobRunsParams)) *MockStore_FailRunningJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.FailRunningJobRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FailRunningJobRuns_Call) Return(_a0 error) *MockStore_FailRunningJobRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_FailRunningJobRuns_Call) RunAndReturn(run func(context.Context, db.FailRunningJobRunsParams) error) *MockStore_FailRunningJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStation provides a mock function with given fields: ctx, search
+func (_m *MockStore) FindStation(ctx context.Context, search string) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, search)
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.ObservationsStation, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.ObservationsStation); ok {
+		r0 = rf(ctx, search)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FindStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStation'
+type MockStore_FindStation_Call struct {
+	*mock.Call
+}
+
+// FindStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search string
+func (_e *MockStore_Expecter) FindStation(ctx interface{}, search interface{}) *MockStore_FindStation_Call {
+	return &MockStore_FindStation_Call{Call: _e.mock.On("FindStation", ctx, search)}
+}
+
+func (_c *MockStore_FindStation_Call) Run(run func(ctx context.Context, search string)) *MockStore_FindStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_FindStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_FindStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FindStation_Call) RunAndReturn(run func(context.Context, string) (db.ObservationsStation, error)) *MockStore_FindStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishJobRun provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FinishJobRun(ctx context.Context, arg db.FinishJobRunParams) (db.JobRun, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.FinishJobRunParams) (db.JobRun, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.FinishJobRunParams) db.JobRun); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.JobRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.FinishJobRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FinishJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishJobRun'
+type MockStore_FinishJobRun_Call struct {
+	*mock.Call
+}
+
+// FinishJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.FinishJobRunParams
+func (_e *MockStore_Expecter) FinishJobRun(ctx interface{}, arg interface{}) *MockStore_FinishJobRun_Call {
+	return &MockStore_FinishJobRun_Call{Call: _e.mock.On("FinishJobRun", ctx, arg)}
+}
+
+func (_c *MockStore_FinishJobRun_Call) Run(run func(ctx context.Context, arg db.FinishJobRunParams)) *MockStore_FinishJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.FinishJobRunParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FinishJobRun_Call) Return(_a0 db.JobRun, _a1 error) *MockStore_FinishJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FinishJobRun_Call) RunAndReturn(run func(context.Context, db.FinishJobRunParams) (db.JobRun, error)) *MockStore_FinishJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishSmsMessageAttempt provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FinishSmsMessageAttempt(ctx context.Context, arg db.FinishSmsMessageAttemptParams) (db.SmsMessage, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.SmsMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.FinishSmsMessageAttemptParams) (db.SmsMessage, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.FinishSmsMessageAttemptParams) db.SmsMessage); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SmsMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.FinishSmsMessageAttemptParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FinishSmsMessageAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishSmsMessageAttempt'
+type MockStore_FinishSmsMessageAttempt_Call struct {
+	*mock.Call
+}
+
+// FinishSmsMessageAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.FinishSmsMessageAttemptParams
+func (_e *MockStore_Expecter) FinishSmsMessageAttempt(ctx interface{}, arg interface{}) *MockStore_FinishSmsMessageAttempt_Call {
+	return &MockStore_FinishSmsMessageAttempt_Call{Call: _e.mock.On("FinishSmsMessageAttempt", ctx, arg)}
+}
+
+func (_c *MockStore_FinishSmsMessageAttempt_Call) Run(run func(ctx context.Context, arg db.FinishSmsMessageAttemptParams)) *MockStore_FinishSmsMessageAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.FinishSmsMessageAttemptParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FinishSmsMessageAttempt_Call) Return(_a0 db.SmsMessage, _a1 error) *MockStore_FinishSmsMessageAttempt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FinishSmsMessageAttempt_Call) RunAndReturn(run func(context.Context, db.FinishSmsMessageAttemptParams) (db.SmsMessage, error)) *MockStore_FinishSmsMessageAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FirstOrCreateSimAccessTokenTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FirstOrCreateSimAccessTokenTx(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.FirstOrCreateSimAccessTokenTxResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) db.FirstOrCreateSimAccessTokenTxResult); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.FirstOrCreateSimAccessTokenTxResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FirstOrCreateSimAccessTokenTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FirstOrCreateSimAccessTokenTx'
+type MockStore_FirstOrCreateSimAccessTokenTx_Call struct {
+	*mock.Call
+}
+
+// FirstOrCreateSimAccessTokenTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.FirstOrCreateSimAccessTokenTxParams
+func (_e *MockStore_Expecter) FirstOrCreateSimAccessTokenTx(ctx interface{}, arg interface{}) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	return &MockStore_FirstOrCreateSimAccessTokenTx_Call{Call: _e.mock.On("FirstOrCreateSimAccessTokenTx", ctx, arg)}
+}
+
+func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Run(run func(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.FirstOrCreateSimAccessTokenTxParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Return(_a0 db.FirstOrCreateSimAccessTokenTxResult, _a1 error) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) RunAndReturn(run func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRun provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetJobRun(ctx context.Context, id int64) (db.JobRun, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.JobRun, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.JobRun); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.JobRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRun'
+type MockStore_GetJobRun_Call struct {
+	*mock.Call
+}
+
+// GetJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetJobRun(ctx interface{}, id interface{}) *MockStore_GetJobRun_Call {
+	return &MockStore_GetJobRun_Call{Call: _e.mock.On("GetJobRun", ctx, id)}
+}
+
+func (_c *MockStore_GetJobRun_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetJobRun_Call) Return(_a0 db.JobRun, _a1 error) *MockStore_GetJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetJobRun_Call) RunAndReturn(run func(context.Context, int64) (db.JobRun, error)) *MockStore_GetJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestSimAccessToken provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetLatestSimAccessToken(ctx context.Context, arg db.GetLatestSimAccessTokenParams) (db.SimAccessToken, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.SimAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetLatestSimAccessTokenParams) (db.SimAccessToken, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetLatestSimAccessTokenParams) db.SimAccessToken); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.SimAccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetLatestSimAccessTokenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSimAccessToken'
+type MockStore_GetLatestSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// GetLatestSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetLatestSimAccessTokenParams
+func (_e *MockStore_Expecter) GetLatestSimAccessToken(ctx interface{}, arg interface{}) *MockStore_GetLatestSimAccessToken_Call {
+	return &MockStore_GetLatestSimAccessToken_Call{Call: _e.mock.On("GetLatestSimAccessToken", ctx, arg)}
+}
+
+func (_c *MockStore_GetLatestSimAccessToken_Call) Run(run func(ctx context.Context, arg db.GetLatestSimAccessTokenParams)) *MockStore_GetLatestSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetLatestSimAccessTokenParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_GetLatestSimAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestSimAccessToken_Call) RunAndReturn(run func(context.Context, db.GetLatestSimAccessTokenParams) (db.SimAccessToken, error)) *MockStore_GetLatestSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestStationHealth provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) GetLatestStationHealth(ctx context.Context, stationID int64) (db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStationHealth'
+type MockStore_GetLatestStationHealth_Call struct {
+	*mock.Call
+}
+
+// GetLatestStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) GetLatestStationHealth(ctx interface{}, stationID interface{}) *MockStore_GetLatestStationHealth_Call {
+	return &MockStore_GetLatestStationHealth_Call{Call: _e.mock.On("GetLatestStationHealth", ctx, stationID)}
+}
+
+func (_c *MockStore_GetLatestStationHealth_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_GetLatestStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_GetLatestStationHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationHealth_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStationhealth, error)) *MockStore_GetLatestStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestStationMoObservation provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) GetLatestStationMoObservation(ctx context.Context, stationID int64) (db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestStationMoObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStationMoObservation'
+type MockStore_GetLatestStationMoObservation_Call struct {
+	*mock.Call
+}
+
+// GetLatestStationMoObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) GetLatestStationMoObservation(ctx interface{}, stationID interface{}) *MockStore_GetLatestStationMoObservation_Call {
+	return &MockStore_GetLatestStationMoObservation_Call{Call: _e.mock.On("GetLatestStationMoObservation", ctx, stationID)}
+}
+
+func (_c *MockStore_GetLatestStationMoObservation_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_GetLatestStationMoObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationMoObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_GetLatestStationMoObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationMoObservation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsMoObservation, error)) *MockStore_GetLatestStationMoObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestStationObservation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetLatestStationObservation(ctx context.Context, id int64) (db.GetLatestStationObservationRow, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.GetLatestStationObservationRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.GetLatestStationObservationRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.GetLatestStationObservationRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.GetLatestStationObservationRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStationObservation'
+type MockStore_GetLatestStationObservation_Call struct {
+	*mock.Call
+}
+
+// GetLatestStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetLatestStationObservation(ctx interface{}, id interface{}) *MockStore_GetLatestStationObservation_Call {
+	return &MockStore_GetLatestStationObservation_Call{Call: _e.mock.On("GetLatestStationObservation", ctx, id)}
+}
+
+func (_c *MockStore_GetLatestStationObservation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetLatestStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationObservation_Call) Return(_a0 db.GetLatestStationObservationRow, _a1 error) *MockStore_GetLatestStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestStationObservation_Call) RunAndReturn(run func(context.Context, int64) (db.GetLatestStationObservationRow, error)) *MockStore_GetLatestStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadRequestByKey provides a mock function with given fields: ctx, idempotencyKey
+func (_m *MockStore) GetLoadRequestByKey(ctx context.Context, idempotencyKey string) (db.LoadRequest, error) {
+	ret := _m.Called(ctx, idempotencyKey)
+
+	var r0 db.LoadRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.LoadRequest, error)); ok {
+		return rf(ctx, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.LoadRequest); ok {
+		r0 = rf(ctx, idempotencyKey)
+	} else {
+		r0 = ret.Get(0).(db.LoadRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLoadRequestByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadRequestByKey'
+type MockStore_GetLoadRequestByKey_Call struct {
+	*mock.Call
+}
+
+// GetLoadRequestByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idempotencyKey string
+func (_e *MockStore_Expecter) GetLoadRequestByKey(ctx interface{}, idempotencyKey interface{}) *MockStore_GetLoadRequestByKey_Call {
+	return &MockStore_GetLoadRequestByKey_Call{Call: _e.mock.On("GetLoadRequestByKey", ctx, idempotencyKey)}
+}
+
+func (_c *MockStore_GetLoadRequestByKey_Call) Run(run func(ctx context.Context, idempotencyKey string)) *MockStore_GetLoadRequestByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLoadRequestByKey_Call) Return(_a0 db.LoadRequest, _a1 error) *MockStore_GetLoadRequestByKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLoadRequestByKey_Call) RunAndReturn(run func(context.Context, string) (db.LoadRequest, error)) *MockStore_GetLoadRequestByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNearestLatestStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetNearestLatestStationObservation(ctx context.Context, arg db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.GetNearestLatestStationObservationRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) db.GetNearestLatestStationObservationRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.GetNearestLatestStationObservationRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetNearestLatestStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetNearestLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNearestLatestStationObservation'
+type MockStore_GetNearestLatestStationObservation_Call struct {
+	*mock.Call
+}
+
+// GetNearestLatestStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetNearestLatestStationObservationParams
+func (_e *MockStore_Expecter) GetNearestLatestStationObservation(ctx interface{}, arg interface{}) *MockStore_GetNearestLatestStationObservation_Call {
+	return &MockStore_GetNearestLatestStationObservation_Call{Call: _e.mock.On("GetNearestLatestStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_GetNearestLatestStationObservation_Call) Run(run func(ctx context.Context, arg db.GetNearestLatestStationObservationParams)) *MockStore_GetNearestLatestStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetNearestLatestStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetNearestLatestStationObservation_Call) Return(_a0 db.GetNearestLatestStationObservationRow, _a1 error) *MockStore_GetNearestLatestStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetNearestLatestStationObservation_Call) RunAndReturn(run func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)) *MockStore_GetNearestLatestStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRawMessage provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetRawMessage(ctx context.Context, id int64) (db.RawMessage, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.RawMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.RawMessage, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.RawMessage); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.RawMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRawMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRawMessage'
+type MockStore_GetRawMessage_Call struct {
+	*mock.Call
+}
+
+// GetRawMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetRawMessage(ctx interface{}, id interface{}) *MockStore_GetRawMessage_Call {
+	return &MockStore_GetRawMessage_Call{Call: _e.mock.On("GetRawMessage", ctx, id)}
+}
+
+func (_c *MockStore_GetRawMessage_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetRawMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRawMessage_Call) Return(_a0 db.RawMessage, _a1 error) *MockStore_GetRawMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRawMessage_Call) RunAndReturn(run func(context.Context, int64) (db.RawMessage, error)) *MockStore_GetRawMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRole provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetRole(ctx context.Context, id int64) (db.Role, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Role, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Role); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
+type MockStore_GetRole_Call struct {
+	*mock.Call
+}
+
+// GetRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetRole(ctx interface{}, id interface{}) *MockStore_GetRole_Call {
+	return &MockStore_GetRole_Call{Call: _e.mock.On("GetRole", ctx, id)}
+}
+
+func (_c *MockStore_GetRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRole_Call) RunAndReturn(run func(context.Context, int64) (db.Role, error)) *MockStore_GetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByName provides a mock function with given fields: ctx, name
+func (_m *MockStore) GetRoleByName(ctx context.Context, name string) (db.Role, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 db.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.Role, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.Role); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(db.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByName'
+type MockStore_GetRoleByName_Call struct {
+	*mock.Call
+}
+
+// GetRoleByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStore_Expecter) GetRoleByName(ctx interface{}, name interface{}) *MockStore_GetRoleByName_Call {
+	return &MockStore_GetRoleByName_Call{Call: _e.mock.On("GetRoleByName", ctx, name)}
+}
+
+func (_c *MockStore_GetRoleByName_Call) Run(run func(ctx context.Context, name string)) *MockStore_GetRoleByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRoleByName_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRoleByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRoleByName_Call) RunAndReturn(run func(context.Context, string) (db.Role, error)) *MockStore_GetRoleByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Session)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type MockStore_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetSession(ctx interface{}, id interface{}) *MockStore_GetSession_Call {
+	return &MockStore_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+}
+
+func (_c *MockStore_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_GetSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *MockStore_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimAccessToken provides a mock function with given fields: ctx, accessToken
+func (_m *MockStore) GetSimAccessToken(ctx context.Context, accessToken string) (db.SimAccessToken, error) {
+	ret := _m.Called(ctx, accessToken)
+
+	var r0 db.SimAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimAccessToken, error)); ok {
+		return rf(ctx, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimAccessToken); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Get(0).(db.SimAccessToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimAccessToken'
+type MockStore_GetSimAccessToken_Call struct {
+	*mock.Call
+}
+
+// GetSimAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *MockStore_Expecter) GetSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_GetSimAccessToken_Call {
+	return &MockStore_GetSimAccessToken_Call{Call: _e.mock.On("GetSimAccessToken", ctx, accessToken)}
+}
+
+func (_c *MockStore_GetSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_GetSimAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_GetSimAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSimAccessToken_Call) RunAndReturn(run func(context.Context, string) (db.SimAccessToken, error)) *MockStore_GetSimAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSimCard provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) GetSimCard(ctx context.Context, mobileNumber string) (db.SimCard, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	var r0 db.SimCard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimCard, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimCard); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(db.SimCard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimCard'
+type MockStore_GetSimCard_Call struct {
+	*mock.Call
+}
+
+// GetSimCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber string
+func (_e *MockStore_Expecter) GetSimCard(ctx interface{}, mobileNumber interface{}) *MockStore_GetSimCard_Call {
+	return &MockStore_GetSimCard_Call{Call: _e.mock.On("GetSimCard", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_GetSimCard_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_GetSimCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_GetSimCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSimCard_Call) RunAndReturn(run func(context.Context, string) (db.SimCard, error)) *MockStore_GetSimCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSmsMessage provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetSmsMessage(ctx context.Context, id int64) (db.SmsMessage, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.SmsMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.SmsMessage, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.SmsMessage); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.SmsMessage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSmsMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSmsMessage'
+type MockStore_GetSmsMessage_Call struct {
+	*mock.Call
+}
+
+// GetSmsMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetSmsMessage(ctx interface{}, id interface{}) *MockStore_GetSmsMessage_Call {
+	return &MockStore_GetSmsMessage_Call{Call: _e.mock.On("GetSmsMessage", ctx, id)}
+}
+
+func (_c *MockStore_GetSmsMessage_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetSmsMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSmsMessage_Call) Return(_a0 db.SmsMessage, _a1 error) *MockStore_GetSmsMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSmsMessage_Call) RunAndReturn(run func(context.Context, int64) (db.SmsMessage, error)) *MockStore_GetSmsMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStation provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetStation(ctx context.Context, id int64) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStation'
+type MockStore_GetStation_Call struct {
+	*mock.Call
+}
+
+// GetStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetStation(ctx interface{}, id interface{}) *MockStore_GetStation_Call {
+	return &MockStore_GetStation_Call{Call: _e.mock.On("GetStation", ctx, id)}
+}
+
+func (_c *MockStore_GetStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetStation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStation, error)) *MockStore_GetStation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationByMobileNumber provides a mock function with given fields: ctx, mobileNumber
+func (_m *MockStore) GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (db.ObservationsStation, error) {
+	ret := _m.Called(ctx, mobileNumber)
+
+	var r0 db.ObservationsStation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (db.ObservationsStation, error)); ok {
+		return rf(ctx, mobileNumber)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) db.ObservationsStation); ok {
+		r0 = rf(ctx, mobileNumber)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, mobileNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationByMobileNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationByMobileNumber'
+type MockStore_GetStationByMobileNumber_Call struct {
+	*mock.Call
+}
+
+// GetStationByMobileNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mobileNumber pgtype.Text
+func (_e *MockStore_Expecter) GetStationByMobileNumber(ctx interface{}, mobileNumber interface{}) *MockStore_GetStationByMobileNumber_Call {
+	return &MockStore_GetStationByMobileNumber_Call{Call: _e.mock.On("GetStationByMobileNumber", ctx, mobileNumber)}
+}
+
+func (_c *MockStore_GetStationByMobileNumber_Call) Run(run func(ctx context.Context, mobileNumber pgtype.Text)) *MockStore_GetStationByMobileNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationByMobileNumber_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStationByMobileNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationByMobileNumber_Call) RunAndReturn(run func(context.Context, pgtype.Text) (db.ObservationsStation, error)) *MockStore_GetStationByMobileNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationCredential provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) GetStationCredential(ctx context.Context, stationID int64) (db.ObservationsStationcredential, error) {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 db.ObservationsStationcredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStationcredential, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStationcredential); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationcredential)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationCredential'
+type MockStore_GetStationCredential_Call struct {
+	*mock.Call
+}
+
+// GetStationCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stationID int64
+func (_e *MockStore_Expecter) GetStationCredential(ctx interface{}, stationID interface{}) *MockStore_GetStationCredential_Call {
+	return &MockStore_GetStationCredential_Call{Call: _e.mock.On("GetStationCredential", ctx, stationID)}
+}
+
+func (_c *MockStore_GetStationCredential_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_GetStationCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationCredential_Call) Return(_a0 db.ObservationsStationcredential, _a1 error) *MockStore_GetStationCredential_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationCredential_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStationcredential, error)) *MockStore_GetStationCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationHealth(ctx context.Context, arg db.GetStationHealthParams) (db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationHealthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationHealth'
+type MockStore_GetStationHealth_Call struct {
+	*mock.Call
+}
+
+// GetStationHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationHealthParams
+func (_e *MockStore_Expecter) GetStationHealth(ctx interface{}, arg interface{}) *MockStore_GetStationHealth_Call {
+	return &MockStore_GetStationHealth_Call{Call: _e.mock.On("GetStationHealth", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationHealth_Call) Run(run func(ctx context.Context, arg db.GetStationHealthParams)) *MockStore_GetStationHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationHealthParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_GetStationHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationHealth_Call) RunAndReturn(run func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_GetStationHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationHealthAlert provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationHealthAlert(ctx context.Context, arg db.GetStationHealthAlertParams) (db.ObservationsStationhealthAlert, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsStationhealthAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthAlertParams) db.ObservationsStationhealthAlert); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationhealthAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationHealthAlertParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationHealthAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationHealthAlert'
+type MockStore_GetStationHealthAlert_Call struct {
+	*mock.Call
+}
+
+// GetStationHealthAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationHealthAlertParams
+func (_e *MockStore_Expecter) GetStationHealthAlert(ctx interface{}, arg interface{}) *MockStore_GetStationHealthAlert_Call {
+	return &MockStore_GetStationHealthAlert_Call{Call: _e.mock.On("GetStationHealthAlert", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationHealthAlert_Call) Run(run func(ctx context.Context, arg db.GetStationHealthAlertParams)) *MockStore_GetStationHealthAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationHealthAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationHealthAlert_Call) Return(_a0 db.ObservationsStationhealthAlert, _a1 error) *MockStore_GetStationHealthAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationHealthAlert_Call) RunAndReturn(run func(context.Context, db.GetStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)) *MockStore_GetStationHealthAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationMoObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationMoObservation(ctx context.Context, arg db.GetStationMoObservationParams) (db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMoObservationParams) (db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationMoObservationParams) db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationMoObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationMoObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationMoObservation'
+type MockStore_GetStationMoObservation_Call struct {
+	*mock.Call
+}
+
+// GetStationMoObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationMoObservationParams
+func (_e *MockStore_Expecter) GetStationMoObservation(ctx interface{}, arg interface{}) *MockStore_GetStationMoObservation_Call {
+	return &MockStore_GetStationMoObservation_Call{Call: _e.mock.On("GetStationMoObservation", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationMoObservation_Call) Run(run func(ctx context.Context, arg db.GetStationMoObservationParams)) *MockStore_GetStationMoObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationMoObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationMoObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_GetStationMoObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationMoObservation_Call) RunAndReturn(run func(context.Context, db.GetStationMoObservationParams) (db.ObservationsMoObservation, error)) *MockStore_GetStationMoObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetStationObservation(ctx context.Context, arg db.GetStationObservationParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 db.ObservationsObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsObservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationObservationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationObservation'
+type MockStore_GetStationObservation_Call struct {
+	*mock.Call
+}
+
+// GetStationObservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetStationObservationParams
+func (_e *MockStore_Expecter) GetStationObservation(ctx interface{}, arg interface{}) *MockStore_GetStationObservation_Call {
+	return &MockStore_GetStationObservation_Call{Call: _e.mock.On("GetStationObservation", ctx, arg)}
+}
+
+func (_c *MockStore_GetStationObservation_Call) Run(run func(ctx context.Context, arg db.GetStationObservationParams)) *MockStore_GetStationObservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db.GetStationObservationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_GetStationObservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationObservation_Call) RunAndReturn(run func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)) *MockStore_GetStationObservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStationUploadKey provides a mock function with given fields: ctx, uploadID
+func (_m *MockStore) GetStationUploadKey(ctx context.Context, uploadID string) (db.ObservationsStationuploadkey, error) {
+	ret := _m.Called(ctx, uploadID)
+
+	var r0 db.ObservationsStationuploadkey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.ObservationsStationuploadkey, error)); ok {
+		return rf(ctx, uploadID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.ObservationsStationuploadkey); ok {
+		r0 = rf(ctx, uploadID)
+	} else {
+		r0 = ret.Get(0).(db.ObservationsStationuploadkey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uploadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetStationUploadKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationUploadKey'
+type MockStore_GetStationUploadKey_Call struct {
+	*mock.Call
+}
+
+// GetStationUploadKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadID string
+func (_e *MockStore_Expecter) GetStationUploadKey(ctx interface{}, uploadID interface{}) *MockStore_GetStationUploadKey_Call {
+	return &MockStore_GetStationUploadKey_Call{Call: _e.mock.On("GetStationUploadKey", ctx, uploadID)}
+}
+
+func (_c *MockStore_GetStationUploadKey_Call) Run(run func(ctx context.Context, uploadID string)) *MockStore_GetStationUploadKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetStationUploadKey_Call) Return(_a0 db.ObservationsStationuploadkey, _a1 error) *MockStore_GetStationUploadKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetStationUploadKey_Call) RunAndReturn(run func(context.Context, string) (db.ObservationsStationuploadkey, error)) *MockStore_GetStationUploadKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetUser(ctx context.Context, id int64) (db.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockStore_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockStore_Expecter) GetUser(ctx interface{}, id interface{}) *MockStore_GetUser_Call {
+	return &MockStore_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id)}
+}
+
+func (_c *MockStore_GetUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUser_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUser_Call) RunAndReturn(run func(context.Context, int64) (db.User, error)) *MockStore_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockStore_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
+	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *MockStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 db.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(db.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
+type MockStore_GetUserByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockStore_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockStore_GetUserByUsername_Call {
+	return &MockStore_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+}
+
+func (_c *MockStore_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockStore_GetUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByUsername_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertCurrentObservations provides a mock function with given fields: ctx
+func (_m *MockStore) InsertCurrentObservations(ctx context.Context) ([]db.ObservationsCurrent, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []db.ObservationsCurrent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ObservationsCurrent, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []db.ObservationsCurrent); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsCurrent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertCurrentObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCurrentObservations'
+type MockStore_InsertCurrentObservations_Call struct {
+	*mock.Call
+}
+
+// InsertCurrentObservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) InsertCurrentObservations(ctx interface{}) *MockStore_InsertCurrentObservations_Call {
+	return &MockStore_InsertCurrentObservations_Call{Call: _e.mock.On("InsertCurrentObservations", ctx)}
+}
+
+func (_c *MockStore_InsertCurrentObservations_Call) Run(run func(ctx context.Context)) *MockStore_InsertCurrentObservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertCurrentObservations_Call) Return(_a0 []db.ObservationsCurrent, _a1 error) *MockStore_InsertCurrentObservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertCurrentObservations_Call) RunAndReturn(run func(context.Context) ([]db.ObservationsCurrent, error)) *MockStore_InsertCurrentObservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveStationHealthAlerts provides a mock function with given fields: ctx, stationID
+func (_m *MockStore) ListActiveStationHealthAlerts(ctx context.Context, stationID int64) ([]db.ObservationsStationhealthAlert, error) {
+	ret := _m.Called(ctx, stationID)
+
+	var r0 []db.ObservationsStationhealthAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.ObservationsStationhealthAlert, error)); ok {
+		return rf(ctx, stationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.ObservationsStationhealthAlert); ok {
+		r0 = rf(ctx, stationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStationhealthAlert)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, stationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_BatchCreateUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCreateUserRoles'
-type MockStore_BatchCreateUserRoles_Call struct {
+// MockStore_ListActiveStationHealthAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveStationHealthAlerts'
+type MockStore_ListActiveStationHealthAlerts_Call struct {
 	*mock.Call
 }
 
-// BatchCreateUserRoles is a helper method to define mock.On call
+// ListActiveStationHealthAlerts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []db.BatchCreateUserRolesParams
-func (_e *MockStore_Expecter) BatchCreateUserRoles(ctx interface{}, arg interface{}) *MockStore_BatchCreateUserRoles_Call {
-	return &MockStore_BatchCreateUserRoles_Call{Call: _e.mock.On("BatchCreateUserRoles", ctx, arg)}
+//   - stationID int64
+func (_e *MockStore_Expecter) ListActiveStationHealthAlerts(ctx interface{}, stationID interface{}) *MockStore_ListActiveStationHealthAlerts_Call {
+	return &MockStore_ListActiveStationHealthAlerts_Call{Call: _e.mock.On("ListActiveStationHealthAlerts", ctx, stationID)}
 }
 
-func (_c *MockStore_BatchCreateUserRoles_Call) Run(run func(ctx context.Context, arg []db.BatchCreateUserRolesParams)) *MockStore_BatchCreateUserRoles_Call {
+func (_c *MockStore_ListActiveStationHealthAlerts_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_ListActiveStationHealthAlerts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]db.BatchCreateUserRolesParams))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_BatchCreateUserRoles_Call) Return(_a0 *db.BatchCreateUserRolesBatchResults) *MockStore_BatchCreateUserRoles_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListActiveStationHealthAlerts_Call) Return(_a0 []db.ObservationsStationhealthAlert, _a1 error) *MockStore_ListActiveStationHealthAlerts_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_BatchCreateUserRoles_Call) RunAndReturn(run func(context.Context, []db.BatchCreateUserRolesParams) *db.BatchCreateUserRolesBatchResults) *MockStore_BatchCreateUserRoles_Call {
+func (_c *MockStore_ListActiveStationHealthAlerts_Call) RunAndReturn(run func(context.Context, int64) ([]db.ObservationsStationhealthAlert, error)) *MockStore_ListActiveStationHealthAlerts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BatchDeleteUserRoles provides a mock function with given fields: ctx, arg
-func (_m *MockStore) BatchDeleteUserRoles(ctx context.Context, arg []db.BatchDeleteUserRolesParams) *db.BatchDeleteUserRolesBatchResults {
-	ret := _m.Called(ctx, arg)
+// ListDailySummarySubscriptions provides a mock function with given fields: ctx, tokenType
+func (_m *MockStore) ListDailySummarySubscriptions(ctx context.Context, tokenType string) ([]db.SmsSubscription, error) {
+	ret := _m.Called(ctx, tokenType)
 
-	var r0 *db.BatchDeleteUserRolesBatchResults
-	if rf, ok := ret.Get(0).(func(context.Context, []db.BatchDeleteUserRolesParams) *db.BatchDeleteUserRolesBatchResults); ok {
-		r0 = rf(ctx, arg)
+	var r0 []db.SmsSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]db.SmsSubscription, error)); ok {
+		return rf(ctx, tokenType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []db.SmsSubscription); ok {
+		r0 = rf(ctx, tokenType)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*db.BatchDeleteUserRolesBatchResults)
+			r0 = ret.Get(0).([]db.SmsSubscription)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_BatchDeleteUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchDeleteUserRoles'
-type MockStore_BatchDeleteUserRoles_Call struct {
+// MockStore_ListDailySummarySubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDailySummarySubscriptions'
+type MockStore_ListDailySummarySubscriptions_Call struct {
 	*mock.Call
 }
 
-// BatchDeleteUserRoles is a helper method to define mock.On call
+// ListDailySummarySubscriptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []db.BatchDeleteUserRolesParams
-func (_e *MockStore_Expecter) BatchDeleteUserRoles(ctx interface{}, arg interface{}) *MockStore_BatchDeleteUserRoles_Call {
-	return &MockStore_BatchDeleteUserRoles_Call{Call: _e.mock.On("BatchDeleteUserRoles", ctx, arg)}
+//   - tokenType string
+func (_e *MockStore_Expecter) ListDailySummarySubscriptions(ctx interface{}, tokenType interface{}) *MockStore_ListDailySummarySubscriptions_Call {
+	return &MockStore_ListDailySummarySubscriptions_Call{Call: _e.mock.On("ListDailySummarySubscriptions", ctx, tokenType)}
 }
 
-func (_c *MockStore_BatchDeleteUserRoles_Call) Run(run func(ctx context.Context, arg []db.BatchDeleteUserRolesParams)) *MockStore_BatchDeleteUserRoles_Call {
+func (_c *MockStore_ListDailySummarySubscriptions_Call) Run(run func(ctx context.Context, tokenType string)) *MockStore_ListDailySummarySubscriptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]db.BatchDeleteUserRolesParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_BatchDeleteUserRoles_Call) Return(_a0 *db.BatchDeleteUserRolesBatchResults) *MockStore_BatchDeleteUserRoles_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListDailySummarySubscriptions_Call) Return(_a0 []db.SmsSubscription, _a1 error) *MockStore_ListDailySummarySubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_BatchDeleteUserRoles_Call) RunAndReturn(run func(context.Context, []db.BatchDeleteUserRolesParams) *db.BatchDeleteUserRolesBatchResults) *MockStore_BatchDeleteUserRoles_Call {
+func (_c *MockStore_ListDailySummarySubscriptions_Call) RunAndReturn(run func(context.Context, string) ([]db.SmsSubscription, error)) *MockStore_ListDailySummarySubscriptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BulkCreateUserRoles provides a mock function with given fields: ctx, arg
-func (_m *MockStore) BulkCreateUserRoles(ctx context.Context, arg []db.UserRolesParams) ([]db.UserRolesParams, []error) {
+// ListGLabsLoads provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListGLabsLoads(ctx context.Context, arg db.ListGLabsLoadsParams) ([]db.GlabsLoad, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.UserRolesParams
-	var r1 []error
-	if rf, ok := ret.Get(0).(func(context.Context, []db.UserRolesParams) ([]db.UserRolesParams, []error)); ok {
+	var r0 []db.GlabsLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListGLabsLoadsParams) ([]db.GlabsLoad, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []db.UserRolesParams) []db.UserRolesParams); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListGLabsLoadsParams) []db.GlabsLoad); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.UserRolesParams)
+			r0 = ret.Get(0).([]db.GlabsLoad)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []db.UserRolesParams) []error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListGLabsLoadsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]error)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// MockStore_BulkCreateUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateUserRoles'
-type MockStore_BulkCreateUserRoles_Call struct {
+// MockStore_ListGLabsLoads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGLabsLoads'
+type MockStore_ListGLabsLoads_Call struct {
 	*mock.Call
 }
 
-// BulkCreateUserRoles is a helper method to define mock.On call
+// ListGLabsLoads is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []db.UserRolesParams
-func (_e *MockStore_Expecter) BulkCreateUserRoles(ctx interface{}, arg interface{}) *MockStore_BulkCreateUserRoles_Call {
-	return &MockStore_BulkCreateUserRoles_Call{Call: _e.mock.On("BulkCreateUserRoles", ctx, arg)}
+//   - arg db.ListGLabsLoadsParams
+func (_e *MockStore_Expecter) ListGLabsLoads(ctx interface{}, arg interface{}) *MockStore_ListGLabsLoads_Call {
+	return &MockStore_ListGLabsLoads_Call{Call: _e.mock.On("ListGLabsLoads", ctx, arg)}
 }
 
-func (_c *MockStore_BulkCreateUserRoles_Call) Run(run func(ctx context.Context, arg []db.UserRolesParams)) *MockStore_BulkCreateUserRoles_Call {
+func (_c *MockStore_ListGLabsLoads_Call) Run(run func(ctx context.Context, arg db.ListGLabsLoadsParams)) *MockStore_ListGLabsLoads_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]db.UserRolesParams))
+		run(args[0].(context.Context), args[1].(db.ListGLabsLoadsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_BulkCreateUserRoles_Call) Return(ret []db.UserRolesParams, errs []error) *MockStore_BulkCreateUserRoles_Call {
-	_c.Call.Return(ret, errs)
+func (_c *MockStore_ListGLabsLoads_Call) Return(_a0 []db.GlabsLoad, _a1 error) *MockStore_ListGLabsLoads_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_BulkCreateUserRoles_Call) RunAndReturn(run func(context.Context, []db.UserRolesParams) ([]db.UserRolesParams, []error)) *MockStore_BulkCreateUserRoles_Call {
+func (_c *MockStore_ListGLabsLoads_Call) RunAndReturn(run func(context.Context, db.ListGLabsLoadsParams) ([]db.GlabsLoad, error)) *MockStore_ListGLabsLoads_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BulkDeleteUserRoles provides a mock function with given fields: ctx, arg
-func (_m *MockStore) BulkDeleteUserRoles(ctx context.Context, arg []db.UserRolesParams) []error {
+// ListJobRuns provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListJobRuns(ctx context.Context, arg db.ListJobRunsParams) ([]db.JobRun, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []error
-	if rf, ok := ret.Get(0).(func(context.Context, []db.UserRolesParams) []error); ok {
+	var r0 []db.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListJobRunsParams) ([]db.JobRun, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListJobRunsParams) []db.JobRun); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]error)
+			r0 = ret.Get(0).([]db.JobRun)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListJobRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_BulkDeleteUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDeleteUserRoles'
-type MockStore_BulkDeleteUserRoles_Call struct {
+// MockStore_ListJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJobRuns'
+type MockStore_ListJobRuns_Call struct {
 	*mock.Call
 }
 
-// BulkDeleteUserRoles is a helper method to define mock.On call
+// ListJobRuns is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []db.UserRolesParams
-func (_e *MockStore_Expecter) BulkDeleteUserRoles(ctx interface{}, arg interface{}) *MockStore_BulkDeleteUserRoles_Call {
-	return &MockStore_BulkDeleteUserRoles_Call{Call: _e.mock.On("BulkDeleteUserRoles", ctx, arg)}
+//   - arg db.ListJobRunsParams
+func (_e *MockStore_Expecter) ListJobRuns(ctx interface{}, arg interface{}) *MockStore_ListJobRuns_Call {
+	return &MockStore_ListJobRuns_Call{Call: _e.mock.On("ListJobRuns", ctx, arg)}
 }
 
-func (_c *MockStore_BulkDeleteUserRoles_Call) Run(run func(ctx context.Context, arg []db.UserRolesParams)) *MockStore_BulkDeleteUserRoles_Call {
+func (_c *MockStore_ListJobRuns_Call) Run(run func(ctx context.Context, arg db.ListJobRunsParams)) *MockStore_ListJobRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]db.UserRolesParams))
+		run(args[0].(context.Context), args[1].(db.ListJobRunsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_BulkDeleteUserRoles_Call) Return(_a0 []error) *MockStore_BulkDeleteUserRoles_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListJobRuns_Call) Return(_a0 []db.JobRun, _a1 error) *MockStore_ListJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_BulkDeleteUserRoles_Call) RunAndReturn(run func(context.Context, []db.UserRolesParams) []error) *MockStore_BulkDeleteUserRoles_Call {
+func (_c *MockStore_ListJobRuns_Call) RunAndReturn(run func(context.Context, db.ListJobRunsParams) ([]db.JobRun, error)) *MockStore_ListJobRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountLufftStationMsg provides a mock function with given fields: ctx, stationID
-func (_m *MockStore) CountLufftStationMsg(ctx context.Context, stationID int64) (int64, error) {
-	ret := _m.Called(ctx, stationID)
+// ListLatestObservations provides a mock function with given fields: ctx
+func (_m *MockStore) ListLatestObservations(ctx context.Context) ([]db.ListLatestObservationsRow, error) {
+	ret := _m.Called(ctx)
 
-	var r0 int64
+	var r0 []db.ListLatestObservationsRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
-		return rf(ctx, stationID)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ListLatestObservationsRow, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
-		r0 = rf(ctx, stationID)
+	if rf, ok := ret.Get(0).(func(context.Context) []db.ListLatestObservationsRow); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListLatestObservationsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, stationID)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -242,51 +6025,52 @@ func (_m *MockStore) CountLufftStationMsg(ctx context.Context, stationID int64)
 	return r0, r1
 }
 
-// MockStore_CountLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountLufftStationMsg'
-type MockStore_CountLufftStationMsg_Call struct {
+// MockStore_ListLatestObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestObservations'
+type MockStore_ListLatestObservations_Call struct {
 	*mock.Call
 }
 
-// CountLufftStationMsg is a helper method to define mock.On call
+// ListLatestObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - stationID int64
-func (_e *MockStore_Expecter) CountLufftStationMsg(ctx interface{}, stationID interface{}) *MockStore_CountLufftStationMsg_Call {
-	return &MockStore_CountLufftStationMsg_Call{Call: _e.mock.On("CountLufftStationMsg", ctx, stationID)}
+func (_e *MockStore_Expecter) ListLatestObservations(ctx interface{}) *MockStore_ListLatestObservations_Call {
+	return &MockStore_ListLatestObservations_Call{Call: _e.mock.On("ListLatestObservations", ctx)}
 }
 
-func (_c *MockStore_CountLufftStationMsg_Call) Run(run func(ctx context.Context, stationID int64)) *MockStore_CountLufftStationMsg_Call {
+func (_c *MockStore_ListLatestObservations_Call) Run(run func(ctx context.Context)) *MockStore_ListLatestObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountLufftStationMsg_Call) Return(_a0 int64, _a1 error) *MockStore_CountLufftStationMsg_Call {
+func (_c *MockStore_ListLatestObservations_Call) Return(_a0 []db.ListLatestObservationsRow, _a1 error) *MockStore_ListLatestObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountLufftStationMsg_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockStore_CountLufftStationMsg_Call {
+func (_c *MockStore_ListLatestObservations_Call) RunAndReturn(run func(context.Context) ([]db.ListLatestObservationsRow, error)) *MockStore_ListLatestObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountObservations(ctx context.Context, arg db.CountObservationsParams) (int64, error) {
+// ListLoadRequests provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListLoadRequests(ctx context.Context, arg db.ListLoadRequestsParams) ([]db.LoadRequest, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 int64
+	var r0 []db.LoadRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLoadRequestsParams) ([]db.LoadRequest, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountObservationsParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLoadRequestsParams) []db.LoadRequest); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.LoadRequest)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListLoadRequestsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -295,52 +6079,54 @@ func (_m *MockStore) CountObservations(ctx context.Context, arg db.CountObservat
 	return r0, r1
 }
 
-// MockStore_CountObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountObservations'
-type MockStore_CountObservations_Call struct {
+// MockStore_ListLoadRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLoadRequests'
+type MockStore_ListLoadRequests_Call struct {
 	*mock.Call
 }
 
-// CountObservations is a helper method to define mock.On call
+// ListLoadRequests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountObservationsParams
-func (_e *MockStore_Expecter) CountObservations(ctx interface{}, arg interface{}) *MockStore_CountObservations_Call {
-	return &MockStore_CountObservations_Call{Call: _e.mock.On("CountObservations", ctx, arg)}
+//   - arg db.ListLoadRequestsParams
+func (_e *MockStore_Expecter) ListLoadRequests(ctx interface{}, arg interface{}) *MockStore_ListLoadRequests_Call {
+	return &MockStore_ListLoadRequests_Call{Call: _e.mock.On("ListLoadRequests", ctx, arg)}
 }
 
-func (_c *MockStore_CountObservations_Call) Run(run func(ctx context.Context, arg db.CountObservationsParams)) *MockStore_CountObservations_Call {
+func (_c *MockStore_ListLoadRequests_Call) Run(run func(ctx context.Context, arg db.ListLoadRequestsParams)) *MockStore_ListLoadRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountObservationsParams))
+		run(args[0].(context.Context), args[1].(db.ListLoadRequestsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountObservations_Call {
+func (_c *MockStore_ListLoadRequests_Call) Return(_a0 []db.LoadRequest, _a1 error) *MockStore_ListLoadRequests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountObservations_Call) RunAndReturn(run func(context.Context, db.CountObservationsParams) (int64, error)) *MockStore_CountObservations_Call {
+func (_c *MockStore_ListLoadRequests_Call) RunAndReturn(run func(context.Context, db.ListLoadRequestsParams) ([]db.LoadRequest, error)) *MockStore_ListLoadRequests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountRoles provides a mock function with given fields: ctx
-func (_m *MockStore) CountRoles(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// ListLufftStationMsg provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListLufftStationMsg(ctx context.Context, arg db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 int64
+	var r0 []db.ListLufftStationMsgRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) []db.ListLufftStationMsgRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListLufftStationMsgRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListLufftStationMsgParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -348,51 +6134,109 @@ func (_m *MockStore) CountRoles(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
-// MockStore_CountRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRoles'
-type MockStore_CountRoles_Call struct {
+// MockStore_ListLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLufftStationMsg'
+type MockStore_ListLufftStationMsg_Call struct {
 	*mock.Call
 }
 
-// CountRoles is a helper method to define mock.On call
+// ListLufftStationMsg is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) CountRoles(ctx interface{}) *MockStore_CountRoles_Call {
-	return &MockStore_CountRoles_Call{Call: _e.mock.On("CountRoles", ctx)}
+//   - arg db.ListLufftStationMsgParams
+func (_e *MockStore_Expecter) ListLufftStationMsg(ctx interface{}, arg interface{}) *MockStore_ListLufftStationMsg_Call {
+	return &MockStore_ListLufftStationMsg_Call{Call: _e.mock.On("ListLufftStationMsg", ctx, arg)}
 }
 
-func (_c *MockStore_CountRoles_Call) Run(run func(ctx context.Context)) *MockStore_CountRoles_Call {
+func (_c *MockStore_ListLufftStationMsg_Call) Run(run func(ctx context.Context, arg db.ListLufftStationMsgParams)) *MockStore_ListLufftStationMsg_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.ListLufftStationMsgParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountRoles_Call) Return(_a0 int64, _a1 error) *MockStore_CountRoles_Call {
+func (_c *MockStore_ListLufftStationMsg_Call) Return(_a0 []db.ListLufftStationMsgRow, _a1 error) *MockStore_ListLufftStationMsg_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountRoles_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountRoles_Call {
+func (_c *MockStore_ListLufftStationMsg_Call) RunAndReturn(run func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)) *MockStore_ListLufftStationMsg_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationObservations(ctx context.Context, arg db.CountStationObservationsParams) (int64, error) {
-	ret := _m.Called(ctx, arg)
+// ListMoObservationQcFlags provides a mock function with given fields: ctx, moObservationID
+func (_m *MockStore) ListMoObservationQcFlags(ctx context.Context, moObservationID int64) ([]db.ObservationsMoQcFlag, error) {
+	ret := _m.Called(ctx, moObservationID)
 
-	var r0 int64
+	var r0 []db.ObservationsMoQcFlag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) (int64, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.ObservationsMoQcFlag, error)); ok {
+		return rf(ctx, moObservationID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationObservationsParams) int64); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.ObservationsMoQcFlag); ok {
+		r0 = rf(ctx, moObservationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsMoQcFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, moObservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListMoObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMoObservationQcFlags'
+type MockStore_ListMoObservationQcFlags_Call struct {
+	*mock.Call
+}
+
+// ListMoObservationQcFlags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moObservationID int64
+func (_e *MockStore_Expecter) ListMoObservationQcFlags(ctx interface{}, moObservationID interface{}) *MockStore_ListMoObservationQcFlags_Call {
+	return &MockStore_ListMoObservationQcFlags_Call{Call: _e.mock.On("ListMoObservationQcFlags", ctx, moObservationID)}
+}
+
+func (_c *MockStore_ListMoObservationQcFlags_Call) Run(run func(ctx context.Context, moObservationID int64)) *MockStore_ListMoObservationQcFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListMoObservationQcFlags_Call) Return(_a0 []db.ObservationsMoQcFlag, _a1 error) *MockStore_ListMoObservationQcFlags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListMoObservationQcFlags_Call) RunAndReturn(run func(context.Context, int64) ([]db.ObservationsMoQcFlag, error)) *MockStore_ListMoObservationQcFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListObservationQcFlags provides a mock function with given fields: ctx, observationID
+func (_m *MockStore) ListObservationQcFlags(ctx context.Context, observationID int64) ([]db.ObservationsQcFlag, error) {
+	ret := _m.Called(ctx, observationID)
+
+	var r0 []db.ObservationsQcFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]db.ObservationsQcFlag, error)); ok {
+		return rf(ctx, observationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []db.ObservationsQcFlag); ok {
+		r0 = rf(ctx, observationID)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsQcFlag)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationObservationsParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, observationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -400,52 +6244,54 @@ func (_m *MockStore) CountStationObservations(ctx context.Context, arg db.CountS
 	return r0, r1
 }
 
-// MockStore_CountStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationObservations'
-type MockStore_CountStationObservations_Call struct {
+// MockStore_ListObservationQcFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObservationQcFlags'
+type MockStore_ListObservationQcFlags_Call struct {
 	*mock.Call
 }
 
-// CountStationObservations is a helper method to define mock.On call
+// ListObservationQcFlags is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationObservationsParams
-func (_e *MockStore_Expecter) CountStationObservations(ctx interface{}, arg interface{}) *MockStore_CountStationObservations_Call {
-	return &MockStore_CountStationObservations_Call{Call: _e.mock.On("CountStationObservations", ctx, arg)}
+//   - observationID int64
+func (_e *MockStore_Expecter) ListObservationQcFlags(ctx interface{}, observationID interface{}) *MockStore_ListObservationQcFlags_Call {
+	return &MockStore_ListObservationQcFlags_Call{Call: _e.mock.On("ListObservationQcFlags", ctx, observationID)}
 }
 
-func (_c *MockStore_CountStationObservations_Call) Run(run func(ctx context.Context, arg db.CountStationObservationsParams)) *MockStore_CountStationObservations_Call {
+func (_c *MockStore_ListObservationQcFlags_Call) Run(run func(ctx context.Context, observationID int64)) *MockStore_ListObservationQcFlags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationObservationsParams))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationObservations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationObservations_Call {
+func (_c *MockStore_ListObservationQcFlags_Call) Return(_a0 []db.ObservationsQcFlag, _a1 error) *MockStore_ListObservationQcFlags_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStationObservations_Call) RunAndReturn(run func(context.Context, db.CountStationObservationsParams) (int64, error)) *MockStore_CountStationObservations_Call {
+func (_c *MockStore_ListObservationQcFlags_Call) RunAndReturn(run func(context.Context, int64) ([]db.ObservationsQcFlag, error)) *MockStore_ListObservationQcFlags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStations provides a mock function with given fields: ctx, status
-func (_m *MockStore) CountStations(ctx context.Context, status pgtype.Text) (int64, error) {
-	ret := _m.Called(ctx, status)
+// ListObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListObservations(ctx context.Context, arg db.ListObservationsParams) ([]db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 int64
+	var r0 []db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (int64, error)); ok {
-		return rf(ctx, status)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) int64); ok {
-		r0 = rf(ctx, status)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) []db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, status)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -453,51 +6299,53 @@ func (_m *MockStore) CountStations(ctx context.Context, status pgtype.Text) (int
 	return r0, r1
 }
 
-// MockStore_CountStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStations'
-type MockStore_CountStations_Call struct {
+// MockStore_ListObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObservations'
+type MockStore_ListObservations_Call struct {
 	*mock.Call
 }
 
-// CountStations is a helper method to define mock.On call
+// ListObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - status pgtype.Text
-func (_e *MockStore_Expecter) CountStations(ctx interface{}, status interface{}) *MockStore_CountStations_Call {
-	return &MockStore_CountStations_Call{Call: _e.mock.On("CountStations", ctx, status)}
+//   - arg db.ListObservationsParams
+func (_e *MockStore_Expecter) ListObservations(ctx interface{}, arg interface{}) *MockStore_ListObservations_Call {
+	return &MockStore_ListObservations_Call{Call: _e.mock.On("ListObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CountStations_Call) Run(run func(ctx context.Context, status pgtype.Text)) *MockStore_CountStations_Call {
+func (_c *MockStore_ListObservations_Call) Run(run func(ctx context.Context, arg db.ListObservationsParams)) *MockStore_ListObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(db.ListObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStations_Call) Return(_a0 int64, _a1 error) *MockStore_CountStations_Call {
+func (_c *MockStore_ListObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStations_Call) RunAndReturn(run func(context.Context, pgtype.Text) (int64, error)) *MockStore_CountStations_Call {
+func (_c *MockStore_ListObservations_Call) RunAndReturn(run func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationsWithinBBox provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationsWithinBBox(ctx context.Context, arg db.CountStationsWithinBBoxParams) (int64, error) {
+// ListObservationsForQc provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListObservationsForQc(ctx context.Context, arg db.ListObservationsForQcParams) ([]db.ObservationsObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 int64
+	var r0 []db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinBBoxParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsForQcParams) ([]db.ObservationsObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinBBoxParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsForQcParams) []db.ObservationsObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsWithinBBoxParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListObservationsForQcParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -506,51 +6354,53 @@ func (_m *MockStore) CountStationsWithinBBox(ctx context.Context, arg db.CountSt
 	return r0, r1
 }
 
-// MockStore_CountStationsWithinBBox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationsWithinBBox'
-type MockStore_CountStationsWithinBBox_Call struct {
+// MockStore_ListObservationsForQc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObservationsForQc'
+type MockStore_ListObservationsForQc_Call struct {
 	*mock.Call
 }
 
-// CountStationsWithinBBox is a helper method to define mock.On call
+// ListObservationsForQc is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationsWithinBBoxParams
-func (_e *MockStore_Expecter) CountStationsWithinBBox(ctx interface{}, arg interface{}) *MockStore_CountStationsWithinBBox_Call {
-	return &MockStore_CountStationsWithinBBox_Call{Call: _e.mock.On("CountStationsWithinBBox", ctx, arg)}
+//   - arg db.ListObservationsForQcParams
+func (_e *MockStore_Expecter) ListObservationsForQc(ctx interface{}, arg interface{}) *MockStore_ListObservationsForQc_Call {
+	return &MockStore_ListObservationsForQc_Call{Call: _e.mock.On("ListObservationsForQc", ctx, arg)}
 }
 
-func (_c *MockStore_CountStationsWithinBBox_Call) Run(run func(ctx context.Context, arg db.CountStationsWithinBBoxParams)) *MockStore_CountStationsWithinBBox_Call {
+func (_c *MockStore_ListObservationsForQc_Call) Run(run func(ctx context.Context, arg db.ListObservationsForQcParams)) *MockStore_ListObservationsForQc_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationsWithinBBoxParams))
+		run(args[0].(context.Context), args[1].(db.ListObservationsForQcParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinBBox_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationsWithinBBox_Call {
+func (_c *MockStore_ListObservationsForQc_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListObservationsForQc_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinBBox_Call) RunAndReturn(run func(context.Context, db.CountStationsWithinBBoxParams) (int64, error)) *MockStore_CountStationsWithinBBox_Call {
+func (_c *MockStore_ListObservationsForQc_Call) RunAndReturn(run func(context.Context, db.ListObservationsForQcParams) ([]db.ObservationsObservation, error)) *MockStore_ListObservationsForQc_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountStationsWithinRadius provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CountStationsWithinRadius(ctx context.Context, arg db.CountStationsWithinRadiusParams) (int64, error) {
+// ListPreviousStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListPreviousStationMoObservations(ctx context.Context, arg db.ListPreviousStationMoObservationsParams) ([]db.ObservationsMoObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 int64
+	var r0 []db.ObservationsMoObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinRadiusParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListPreviousStationMoObservationsParams) ([]db.ObservationsMoObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CountStationsWithinRadiusParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListPreviousStationMoObservationsParams) []db.ObservationsMoObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsMoObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CountStationsWithinRadiusParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListPreviousStationMoObservationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -559,52 +6409,54 @@ func (_m *MockStore) CountStationsWithinRadius(ctx context.Context, arg db.Count
 	return r0, r1
 }
 
-// MockStore_CountStationsWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStationsWithinRadius'
-type MockStore_CountStationsWithinRadius_Call struct {
+// MockStore_ListPreviousStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPreviousStationMoObservations'
+type MockStore_ListPreviousStationMoObservations_Call struct {
 	*mock.Call
 }
 
-// CountStationsWithinRadius is a helper method to define mock.On call
+// ListPreviousStationMoObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CountStationsWithinRadiusParams
-func (_e *MockStore_Expecter) CountStationsWithinRadius(ctx interface{}, arg interface{}) *MockStore_CountStationsWithinRadius_Call {
-	return &MockStore_CountStationsWithinRadius_Call{Call: _e.mock.On("CountStationsWithinRadius", ctx, arg)}
+//   - arg db.ListPreviousStationMoObservationsParams
+func (_e *MockStore_Expecter) ListPreviousStationMoObservations(ctx interface{}, arg interface{}) *MockStore_ListPreviousStationMoObservations_Call {
+	return &MockStore_ListPreviousStationMoObservations_Call{Call: _e.mock.On("ListPreviousStationMoObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CountStationsWithinRadius_Call) Run(run func(ctx context.Context, arg db.CountStationsWithinRadiusParams)) *MockStore_CountStationsWithinRadius_Call {
+func (_c *MockStore_ListPreviousStationMoObservations_Call) Run(run func(ctx context.Context, arg db.ListPreviousStationMoObservationsParams)) *MockStore_ListPreviousStationMoObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CountStationsWithinRadiusParams))
+		run(args[0].(context.Context), args[1].(db.ListPreviousStationMoObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinRadius_Call) Return(_a0 int64, _a1 error) *MockStore_CountStationsWithinRadius_Call {
+func (_c *MockStore_ListPreviousStationMoObservations_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListPreviousStationMoObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountStationsWithinRadius_Call) RunAndReturn(run func(context.Context, db.CountStationsWithinRadiusParams) (int64, error)) *MockStore_CountStationsWithinRadius_Call {
+func (_c *MockStore_ListPreviousStationMoObservations_Call) RunAndReturn(run func(context.Context, db.ListPreviousStationMoObservationsParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListPreviousStationMoObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountUsers provides a mock function with given fields: ctx
-func (_m *MockStore) CountUsers(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// ListPreviousStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListPreviousStationObservations(ctx context.Context, arg db.ListPreviousStationObservationsParams) ([]db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 int64
+	var r0 []db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListPreviousStationObservationsParams) ([]db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListPreviousStationObservationsParams) []db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListPreviousStationObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -612,51 +6464,54 @@ func (_m *MockStore) CountUsers(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
-// MockStore_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
-type MockStore_CountUsers_Call struct {
+// MockStore_ListPreviousStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPreviousStationObservations'
+type MockStore_ListPreviousStationObservations_Call struct {
 	*mock.Call
 }
 
-// CountUsers is a helper method to define mock.On call
+// ListPreviousStationObservations is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) CountUsers(ctx interface{}) *MockStore_CountUsers_Call {
-	return &MockStore_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+//   - arg db.ListPreviousStationObservationsParams
+func (_e *MockStore_Expecter) ListPreviousStationObservations(ctx interface{}, arg interface{}) *MockStore_ListPreviousStationObservations_Call {
+	return &MockStore_ListPreviousStationObservations_Call{Call: _e.mock.On("ListPreviousStationObservations", ctx, arg)}
 }
 
-func (_c *MockStore_CountUsers_Call) Run(run func(ctx context.Context)) *MockStore_CountUsers_Call {
+func (_c *MockStore_ListPreviousStationObservations_Call) Run(run func(ctx context.Context, arg db.ListPreviousStationObservationsParams)) *MockStore_ListPreviousStationObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.ListPreviousStationObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CountUsers_Call) Return(_a0 int64, _a1 error) *MockStore_CountUsers_Call {
+func (_c *MockStore_ListPreviousStationObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListPreviousStationObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CountUsers_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockStore_CountUsers_Call {
+func (_c *MockStore_ListPreviousStationObservations_Call) RunAndReturn(run func(context.Context, db.ListPreviousStationObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListPreviousStationObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCurrentObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateCurrentObservation(ctx context.Context, arg db.CreateCurrentObservationParams) (db.ObservationsCurrent, error) {
-	ret := _m.Called(ctx, arg)
+// ListProvinceStationIDs provides a mock function with given fields: ctx, province
+func (_m *MockStore) ListProvinceStationIDs(ctx context.Context, province string) ([]int64, error) {
+	ret := _m.Called(ctx, province)
 
-	var r0 db.ObservationsCurrent
+	var r0 []int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]int64, error)); ok {
+		return rf(ctx, province)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateCurrentObservationParams) db.ObservationsCurrent); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []int64); ok {
+		r0 = rf(ctx, province)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsCurrent)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateCurrentObservationParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, province)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -664,51 +6519,53 @@ func (_m *MockStore) CreateCurrentObservation(ctx context.Context, arg db.Create
 	return r0, r1
 }
 
-// MockStore_CreateCurrentObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCurrentObservation'
-type MockStore_CreateCurrentObservation_Call struct {
+// MockStore_ListProvinceStationIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProvinceStationIDs'
+type MockStore_ListProvinceStationIDs_Call struct {
 	*mock.Call
 }
 
-// CreateCurrentObservation is a helper method to define mock.On call
+// ListProvinceStationIDs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateCurrentObservationParams
-func (_e *MockStore_Expecter) CreateCurrentObservation(ctx interface{}, arg interface{}) *MockStore_CreateCurrentObservation_Call {
-	return &MockStore_CreateCurrentObservation_Call{Call: _e.mock.On("CreateCurrentObservation", ctx, arg)}
+//   - province string
+func (_e *MockStore_Expecter) ListProvinceStationIDs(ctx interface{}, province interface{}) *MockStore_ListProvinceStationIDs_Call {
+	return &MockStore_ListProvinceStationIDs_Call{Call: _e.mock.On("ListProvinceStationIDs", ctx, province)}
 }
 
-func (_c *MockStore_CreateCurrentObservation_Call) Run(run func(ctx context.Context, arg db.CreateCurrentObservationParams)) *MockStore_CreateCurrentObservation_Call {
+func (_c *MockStore_ListProvinceStationIDs_Call) Run(run func(ctx context.Context, province string)) *MockStore_ListProvinceStationIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateCurrentObservationParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateCurrentObservation_Call) Return(_a0 db.ObservationsCurrent, _a1 error) *MockStore_CreateCurrentObservation_Call {
+func (_c *MockStore_ListProvinceStationIDs_Call) Return(_a0 []int64, _a1 error) *MockStore_ListProvinceStationIDs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateCurrentObservation_Call) RunAndReturn(run func(context.Context, db.CreateCurrentObservationParams) (db.ObservationsCurrent, error)) *MockStore_CreateCurrentObservation_Call {
+func (_c *MockStore_ListProvinceStationIDs_Call) RunAndReturn(run func(context.Context, string) ([]int64, error)) *MockStore_ListProvinceStationIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateGLabsLoad provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateGLabsLoad(ctx context.Context, arg db.CreateGLabsLoadParams) (db.GlabsLoad, error) {
+// ListRawMessages provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListRawMessages(ctx context.Context, arg db.ListRawMessagesParams) ([]db.RawMessage, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.GlabsLoad
+	var r0 []db.RawMessage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListRawMessagesParams) ([]db.RawMessage, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateGLabsLoadParams) db.GlabsLoad); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListRawMessagesParams) []db.RawMessage); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.GlabsLoad)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.RawMessage)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateGLabsLoadParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListRawMessagesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -717,52 +6574,54 @@ func (_m *MockStore) CreateGLabsLoad(ctx context.Context, arg db.CreateGLabsLoad
 	return r0, r1
 }
 
-// MockStore_CreateGLabsLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGLabsLoad'
-type MockStore_CreateGLabsLoad_Call struct {
+// MockStore_ListRawMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRawMessages'
+type MockStore_ListRawMessages_Call struct {
 	*mock.Call
 }
 
-// CreateGLabsLoad is a helper method to define mock.On call
+// ListRawMessages is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateGLabsLoadParams
-func (_e *MockStore_Expecter) CreateGLabsLoad(ctx interface{}, arg interface{}) *MockStore_CreateGLabsLoad_Call {
-	return &MockStore_CreateGLabsLoad_Call{Call: _e.mock.On("CreateGLabsLoad", ctx, arg)}
+//   - arg db.ListRawMessagesParams
+func (_e *MockStore_Expecter) ListRawMessages(ctx interface{}, arg interface{}) *MockStore_ListRawMessages_Call {
+	return &MockStore_ListRawMessages_Call{Call: _e.mock.On("ListRawMessages", ctx, arg)}
 }
 
-func (_c *MockStore_CreateGLabsLoad_Call) Run(run func(ctx context.Context, arg db.CreateGLabsLoadParams)) *MockStore_CreateGLabsLoad_Call {
+func (_c *MockStore_ListRawMessages_Call) Run(run func(ctx context.Context, arg db.ListRawMessagesParams)) *MockStore_ListRawMessages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateGLabsLoadParams))
+		run(args[0].(context.Context), args[1].(db.ListRawMessagesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateGLabsLoad_Call) Return(_a0 db.GlabsLoad, _a1 error) *MockStore_CreateGLabsLoad_Call {
+func (_c *MockStore_ListRawMessages_Call) Return(_a0 []db.RawMessage, _a1 error) *MockStore_ListRawMessages_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateGLabsLoad_Call) RunAndReturn(run func(context.Context, db.CreateGLabsLoadParams) (db.GlabsLoad, error)) *MockStore_CreateGLabsLoad_Call {
+func (_c *MockStore_ListRawMessages_Call) RunAndReturn(run func(context.Context, db.ListRawMessagesParams) ([]db.RawMessage, error)) *MockStore_ListRawMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRole provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateRole(ctx context.Context, arg db.CreateRoleParams) (db.Role, error) {
-	ret := _m.Called(ctx, arg)
+// ListRawMessagesByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockStore) ListRawMessagesByIDs(ctx context.Context, ids []int64) ([]db.RawMessage, error) {
+	ret := _m.Called(ctx, ids)
 
-	var r0 db.Role
+	var r0 []db.RawMessage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) (db.Role, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]db.RawMessage, error)); ok {
+		return rf(ctx, ids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateRoleParams) db.Role); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []db.RawMessage); ok {
+		r0 = rf(ctx, ids)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.RawMessage)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateRoleParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -770,51 +6629,53 @@ func (_m *MockStore) CreateRole(ctx context.Context, arg db.CreateRoleParams) (d
 	return r0, r1
 }
 
-// MockStore_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
-type MockStore_CreateRole_Call struct {
+// MockStore_ListRawMessagesByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRawMessagesByIDs'
+type MockStore_ListRawMessagesByIDs_Call struct {
 	*mock.Call
 }
 
-// CreateRole is a helper method to define mock.On call
+// ListRawMessagesByIDs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateRoleParams
-func (_e *MockStore_Expecter) CreateRole(ctx interface{}, arg interface{}) *MockStore_CreateRole_Call {
-	return &MockStore_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, arg)}
+//   - ids []int64
+func (_e *MockStore_Expecter) ListRawMessagesByIDs(ctx interface{}, ids interface{}) *MockStore_ListRawMessagesByIDs_Call {
+	return &MockStore_ListRawMessagesByIDs_Call{Call: _e.mock.On("ListRawMessagesByIDs", ctx, ids)}
 }
 
-func (_c *MockStore_CreateRole_Call) Run(run func(ctx context.Context, arg db.CreateRoleParams)) *MockStore_CreateRole_Call {
+func (_c *MockStore_ListRawMessagesByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockStore_ListRawMessagesByIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateRoleParams))
+		run(args[0].(context.Context), args[1].([]int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_CreateRole_Call {
+func (_c *MockStore_ListRawMessagesByIDs_Call) Return(_a0 []db.RawMessage, _a1 error) *MockStore_ListRawMessagesByIDs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateRole_Call) RunAndReturn(run func(context.Context, db.CreateRoleParams) (db.Role, error)) *MockStore_CreateRole_Call {
+func (_c *MockStore_ListRawMessagesByIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]db.RawMessage, error)) *MockStore_ListRawMessagesByIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSession provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) (db.Session, error) {
+// ListRoles provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListRoles(ctx context.Context, arg db.ListRolesParams) ([]db.Role, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Session
+	var r0 []db.Role
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) (db.Session, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) ([]db.Role, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSessionParams) db.Session); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) []db.Role); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.Role)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSessionParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListRolesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -823,51 +6684,53 @@ func (_m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionPara
 	return r0, r1
 }
 
-// MockStore_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
-type MockStore_CreateSession_Call struct {
+// MockStore_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type MockStore_ListRoles_Call struct {
 	*mock.Call
 }
 
-// CreateSession is a helper method to define mock.On call
+// ListRoles is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateSessionParams
-func (_e *MockStore_Expecter) CreateSession(ctx interface{}, arg interface{}) *MockStore_CreateSession_Call {
-	return &MockStore_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, arg)}
+//   - arg db.ListRolesParams
+func (_e *MockStore_Expecter) ListRoles(ctx interface{}, arg interface{}) *MockStore_ListRoles_Call {
+	return &MockStore_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSession_Call) Run(run func(ctx context.Context, arg db.CreateSessionParams)) *MockStore_CreateSession_Call {
+func (_c *MockStore_ListRoles_Call) Run(run func(ctx context.Context, arg db.ListRolesParams)) *MockStore_ListRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateSessionParams))
+		run(args[0].(context.Context), args[1].(db.ListRolesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_CreateSession_Call {
+func (_c *MockStore_ListRoles_Call) Return(_a0 []db.Role, _a1 error) *MockStore_ListRoles_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSession_Call) RunAndReturn(run func(context.Context, db.CreateSessionParams) (db.Session, error)) *MockStore_CreateSession_Call {
+func (_c *MockStore_ListRoles_Call) RunAndReturn(run func(context.Context, db.ListRolesParams) ([]db.Role, error)) *MockStore_ListRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSimAccessToken provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSimAccessToken(ctx context.Context, arg db.CreateSimAccessTokenParams) (db.SimAccessToken, error) {
+// ListSilentStationSims provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSilentStationSims(ctx context.Context, arg db.ListSilentStationSimsParams) ([]db.ListSilentStationSimsRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.SimAccessToken
+	var r0 []db.ListSilentStationSimsRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSilentStationSimsParams) ([]db.ListSilentStationSimsRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimAccessTokenParams) db.SimAccessToken); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSilentStationSimsParams) []db.ListSilentStationSimsRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.SimAccessToken)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListSilentStationSimsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimAccessTokenParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSilentStationSimsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -876,52 +6739,54 @@ func (_m *MockStore) CreateSimAccessToken(ctx context.Context, arg db.CreateSimA
 	return r0, r1
 }
 
-// MockStore_CreateSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimAccessToken'
-type MockStore_CreateSimAccessToken_Call struct {
+// MockStore_ListSilentStationSims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSilentStationSims'
+type MockStore_ListSilentStationSims_Call struct {
 	*mock.Call
 }
 
-// CreateSimAccessToken is a helper method to define mock.On call
+// ListSilentStationSims is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateSimAccessTokenParams
-func (_e *MockStore_Expecter) CreateSimAccessToken(ctx interface{}, arg interface{}) *MockStore_CreateSimAccessToken_Call {
-	return &MockStore_CreateSimAccessToken_Call{Call: _e.mock.On("CreateSimAccessToken", ctx, arg)}
+//   - arg db.ListSilentStationSimsParams
+func (_e *MockStore_Expecter) ListSilentStationSims(ctx interface{}, arg interface{}) *MockStore_ListSilentStationSims_Call {
+	return &MockStore_ListSilentStationSims_Call{Call: _e.mock.On("ListSilentStationSims", ctx, arg)}
 }
 
-func (_c *MockStore_CreateSimAccessToken_Call) Run(run func(ctx context.Context, arg db.CreateSimAccessTokenParams)) *MockStore_CreateSimAccessToken_Call {
+func (_c *MockStore_ListSilentStationSims_Call) Run(run func(ctx context.Context, arg db.ListSilentStationSimsParams)) *MockStore_ListSilentStationSims_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateSimAccessTokenParams))
+		run(args[0].(context.Context), args[1].(db.ListSilentStationSimsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_CreateSimAccessToken_Call {
+func (_c *MockStore_ListSilentStationSims_Call) Return(_a0 []db.ListSilentStationSimsRow, _a1 error) *MockStore_ListSilentStationSims_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSimAccessToken_Call) RunAndReturn(run func(context.Context, db.CreateSimAccessTokenParams) (db.SimAccessToken, error)) *MockStore_CreateSimAccessToken_Call {
+func (_c *MockStore_ListSilentStationSims_Call) RunAndReturn(run func(context.Context, db.ListSilentStationSimsParams) ([]db.ListSilentStationSimsRow, error)) *MockStore_ListSilentStationSims_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSimCard provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateSimCard(ctx context.Context, arg db.CreateSimCardParams) (db.SimCard, error) {
-	ret := _m.Called(ctx, arg)
-
-	var r0 db.SimCard
+// ListSimCardLastLoads provides a mock function with given fields: ctx
+func (_m *MockStore) ListSimCardLastLoads(ctx context.Context) ([]db.ListSimCardLastLoadsRow, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []db.ListSimCardLastLoadsRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) (db.SimCard, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ListSimCardLastLoadsRow, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateSimCardParams) db.SimCard); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context) []db.ListSimCardLastLoadsRow); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(db.SimCard)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListSimCardLastLoadsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateSimCardParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -929,51 +6794,52 @@ func (_m *MockStore) CreateSimCard(ctx context.Context, arg db.CreateSimCardPara
 	return r0, r1
 }
 
-// MockStore_CreateSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSimCard'
-type MockStore_CreateSimCard_Call struct {
+// MockStore_ListSimCardLastLoads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSimCardLastLoads'
+type MockStore_ListSimCardLastLoads_Call struct {
 	*mock.Call
 }
 
-// CreateSimCard is a helper method to define mock.On call
+// ListSimCardLastLoads is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateSimCardParams
-func (_e *MockStore_Expecter) CreateSimCard(ctx interface{}, arg interface{}) *MockStore_CreateSimCard_Call {
-	return &MockStore_CreateSimCard_Call{Call: _e.mock.On("CreateSimCard", ctx, arg)}
+func (_e *MockStore_Expecter) ListSimCardLastLoads(ctx interface{}) *MockStore_ListSimCardLastLoads_Call {
+	return &MockStore_ListSimCardLastLoads_Call{Call: _e.mock.On("ListSimCardLastLoads", ctx)}
 }
 
-func (_c *MockStore_CreateSimCard_Call) Run(run func(ctx context.Context, arg db.CreateSimCardParams)) *MockStore_CreateSimCard_Call {
+func (_c *MockStore_ListSimCardLastLoads_Call) Run(run func(ctx context.Context)) *MockStore_ListSimCardLastLoads_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateSimCardParams))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_CreateSimCard_Call {
+func (_c *MockStore_ListSimCardLastLoads_Call) Return(_a0 []db.ListSimCardLastLoadsRow, _a1 error) *MockStore_ListSimCardLastLoads_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSimCard_Call) RunAndReturn(run func(context.Context, db.CreateSimCardParams) (db.SimCard, error)) *MockStore_CreateSimCard_Call {
+func (_c *MockStore_ListSimCardLastLoads_Call) RunAndReturn(run func(context.Context) ([]db.ListSimCardLastLoadsRow, error)) *MockStore_ListSimCardLastLoads_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStation(ctx context.Context, arg db.CreateStationParams) (db.ObservationsStation, error) {
+// ListSimCards provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSimCards(ctx context.Context, arg db.ListSimCardsParams) ([]db.ListSimCardsRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsStation
+	var r0 []db.ListSimCardsRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardsParams) ([]db.ListSimCardsRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationParams) db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSimCardsParams) []db.ListSimCardsRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListSimCardsRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSimCardsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -982,51 +6848,53 @@ func (_m *MockStore) CreateStation(ctx context.Context, arg db.CreateStationPara
 	return r0, r1
 }
 
-// MockStore_CreateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStation'
-type MockStore_CreateStation_Call struct {
+// MockStore_ListSimCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSimCards'
+type MockStore_ListSimCards_Call struct {
 	*mock.Call
 }
 
-// CreateStation is a helper method to define mock.On call
+// ListSimCards is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationParams
-func (_e *MockStore_Expecter) CreateStation(ctx interface{}, arg interface{}) *MockStore_CreateStation_Call {
-	return &MockStore_CreateStation_Call{Call: _e.mock.On("CreateStation", ctx, arg)}
+//   - arg db.ListSimCardsParams
+func (_e *MockStore_Expecter) ListSimCards(ctx interface{}, arg interface{}) *MockStore_ListSimCards_Call {
+	return &MockStore_ListSimCards_Call{Call: _e.mock.On("ListSimCards", ctx, arg)}
 }
 
-func (_c *MockStore_CreateStation_Call) Run(run func(ctx context.Context, arg db.CreateStationParams)) *MockStore_CreateStation_Call {
+func (_c *MockStore_ListSimCards_Call) Run(run func(ctx context.Context, arg db.ListSimCardsParams)) *MockStore_ListSimCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationParams))
+		run(args[0].(context.Context), args[1].(db.ListSimCardsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_CreateStation_Call {
+func (_c *MockStore_ListSimCards_Call) Return(_a0 []db.ListSimCardsRow, _a1 error) *MockStore_ListSimCards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStation_Call) RunAndReturn(run func(context.Context, db.CreateStationParams) (db.ObservationsStation, error)) *MockStore_CreateStation_Call {
+func (_c *MockStore_ListSimCards_Call) RunAndReturn(run func(context.Context, db.ListSimCardsParams) ([]db.ListSimCardsRow, error)) *MockStore_ListSimCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStationHealth(ctx context.Context, arg db.CreateStationHealthParams) (db.ObservationsStationhealth, error) {
+// ListSmsMessages provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSmsMessages(ctx context.Context, arg db.ListSmsMessagesParams) ([]db.SmsMessage, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsStationhealth
+	var r0 []db.SmsMessage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsMessagesParams) ([]db.SmsMessage, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationHealthParams) db.ObservationsStationhealth); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsMessagesParams) []db.SmsMessage); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStationhealth)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsMessage)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationHealthParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSmsMessagesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1035,51 +6903,53 @@ func (_m *MockStore) CreateStationHealth(ctx context.Context, arg db.CreateStati
 	return r0, r1
 }
 
-// MockStore_CreateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationHealth'
-type MockStore_CreateStationHealth_Call struct {
+// MockStore_ListSmsMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmsMessages'
+type MockStore_ListSmsMessages_Call struct {
 	*mock.Call
 }
 
-// CreateStationHealth is a helper method to define mock.On call
+// ListSmsMessages is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationHealthParams
-func (_e *MockStore_Expecter) CreateStationHealth(ctx interface{}, arg interface{}) *MockStore_CreateStationHealth_Call {
-	return &MockStore_CreateStationHealth_Call{Call: _e.mock.On("CreateStationHealth", ctx, arg)}
+//   - arg db.ListSmsMessagesParams
+func (_e *MockStore_Expecter) ListSmsMessages(ctx interface{}, arg interface{}) *MockStore_ListSmsMessages_Call {
+	return &MockStore_ListSmsMessages_Call{Call: _e.mock.On("ListSmsMessages", ctx, arg)}
 }
 
-func (_c *MockStore_CreateStationHealth_Call) Run(run func(ctx context.Context, arg db.CreateStationHealthParams)) *MockStore_CreateStationHealth_Call {
+func (_c *MockStore_ListSmsMessages_Call) Run(run func(ctx context.Context, arg db.ListSmsMessagesParams)) *MockStore_ListSmsMessages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.ListSmsMessagesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_CreateStationHealth_Call {
+func (_c *MockStore_ListSmsMessages_Call) Return(_a0 []db.SmsMessage, _a1 error) *MockStore_ListSmsMessages_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStationHealth_Call) RunAndReturn(run func(context.Context, db.CreateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_CreateStationHealth_Call {
+func (_c *MockStore_ListSmsMessages_Call) RunAndReturn(run func(context.Context, db.ListSmsMessagesParams) ([]db.SmsMessage, error)) *MockStore_ListSmsMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateStationObservation(ctx context.Context, arg db.CreateStationObservationParams) (db.ObservationsObservation, error) {
+// ListSmsQueries provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSmsQueries(ctx context.Context, arg db.ListSmsQueriesParams) ([]db.SmsQuery, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsObservation
+	var r0 []db.SmsQuery
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsQueriesParams) ([]db.SmsQuery, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateStationObservationParams) db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsQueriesParams) []db.SmsQuery); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsObservation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsQuery)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateStationObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSmsQueriesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1088,51 +6958,53 @@ func (_m *MockStore) CreateStationObservation(ctx context.Context, arg db.Create
 	return r0, r1
 }
 
-// MockStore_CreateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStationObservation'
-type MockStore_CreateStationObservation_Call struct {
+// MockStore_ListSmsQueries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmsQueries'
+type MockStore_ListSmsQueries_Call struct {
 	*mock.Call
 }
 
-// CreateStationObservation is a helper method to define mock.On call
+// ListSmsQueries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateStationObservationParams
-func (_e *MockStore_Expecter) CreateStationObservation(ctx interface{}, arg interface{}) *MockStore_CreateStationObservation_Call {
-	return &MockStore_CreateStationObservation_Call{Call: _e.mock.On("CreateStationObservation", ctx, arg)}
+//   - arg db.ListSmsQueriesParams
+func (_e *MockStore_Expecter) ListSmsQueries(ctx interface{}, arg interface{}) *MockStore_ListSmsQueries_Call {
+	return &MockStore_ListSmsQueries_Call{Call: _e.mock.On("ListSmsQueries", ctx, arg)}
 }
 
-func (_c *MockStore_CreateStationObservation_Call) Run(run func(ctx context.Context, arg db.CreateStationObservationParams)) *MockStore_CreateStationObservation_Call {
+func (_c *MockStore_ListSmsQueries_Call) Run(run func(ctx context.Context, arg db.ListSmsQueriesParams)) *MockStore_ListSmsQueries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListSmsQueriesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_CreateStationObservation_Call {
+func (_c *MockStore_ListSmsQueries_Call) Return(_a0 []db.SmsQuery, _a1 error) *MockStore_ListSmsQueries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateStationObservation_Call) RunAndReturn(run func(context.Context, db.CreateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_CreateStationObservation_Call {
+func (_c *MockStore_ListSmsQueries_Call) RunAndReturn(run func(context.Context, db.ListSmsQueriesParams) ([]db.SmsQuery, error)) *MockStore_ListSmsQueries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// ListSmsSubscriptions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListSmsSubscriptions(ctx context.Context, arg db.ListSmsSubscriptionsParams) ([]db.SmsSubscription, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.User
+	var r0 []db.SmsSubscription
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) (db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsSubscriptionsParams) ([]db.SmsSubscription, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.CreateUserParams) db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListSmsSubscriptionsParams) []db.SmsSubscription); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsSubscription)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListSmsSubscriptionsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1141,352 +7013,438 @@ func (_m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (d
 	return r0, r1
 }
 
-// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockStore_CreateUser_Call struct {
+// MockStore_ListSmsSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSmsSubscriptions'
+type MockStore_ListSmsSubscriptions_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// ListSmsSubscriptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.CreateUserParams
-func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
-	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg db.ListSmsSubscriptionsParams
+func (_e *MockStore_Expecter) ListSmsSubscriptions(ctx interface{}, arg interface{}) *MockStore_ListSmsSubscriptions_Call {
+	return &MockStore_ListSmsSubscriptions_Call{Call: _e.mock.On("ListSmsSubscriptions", ctx, arg)}
 }
 
-func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg db.CreateUserParams)) *MockStore_CreateUser_Call {
+func (_c *MockStore_ListSmsSubscriptions_Call) Run(run func(ctx context.Context, arg db.ListSmsSubscriptionsParams)) *MockStore_ListSmsSubscriptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.CreateUserParams))
+		run(args[0].(context.Context), args[1].(db.ListSmsSubscriptionsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_CreateUser_Call {
+func (_c *MockStore_ListSmsSubscriptions_Call) Return(_a0 []db.SmsSubscription, _a1 error) *MockStore_ListSmsSubscriptions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, db.CreateUserParams) (db.User, error)) *MockStore_CreateUser_Call {
+func (_c *MockStore_ListSmsSubscriptions_Call) RunAndReturn(run func(context.Context, db.ListSmsSubscriptionsParams) ([]db.SmsSubscription, error)) *MockStore_ListSmsSubscriptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRole provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteRole(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationAlertSubscriptions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationAlertSubscriptions(ctx context.Context, arg db.ListStationAlertSubscriptionsParams) ([]db.SmsSubscription, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.SmsSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationAlertSubscriptionsParams) ([]db.SmsSubscription, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationAlertSubscriptionsParams) []db.SmsSubscription); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.SmsSubscription)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationAlertSubscriptionsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
-type MockStore_DeleteRole_Call struct {
+// MockStore_ListStationAlertSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationAlertSubscriptions'
+type MockStore_ListStationAlertSubscriptions_Call struct {
 	*mock.Call
 }
 
-// DeleteRole is a helper method to define mock.On call
+// ListStationAlertSubscriptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteRole(ctx interface{}, id interface{}) *MockStore_DeleteRole_Call {
-	return &MockStore_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, id)}
+//   - arg db.ListStationAlertSubscriptionsParams
+func (_e *MockStore_Expecter) ListStationAlertSubscriptions(ctx interface{}, arg interface{}) *MockStore_ListStationAlertSubscriptions_Call {
+	return &MockStore_ListStationAlertSubscriptions_Call{Call: _e.mock.On("ListStationAlertSubscriptions", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteRole_Call {
+func (_c *MockStore_ListStationAlertSubscriptions_Call) Run(run func(ctx context.Context, arg db.ListStationAlertSubscriptionsParams)) *MockStore_ListStationAlertSubscriptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationAlertSubscriptionsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteRole_Call) Return(_a0 error) *MockStore_DeleteRole_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationAlertSubscriptions_Call) Return(_a0 []db.SmsSubscription, _a1 error) *MockStore_ListStationAlertSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteRole_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteRole_Call {
+func (_c *MockStore_ListStationAlertSubscriptions_Call) RunAndReturn(run func(context.Context, db.ListStationAlertSubscriptionsParams) ([]db.SmsSubscription, error)) *MockStore_ListStationAlertSubscriptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSession provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// ListStationDailyObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationDailyObservations(ctx context.Context, arg db.ListStationDailyObservationsParams) ([]db.ObservationsDeriveddaily, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ObservationsDeriveddaily
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationDailyObservationsParams) ([]db.ObservationsDeriveddaily, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationDailyObservationsParams) []db.ObservationsDeriveddaily); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsDeriveddaily)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationDailyObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
-type MockStore_DeleteSession_Call struct {
+// MockStore_ListStationDailyObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationDailyObservations'
+type MockStore_ListStationDailyObservations_Call struct {
 	*mock.Call
 }
 
-// DeleteSession is a helper method to define mock.On call
+// ListStationDailyObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) DeleteSession(ctx interface{}, id interface{}) *MockStore_DeleteSession_Call {
-	return &MockStore_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, id)}
+//   - arg db.ListStationDailyObservationsParams
+func (_e *MockStore_Expecter) ListStationDailyObservations(ctx interface{}, arg interface{}) *MockStore_ListStationDailyObservations_Call {
+	return &MockStore_ListStationDailyObservations_Call{Call: _e.mock.On("ListStationDailyObservations", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteSession_Call {
+func (_c *MockStore_ListStationDailyObservations_Call) Run(run func(ctx context.Context, arg db.ListStationDailyObservationsParams)) *MockStore_ListStationDailyObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(db.ListStationDailyObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSession_Call) Return(_a0 error) *MockStore_DeleteSession_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationDailyObservations_Call) Return(_a0 []db.ObservationsDeriveddaily, _a1 error) *MockStore_ListStationDailyObservations_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteSession_Call {
+func (_c *MockStore_ListStationDailyObservations_Call) RunAndReturn(run func(context.Context, db.ListStationDailyObservationsParams) ([]db.ObservationsDeriveddaily, error)) *MockStore_ListStationDailyObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSimAccessToken provides a mock function with given fields: ctx, accessToken
-func (_m *MockStore) DeleteSimAccessToken(ctx context.Context, accessToken string) error {
-	ret := _m.Called(ctx, accessToken)
+// ListStationHealthAlerts provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationHealthAlerts(ctx context.Context, arg db.ListStationHealthAlertsParams) ([]db.ObservationsStationhealthAlert, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, accessToken)
+	var r0 []db.ObservationsStationhealthAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthAlertsParams) ([]db.ObservationsStationhealthAlert, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthAlertsParams) []db.ObservationsStationhealthAlert); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStationhealthAlert)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHealthAlertsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSimAccessToken'
-type MockStore_DeleteSimAccessToken_Call struct {
+// MockStore_ListStationHealthAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHealthAlerts'
+type MockStore_ListStationHealthAlerts_Call struct {
 	*mock.Call
 }
 
-// DeleteSimAccessToken is a helper method to define mock.On call
+// ListStationHealthAlerts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - accessToken string
-func (_e *MockStore_Expecter) DeleteSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_DeleteSimAccessToken_Call {
-	return &MockStore_DeleteSimAccessToken_Call{Call: _e.mock.On("DeleteSimAccessToken", ctx, accessToken)}
+//   - arg db.ListStationHealthAlertsParams
+func (_e *MockStore_Expecter) ListStationHealthAlerts(ctx interface{}, arg interface{}) *MockStore_ListStationHealthAlerts_Call {
+	return &MockStore_ListStationHealthAlerts_Call{Call: _e.mock.On("ListStationHealthAlerts", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_DeleteSimAccessToken_Call {
+func (_c *MockStore_ListStationHealthAlerts_Call) Run(run func(ctx context.Context, arg db.ListStationHealthAlertsParams)) *MockStore_ListStationHealthAlerts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(db.ListStationHealthAlertsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteSimAccessToken_Call) Return(_a0 error) *MockStore_DeleteSimAccessToken_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationHealthAlerts_Call) Return(_a0 []db.ObservationsStationhealthAlert, _a1 error) *MockStore_ListStationHealthAlerts_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteSimAccessToken_Call) RunAndReturn(run func(context.Context, string) error) *MockStore_DeleteSimAccessToken_Call {
+func (_c *MockStore_ListStationHealthAlerts_Call) RunAndReturn(run func(context.Context, db.ListStationHealthAlertsParams) ([]db.ObservationsStationhealthAlert, error)) *MockStore_ListStationHealthAlerts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStation provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteStation(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationHealths provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationHealths(ctx context.Context, arg db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) []db.ObservationsStationhealth); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStationhealth)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHealthsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStation'
-type MockStore_DeleteStation_Call struct {
+// MockStore_ListStationHealths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHealths'
+type MockStore_ListStationHealths_Call struct {
 	*mock.Call
 }
 
-// DeleteStation is a helper method to define mock.On call
+// ListStationHealths is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteStation(ctx interface{}, id interface{}) *MockStore_DeleteStation_Call {
-	return &MockStore_DeleteStation_Call{Call: _e.mock.On("DeleteStation", ctx, id)}
+//   - arg db.ListStationHealthsParams
+func (_e *MockStore_Expecter) ListStationHealths(ctx interface{}, arg interface{}) *MockStore_ListStationHealths_Call {
+	return &MockStore_ListStationHealths_Call{Call: _e.mock.On("ListStationHealths", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteStation_Call {
+func (_c *MockStore_ListStationHealths_Call) Run(run func(ctx context.Context, arg db.ListStationHealthsParams)) *MockStore_ListStationHealths_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationHealthsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStation_Call) Return(_a0 error) *MockStore_DeleteStation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationHealths_Call) Return(_a0 []db.ObservationsStationhealth, _a1 error) *MockStore_ListStationHealths_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStation_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteStation_Call {
+func (_c *MockStore_ListStationHealths_Call) RunAndReturn(run func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)) *MockStore_ListStationHealths_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) DeleteStationHealth(ctx context.Context, arg db.DeleteStationHealthParams) error {
+// ListStationHealthsByDate provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationHealthsByDate(ctx context.Context, arg db.ListStationHealthsByDateParams) ([]db.ObservationsStationhealth, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationHealthParams) error); ok {
+	var r0 []db.ObservationsStationhealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsByDateParams) ([]db.ObservationsStationhealth, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsByDateParams) []db.ObservationsStationhealth); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStationhealth)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHealthsByDateParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationHealth'
-type MockStore_DeleteStationHealth_Call struct {
+// MockStore_ListStationHealthsByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHealthsByDate'
+type MockStore_ListStationHealthsByDate_Call struct {
 	*mock.Call
 }
 
-// DeleteStationHealth is a helper method to define mock.On call
+// ListStationHealthsByDate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.DeleteStationHealthParams
-func (_e *MockStore_Expecter) DeleteStationHealth(ctx interface{}, arg interface{}) *MockStore_DeleteStationHealth_Call {
-	return &MockStore_DeleteStationHealth_Call{Call: _e.mock.On("DeleteStationHealth", ctx, arg)}
+//   - arg db.ListStationHealthsByDateParams
+func (_e *MockStore_Expecter) ListStationHealthsByDate(ctx interface{}, arg interface{}) *MockStore_ListStationHealthsByDate_Call {
+	return &MockStore_ListStationHealthsByDate_Call{Call: _e.mock.On("ListStationHealthsByDate", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStationHealth_Call) Run(run func(ctx context.Context, arg db.DeleteStationHealthParams)) *MockStore_DeleteStationHealth_Call {
+func (_c *MockStore_ListStationHealthsByDate_Call) Run(run func(ctx context.Context, arg db.ListStationHealthsByDateParams)) *MockStore_ListStationHealthsByDate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.DeleteStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.ListStationHealthsByDateParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStationHealth_Call) Return(_a0 error) *MockStore_DeleteStationHealth_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationHealthsByDate_Call) Return(_a0 []db.ObservationsStationhealth, _a1 error) *MockStore_ListStationHealthsByDate_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStationHealth_Call) RunAndReturn(run func(context.Context, db.DeleteStationHealthParams) error) *MockStore_DeleteStationHealth_Call {
+func (_c *MockStore_ListStationHealthsByDate_Call) RunAndReturn(run func(context.Context, db.ListStationHealthsByDateParams) ([]db.ObservationsStationhealth, error)) *MockStore_ListStationHealthsByDate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) DeleteStationObservation(ctx context.Context, arg db.DeleteStationObservationParams) error {
+// ListStationHourlyObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationHourlyObservations(ctx context.Context, arg db.ListStationHourlyObservationsParams) ([]db.ObservationsDerivedhourly, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.DeleteStationObservationParams) error); ok {
+	var r0 []db.ObservationsDerivedhourly
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHourlyObservationsParams) ([]db.ObservationsDerivedhourly, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHourlyObservationsParams) []db.ObservationsDerivedhourly); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsDerivedhourly)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHourlyObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStationObservation'
-type MockStore_DeleteStationObservation_Call struct {
+// MockStore_ListStationHourlyObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHourlyObservations'
+type MockStore_ListStationHourlyObservations_Call struct {
 	*mock.Call
 }
 
-// DeleteStationObservation is a helper method to define mock.On call
+// ListStationHourlyObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.DeleteStationObservationParams
-func (_e *MockStore_Expecter) DeleteStationObservation(ctx interface{}, arg interface{}) *MockStore_DeleteStationObservation_Call {
-	return &MockStore_DeleteStationObservation_Call{Call: _e.mock.On("DeleteStationObservation", ctx, arg)}
+//   - arg db.ListStationHourlyObservationsParams
+func (_e *MockStore_Expecter) ListStationHourlyObservations(ctx interface{}, arg interface{}) *MockStore_ListStationHourlyObservations_Call {
+	return &MockStore_ListStationHourlyObservations_Call{Call: _e.mock.On("ListStationHourlyObservations", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteStationObservation_Call) Run(run func(ctx context.Context, arg db.DeleteStationObservationParams)) *MockStore_DeleteStationObservation_Call {
+func (_c *MockStore_ListStationHourlyObservations_Call) Run(run func(ctx context.Context, arg db.ListStationHourlyObservationsParams)) *MockStore_ListStationHourlyObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.DeleteStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListStationHourlyObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteStationObservation_Call) Return(_a0 error) *MockStore_DeleteStationObservation_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationHourlyObservations_Call) Return(_a0 []db.ObservationsDerivedhourly, _a1 error) *MockStore_ListStationHourlyObservations_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteStationObservation_Call) RunAndReturn(run func(context.Context, db.DeleteStationObservationParams) error) *MockStore_DeleteStationObservation_Call {
+func (_c *MockStore_ListStationHourlyObservations_Call) RunAndReturn(run func(context.Context, db.ListStationHourlyObservationsParams) ([]db.ObservationsDerivedhourly, error)) *MockStore_ListStationHourlyObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function with given fields: ctx, id
-func (_m *MockStore) DeleteUser(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ListStationMoObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMoObservations(ctx context.Context, arg db.ListStationMoObservationsParams) ([]db.ObservationsMoObservation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []db.ObservationsMoObservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMoObservationsParams) ([]db.ObservationsMoObservation, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMoObservationsParams) []db.ObservationsMoObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsMoObservation)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMoObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type MockStore_DeleteUser_Call struct {
+// MockStore_ListStationMoObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMoObservations'
+type MockStore_ListStationMoObservations_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
+// ListStationMoObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockStore_DeleteUser_Call {
-	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+//   - arg db.ListStationMoObservationsParams
+func (_e *MockStore_Expecter) ListStationMoObservations(ctx interface{}, arg interface{}) *MockStore_ListStationMoObservations_Call {
+	return &MockStore_ListStationMoObservations_Call{Call: _e.mock.On("ListStationMoObservations", ctx, arg)}
 }
 
-func (_c *MockStore_DeleteUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_DeleteUser_Call {
+func (_c *MockStore_ListStationMoObservations_Call) Run(run func(ctx context.Context, arg db.ListStationMoObservationsParams)) *MockStore_ListStationMoObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationMoObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) Return(_a0 error) *MockStore_DeleteUser_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_ListStationMoObservations_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListStationMoObservations_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(context.Context, int64) error) *MockStore_DeleteUser_Call {
+func (_c *MockStore_ListStationMoObservations_Call) RunAndReturn(run func(context.Context, db.ListStationMoObservationsParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListStationMoObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FirstOrCreateSimAccessTokenTx provides a mock function with given fields: ctx, arg
-func (_m *MockStore) FirstOrCreateSimAccessTokenTx(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error) {
+// ListStationMoObservationsForQc provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationMoObservationsForQc(ctx context.Context, arg db.ListStationMoObservationsForQcParams) ([]db.ObservationsMoObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.FirstOrCreateSimAccessTokenTxResult
+	var r0 []db.ObservationsMoObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMoObservationsForQcParams) ([]db.ObservationsMoObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) db.FirstOrCreateSimAccessTokenTxResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationMoObservationsForQcParams) []db.ObservationsMoObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.FirstOrCreateSimAccessTokenTxResult)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsMoObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationMoObservationsForQcParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1495,52 +7453,54 @@ func (_m *MockStore) FirstOrCreateSimAccessTokenTx(ctx context.Context, arg db.F
 	return r0, r1
 }
 
-// MockStore_FirstOrCreateSimAccessTokenTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FirstOrCreateSimAccessTokenTx'
-type MockStore_FirstOrCreateSimAccessTokenTx_Call struct {
+// MockStore_ListStationMoObservationsForQc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationMoObservationsForQc'
+type MockStore_ListStationMoObservationsForQc_Call struct {
 	*mock.Call
 }
 
-// FirstOrCreateSimAccessTokenTx is a helper method to define mock.On call
+// ListStationMoObservationsForQc is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.FirstOrCreateSimAccessTokenTxParams
-func (_e *MockStore_Expecter) FirstOrCreateSimAccessTokenTx(ctx interface{}, arg interface{}) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
-	return &MockStore_FirstOrCreateSimAccessTokenTx_Call{Call: _e.mock.On("FirstOrCreateSimAccessTokenTx", ctx, arg)}
+//   - arg db.ListStationMoObservationsForQcParams
+func (_e *MockStore_Expecter) ListStationMoObservationsForQc(ctx interface{}, arg interface{}) *MockStore_ListStationMoObservationsForQc_Call {
+	return &MockStore_ListStationMoObservationsForQc_Call{Call: _e.mock.On("ListStationMoObservationsForQc", ctx, arg)}
 }
 
-func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Run(run func(ctx context.Context, arg db.FirstOrCreateSimAccessTokenTxParams)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+func (_c *MockStore_ListStationMoObservationsForQc_Call) Run(run func(ctx context.Context, arg db.ListStationMoObservationsForQcParams)) *MockStore_ListStationMoObservationsForQc_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.FirstOrCreateSimAccessTokenTxParams))
+		run(args[0].(context.Context), args[1].(db.ListStationMoObservationsForQcParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) Return(_a0 db.FirstOrCreateSimAccessTokenTxResult, _a1 error) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+func (_c *MockStore_ListStationMoObservationsForQc_Call) Return(_a0 []db.ObservationsMoObservation, _a1 error) *MockStore_ListStationMoObservationsForQc_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_FirstOrCreateSimAccessTokenTx_Call) RunAndReturn(run func(context.Context, db.FirstOrCreateSimAccessTokenTxParams) (db.FirstOrCreateSimAccessTokenTxResult, error)) *MockStore_FirstOrCreateSimAccessTokenTx_Call {
+func (_c *MockStore_ListStationMoObservationsForQc_Call) RunAndReturn(run func(context.Context, db.ListStationMoObservationsForQcParams) ([]db.ObservationsMoObservation, error)) *MockStore_ListStationMoObservationsForQc_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestStationObservation provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetLatestStationObservation(ctx context.Context, id int64) (db.GetLatestStationObservationRow, error) {
-	ret := _m.Called(ctx, id)
+// ListStationObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationObservations(ctx context.Context, arg db.ListStationObservationsParams) ([]db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.GetLatestStationObservationRow
+	var r0 []db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.GetLatestStationObservationRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.GetLatestStationObservationRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) []db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.GetLatestStationObservationRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationObservationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1548,51 +7508,53 @@ func (_m *MockStore) GetLatestStationObservation(ctx context.Context, id int64)
 	return r0, r1
 }
 
-// MockStore_GetLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStationObservation'
-type MockStore_GetLatestStationObservation_Call struct {
+// MockStore_ListStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationObservations'
+type MockStore_ListStationObservations_Call struct {
 	*mock.Call
 }
 
-// GetLatestStationObservation is a helper method to define mock.On call
+// ListStationObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetLatestStationObservation(ctx interface{}, id interface{}) *MockStore_GetLatestStationObservation_Call {
-	return &MockStore_GetLatestStationObservation_Call{Call: _e.mock.On("GetLatestStationObservation", ctx, id)}
+//   - arg db.ListStationObservationsParams
+func (_e *MockStore_Expecter) ListStationObservations(ctx interface{}, arg interface{}) *MockStore_ListStationObservations_Call {
+	return &MockStore_ListStationObservations_Call{Call: _e.mock.On("ListStationObservations", ctx, arg)}
 }
 
-func (_c *MockStore_GetLatestStationObservation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetLatestStationObservation_Call {
+func (_c *MockStore_ListStationObservations_Call) Run(run func(ctx context.Context, arg db.ListStationObservationsParams)) *MockStore_ListStationObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetLatestStationObservation_Call) Return(_a0 db.GetLatestStationObservationRow, _a1 error) *MockStore_GetLatestStationObservation_Call {
+func (_c *MockStore_ListStationObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListStationObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetLatestStationObservation_Call) RunAndReturn(run func(context.Context, int64) (db.GetLatestStationObservationRow, error)) *MockStore_GetLatestStationObservation_Call {
+func (_c *MockStore_ListStationObservations_Call) RunAndReturn(run func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListStationObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNearestLatestStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetNearestLatestStationObservation(ctx context.Context, arg db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error) {
+// ListStationObservationsForQc provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationObservationsForQc(ctx context.Context, arg db.ListStationObservationsForQcParams) ([]db.ObservationsObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.GetNearestLatestStationObservationRow
+	var r0 []db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsForQcParams) ([]db.ObservationsObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetNearestLatestStationObservationParams) db.GetNearestLatestStationObservationRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsForQcParams) []db.ObservationsObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.GetNearestLatestStationObservationRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsObservation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetNearestLatestStationObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationObservationsForQcParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1601,52 +7563,54 @@ func (_m *MockStore) GetNearestLatestStationObservation(ctx context.Context, arg
 	return r0, r1
 }
 
-// MockStore_GetNearestLatestStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNearestLatestStationObservation'
-type MockStore_GetNearestLatestStationObservation_Call struct {
+// MockStore_ListStationObservationsForQc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationObservationsForQc'
+type MockStore_ListStationObservationsForQc_Call struct {
 	*mock.Call
 }
 
-// GetNearestLatestStationObservation is a helper method to define mock.On call
+// ListStationObservationsForQc is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetNearestLatestStationObservationParams
-func (_e *MockStore_Expecter) GetNearestLatestStationObservation(ctx interface{}, arg interface{}) *MockStore_GetNearestLatestStationObservation_Call {
-	return &MockStore_GetNearestLatestStationObservation_Call{Call: _e.mock.On("GetNearestLatestStationObservation", ctx, arg)}
+//   - arg db.ListStationObservationsForQcParams
+func (_e *MockStore_Expecter) ListStationObservationsForQc(ctx interface{}, arg interface{}) *MockStore_ListStationObservationsForQc_Call {
+	return &MockStore_ListStationObservationsForQc_Call{Call: _e.mock.On("ListStationObservationsForQc", ctx, arg)}
 }
 
-func (_c *MockStore_GetNearestLatestStationObservation_Call) Run(run func(ctx context.Context, arg db.GetNearestLatestStationObservationParams)) *MockStore_GetNearestLatestStationObservation_Call {
+func (_c *MockStore_ListStationObservationsForQc_Call) Run(run func(ctx context.Context, arg db.ListStationObservationsForQcParams)) *MockStore_ListStationObservationsForQc_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetNearestLatestStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.ListStationObservationsForQcParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetNearestLatestStationObservation_Call) Return(_a0 db.GetNearestLatestStationObservationRow, _a1 error) *MockStore_GetNearestLatestStationObservation_Call {
+func (_c *MockStore_ListStationObservationsForQc_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListStationObservationsForQc_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetNearestLatestStationObservation_Call) RunAndReturn(run func(context.Context, db.GetNearestLatestStationObservationParams) (db.GetNearestLatestStationObservationRow, error)) *MockStore_GetNearestLatestStationObservation_Call {
+func (_c *MockStore_ListStationObservationsForQc_Call) RunAndReturn(run func(context.Context, db.ListStationObservationsForQcParams) ([]db.ObservationsObservation, error)) *MockStore_ListStationObservationsForQc_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRole provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetRole(ctx context.Context, id int64) (db.Role, error) {
-	ret := _m.Called(ctx, id)
+// ListStations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStations(ctx context.Context, arg db.ListStationsParams) ([]db.ObservationsStation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Role
+	var r0 []db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.Role, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.Role); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) []db.ObservationsStation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1654,52 +7618,54 @@ func (_m *MockStore) GetRole(ctx context.Context, id int64) (db.Role, error) {
 	return r0, r1
 }
 
-// MockStore_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
-type MockStore_GetRole_Call struct {
+// MockStore_ListStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStations'
+type MockStore_ListStations_Call struct {
 	*mock.Call
 }
 
-// GetRole is a helper method to define mock.On call
+// ListStations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetRole(ctx interface{}, id interface{}) *MockStore_GetRole_Call {
-	return &MockStore_GetRole_Call{Call: _e.mock.On("GetRole", ctx, id)}
+//   - arg db.ListStationsParams
+func (_e *MockStore_Expecter) ListStations(ctx interface{}, arg interface{}) *MockStore_ListStations_Call {
+	return &MockStore_ListStations_Call{Call: _e.mock.On("ListStations", ctx, arg)}
 }
 
-func (_c *MockStore_GetRole_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetRole_Call {
+func (_c *MockStore_ListStations_Call) Run(run func(ctx context.Context, arg db.ListStationsParams)) *MockStore_ListStations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListStationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRole_Call {
+func (_c *MockStore_ListStations_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRole_Call) RunAndReturn(run func(context.Context, int64) (db.Role, error)) *MockStore_GetRole_Call {
+func (_c *MockStore_ListStations_Call) RunAndReturn(run func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)) *MockStore_ListStations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoleByName provides a mock function with given fields: ctx, name
-func (_m *MockStore) GetRoleByName(ctx context.Context, name string) (db.Role, error) {
-	ret := _m.Called(ctx, name)
+// ListStationsAtRisk provides a mock function with given fields: ctx, expiresBefore
+func (_m *MockStore) ListStationsAtRisk(ctx context.Context, expiresBefore pgtype.Timestamptz) ([]db.ListStationsAtRiskRow, error) {
+	ret := _m.Called(ctx, expiresBefore)
 
-	var r0 db.Role
+	var r0 []db.ListStationsAtRiskRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.Role, error)); ok {
-		return rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]db.ListStationsAtRiskRow, error)); ok {
+		return rf(ctx, expiresBefore)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.Role); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []db.ListStationsAtRiskRow); ok {
+		r0 = rf(ctx, expiresBefore)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ListStationsAtRiskRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, expiresBefore)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1707,52 +7673,54 @@ func (_m *MockStore) GetRoleByName(ctx context.Context, name string) (db.Role, e
 	return r0, r1
 }
 
-// MockStore_GetRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByName'
-type MockStore_GetRoleByName_Call struct {
+// MockStore_ListStationsAtRisk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsAtRisk'
+type MockStore_ListStationsAtRisk_Call struct {
 	*mock.Call
 }
 
-// GetRoleByName is a helper method to define mock.On call
+// ListStationsAtRisk is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockStore_Expecter) GetRoleByName(ctx interface{}, name interface{}) *MockStore_GetRoleByName_Call {
-	return &MockStore_GetRoleByName_Call{Call: _e.mock.On("GetRoleByName", ctx, name)}
+//   - expiresBefore pgtype.Timestamptz
+func (_e *MockStore_Expecter) ListStationsAtRisk(ctx interface{}, expiresBefore interface{}) *MockStore_ListStationsAtRisk_Call {
+	return &MockStore_ListStationsAtRisk_Call{Call: _e.mock.On("ListStationsAtRisk", ctx, expiresBefore)}
 }
 
-func (_c *MockStore_GetRoleByName_Call) Run(run func(ctx context.Context, name string)) *MockStore_GetRoleByName_Call {
+func (_c *MockStore_ListStationsAtRisk_Call) Run(run func(ctx context.Context, expiresBefore pgtype.Timestamptz)) *MockStore_ListStationsAtRisk_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRoleByName_Call) Return(_a0 db.Role, _a1 error) *MockStore_GetRoleByName_Call {
+func (_c *MockStore_ListStationsAtRisk_Call) Return(_a0 []db.ListStationsAtRiskRow, _a1 error) *MockStore_ListStationsAtRisk_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRoleByName_Call) RunAndReturn(run func(context.Context, string) (db.Role, error)) *MockStore_GetRoleByName_Call {
+func (_c *MockStore_ListStationsAtRisk_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]db.ListStationsAtRiskRow, error)) *MockStore_ListStationsAtRisk_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSession provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session, error) {
-	ret := _m.Called(ctx, id)
+// ListStationsWithinBBox provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationsWithinBBox(ctx context.Context, arg db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.Session
+	var r0 []db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Session, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Session); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinBBoxParams) []db.ObservationsStation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Session)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsWithinBBoxParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1760,52 +7728,54 @@ func (_m *MockStore) GetSession(ctx context.Context, id uuid.UUID) (db.Session,
 	return r0, r1
 }
 
-// MockStore_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
-type MockStore_GetSession_Call struct {
+// MockStore_ListStationsWithinBBox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsWithinBBox'
+type MockStore_ListStationsWithinBBox_Call struct {
 	*mock.Call
 }
 
-// GetSession is a helper method to define mock.On call
+// ListStationsWithinBBox is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetSession(ctx interface{}, id interface{}) *MockStore_GetSession_Call {
-	return &MockStore_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+//   - arg db.ListStationsWithinBBoxParams
+func (_e *MockStore_Expecter) ListStationsWithinBBox(ctx interface{}, arg interface{}) *MockStore_ListStationsWithinBBox_Call {
+	return &MockStore_ListStationsWithinBBox_Call{Call: _e.mock.On("ListStationsWithinBBox", ctx, arg)}
 }
 
-func (_c *MockStore_GetSession_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetSession_Call {
+func (_c *MockStore_ListStationsWithinBBox_Call) Run(run func(ctx context.Context, arg db.ListStationsWithinBBoxParams)) *MockStore_ListStationsWithinBBox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(db.ListStationsWithinBBoxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSession_Call) Return(_a0 db.Session, _a1 error) *MockStore_GetSession_Call {
+func (_c *MockStore_ListStationsWithinBBox_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsWithinBBox_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSession_Call) RunAndReturn(run func(context.Context, uuid.UUID) (db.Session, error)) *MockStore_GetSession_Call {
+func (_c *MockStore_ListStationsWithinBBox_Call) RunAndReturn(run func(context.Context, db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error)) *MockStore_ListStationsWithinBBox_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSimAccessToken provides a mock function with given fields: ctx, accessToken
-func (_m *MockStore) GetSimAccessToken(ctx context.Context, accessToken string) (db.SimAccessToken, error) {
-	ret := _m.Called(ctx, accessToken)
+// ListStationsWithinRadius provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListStationsWithinRadius(ctx context.Context, arg db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.SimAccessToken
+	var r0 []db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimAccessToken, error)); ok {
-		return rf(ctx, accessToken)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimAccessToken); ok {
-		r0 = rf(ctx, accessToken)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinRadiusParams) []db.ObservationsStation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.SimAccessToken)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ObservationsStation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, accessToken)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsWithinRadiusParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1813,52 +7783,54 @@ func (_m *MockStore) GetSimAccessToken(ctx context.Context, accessToken string)
 	return r0, r1
 }
 
-// MockStore_GetSimAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimAccessToken'
-type MockStore_GetSimAccessToken_Call struct {
+// MockStore_ListStationsWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsWithinRadius'
+type MockStore_ListStationsWithinRadius_Call struct {
 	*mock.Call
 }
 
-// GetSimAccessToken is a helper method to define mock.On call
+// ListStationsWithinRadius is a helper method to define mock.On call
 //   - ctx context.Context
-//   - accessToken string
-func (_e *MockStore_Expecter) GetSimAccessToken(ctx interface{}, accessToken interface{}) *MockStore_GetSimAccessToken_Call {
-	return &MockStore_GetSimAccessToken_Call{Call: _e.mock.On("GetSimAccessToken", ctx, accessToken)}
+//   - arg db.ListStationsWithinRadiusParams
+func (_e *MockStore_Expecter) ListStationsWithinRadius(ctx interface{}, arg interface{}) *MockStore_ListStationsWithinRadius_Call {
+	return &MockStore_ListStationsWithinRadius_Call{Call: _e.mock.On("ListStationsWithinRadius", ctx, arg)}
 }
 
-func (_c *MockStore_GetSimAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockStore_GetSimAccessToken_Call {
+func (_c *MockStore_ListStationsWithinRadius_Call) Run(run func(ctx context.Context, arg db.ListStationsWithinRadiusParams)) *MockStore_ListStationsWithinRadius_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(db.ListStationsWithinRadiusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSimAccessToken_Call) Return(_a0 db.SimAccessToken, _a1 error) *MockStore_GetSimAccessToken_Call {
+func (_c *MockStore_ListStationsWithinRadius_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsWithinRadius_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSimAccessToken_Call) RunAndReturn(run func(context.Context, string) (db.SimAccessToken, error)) *MockStore_GetSimAccessToken_Call {
+func (_c *MockStore_ListStationsWithinRadius_Call) RunAndReturn(run func(context.Context, db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error)) *MockStore_ListStationsWithinRadius_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSimCard provides a mock function with given fields: ctx, mobileNumber
-func (_m *MockStore) GetSimCard(ctx context.Context, mobileNumber string) (db.SimCard, error) {
-	ret := _m.Called(ctx, mobileNumber)
+// ListUserRoles provides a mock function with given fields: ctx, userID
+func (_m *MockStore) ListUserRoles(ctx context.Context, userID int64) ([]string, error) {
+	ret := _m.Called(ctx, userID)
 
-	var r0 db.SimCard
+	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.SimCard, error)); ok {
-		return rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]string, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.SimCard); ok {
-		r0 = rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(ctx, userID)
 	} else {
-		r0 = ret.Get(0).(db.SimCard)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, mobileNumber)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1866,52 +7838,54 @@ func (_m *MockStore) GetSimCard(ctx context.Context, mobileNumber string) (db.Si
 	return r0, r1
 }
 
-// MockStore_GetSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSimCard'
-type MockStore_GetSimCard_Call struct {
+// MockStore_ListUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRoles'
+type MockStore_ListUserRoles_Call struct {
 	*mock.Call
 }
 
-// GetSimCard is a helper method to define mock.On call
+// ListUserRoles is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mobileNumber string
-func (_e *MockStore_Expecter) GetSimCard(ctx interface{}, mobileNumber interface{}) *MockStore_GetSimCard_Call {
-	return &MockStore_GetSimCard_Call{Call: _e.mock.On("GetSimCard", ctx, mobileNumber)}
+//   - userID int64
+func (_e *MockStore_Expecter) ListUserRoles(ctx interface{}, userID interface{}) *MockStore_ListUserRoles_Call {
+	return &MockStore_ListUserRoles_Call{Call: _e.mock.On("ListUserRoles", ctx, userID)}
 }
 
-func (_c *MockStore_GetSimCard_Call) Run(run func(ctx context.Context, mobileNumber string)) *MockStore_GetSimCard_Call {
+func (_c *MockStore_ListUserRoles_Call) Run(run func(ctx context.Context, userID int64)) *MockStore_ListUserRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_GetSimCard_Call {
+func (_c *MockStore_ListUserRoles_Call) Return(_a0 []string, _a1 error) *MockStore_ListUserRoles_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSimCard_Call) RunAndReturn(run func(context.Context, string) (db.SimCard, error)) *MockStore_GetSimCard_Call {
+func (_c *MockStore_ListUserRoles_Call) RunAndReturn(run func(context.Context, int64) ([]string, error)) *MockStore_ListUserRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStation provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetStation(ctx context.Context, id int64) (db.ObservationsStation, error) {
-	ret := _m.Called(ctx, id)
+// ListUsers provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsStation
+	var r0 []db.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.ObservationsStation, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) ([]db.User, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.ObservationsStation); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) []db.User); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.User)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, db.ListUsersParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1919,104 +7893,93 @@ func (_m *MockStore) GetStation(ctx context.Context, id int64) (db.ObservationsS
 	return r0, r1
 }
 
-// MockStore_GetStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStation'
-type MockStore_GetStation_Call struct {
+// MockStore_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockStore_ListUsers_Call struct {
 	*mock.Call
 }
 
-// GetStation is a helper method to define mock.On call
+// ListUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetStation(ctx interface{}, id interface{}) *MockStore_GetStation_Call {
-	return &MockStore_GetStation_Call{Call: _e.mock.On("GetStation", ctx, id)}
+//   - arg db.ListUsersParams
+func (_e *MockStore_Expecter) ListUsers(ctx interface{}, arg interface{}) *MockStore_ListUsers_Call {
+	return &MockStore_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, arg)}
 }
 
-func (_c *MockStore_GetStation_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetStation_Call {
+func (_c *MockStore_ListUsers_Call) Run(run func(ctx context.Context, arg db.ListUsersParams)) *MockStore_ListUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.ListUsersParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStation_Call {
+func (_c *MockStore_ListUsers_Call) Return(_a0 []db.User, _a1 error) *MockStore_ListUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStation_Call) RunAndReturn(run func(context.Context, int64) (db.ObservationsStation, error)) *MockStore_GetStation_Call {
+func (_c *MockStore_ListUsers_Call) RunAndReturn(run func(context.Context, db.ListUsersParams) ([]db.User, error)) *MockStore_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationByMobileNumber provides a mock function with given fields: ctx, mobileNumber
-func (_m *MockStore) GetStationByMobileNumber(ctx context.Context, mobileNumber pgtype.Text) (db.ObservationsStation, error) {
-	ret := _m.Called(ctx, mobileNumber)
-
-	var r0 db.ObservationsStation
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (db.ObservationsStation, error)); ok {
-		return rf(ctx, mobileNumber)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) db.ObservationsStation); ok {
-		r0 = rf(ctx, mobileNumber)
-	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
-	}
+// LockLoadRequests provides a mock function with given fields: ctx
+func (_m *MockStore) LockLoadRequests(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, mobileNumber)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetStationByMobileNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationByMobileNumber'
-type MockStore_GetStationByMobileNumber_Call struct {
+// MockStore_LockLoadRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockLoadRequests'
+type MockStore_LockLoadRequests_Call struct {
 	*mock.Call
 }
 
-// GetStationByMobileNumber is a helper method to define mock.On call
+// LockLoadRequests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mobileNumber pgtype.Text
-func (_e *MockStore_Expecter) GetStationByMobileNumber(ctx interface{}, mobileNumber interface{}) *MockStore_GetStationByMobileNumber_Call {
-	return &MockStore_GetStationByMobileNumber_Call{Call: _e.mock.On("GetStationByMobileNumber", ctx, mobileNumber)}
+func (_e *MockStore_Expecter) LockLoadRequests(ctx interface{}) *MockStore_LockLoadRequests_Call {
+	return &MockStore_LockLoadRequests_Call{Call: _e.mock.On("LockLoadRequests", ctx)}
 }
 
-func (_c *MockStore_GetStationByMobileNumber_Call) Run(run func(ctx context.Context, mobileNumber pgtype.Text)) *MockStore_GetStationByMobileNumber_Call {
+func (_c *MockStore_LockLoadRequests_Call) Run(run func(ctx context.Context)) *MockStore_LockLoadRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationByMobileNumber_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_GetStationByMobileNumber_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_LockLoadRequests_Call) Return(_a0 error) *MockStore_LockLoadRequests_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetStationByMobileNumber_Call) RunAndReturn(run func(context.Context, pgtype.Text) (db.ObservationsStation, error)) *MockStore_GetStationByMobileNumber_Call {
+func (_c *MockStore_LockLoadRequests_Call) RunAndReturn(run func(context.Context) error) *MockStore_LockLoadRequests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetStationHealth(ctx context.Context, arg db.GetStationHealthParams) (db.ObservationsStationhealth, error) {
+// ResolveStationHealthAlert provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ResolveStationHealthAlert(ctx context.Context, arg db.ResolveStationHealthAlertParams) (db.ObservationsStationhealthAlert, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsStationhealth
+	var r0 db.ObservationsStationhealthAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ResolveStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationHealthParams) db.ObservationsStationhealth); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.ResolveStationHealthAlertParams) db.ObservationsStationhealthAlert); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStationhealth)
+		r0 = ret.Get(0).(db.ObservationsStationhealthAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationHealthParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.ResolveStationHealthAlertParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2025,52 +7988,52 @@ func (_m *MockStore) GetStationHealth(ctx context.Context, arg db.GetStationHeal
 	return r0, r1
 }
 
-// MockStore_GetStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationHealth'
-type MockStore_GetStationHealth_Call struct {
+// MockStore_ResolveStationHealthAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveStationHealthAlert'
+type MockStore_ResolveStationHealthAlert_Call struct {
 	*mock.Call
 }
 
-// GetStationHealth is a helper method to define mock.On call
+// ResolveStationHealthAlert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetStationHealthParams
-func (_e *MockStore_Expecter) GetStationHealth(ctx interface{}, arg interface{}) *MockStore_GetStationHealth_Call {
-	return &MockStore_GetStationHealth_Call{Call: _e.mock.On("GetStationHealth", ctx, arg)}
+//   - arg db.ResolveStationHealthAlertParams
+func (_e *MockStore_Expecter) ResolveStationHealthAlert(ctx interface{}, arg interface{}) *MockStore_ResolveStationHealthAlert_Call {
+	return &MockStore_ResolveStationHealthAlert_Call{Call: _e.mock.On("ResolveStationHealthAlert", ctx, arg)}
 }
 
-func (_c *MockStore_GetStationHealth_Call) Run(run func(ctx context.Context, arg db.GetStationHealthParams)) *MockStore_GetStationHealth_Call {
+func (_c *MockStore_ResolveStationHealthAlert_Call) Run(run func(ctx context.Context, arg db.ResolveStationHealthAlertParams)) *MockStore_ResolveStationHealthAlert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.ResolveStationHealthAlertParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_GetStationHealth_Call {
+func (_c *MockStore_ResolveStationHealthAlert_Call) Return(_a0 db.ObservationsStationhealthAlert, _a1 error) *MockStore_ResolveStationHealthAlert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStationHealth_Call) RunAndReturn(run func(context.Context, db.GetStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_GetStationHealth_Call {
+func (_c *MockStore_ResolveStationHealthAlert_Call) RunAndReturn(run func(context.Context, db.ResolveStationHealthAlertParams) (db.ObservationsStationhealthAlert, error)) *MockStore_ResolveStationHealthAlert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) GetStationObservation(ctx context.Context, arg db.GetStationObservationParams) (db.ObservationsObservation, error) {
-	ret := _m.Called(ctx, arg)
+// StartSmsMessageAttempt provides a mock function with given fields: ctx, id
+func (_m *MockStore) StartSmsMessageAttempt(ctx context.Context, id int64) (db.SmsMessage, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 db.ObservationsObservation
+	var r0 db.SmsMessage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.SmsMessage, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.GetStationObservationParams) db.ObservationsObservation); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) db.SmsMessage); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsObservation)
+		r0 = ret.Get(0).(db.SmsMessage)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.GetStationObservationParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2078,105 +8041,96 @@ func (_m *MockStore) GetStationObservation(ctx context.Context, arg db.GetStatio
 	return r0, r1
 }
 
-// MockStore_GetStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStationObservation'
-type MockStore_GetStationObservation_Call struct {
+// MockStore_StartSmsMessageAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartSmsMessageAttempt'
+type MockStore_StartSmsMessageAttempt_Call struct {
 	*mock.Call
 }
 
-// GetStationObservation is a helper method to define mock.On call
+// StartSmsMessageAttempt is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetStationObservationParams
-func (_e *MockStore_Expecter) GetStationObservation(ctx interface{}, arg interface{}) *MockStore_GetStationObservation_Call {
-	return &MockStore_GetStationObservation_Call{Call: _e.mock.On("GetStationObservation", ctx, arg)}
+//   - id int64
+func (_e *MockStore_Expecter) StartSmsMessageAttempt(ctx interface{}, id interface{}) *MockStore_StartSmsMessageAttempt_Call {
+	return &MockStore_StartSmsMessageAttempt_Call{Call: _e.mock.On("StartSmsMessageAttempt", ctx, id)}
 }
 
-func (_c *MockStore_GetStationObservation_Call) Run(run func(ctx context.Context, arg db.GetStationObservationParams)) *MockStore_GetStationObservation_Call {
+func (_c *MockStore_StartSmsMessageAttempt_Call) Run(run func(ctx context.Context, id int64)) *MockStore_StartSmsMessageAttempt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.GetStationObservationParams))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_GetStationObservation_Call {
+func (_c *MockStore_StartSmsMessageAttempt_Call) Return(_a0 db.SmsMessage, _a1 error) *MockStore_StartSmsMessageAttempt_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetStationObservation_Call) RunAndReturn(run func(context.Context, db.GetStationObservationParams) (db.ObservationsObservation, error)) *MockStore_GetStationObservation_Call {
+func (_c *MockStore_StartSmsMessageAttempt_Call) RunAndReturn(run func(context.Context, int64) (db.SmsMessage, error)) *MockStore_StartSmsMessageAttempt_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetUser(ctx context.Context, id int64) (db.User, error) {
-	ret := _m.Called(ctx, id)
-
-	var r0 db.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) (db.User, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) db.User); ok {
-		r0 = rf(ctx, id)
-	} else {
-		r0 = ret.Get(0).(db.User)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, id)
+// StreamObservations provides a mock function with given fields: ctx, arg, fn
+func (_m *MockStore) StreamObservations(ctx context.Context, arg db.StreamObservationsParams, fn func(db.StreamObservationsRow) error) error {
+	ret := _m.Called(ctx, arg, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.StreamObservationsParams, func(db.StreamObservationsRow) error) error); ok {
+		r0 = rf(ctx, arg, fn)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockStore_GetUser_Call struct {
+// MockStore_StreamObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamObservations'
+type MockStore_StreamObservations_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// StreamObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *MockStore_Expecter) GetUser(ctx interface{}, id interface{}) *MockStore_GetUser_Call {
-	return &MockStore_GetUser_Call{Call: _e.mock.On("GetUser", ctx, id)}
+//   - arg db.StreamObservationsParams
+//   - fn func(db.StreamObservationsRow) error
+func (_e *MockStore_Expecter) StreamObservations(ctx interface{}, arg interface{}, fn interface{}) *MockStore_StreamObservations_Call {
+	return &MockStore_StreamObservations_Call{Call: _e.mock.On("StreamObservations", ctx, arg, fn)}
 }
 
-func (_c *MockStore_GetUser_Call) Run(run func(ctx context.Context, id int64)) *MockStore_GetUser_Call {
+func (_c *MockStore_StreamObservations_Call) Run(run func(ctx context.Context, arg db.StreamObservationsParams, fn func(db.StreamObservationsRow) error)) *MockStore_StreamObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.StreamObservationsParams), args[2].(func(db.StreamObservationsRow) error))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUser_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUser_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_StreamObservations_Call) Return(_a0 error) *MockStore_StreamObservations_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetUser_Call) RunAndReturn(run func(context.Context, int64) (db.User, error)) *MockStore_GetUser_Call {
+func (_c *MockStore_StreamObservations_Call) RunAndReturn(run func(context.Context, db.StreamObservationsParams, func(db.StreamObservationsRow) error) error) *MockStore_StreamObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
-	ret := _m.Called(ctx, email)
+// SumLoadRequestCost provides a mock function with given fields: ctx, since
+func (_m *MockStore) SumLoadRequestCost(ctx context.Context, since pgtype.Timestamptz) (int64, error) {
+	ret := _m.Called(ctx, since)
 
-	var r0 db.User
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) (int64, error)); ok {
+		return rf(ctx, since)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) int64); ok {
+		r0 = rf(ctx, since)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, since)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2184,52 +8138,52 @@ func (_m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User,
 	return r0, r1
 }
 
-// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type MockStore_GetUserByEmail_Call struct {
+// MockStore_SumLoadRequestCost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumLoadRequestCost'
+type MockStore_SumLoadRequestCost_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// SumLoadRequestCost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
-	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+//   - since pgtype.Timestamptz
+func (_e *MockStore_Expecter) SumLoadRequestCost(ctx interface{}, since interface{}) *MockStore_SumLoadRequestCost_Call {
+	return &MockStore_SumLoadRequestCost_Call{Call: _e.mock.On("SumLoadRequestCost", ctx, since)}
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_SumLoadRequestCost_Call) Run(run func(ctx context.Context, since pgtype.Timestamptz)) *MockStore_SumLoadRequestCost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_SumLoadRequestCost_Call) Return(_a0 int64, _a1 error) *MockStore_SumLoadRequestCost_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_SumLoadRequestCost_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) (int64, error)) *MockStore_SumLoadRequestCost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByUsername provides a mock function with given fields: ctx, username
-func (_m *MockStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
-	ret := _m.Called(ctx, username)
+// UpdateLoadRequestSent provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateLoadRequestSent(ctx context.Context, arg db.UpdateLoadRequestSentParams) (db.LoadRequest, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 db.User
+	var r0 db.LoadRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (db.User, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateLoadRequestSentParams) (db.LoadRequest, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) db.User); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateLoadRequestSentParams) db.LoadRequest); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(db.LoadRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateLoadRequestSentParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2237,54 +8191,52 @@ func (_m *MockStore) GetUserByUsername(ctx context.Context, username string) (db
 	return r0, r1
 }
 
-// MockStore_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
-type MockStore_GetUserByUsername_Call struct {
+// MockStore_UpdateLoadRequestSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLoadRequestSent'
+type MockStore_UpdateLoadRequestSent_Call struct {
 	*mock.Call
 }
 
-// GetUserByUsername is a helper method to define mock.On call
+// UpdateLoadRequestSent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockStore_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockStore_GetUserByUsername_Call {
-	return &MockStore_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+//   - arg db.UpdateLoadRequestSentParams
+func (_e *MockStore_Expecter) UpdateLoadRequestSent(ctx interface{}, arg interface{}) *MockStore_UpdateLoadRequestSent_Call {
+	return &MockStore_UpdateLoadRequestSent_Call{Call: _e.mock.On("UpdateLoadRequestSent", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_UpdateLoadRequestSent_Call) Run(run func(ctx context.Context, arg db.UpdateLoadRequestSentParams)) *MockStore_UpdateLoadRequestSent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(db.UpdateLoadRequestSentParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByUsername_Call) Return(_a0 db.User, _a1 error) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_UpdateLoadRequestSent_Call) Return(_a0 db.LoadRequest, _a1 error) *MockStore_UpdateLoadRequestSent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (db.User, error)) *MockStore_GetUserByUsername_Call {
+func (_c *MockStore_UpdateLoadRequestSent_Call) RunAndReturn(run func(context.Context, db.UpdateLoadRequestSentParams) (db.LoadRequest, error)) *MockStore_UpdateLoadRequestSent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertCurrentObservations provides a mock function with given fields: ctx
-func (_m *MockStore) InsertCurrentObservations(ctx context.Context) ([]db.ObservationsCurrent, error) {
-	ret := _m.Called(ctx)
+// UpdateObservationQcLevel provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateObservationQcLevel(ctx context.Context, arg db.UpdateObservationQcLevelParams) (db.ObservationsObservation, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsCurrent
+	var r0 db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ObservationsCurrent, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateObservationQcLevelParams) (db.ObservationsObservation, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []db.ObservationsCurrent); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateObservationQcLevelParams) db.ObservationsObservation); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsCurrent)
-		}
+		r0 = ret.Get(0).(db.ObservationsObservation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateObservationQcLevelParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2292,53 +8244,52 @@ func (_m *MockStore) InsertCurrentObservations(ctx context.Context) ([]db.Observ
 	return r0, r1
 }
 
-// MockStore_InsertCurrentObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertCurrentObservations'
-type MockStore_InsertCurrentObservations_Call struct {
+// MockStore_UpdateObservationQcLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateObservationQcLevel'
+type MockStore_UpdateObservationQcLevel_Call struct {
 	*mock.Call
 }
 
-// InsertCurrentObservations is a helper method to define mock.On call
+// UpdateObservationQcLevel is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) InsertCurrentObservations(ctx interface{}) *MockStore_InsertCurrentObservations_Call {
-	return &MockStore_InsertCurrentObservations_Call{Call: _e.mock.On("InsertCurrentObservations", ctx)}
+//   - arg db.UpdateObservationQcLevelParams
+func (_e *MockStore_Expecter) UpdateObservationQcLevel(ctx interface{}, arg interface{}) *MockStore_UpdateObservationQcLevel_Call {
+	return &MockStore_UpdateObservationQcLevel_Call{Call: _e.mock.On("UpdateObservationQcLevel", ctx, arg)}
 }
 
-func (_c *MockStore_InsertCurrentObservations_Call) Run(run func(ctx context.Context)) *MockStore_InsertCurrentObservations_Call {
+func (_c *MockStore_UpdateObservationQcLevel_Call) Run(run func(ctx context.Context, arg db.UpdateObservationQcLevelParams)) *MockStore_UpdateObservationQcLevel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.UpdateObservationQcLevelParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_InsertCurrentObservations_Call) Return(_a0 []db.ObservationsCurrent, _a1 error) *MockStore_InsertCurrentObservations_Call {
+func (_c *MockStore_UpdateObservationQcLevel_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_UpdateObservationQcLevel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_InsertCurrentObservations_Call) RunAndReturn(run func(context.Context) ([]db.ObservationsCurrent, error)) *MockStore_InsertCurrentObservations_Call {
+func (_c *MockStore_UpdateObservationQcLevel_Call) RunAndReturn(run func(context.Context, db.UpdateObservationQcLevelParams) (db.ObservationsObservation, error)) *MockStore_UpdateObservationQcLevel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListLatestObservations provides a mock function with given fields: ctx
-func (_m *MockStore) ListLatestObservations(ctx context.Context) ([]db.ListLatestObservationsRow, error) {
-	ret := _m.Called(ctx)
+// UpdateObservationQcTx provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateObservationQcTx(ctx context.Context, arg db.UpdateObservationQcTxParams) (db.UpdateObservationQcTxResult, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ListLatestObservationsRow
+	var r0 db.UpdateObservationQcTxResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]db.ListLatestObservationsRow, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateObservationQcTxParams) (db.UpdateObservationQcTxResult, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []db.ListLatestObservationsRow); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateObservationQcTxParams) db.UpdateObservationQcTxResult); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ListLatestObservationsRow)
-		}
+		r0 = ret.Get(0).(db.UpdateObservationQcTxResult)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateObservationQcTxParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2346,52 +8297,51 @@ func (_m *MockStore) ListLatestObservations(ctx context.Context) ([]db.ListLates
 	return r0, r1
 }
 
-// MockStore_ListLatestObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLatestObservations'
-type MockStore_ListLatestObservations_Call struct {
+// MockStore_UpdateObservationQcTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateObservationQcTx'
+type MockStore_UpdateObservationQcTx_Call struct {
 	*mock.Call
 }
 
-// ListLatestObservations is a helper method to define mock.On call
+// UpdateObservationQcTx is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) ListLatestObservations(ctx interface{}) *MockStore_ListLatestObservations_Call {
-	return &MockStore_ListLatestObservations_Call{Call: _e.mock.On("ListLatestObservations", ctx)}
+//   - arg db.UpdateObservationQcTxParams
+func (_e *MockStore_Expecter) UpdateObservationQcTx(ctx interface{}, arg interface{}) *MockStore_UpdateObservationQcTx_Call {
+	return &MockStore_UpdateObservationQcTx_Call{Call: _e.mock.On("UpdateObservationQcTx", ctx, arg)}
 }
 
-func (_c *MockStore_ListLatestObservations_Call) Run(run func(ctx context.Context)) *MockStore_ListLatestObservations_Call {
+func (_c *MockStore_UpdateObservationQcTx_Call) Run(run func(ctx context.Context, arg db.UpdateObservationQcTxParams)) *MockStore_UpdateObservationQcTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(db.UpdateObservationQcTxParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListLatestObservations_Call) Return(_a0 []db.ListLatestObservationsRow, _a1 error) *MockStore_ListLatestObservations_Call {
+func (_c *MockStore_UpdateObservationQcTx_Call) Return(_a0 db.UpdateObservationQcTxResult, _a1 error) *MockStore_UpdateObservationQcTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListLatestObservations_Call) RunAndReturn(run func(context.Context) ([]db.ListLatestObservationsRow, error)) *MockStore_ListLatestObservations_Call {
+func (_c *MockStore_UpdateObservationQcTx_Call) RunAndReturn(run func(context.Context, db.UpdateObservationQcTxParams) (db.UpdateObservationQcTxResult, error)) *MockStore_UpdateObservationQcTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListLufftStationMsg provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListLufftStationMsg(ctx context.Context, arg db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error) {
+// UpdateRawMessageOutcome provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateRawMessageOutcome(ctx context.Context, arg db.UpdateRawMessageOutcomeParams) (db.RawMessage, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ListLufftStationMsgRow
+	var r0 db.RawMessage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRawMessageOutcomeParams) (db.RawMessage, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListLufftStationMsgParams) []db.ListLufftStationMsgRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRawMessageOutcomeParams) db.RawMessage); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ListLufftStationMsgRow)
-		}
+		r0 = ret.Get(0).(db.RawMessage)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListLufftStationMsgParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateRawMessageOutcomeParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2400,53 +8350,51 @@ func (_m *MockStore) ListLufftStationMsg(ctx context.Context, arg db.ListLufftSt
 	return r0, r1
 }
 
-// MockStore_ListLufftStationMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLufftStationMsg'
-type MockStore_ListLufftStationMsg_Call struct {
+// MockStore_UpdateRawMessageOutcome_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRawMessageOutcome'
+type MockStore_UpdateRawMessageOutcome_Call struct {
 	*mock.Call
 }
 
-// ListLufftStationMsg is a helper method to define mock.On call
+// UpdateRawMessageOutcome is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListLufftStationMsgParams
-func (_e *MockStore_Expecter) ListLufftStationMsg(ctx interface{}, arg interface{}) *MockStore_ListLufftStationMsg_Call {
-	return &MockStore_ListLufftStationMsg_Call{Call: _e.mock.On("ListLufftStationMsg", ctx, arg)}
+//   - arg db.UpdateRawMessageOutcomeParams
+func (_e *MockStore_Expecter) UpdateRawMessageOutcome(ctx interface{}, arg interface{}) *MockStore_UpdateRawMessageOutcome_Call {
+	return &MockStore_UpdateRawMessageOutcome_Call{Call: _e.mock.On("UpdateRawMessageOutcome", ctx, arg)}
 }
 
-func (_c *MockStore_ListLufftStationMsg_Call) Run(run func(ctx context.Context, arg db.ListLufftStationMsgParams)) *MockStore_ListLufftStationMsg_Call {
+func (_c *MockStore_UpdateRawMessageOutcome_Call) Run(run func(ctx context.Context, arg db.UpdateRawMessageOutcomeParams)) *MockStore_UpdateRawMessageOutcome_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListLufftStationMsgParams))
+		run(args[0].(context.Context), args[1].(db.UpdateRawMessageOutcomeParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListLufftStationMsg_Call) Return(_a0 []db.ListLufftStationMsgRow, _a1 error) *MockStore_ListLufftStationMsg_Call {
+func (_c *MockStore_UpdateRawMessageOutcome_Call) Return(_a0 db.RawMessage, _a1 error) *MockStore_UpdateRawMessageOutcome_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListLufftStationMsg_Call) RunAndReturn(run func(context.Context, db.ListLufftStationMsgParams) ([]db.ListLufftStationMsgRow, error)) *MockStore_ListLufftStationMsg_Call {
+func (_c *MockStore_UpdateRawMessageOutcome_Call) RunAndReturn(run func(context.Context, db.UpdateRawMessageOutcomeParams) (db.RawMessage, error)) *MockStore_UpdateRawMessageOutcome_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListObservations(ctx context.Context, arg db.ListObservationsParams) ([]db.ObservationsObservation, error) {
+// UpdateRole provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateRole(ctx context.Context, arg db.UpdateRoleParams) (db.Role, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsObservation
+	var r0 db.Role
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) (db.Role, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListObservationsParams) []db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) db.Role); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsObservation)
-		}
+		r0 = ret.Get(0).(db.Role)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateRoleParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2455,53 +8403,51 @@ func (_m *MockStore) ListObservations(ctx context.Context, arg db.ListObservatio
 	return r0, r1
 }
 
-// MockStore_ListObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListObservations'
-type MockStore_ListObservations_Call struct {
+// MockStore_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockStore_UpdateRole_Call struct {
 	*mock.Call
 }
 
-// ListObservations is a helper method to define mock.On call
+// UpdateRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListObservationsParams
-func (_e *MockStore_Expecter) ListObservations(ctx interface{}, arg interface{}) *MockStore_ListObservations_Call {
-	return &MockStore_ListObservations_Call{Call: _e.mock.On("ListObservations", ctx, arg)}
+//   - arg db.UpdateRoleParams
+func (_e *MockStore_Expecter) UpdateRole(ctx interface{}, arg interface{}) *MockStore_UpdateRole_Call {
+	return &MockStore_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, arg)}
 }
 
-func (_c *MockStore_ListObservations_Call) Run(run func(ctx context.Context, arg db.ListObservationsParams)) *MockStore_ListObservations_Call {
+func (_c *MockStore_UpdateRole_Call) Run(run func(ctx context.Context, arg db.UpdateRoleParams)) *MockStore_UpdateRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListObservationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateRoleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListObservations_Call {
+func (_c *MockStore_UpdateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_UpdateRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListObservations_Call) RunAndReturn(run func(context.Context, db.ListObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListObservations_Call {
+func (_c *MockStore_UpdateRole_Call) RunAndReturn(run func(context.Context, db.UpdateRoleParams) (db.Role, error)) *MockStore_UpdateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListRoles provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListRoles(ctx context.Context, arg db.ListRolesParams) ([]db.Role, error) {
+// UpdateSimCard provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSimCard(ctx context.Context, arg db.UpdateSimCardParams) (db.SimCard, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.Role
+	var r0 db.SimCard
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) ([]db.Role, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardParams) (db.SimCard, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListRolesParams) []db.Role); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardParams) db.SimCard); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.Role)
-		}
+		r0 = ret.Get(0).(db.SimCard)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListRolesParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateSimCardParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2510,108 +8456,94 @@ func (_m *MockStore) ListRoles(ctx context.Context, arg db.ListRolesParams) ([]d
 	return r0, r1
 }
 
-// MockStore_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
-type MockStore_ListRoles_Call struct {
+// MockStore_UpdateSimCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSimCard'
+type MockStore_UpdateSimCard_Call struct {
 	*mock.Call
 }
 
-// ListRoles is a helper method to define mock.On call
+// UpdateSimCard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListRolesParams
-func (_e *MockStore_Expecter) ListRoles(ctx interface{}, arg interface{}) *MockStore_ListRoles_Call {
-	return &MockStore_ListRoles_Call{Call: _e.mock.On("ListRoles", ctx, arg)}
+//   - arg db.UpdateSimCardParams
+func (_e *MockStore_Expecter) UpdateSimCard(ctx interface{}, arg interface{}) *MockStore_UpdateSimCard_Call {
+	return &MockStore_UpdateSimCard_Call{Call: _e.mock.On("UpdateSimCard", ctx, arg)}
 }
 
-func (_c *MockStore_ListRoles_Call) Run(run func(ctx context.Context, arg db.ListRolesParams)) *MockStore_ListRoles_Call {
+func (_c *MockStore_UpdateSimCard_Call) Run(run func(ctx context.Context, arg db.UpdateSimCardParams)) *MockStore_UpdateSimCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListRolesParams))
+		run(args[0].(context.Context), args[1].(db.UpdateSimCardParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListRoles_Call) Return(_a0 []db.Role, _a1 error) *MockStore_ListRoles_Call {
+func (_c *MockStore_UpdateSimCard_Call) Return(_a0 db.SimCard, _a1 error) *MockStore_UpdateSimCard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListRoles_Call) RunAndReturn(run func(context.Context, db.ListRolesParams) ([]db.Role, error)) *MockStore_ListRoles_Call {
+func (_c *MockStore_UpdateSimCard_Call) RunAndReturn(run func(context.Context, db.UpdateSimCardParams) (db.SimCard, error)) *MockStore_UpdateSimCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationHealths provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationHealths(ctx context.Context, arg db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error) {
+// UpdateSimCardLoad provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateSimCardLoad(ctx context.Context, arg db.UpdateSimCardLoadParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsStationhealth
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationHealthsParams) []db.ObservationsStationhealth); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateSimCardLoadParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStationhealth)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationHealthsParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_ListStationHealths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationHealths'
-type MockStore_ListStationHealths_Call struct {
+// MockStore_UpdateSimCardLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSimCardLoad'
+type MockStore_UpdateSimCardLoad_Call struct {
 	*mock.Call
 }
 
-// ListStationHealths is a helper method to define mock.On call
+// UpdateSimCardLoad is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationHealthsParams
-func (_e *MockStore_Expecter) ListStationHealths(ctx interface{}, arg interface{}) *MockStore_ListStationHealths_Call {
-	return &MockStore_ListStationHealths_Call{Call: _e.mock.On("ListStationHealths", ctx, arg)}
+//   - arg db.UpdateSimCardLoadParams
+func (_e *MockStore_Expecter) UpdateSimCardLoad(ctx interface{}, arg interface{}) *MockStore_UpdateSimCardLoad_Call {
+	return &MockStore_UpdateSimCardLoad_Call{Call: _e.mock.On("UpdateSimCardLoad", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationHealths_Call) Run(run func(ctx context.Context, arg db.ListStationHealthsParams)) *MockStore_ListStationHealths_Call {
+func (_c *MockStore_UpdateSimCardLoad_Call) Run(run func(ctx context.Context, arg db.UpdateSimCardLoadParams)) *MockStore_UpdateSimCardLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationHealthsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateSimCardLoadParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationHealths_Call) Return(_a0 []db.ObservationsStationhealth, _a1 error) *MockStore_ListStationHealths_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateSimCardLoad_Call) Return(_a0 error) *MockStore_UpdateSimCardLoad_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_ListStationHealths_Call) RunAndReturn(run func(context.Context, db.ListStationHealthsParams) ([]db.ObservationsStationhealth, error)) *MockStore_ListStationHealths_Call {
+func (_c *MockStore_UpdateSimCardLoad_Call) RunAndReturn(run func(context.Context, db.UpdateSimCardLoadParams) error) *MockStore_UpdateSimCardLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationObservations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationObservations(ctx context.Context, arg db.ListStationObservationsParams) ([]db.ObservationsObservation, error) {
+// UpdateStation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStation(ctx context.Context, arg db.UpdateStationParams) (db.ObservationsStation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsObservation
+	var r0 db.ObservationsStation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationObservationsParams) []db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) db.ObservationsStation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsObservation)
-		}
+		r0 = ret.Get(0).(db.ObservationsStation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationObservationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2620,53 +8552,51 @@ func (_m *MockStore) ListStationObservations(ctx context.Context, arg db.ListSta
 	return r0, r1
 }
 
-// MockStore_ListStationObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationObservations'
-type MockStore_ListStationObservations_Call struct {
+// MockStore_UpdateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStation'
+type MockStore_UpdateStation_Call struct {
 	*mock.Call
 }
 
-// ListStationObservations is a helper method to define mock.On call
-//   - ctx context.Context
-//   - arg db.ListStationObservationsParams
-func (_e *MockStore_Expecter) ListStationObservations(ctx interface{}, arg interface{}) *MockStore_ListStationObservations_Call {
-	return &MockStore_ListStationObservations_Call{Call: _e.mock.On("ListStationObservations", ctx, arg)}
+// UpdateStation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.UpdateStationParams
+func (_e *MockStore_Expecter) UpdateStation(ctx interface{}, arg interface{}) *MockStore_UpdateStation_Call {
+	return &MockStore_UpdateStation_Call{Call: _e.mock.On("UpdateStation", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationObservations_Call) Run(run func(ctx context.Context, arg db.ListStationObservationsParams)) *MockStore_ListStationObservations_Call {
+func (_c *MockStore_UpdateStation_Call) Run(run func(ctx context.Context, arg db.UpdateStationParams)) *MockStore_UpdateStation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationObservationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationObservations_Call) Return(_a0 []db.ObservationsObservation, _a1 error) *MockStore_ListStationObservations_Call {
+func (_c *MockStore_UpdateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_UpdateStation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationObservations_Call) RunAndReturn(run func(context.Context, db.ListStationObservationsParams) ([]db.ObservationsObservation, error)) *MockStore_ListStationObservations_Call {
+func (_c *MockStore_UpdateStation_Call) RunAndReturn(run func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)) *MockStore_UpdateStation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStations provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStations(ctx context.Context, arg db.ListStationsParams) ([]db.ObservationsStation, error) {
+// UpdateStationHealth provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationHealth(ctx context.Context, arg db.UpdateStationHealthParams) (db.ObservationsStationhealth, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsStation
+	var r0 db.ObservationsStationhealth
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsParams) []db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) db.ObservationsStationhealth); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStation)
-		}
+		r0 = ret.Get(0).(db.ObservationsStationhealth)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationHealthParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2675,53 +8605,51 @@ func (_m *MockStore) ListStations(ctx context.Context, arg db.ListStationsParams
 	return r0, r1
 }
 
-// MockStore_ListStations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStations'
-type MockStore_ListStations_Call struct {
+// MockStore_UpdateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationHealth'
+type MockStore_UpdateStationHealth_Call struct {
 	*mock.Call
 }
 
-// ListStations is a helper method to define mock.On call
+// UpdateStationHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationsParams
-func (_e *MockStore_Expecter) ListStations(ctx interface{}, arg interface{}) *MockStore_ListStations_Call {
-	return &MockStore_ListStations_Call{Call: _e.mock.On("ListStations", ctx, arg)}
+//   - arg db.UpdateStationHealthParams
+func (_e *MockStore_Expecter) UpdateStationHealth(ctx interface{}, arg interface{}) *MockStore_UpdateStationHealth_Call {
+	return &MockStore_UpdateStationHealth_Call{Call: _e.mock.On("UpdateStationHealth", ctx, arg)}
 }
 
-func (_c *MockStore_ListStations_Call) Run(run func(ctx context.Context, arg db.ListStationsParams)) *MockStore_ListStations_Call {
+func (_c *MockStore_UpdateStationHealth_Call) Run(run func(ctx context.Context, arg db.UpdateStationHealthParams)) *MockStore_UpdateStationHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationsParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationHealthParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStations_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStations_Call {
+func (_c *MockStore_UpdateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_UpdateStationHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStations_Call) RunAndReturn(run func(context.Context, db.ListStationsParams) ([]db.ObservationsStation, error)) *MockStore_ListStations_Call {
+func (_c *MockStore_UpdateStationHealth_Call) RunAndReturn(run func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_UpdateStationHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationsWithinBBox provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationsWithinBBox(ctx context.Context, arg db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error) {
+// UpdateStationMoObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationMoObservation(ctx context.Context, arg db.UpdateStationMoObservationParams) (db.ObservationsMoObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsStation
+	var r0 db.ObservationsMoObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMoObservationParams) (db.ObservationsMoObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinBBoxParams) []db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationMoObservationParams) db.ObservationsMoObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStation)
-		}
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsWithinBBoxParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationMoObservationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2730,53 +8658,51 @@ func (_m *MockStore) ListStationsWithinBBox(ctx context.Context, arg db.ListStat
 	return r0, r1
 }
 
-// MockStore_ListStationsWithinBBox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsWithinBBox'
-type MockStore_ListStationsWithinBBox_Call struct {
+// MockStore_UpdateStationMoObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationMoObservation'
+type MockStore_UpdateStationMoObservation_Call struct {
 	*mock.Call
 }
 
-// ListStationsWithinBBox is a helper method to define mock.On call
+// UpdateStationMoObservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationsWithinBBoxParams
-func (_e *MockStore_Expecter) ListStationsWithinBBox(ctx interface{}, arg interface{}) *MockStore_ListStationsWithinBBox_Call {
-	return &MockStore_ListStationsWithinBBox_Call{Call: _e.mock.On("ListStationsWithinBBox", ctx, arg)}
+//   - arg db.UpdateStationMoObservationParams
+func (_e *MockStore_Expecter) UpdateStationMoObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationMoObservation_Call {
+	return &MockStore_UpdateStationMoObservation_Call{Call: _e.mock.On("UpdateStationMoObservation", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationsWithinBBox_Call) Run(run func(ctx context.Context, arg db.ListStationsWithinBBoxParams)) *MockStore_ListStationsWithinBBox_Call {
+func (_c *MockStore_UpdateStationMoObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationMoObservationParams)) *MockStore_UpdateStationMoObservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationsWithinBBoxParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationMoObservationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinBBox_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsWithinBBox_Call {
+func (_c *MockStore_UpdateStationMoObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_UpdateStationMoObservation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinBBox_Call) RunAndReturn(run func(context.Context, db.ListStationsWithinBBoxParams) ([]db.ObservationsStation, error)) *MockStore_ListStationsWithinBBox_Call {
+func (_c *MockStore_UpdateStationMoObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationMoObservationParams) (db.ObservationsMoObservation, error)) *MockStore_UpdateStationMoObservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListStationsWithinRadius provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListStationsWithinRadius(ctx context.Context, arg db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error) {
+// UpdateStationObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateStationObservation(ctx context.Context, arg db.UpdateStationObservationParams) (db.ObservationsObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.ObservationsStation
+	var r0 db.ObservationsObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListStationsWithinRadiusParams) []db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) db.ObservationsObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.ObservationsStation)
-		}
+		r0 = ret.Get(0).(db.ObservationsObservation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListStationsWithinRadiusParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationObservationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2785,54 +8711,52 @@ func (_m *MockStore) ListStationsWithinRadius(ctx context.Context, arg db.ListSt
 	return r0, r1
 }
 
-// MockStore_ListStationsWithinRadius_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStationsWithinRadius'
-type MockStore_ListStationsWithinRadius_Call struct {
+// MockStore_UpdateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationObservation'
+type MockStore_UpdateStationObservation_Call struct {
 	*mock.Call
 }
 
-// ListStationsWithinRadius is a helper method to define mock.On call
+// UpdateStationObservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListStationsWithinRadiusParams
-func (_e *MockStore_Expecter) ListStationsWithinRadius(ctx interface{}, arg interface{}) *MockStore_ListStationsWithinRadius_Call {
-	return &MockStore_ListStationsWithinRadius_Call{Call: _e.mock.On("ListStationsWithinRadius", ctx, arg)}
+//   - arg db.UpdateStationObservationParams
+func (_e *MockStore_Expecter) UpdateStationObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationObservation_Call {
+	return &MockStore_UpdateStationObservation_Call{Call: _e.mock.On("UpdateStationObservation", ctx, arg)}
 }
 
-func (_c *MockStore_ListStationsWithinRadius_Call) Run(run func(ctx context.Context, arg db.ListStationsWithinRadiusParams)) *MockStore_ListStationsWithinRadius_Call {
+func (_c *MockStore_UpdateStationObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationObservationParams)) *MockStore_UpdateStationObservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListStationsWithinRadiusParams))
+		run(args[0].(context.Context), args[1].(db.UpdateStationObservationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinRadius_Call) Return(_a0 []db.ObservationsStation, _a1 error) *MockStore_ListStationsWithinRadius_Call {
+func (_c *MockStore_UpdateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_UpdateStationObservation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListStationsWithinRadius_Call) RunAndReturn(run func(context.Context, db.ListStationsWithinRadiusParams) ([]db.ObservationsStation, error)) *MockStore_ListStationsWithinRadius_Call {
+func (_c *MockStore_UpdateStationObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_UpdateStationObservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUserRoles provides a mock function with given fields: ctx, userID
-func (_m *MockStore) ListUserRoles(ctx context.Context, userID int64) ([]string, error) {
-	ret := _m.Called(ctx, userID)
+// UpdateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 []string
+	var r0 db.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]string, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) (db.User, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) db.User); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(db.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateUserParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2840,53 +8764,51 @@ func (_m *MockStore) ListUserRoles(ctx context.Context, userID int64) ([]string,
 	return r0, r1
 }
 
-// MockStore_ListUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRoles'
-type MockStore_ListUserRoles_Call struct {
+// MockStore_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockStore_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// ListUserRoles is a helper method to define mock.On call
+// UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int64
-func (_e *MockStore_Expecter) ListUserRoles(ctx interface{}, userID interface{}) *MockStore_ListUserRoles_Call {
-	return &MockStore_ListUserRoles_Call{Call: _e.mock.On("ListUserRoles", ctx, userID)}
+//   - arg db.UpdateUserParams
+func (_e *MockStore_Expecter) UpdateUser(ctx interface{}, arg interface{}) *MockStore_UpdateUser_Call {
+	return &MockStore_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
 }
 
-func (_c *MockStore_ListUserRoles_Call) Run(run func(ctx context.Context, userID int64)) *MockStore_ListUserRoles_Call {
+func (_c *MockStore_UpdateUser_Call) Run(run func(ctx context.Context, arg db.UpdateUserParams)) *MockStore_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(db.UpdateUserParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListUserRoles_Call) Return(_a0 []string, _a1 error) *MockStore_ListUserRoles_Call {
+func (_c *MockStore_UpdateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_UpdateUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListUserRoles_Call) RunAndReturn(run func(context.Context, int64) ([]string, error)) *MockStore_ListUserRoles_Call {
+func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(context.Context, db.UpdateUserParams) (db.User, error)) *MockStore_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUsers provides a mock function with given fields: ctx, arg
-func (_m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+// UpsertDailyObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertDailyObservations(ctx context.Context, arg db.UpsertDailyObservationsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []db.User
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) ([]db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertDailyObservationsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.ListUsersParams) []db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertDailyObservationsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.User)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.ListUsersParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertDailyObservationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2895,51 +8817,51 @@ func (_m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]d
 	return r0, r1
 }
 
-// MockStore_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
-type MockStore_ListUsers_Call struct {
+// MockStore_UpsertDailyObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDailyObservations'
+type MockStore_UpsertDailyObservations_Call struct {
 	*mock.Call
 }
 
-// ListUsers is a helper method to define mock.On call
+// UpsertDailyObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.ListUsersParams
-func (_e *MockStore_Expecter) ListUsers(ctx interface{}, arg interface{}) *MockStore_ListUsers_Call {
-	return &MockStore_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, arg)}
+//   - arg db.UpsertDailyObservationsParams
+func (_e *MockStore_Expecter) UpsertDailyObservations(ctx interface{}, arg interface{}) *MockStore_UpsertDailyObservations_Call {
+	return &MockStore_UpsertDailyObservations_Call{Call: _e.mock.On("UpsertDailyObservations", ctx, arg)}
 }
 
-func (_c *MockStore_ListUsers_Call) Run(run func(ctx context.Context, arg db.ListUsersParams)) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpsertDailyObservations_Call) Run(run func(ctx context.Context, arg db.UpsertDailyObservationsParams)) *MockStore_UpsertDailyObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.ListUsersParams))
+		run(args[0].(context.Context), args[1].(db.UpsertDailyObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_ListUsers_Call) Return(_a0 []db.User, _a1 error) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpsertDailyObservations_Call) Return(_a0 int64, _a1 error) *MockStore_UpsertDailyObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_ListUsers_Call) RunAndReturn(run func(context.Context, db.ListUsersParams) ([]db.User, error)) *MockStore_ListUsers_Call {
+func (_c *MockStore_UpsertDailyObservations_Call) RunAndReturn(run func(context.Context, db.UpsertDailyObservationsParams) (int64, error)) *MockStore_UpsertDailyObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRole provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateRole(ctx context.Context, arg db.UpdateRoleParams) (db.Role, error) {
+// UpsertHourlyObservations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertHourlyObservations(ctx context.Context, arg db.UpsertHourlyObservationsParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.Role
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) (db.Role, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertHourlyObservationsParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateRoleParams) db.Role); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertHourlyObservationsParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Role)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateRoleParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertHourlyObservationsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2948,51 +8870,51 @@ func (_m *MockStore) UpdateRole(ctx context.Context, arg db.UpdateRoleParams) (d
 	return r0, r1
 }
 
-// MockStore_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
-type MockStore_UpdateRole_Call struct {
+// MockStore_UpsertHourlyObservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertHourlyObservations'
+type MockStore_UpsertHourlyObservations_Call struct {
 	*mock.Call
 }
 
-// UpdateRole is a helper method to define mock.On call
+// UpsertHourlyObservations is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateRoleParams
-func (_e *MockStore_Expecter) UpdateRole(ctx interface{}, arg interface{}) *MockStore_UpdateRole_Call {
-	return &MockStore_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, arg)}
+//   - arg db.UpsertHourlyObservationsParams
+func (_e *MockStore_Expecter) UpsertHourlyObservations(ctx interface{}, arg interface{}) *MockStore_UpsertHourlyObservations_Call {
+	return &MockStore_UpsertHourlyObservations_Call{Call: _e.mock.On("UpsertHourlyObservations", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateRole_Call) Run(run func(ctx context.Context, arg db.UpdateRoleParams)) *MockStore_UpdateRole_Call {
+func (_c *MockStore_UpsertHourlyObservations_Call) Run(run func(ctx context.Context, arg db.UpsertHourlyObservationsParams)) *MockStore_UpsertHourlyObservations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateRoleParams))
+		run(args[0].(context.Context), args[1].(db.UpsertHourlyObservationsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateRole_Call) Return(_a0 db.Role, _a1 error) *MockStore_UpdateRole_Call {
+func (_c *MockStore_UpsertHourlyObservations_Call) Return(_a0 int64, _a1 error) *MockStore_UpsertHourlyObservations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateRole_Call) RunAndReturn(run func(context.Context, db.UpdateRoleParams) (db.Role, error)) *MockStore_UpdateRole_Call {
+func (_c *MockStore_UpsertHourlyObservations_Call) RunAndReturn(run func(context.Context, db.UpsertHourlyObservationsParams) (int64, error)) *MockStore_UpsertHourlyObservations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStation(ctx context.Context, arg db.UpdateStationParams) (db.ObservationsStation, error) {
+// UpsertSmsSubscription provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertSmsSubscription(ctx context.Context, arg db.UpsertSmsSubscriptionParams) (db.SmsSubscription, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsStation
+	var r0 db.SmsSubscription
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertSmsSubscriptionParams) (db.SmsSubscription, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationParams) db.ObservationsStation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertSmsSubscriptionParams) db.SmsSubscription); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStation)
+		r0 = ret.Get(0).(db.SmsSubscription)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertSmsSubscriptionParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3001,51 +8923,51 @@ func (_m *MockStore) UpdateStation(ctx context.Context, arg db.UpdateStationPara
 	return r0, r1
 }
 
-// MockStore_UpdateStation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStation'
-type MockStore_UpdateStation_Call struct {
+// MockStore_UpsertSmsSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertSmsSubscription'
+type MockStore_UpsertSmsSubscription_Call struct {
 	*mock.Call
 }
 
-// UpdateStation is a helper method to define mock.On call
+// UpsertSmsSubscription is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationParams
-func (_e *MockStore_Expecter) UpdateStation(ctx interface{}, arg interface{}) *MockStore_UpdateStation_Call {
-	return &MockStore_UpdateStation_Call{Call: _e.mock.On("UpdateStation", ctx, arg)}
+//   - arg db.UpsertSmsSubscriptionParams
+func (_e *MockStore_Expecter) UpsertSmsSubscription(ctx interface{}, arg interface{}) *MockStore_UpsertSmsSubscription_Call {
+	return &MockStore_UpsertSmsSubscription_Call{Call: _e.mock.On("UpsertSmsSubscription", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateStation_Call) Run(run func(ctx context.Context, arg db.UpdateStationParams)) *MockStore_UpdateStation_Call {
+func (_c *MockStore_UpsertSmsSubscription_Call) Run(run func(ctx context.Context, arg db.UpsertSmsSubscriptionParams)) *MockStore_UpsertSmsSubscription_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationParams))
+		run(args[0].(context.Context), args[1].(db.UpsertSmsSubscriptionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStation_Call) Return(_a0 db.ObservationsStation, _a1 error) *MockStore_UpdateStation_Call {
+func (_c *MockStore_UpsertSmsSubscription_Call) Return(_a0 db.SmsSubscription, _a1 error) *MockStore_UpsertSmsSubscription_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStation_Call) RunAndReturn(run func(context.Context, db.UpdateStationParams) (db.ObservationsStation, error)) *MockStore_UpdateStation_Call {
+func (_c *MockStore_UpsertSmsSubscription_Call) RunAndReturn(run func(context.Context, db.UpsertSmsSubscriptionParams) (db.SmsSubscription, error)) *MockStore_UpsertSmsSubscription_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStationHealth provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStationHealth(ctx context.Context, arg db.UpdateStationHealthParams) (db.ObservationsStationhealth, error) {
+// UpsertStationCredential provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertStationCredential(ctx context.Context, arg db.UpsertStationCredentialParams) (db.ObservationsStationcredential, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsStationhealth
+	var r0 db.ObservationsStationcredential
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationCredentialParams) (db.ObservationsStationcredential, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationHealthParams) db.ObservationsStationhealth); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationCredentialParams) db.ObservationsStationcredential); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsStationhealth)
+		r0 = ret.Get(0).(db.ObservationsStationcredential)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationHealthParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertStationCredentialParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3054,51 +8976,51 @@ func (_m *MockStore) UpdateStationHealth(ctx context.Context, arg db.UpdateStati
 	return r0, r1
 }
 
-// MockStore_UpdateStationHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationHealth'
-type MockStore_UpdateStationHealth_Call struct {
+// MockStore_UpsertStationCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStationCredential'
+type MockStore_UpsertStationCredential_Call struct {
 	*mock.Call
 }
 
-// UpdateStationHealth is a helper method to define mock.On call
+// UpsertStationCredential is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationHealthParams
-func (_e *MockStore_Expecter) UpdateStationHealth(ctx interface{}, arg interface{}) *MockStore_UpdateStationHealth_Call {
-	return &MockStore_UpdateStationHealth_Call{Call: _e.mock.On("UpdateStationHealth", ctx, arg)}
+//   - arg db.UpsertStationCredentialParams
+func (_e *MockStore_Expecter) UpsertStationCredential(ctx interface{}, arg interface{}) *MockStore_UpsertStationCredential_Call {
+	return &MockStore_UpsertStationCredential_Call{Call: _e.mock.On("UpsertStationCredential", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateStationHealth_Call) Run(run func(ctx context.Context, arg db.UpdateStationHealthParams)) *MockStore_UpdateStationHealth_Call {
+func (_c *MockStore_UpsertStationCredential_Call) Run(run func(ctx context.Context, arg db.UpsertStationCredentialParams)) *MockStore_UpsertStationCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationHealthParams))
+		run(args[0].(context.Context), args[1].(db.UpsertStationCredentialParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStationHealth_Call) Return(_a0 db.ObservationsStationhealth, _a1 error) *MockStore_UpdateStationHealth_Call {
+func (_c *MockStore_UpsertStationCredential_Call) Return(_a0 db.ObservationsStationcredential, _a1 error) *MockStore_UpsertStationCredential_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStationHealth_Call) RunAndReturn(run func(context.Context, db.UpdateStationHealthParams) (db.ObservationsStationhealth, error)) *MockStore_UpdateStationHealth_Call {
+func (_c *MockStore_UpsertStationCredential_Call) RunAndReturn(run func(context.Context, db.UpsertStationCredentialParams) (db.ObservationsStationcredential, error)) *MockStore_UpsertStationCredential_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStationObservation provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateStationObservation(ctx context.Context, arg db.UpdateStationObservationParams) (db.ObservationsObservation, error) {
+// UpsertStationMoObservation provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertStationMoObservation(ctx context.Context, arg db.UpsertStationMoObservationParams) (db.ObservationsMoObservation, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.ObservationsObservation
+	var r0 db.ObservationsMoObservation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationMoObservationParams) (db.ObservationsMoObservation, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateStationObservationParams) db.ObservationsObservation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationMoObservationParams) db.ObservationsMoObservation); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.ObservationsObservation)
+		r0 = ret.Get(0).(db.ObservationsMoObservation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateStationObservationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertStationMoObservationParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3107,51 +9029,51 @@ func (_m *MockStore) UpdateStationObservation(ctx context.Context, arg db.Update
 	return r0, r1
 }
 
-// MockStore_UpdateStationObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStationObservation'
-type MockStore_UpdateStationObservation_Call struct {
+// MockStore_UpsertStationMoObservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStationMoObservation'
+type MockStore_UpsertStationMoObservation_Call struct {
 	*mock.Call
 }
 
-// UpdateStationObservation is a helper method to define mock.On call
+// UpsertStationMoObservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateStationObservationParams
-func (_e *MockStore_Expecter) UpdateStationObservation(ctx interface{}, arg interface{}) *MockStore_UpdateStationObservation_Call {
-	return &MockStore_UpdateStationObservation_Call{Call: _e.mock.On("UpdateStationObservation", ctx, arg)}
+//   - arg db.UpsertStationMoObservationParams
+func (_e *MockStore_Expecter) UpsertStationMoObservation(ctx interface{}, arg interface{}) *MockStore_UpsertStationMoObservation_Call {
+	return &MockStore_UpsertStationMoObservation_Call{Call: _e.mock.On("UpsertStationMoObservation", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateStationObservation_Call) Run(run func(ctx context.Context, arg db.UpdateStationObservationParams)) *MockStore_UpdateStationObservation_Call {
+func (_c *MockStore_UpsertStationMoObservation_Call) Run(run func(ctx context.Context, arg db.UpsertStationMoObservationParams)) *MockStore_UpsertStationMoObservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateStationObservationParams))
+		run(args[0].(context.Context), args[1].(db.UpsertStationMoObservationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateStationObservation_Call) Return(_a0 db.ObservationsObservation, _a1 error) *MockStore_UpdateStationObservation_Call {
+func (_c *MockStore_UpsertStationMoObservation_Call) Return(_a0 db.ObservationsMoObservation, _a1 error) *MockStore_UpsertStationMoObservation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateStationObservation_Call) RunAndReturn(run func(context.Context, db.UpdateStationObservationParams) (db.ObservationsObservation, error)) *MockStore_UpdateStationObservation_Call {
+func (_c *MockStore_UpsertStationMoObservation_Call) RunAndReturn(run func(context.Context, db.UpsertStationMoObservationParams) (db.ObservationsMoObservation, error)) *MockStore_UpsertStationMoObservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+// UpsertStationUploadKey provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertStationUploadKey(ctx context.Context, arg db.UpsertStationUploadKeyParams) (db.ObservationsStationuploadkey, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 db.User
+	var r0 db.ObservationsStationuploadkey
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) (db.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationUploadKeyParams) (db.ObservationsStationuploadkey, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, db.UpdateUserParams) db.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, db.UpsertStationUploadKeyParams) db.ObservationsStationuploadkey); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.User)
+		r0 = ret.Get(0).(db.ObservationsStationuploadkey)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, db.UpdateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, db.UpsertStationUploadKeyParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3160,31 +9082,31 @@ func (_m *MockStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (d
 	return r0, r1
 }
 
-// MockStore_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type MockStore_UpdateUser_Call struct {
+// MockStore_UpsertStationUploadKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStationUploadKey'
+type MockStore_UpsertStationUploadKey_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// UpsertStationUploadKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.UpdateUserParams
-func (_e *MockStore_Expecter) UpdateUser(ctx interface{}, arg interface{}) *MockStore_UpdateUser_Call {
-	return &MockStore_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, arg)}
+//   - arg db.UpsertStationUploadKeyParams
+func (_e *MockStore_Expecter) UpsertStationUploadKey(ctx interface{}, arg interface{}) *MockStore_UpsertStationUploadKey_Call {
+	return &MockStore_UpsertStationUploadKey_Call{Call: _e.mock.On("UpsertStationUploadKey", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateUser_Call) Run(run func(ctx context.Context, arg db.UpdateUserParams)) *MockStore_UpdateUser_Call {
+func (_c *MockStore_UpsertStationUploadKey_Call) Run(run func(ctx context.Context, arg db.UpsertStationUploadKeyParams)) *MockStore_UpsertStationUploadKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(db.UpdateUserParams))
+		run(args[0].(context.Context), args[1].(db.UpsertStationUploadKeyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateUser_Call) Return(_a0 db.User, _a1 error) *MockStore_UpdateUser_Call {
+func (_c *MockStore_UpsertStationUploadKey_Call) Return(_a0 db.ObservationsStationuploadkey, _a1 error) *MockStore_UpsertStationUploadKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(context.Context, db.UpdateUserParams) (db.User, error)) *MockStore_UpdateUser_Call {
+func (_c *MockStore_UpsertStationUploadKey_Call) RunAndReturn(run func(context.Context, db.UpsertStationUploadKeyParams) (db.ObservationsStationuploadkey, error)) *MockStore_UpsertStationUploadKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
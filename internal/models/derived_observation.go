@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+)
+
+type DerivedObservation struct {
+	StationID     int64      `json:"station_id"`
+	Temp          *float32   `json:"temp"`
+	Rh            *float32   `json:"rh"`
+	Rain          *float32   `json:"rain"`
+	Wspd          *float32   `json:"wspd"`
+	Wdir          *float32   `json:"wdir"`
+	Gust          *float32   `json:"gust"`
+	Tn            *float32   `json:"tn"`
+	Tx            *float32   `json:"tx"`
+	DataCount     int32      `json:"data_count"`
+	Timestamp     time.Time  `json:"timestamp"`
+	GustTimestamp *time.Time `json:"gust_timestamp"`
+	TnTimestamp   *time.Time `json:"tn_timestamp"`
+	TxTimestamp   *time.Time `json:"tx_timestamp"`
+} //@name DerivedObservation
+
+type DerivedObs interface {
+	db.ObservationsDerivedhourly | db.ObservationsDeriveddaily
+}
+
+// NewDerivedObservation creates new DerivedObservation from an hourly or daily aggregate
+func NewDerivedObservation[T DerivedObs](obs T) DerivedObservation {
+	o := db.ObservationsDerivedhourly(obs)
+	res := DerivedObservation{
+		StationID: o.StationID,
+		DataCount: o.DataCount,
+	}
+
+	if o.Temp.Valid {
+		res.Temp = &o.Temp.Float32
+	}
+	if o.Rh.Valid {
+		res.Rh = &o.Rh.Float32
+	}
+	if o.Rain.Valid {
+		res.Rain = &o.Rain.Float32
+	}
+	if o.Wspd.Valid {
+		res.Wspd = &o.Wspd.Float32
+	}
+	if o.Wdir.Valid {
+		res.Wdir = &o.Wdir.Float32
+	}
+	if o.Gust.Valid {
+		res.Gust = &o.Gust.Float32
+	}
+	if o.Tn.Valid {
+		res.Tn = &o.Tn.Float32
+	}
+	if o.Tx.Valid {
+		res.Tx = &o.Tx.Float32
+	}
+
+	if o.Timestamp.Valid {
+		res.Timestamp = o.Timestamp.Time
+	}
+	if o.GustTimestamp.Valid {
+		res.GustTimestamp = &o.GustTimestamp.Time
+	}
+	if o.TnTimestamp.Valid {
+		res.TnTimestamp = &o.TnTimestamp.Time
+	}
+	if o.TxTimestamp.Valid {
+		res.TxTimestamp = &o.TxTimestamp.Time
+	}
+
+	return res
+}
@@ -0,0 +1,184 @@
+package models
+
+import (
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type BaseMoObs struct {
+	Pres      *float32  `json:"pres" fake:"{float32range:990,1100}"`
+	Rr        *float32  `json:"rr"`
+	Rh        *float32  `json:"rh"`
+	Temp      *float32  `json:"temp" fake:"{float32range:25,35}"`
+	Td        *float32  `json:"td"`
+	Wdir      *float32  `json:"wdir"`
+	Wdirx     *float32  `json:"wdirx"`
+	Wspd      *float32  `json:"wspd"`
+	Wspdx     *float32  `json:"wspdx"`
+	Wrun      *float32  `json:"wrun"`
+	Srad      *float32  `json:"srad"`
+	Sradx     *float32  `json:"sradx"`
+	Senergy   *float32  `json:"senergy"`
+	Hi        *float32  `json:"hi"`
+	Wchill    *float32  `json:"wchill"`
+	Thwi      *float32  `json:"thwi"`
+	Thswi     *float32  `json:"thswi"`
+	Rain      *float32  `json:"rain"`
+	Tx        *float32  `json:"tx"`
+	Tn        *float32  `json:"tn"`
+	Uvi       *float32  `json:"uvi"`
+	Uvdose    *float32  `json:"uvdose"`
+	Uvx       *float32  `json:"uvx"`
+	Hdd       *float32  `json:"hdd"`
+	Cdd       *float32  `json:"cdd"`
+	Et        *float32  `json:"et"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type MoObservation struct {
+	ID        int64 `json:"id" fake:"{number:1,1000}"`
+	StationID int64 `json:"station_id" fake:"{number:1,250}"`
+	QcLevel   int32 `json:"qc_level"`
+	BaseMoObs
+} //@name MoObservation
+
+func float4Ptr(v pgtype.Float4) *float32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float32
+}
+
+// NewMoObservation creates new MoObservation from db.ObservationsMoObservation
+func NewMoObservation(obs db.ObservationsMoObservation) MoObservation {
+	res := MoObservation{
+		ID:        obs.ID,
+		StationID: obs.StationID,
+		QcLevel:   obs.QcLevel,
+		BaseMoObs: BaseMoObs{
+			Pres:    float4Ptr(obs.Pres),
+			Rr:      float4Ptr(obs.Rr),
+			Rh:      float4Ptr(obs.Rh),
+			Temp:    float4Ptr(obs.Temp),
+			Td:      float4Ptr(obs.Td),
+			Wdir:    float4Ptr(obs.Wdir),
+			Wdirx:   float4Ptr(obs.Wdirx),
+			Wspd:    float4Ptr(obs.Wspd),
+			Wspdx:   float4Ptr(obs.Wspdx),
+			Wrun:    float4Ptr(obs.Wrun),
+			Srad:    float4Ptr(obs.Srad),
+			Sradx:   float4Ptr(obs.Sradx),
+			Senergy: float4Ptr(obs.Senergy),
+			Hi:      float4Ptr(obs.Hi),
+			Wchill:  float4Ptr(obs.Wchill),
+			Thwi:    float4Ptr(obs.Thwi),
+			Thswi:   float4Ptr(obs.Thswi),
+			Rain:    float4Ptr(obs.Rain),
+			Tx:      float4Ptr(obs.Tx),
+			Tn:      float4Ptr(obs.Tn),
+			Uvi:     float4Ptr(obs.Uvi),
+			Uvdose:  float4Ptr(obs.Uvdose),
+			Uvx:     float4Ptr(obs.Uvx),
+			Hdd:     float4Ptr(obs.Hdd),
+			Cdd:     float4Ptr(obs.Cdd),
+			Et:      float4Ptr(obs.Et),
+		},
+	}
+
+	if obs.Timestamp.Valid {
+		res.Timestamp = obs.Timestamp.Time
+	}
+
+	return res
+}
+
+type CreateMoObsReq struct {
+	StationID int64 `json:"station_id"`
+	QcLevel   int32 `json:"qc_level"`
+	BaseMoObs
+} //@name CreateMoObservationReq
+
+func (r CreateMoObsReq) Transform() db.CreateStationMoObservationParams {
+	return db.CreateStationMoObservationParams{
+		StationID: r.StationID,
+		QcLevel:   r.QcLevel,
+		Pres:      util.ToFloat4(r.Pres),
+		Rr:        util.ToFloat4(r.Rr),
+		Rh:        util.ToFloat4(r.Rh),
+		Temp:      util.ToFloat4(r.Temp),
+		Td:        util.ToFloat4(r.Td),
+		Wdir:      util.ToFloat4(r.Wdir),
+		Wdirx:     util.ToFloat4(r.Wdirx),
+		Wspd:      util.ToFloat4(r.Wspd),
+		Wspdx:     util.ToFloat4(r.Wspdx),
+		Wrun:      util.ToFloat4(r.Wrun),
+		Srad:      util.ToFloat4(r.Srad),
+		Sradx:     util.ToFloat4(r.Sradx),
+		Senergy:   util.ToFloat4(r.Senergy),
+		Hi:        util.ToFloat4(r.Hi),
+		Wchill:    util.ToFloat4(r.Wchill),
+		Thwi:      util.ToFloat4(r.Thwi),
+		Thswi:     util.ToFloat4(r.Thswi),
+		Rain:      util.ToFloat4(r.Rain),
+		Tx:        util.ToFloat4(r.Tx),
+		Tn:        util.ToFloat4(r.Tn),
+		Uvi:       util.ToFloat4(r.Uvi),
+		Uvdose:    util.ToFloat4(r.Uvdose),
+		Uvx:       util.ToFloat4(r.Uvx),
+		Hdd:       util.ToFloat4(r.Hdd),
+		Cdd:       util.ToFloat4(r.Cdd),
+		Et:        util.ToFloat4(r.Et),
+		Timestamp: pgtype.Timestamptz{
+			Time:  r.Timestamp,
+			Valid: !r.Timestamp.IsZero(),
+		},
+	}
+}
+
+type UpdateMoObsReq struct {
+	ID        int64  `json:"id"`
+	StationID int64  `json:"station_id"`
+	QcLevel   *int32 `json:"qc_level"`
+	BaseMoObs
+} //@name UpdateMoObservationParams
+
+func (r UpdateMoObsReq) Transform() db.UpdateStationMoObservationParams {
+	return db.UpdateStationMoObservationParams{
+		ID:        r.ID,
+		StationID: r.StationID,
+		QcLevel:   util.ToInt4(r.QcLevel),
+		Pres:      util.ToFloat4(r.Pres),
+		Rr:        util.ToFloat4(r.Rr),
+		Rh:        util.ToFloat4(r.Rh),
+		Temp:      util.ToFloat4(r.Temp),
+		Td:        util.ToFloat4(r.Td),
+		Wdir:      util.ToFloat4(r.Wdir),
+		Wdirx:     util.ToFloat4(r.Wdirx),
+		Wspd:      util.ToFloat4(r.Wspd),
+		Wspdx:     util.ToFloat4(r.Wspdx),
+		Wrun:      util.ToFloat4(r.Wrun),
+		Srad:      util.ToFloat4(r.Srad),
+		Sradx:     util.ToFloat4(r.Sradx),
+		Senergy:   util.ToFloat4(r.Senergy),
+		Hi:        util.ToFloat4(r.Hi),
+		Wchill:    util.ToFloat4(r.Wchill),
+		Thwi:      util.ToFloat4(r.Thwi),
+		Thswi:     util.ToFloat4(r.Thswi),
+		Rain:      util.ToFloat4(r.Rain),
+		Tx:        util.ToFloat4(r.Tx),
+		Tn:        util.ToFloat4(r.Tn),
+		Uvi:       util.ToFloat4(r.Uvi),
+		Uvdose:    util.ToFloat4(r.Uvdose),
+		Uvx:       util.ToFloat4(r.Uvx),
+		Hdd:       util.ToFloat4(r.Hdd),
+		Cdd:       util.ToFloat4(r.Cdd),
+		Et:        util.ToFloat4(r.Et),
+		Timestamp: pgtype.Timestamptz{
+			Time:  r.Timestamp,
+			Valid: !r.Timestamp.IsZero(),
+		},
+	}
+}
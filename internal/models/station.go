@@ -19,6 +19,7 @@ type BaseStation struct {
 	Province      util.Province `json:"province"`
 	Region        util.Region   `json:"region"`
 	Address       string        `json:"address"`
+	Timezone      string        `json:"timezone,omitempty" binding:"omitempty,timezone" fake:"{timezoneregion}"`
 }
 
 type Station struct {
@@ -62,6 +63,7 @@ func NewStation(station db.ObservationsStation, simple bool) Station {
 		if station.Status.Valid {
 			res.DateInstalled = util.Date{Time: station.DateInstalled.Time}
 		}
+		res.Timezone = station.Timezone
 	}
 	if station.Province.Valid {
 		res.Province = util.Province(station.Province.String)
@@ -121,6 +123,7 @@ func transformStation[T StationParams](req BaseStation, extraParams T) T {
 		Province:     util.ToPgText(string(req.Province)),
 		Region:       util.ToPgText(string(req.Region)),
 		Address:      util.ToPgText(req.Address),
+		Timezone:     util.ToPgText(req.Timezone),
 	}
 
 	switch v := any(extraParams).(type) {
@@ -143,6 +146,7 @@ func transformStation[T StationParams](req BaseStation, extraParams T) T {
 			Province:      arg.Province,
 			Region:        arg.Region,
 			Address:       arg.Address,
+			Timezone:      arg.Timezone,
 		}).(T)
 	default:
 		panic("Unsupported type")
@@ -0,0 +1,105 @@
+// Package mqtt receives the messages stations publish to an MQTT broker,
+// through the Eclipse Paho client, and stores them with a Listener.
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	defaultKeepAlive = 30 * time.Second
+	// disconnectQuiesce is how long a disconnecting client waits for its
+	// pending packets, in milliseconds.
+	disconnectQuiesce = 250
+)
+
+// Options configure the connection to the broker.
+type Options struct {
+	// Broker address, e.g. "tcp://localhost:1883" or "ssl://broker:8883".
+	Broker   string
+	ClientID string
+	Username string
+	Password string
+	// CleanSession discards the session of ClientID kept by the broker.
+	// Leave it false for the broker to keep the QoS 1 messages published
+	// while the client is disconnected, or not yet acknowledged.
+	CleanSession bool
+	// KeepAlive is the interval of the pings to the broker. Defaults to 30s.
+	KeepAlive time.Duration
+	// TLSConfig of "ssl://" and "tls://" brokers.
+	TLSConfig *tls.Config
+}
+
+// clientOptions returns the options of a client that neither reconnects nor
+// acknowledges the messages on its own, and handles them one at a time, in
+// the order they are received.
+func (opts Options) clientOptions() *paho.ClientOptions {
+	keepAlive := opts.KeepAlive
+	if keepAlive <= 0 {
+		keepAlive = defaultKeepAlive
+	}
+
+	return paho.NewClientOptions().
+		AddBroker(opts.Broker).
+		SetClientID(opts.ClientID).
+		SetUsername(opts.Username).
+		SetPassword(opts.Password).
+		SetCleanSession(opts.CleanSession).
+		SetKeepAlive(keepAlive).
+		SetTLSConfig(opts.TLSConfig).
+		SetAutoReconnect(false).
+		SetConnectRetry(false).
+		SetAutoAckDisabled(true).
+		SetOrderMatters(true)
+}
+
+// Message is a message received from the broker.
+type Message struct {
+	Topic     string
+	Payload   []byte
+	QoS       byte
+	PacketID  uint16
+	Duplicate bool
+}
+
+func newMessage(m paho.Message) Message {
+	return Message{
+		Topic:     m.Topic(),
+		Payload:   m.Payload(),
+		QoS:       m.Qos(),
+		PacketID:  m.MessageID(),
+		Duplicate: m.Duplicate(),
+	}
+}
+
+// wait waits for the operation of t to complete, or for ctx to be done.
+func wait(ctx context.Context, t paho.Token) error {
+	select {
+	case <-t.Done():
+		return t.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// subscribe subscribes to topic filters, each with its maximum QoS, and waits
+// for the broker to grant them.
+func subscribe(ctx context.Context, c paho.Client, filters map[string]byte) error {
+	t := c.SubscribeMultiple(filters, nil)
+	if err := wait(ctx, t); err != nil {
+		return err
+	}
+	if st, ok := t.(*paho.SubscribeToken); ok {
+		for _, code := range st.Result() {
+			if code == 0x80 {
+				return errors.New("mqtt subscription refused")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package mqtt
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/stretchr/testify/require"
+)
+
+// testRefusedTopic is the topic filter the testBroker refuses.
+const testRefusedTopic = "refused/+"
+
+// testBroker is an in-process stand-in of an MQTT broker, serving one client
+// connection at a time.
+type testBroker struct {
+	t  *testing.T
+	ln net.Listener
+	// connackCode answers the CONNECT packets.
+	connackCode byte
+	// queued are sent to the client before the first SUBACK, as by a broker
+	// resuming a session.
+	queued []Message
+
+	connects      chan *packets.ConnectPacket
+	subscriptions chan []string
+	pubacks       chan uint16
+	disconnects   chan struct{}
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	b := &testBroker{
+		t:             t,
+		ln:            ln,
+		connects:      make(chan *packets.ConnectPacket, 10),
+		subscriptions: make(chan []string, 10),
+		pubacks:       make(chan uint16, 100),
+		disconnects:   make(chan struct{}, 10),
+	}
+	t.Cleanup(func() { ln.Close() })
+	go b.serve()
+	return b
+}
+
+func (b *testBroker) url() string {
+	return "tcp://" + b.ln.Addr().String()
+}
+
+func (b *testBroker) serve() {
+	for {
+		conn, err := b.ln.Accept()
+		if err != nil {
+			return
+		}
+		b.mu.Lock()
+		b.conn = conn
+		b.mu.Unlock()
+		b.serveConn(conn)
+	}
+}
+
+func (b *testBroker) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		p, err := packets.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+
+		switch p := p.(type) {
+		case *packets.ConnectPacket:
+			b.connects <- p
+			ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+			ack.ReturnCode = b.connackCode
+			b.write(ack)
+			if b.connackCode != packets.Accepted {
+				return
+			}
+		case *packets.SubscribePacket:
+			for _, msg := range b.queued {
+				b.publish(msg)
+			}
+			b.queued = nil
+			ack := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+			ack.MessageID = p.MessageID
+			for _, topic := range p.Topics {
+				code := byte(1)
+				if topic == testRefusedTopic {
+					code = 0x80
+				}
+				ack.ReturnCodes = append(ack.ReturnCodes, code)
+			}
+			b.write(ack)
+			b.subscriptions <- p.Topics
+		case *packets.PubackPacket:
+			b.pubacks <- p.MessageID
+		case *packets.PingreqPacket:
+			b.write(packets.NewControlPacket(packets.Pingresp))
+		case *packets.DisconnectPacket:
+			b.disconnects <- struct{}{}
+			return
+		}
+	}
+}
+
+func (b *testBroker) write(p packets.ControlPacket) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	require.NoError(b.t, p.Write(b.conn))
+}
+
+// publish sends msg to the connected client.
+func (b *testBroker) publish(msg Message) {
+	p := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+	p.TopicName = msg.Topic
+	p.Payload = msg.Payload
+	p.Qos = msg.QoS
+	p.MessageID = msg.PacketID
+	p.Dup = msg.Duplicate
+	b.write(p)
+}
+
+// closeConn drops the connection of the client.
+func (b *testBroker) closeConn() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.conn.Close()
+}
+
+func requireReceive[T any](t *testing.T, ch <-chan T) T {
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out")
+		panic("unreachable")
+	}
+}
+
+func requireNothing[T any](t *testing.T, ch <-chan T) {
+	select {
+	case v := <-ch:
+		require.FailNow(t, "unexpected", "%v", v)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	broker := newTestBroker(t)
+	ctx := context.Background()
+
+	opts := Options{Broker: broker.url(), ClientID: "test", Username: "user", Password: "secret"}
+	c := paho.NewClient(opts.clientOptions())
+	require.NoError(t, wait(ctx, c.Connect()))
+	defer c.Disconnect(0)
+
+	connect := requireReceive(t, broker.connects)
+	require.Equal(t, byte(4), connect.ProtocolVersion)
+	require.Equal(t, "test", connect.ClientIdentifier)
+	require.Equal(t, "user", connect.Username)
+	require.Equal(t, []byte("secret"), connect.Password)
+	require.False(t, connect.CleanSession)
+
+	require.NoError(t, subscribe(ctx, c, map[string]byte{"a/+": 1}))
+	require.Equal(t, []string{"a/+"}, requireReceive(t, broker.subscriptions))
+
+	require.EqualError(t, subscribe(ctx, c, map[string]byte{testRefusedTopic: 1}), "mqtt subscription refused")
+}
@@ -0,0 +1,370 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/emiliogozo/panahon-api-go/internal/alert"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/qc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultTopicPrefix is the first level of the station topics.
+	DefaultTopicPrefix = "panahon"
+
+	// TopicObservation is the last level of the topic of observations.
+	TopicObservation = "obs"
+	// TopicHealth is the last level of the topic of health records.
+	TopicHealth = "health"
+
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+// RawMessageProvider is the provider of the messages archived in
+// raw_messages, with their topic as recipient.
+const RawMessageProvider = "MQTT"
+
+// Listener stores the messages stations publish to an MQTT broker:
+// observations under <prefix>/<station_id>/obs, as a json
+// sensor.StationObservation, and health records under
+// <prefix>/<station_id>/health, as a json sensor.StationHealth.
+//
+// Messages are subscribed to with QoS 1 and acknowledged once stored, or once
+// rejected for good, e.g. when malformed or already stored. A message that
+// cannot be stored, e.g. while the database is down, drops the connection,
+// and is delivered again once reconnected.
+type Listener struct {
+	opts        Options
+	prefix      string
+	store       db.Store
+	qcChecker   *qc.Checker
+	alertEngine *alert.Engine
+	logger      *zerolog.Logger
+}
+
+// ErrNoClientID is returned by NewListener when opts.ClientID is empty.
+var ErrNoClientID = errors.New("mqtt client id required")
+
+// NewListener creates a new Listener. An empty prefix means
+// DefaultTopicPrefix. opts.ClientID is required: the broker keeps a session,
+// with the messages not yet acknowledged, per client identifier, and
+// disconnects a client when another one connects with the same identifier.
+// Each instance needs its own, kept across restarts.
+func NewListener(opts Options, prefix string, store db.Store, logger *zerolog.Logger) (*Listener, error) {
+	if len(opts.ClientID) == 0 {
+		return nil, ErrNoClientID
+	}
+	if len(prefix) == 0 {
+		prefix = DefaultTopicPrefix
+	}
+
+	return &Listener{
+		opts:        opts,
+		prefix:      strings.TrimSuffix(prefix, "/"),
+		store:       store,
+		qcChecker:   qc.NewDefaultChecker(),
+		alertEngine: alert.NewDefaultEngine(),
+		logger:      logger,
+	}, nil
+}
+
+// Start runs the listener in g until ctx is done. Connection failures are
+// logged and retried, so they do not stop the group.
+func (l *Listener) Start(ctx context.Context, g *errgroup.Group) {
+	g.Go(func() error {
+		l.logger.Info().Msgf("starting mqtt listener: %s", l.opts.Broker)
+		l.Run(ctx)
+		l.logger.Info().Msg("mqtt listener stopped")
+		return nil
+	})
+}
+
+// Run connects to the broker and handles the messages until ctx is done,
+// reconnecting with an increasing delay when the connection fails.
+func (l *Listener) Run(ctx context.Context) {
+	delay := minReconnectDelay
+	for {
+		connected, err := l.session(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			delay = minReconnectDelay
+		}
+
+		l.logger.Error().Err(err).
+			Str("broker", l.opts.Broker).
+			Msgf("[MQTT] Connection failed, retrying in %s", delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// session connects to the broker and handles the messages until the
+// connection is lost, a message cannot be stored, or ctx is done.
+func (l *Listener) session(ctx context.Context) (connected bool, err error) {
+	ended := make(chan error, 1)
+	end := func(err error) {
+		select {
+		case ended <- err:
+		default:
+		}
+	}
+
+	// Messages are stored through shutdown, and acknowledged. mu is held while
+	// a message is at hand.
+	handleCtx := context.WithoutCancel(ctx)
+	var mu sync.Mutex
+	stopped := false
+	receive := func(_ paho.Client, m paho.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if stopped {
+			// Left to the broker to deliver again.
+			return
+		}
+		msg := newMessage(m)
+		if err := l.handle(handleCtx, msg); err != nil && msg.QoS > 0 {
+			// Later messages are not acknowledged either, as the broker
+			// expects the acknowledgements in order.
+			stopped = true
+			end(fmt.Errorf("mqtt message not handled: %w", err))
+			return
+		}
+		m.Ack()
+	}
+
+	opts := l.opts.clientOptions().
+		SetDefaultPublishHandler(receive).
+		SetConnectionLostHandler(func(_ paho.Client, err error) { end(err) })
+	c := paho.NewClient(opts)
+	if err := wait(ctx, c.Connect()); err != nil {
+		c.Disconnect(0)
+		return false, err
+	}
+	defer c.Disconnect(disconnectQuiesce)
+
+	err = subscribe(ctx, c, map[string]byte{
+		l.prefix + "/+/" + TopicObservation: 1,
+		l.prefix + "/+/" + TopicHealth:      1,
+	})
+	if err != nil {
+		return false, err
+	}
+	l.logger.Info().Str("broker", l.opts.Broker).Msg("[MQTT] Subscribed")
+
+	select {
+	case err = <-ended:
+	case <-ctx.Done():
+	}
+
+	// Waits for the message at hand.
+	mu.Lock()
+	stopped = true
+	mu.Unlock()
+	return true, err
+}
+
+// handle archives and stores a message, and records the outcome. It returns
+// an error only when the message should be delivered again.
+func (l *Listener) handle(ctx context.Context, msg Message) error {
+	raw, err := l.store.CreateRawMessage(ctx, db.CreateRawMessageParams{
+		Provider:  RawMessageProvider,
+		Recipient: util.ToPgText(msg.Topic),
+		Body:      string(msg.Payload),
+		Status:    service.RawMessageStatusReceived,
+	})
+	if err != nil {
+		// Archiving never keeps a message from being stored.
+		l.logger.Error().Err(err).Str("topic", msg.Topic).Msg("[RawMessage] Cannot archive message")
+	}
+
+	res, err := l.route(ctx, msg)
+	if raw.ID > 0 {
+		arg := db.UpdateRawMessageOutcomeParams{
+			ID:     raw.ID,
+			Status: res.status,
+		}
+		if res.stationID > 0 {
+			arg.StationID = pgtype.Int8{Int64: res.stationID, Valid: true}
+		}
+		if res.err != nil {
+			arg.Error = util.ToPgText(res.err.Error())
+		}
+		if _, err := l.store.UpdateRawMessageOutcome(ctx, arg); err != nil {
+			l.logger.Error().Err(err).Int64("id", raw.ID).Str("status", res.status).Msg("[RawMessage] Cannot record outcome")
+		}
+	}
+	return err
+}
+
+// outcome is how a message was handled, as recorded with its archive.
+// stationID is zero if the station is unknown.
+type outcome struct {
+	status    string
+	stationID int64
+	err       error
+}
+
+var (
+	errUnknownTopic     = errors.New("unknown topic")
+	errInvalidStationID = errors.New("invalid station id")
+	errNoTimestamp      = errors.New("missing timestamp")
+	errStationNotFound  = errors.New("station not found")
+)
+
+// route stores a message according to its topic.
+func (l *Listener) route(ctx context.Context, msg Message) (outcome, error) {
+	levels := strings.Split(strings.TrimPrefix(msg.Topic, l.prefix+"/"), "/")
+	if len(levels) != 2 || !strings.HasPrefix(msg.Topic, l.prefix+"/") {
+		l.logger.Warn().Str("topic", msg.Topic).Msg("[MQTT] Unknown topic")
+		return outcome{status: service.RawMessageStatusRejected, err: errUnknownTopic}, nil
+	}
+	stationID, err := strconv.ParseInt(levels[0], 10, 64)
+	if err != nil || stationID < 1 {
+		l.logger.Warn().Str("topic", msg.Topic).Msg("[MQTT] Invalid station id")
+		return outcome{status: service.RawMessageStatusRejected, err: errInvalidStationID}, nil
+	}
+
+	switch levels[1] {
+	case TopicObservation:
+		return l.storeObservation(ctx, stationID, msg)
+	case TopicHealth:
+		return l.storeHealth(ctx, stationID, msg)
+	default:
+		l.logger.Warn().Str("topic", msg.Topic).Msg("[MQTT] Unknown topic")
+		return outcome{status: service.RawMessageStatusRejected, err: errUnknownTopic}, nil
+	}
+}
+
+func (l *Listener) storeObservation(ctx context.Context, stationID int64, msg Message) (outcome, error) {
+	var obs sensor.StationObservation
+	if err := json.Unmarshal(msg.Payload, &obs); err != nil {
+		l.logger.Warn().Err(err).Str("topic", msg.Topic).Msg("[MQTT] Invalid observation")
+		return outcome{status: service.RawMessageStatusRejected, err: err}, nil
+	}
+	if obs.Timestamp.IsZero() {
+		l.logger.Warn().Str("topic", msg.Topic).Msg("[MQTT] Observation without timestamp")
+		return outcome{status: service.RawMessageStatusRejected, err: errNoTimestamp}, nil
+	}
+
+	res, err := l.store.CreateStationObservationIfNotExists(ctx, db.CreateStationObservationIfNotExistsParams{
+		StationID: stationID,
+		Pres:      util.ToFloat4(obs.Pres),
+		Rr:        util.ToFloat4(obs.Rr),
+		Rh:        util.ToFloat4(obs.Rh),
+		Temp:      util.ToFloat4(obs.Temp),
+		Td:        util.ToFloat4(obs.Td),
+		Wdir:      util.ToFloat4(obs.Wdir),
+		Wspd:      util.ToFloat4(obs.Wspd),
+		Wspdx:     util.ToFloat4(obs.Wspdx),
+		Srad:      util.ToFloat4(obs.Srad),
+		Mslp:      util.ToFloat4(obs.Mslp),
+		Hi:        util.ToFloat4(obs.Hi),
+		Wchill:    util.ToFloat4(obs.Wchill),
+		Timestamp: pgtype.Timestamptz{
+			Time:  obs.Timestamp,
+			Valid: true,
+		},
+	})
+	if err != nil || res.ID == 0 {
+		return l.checkStoreErr(err, stationID, msg, "observation")
+	}
+
+	if _, err := l.qcChecker.Apply(ctx, l.store, res); err != nil {
+		l.logger.Error().Err(err).
+			Int64("observation_id", res.ID).
+			Msg("[QC] Cannot apply quality control")
+	}
+	l.logger.Debug().Str("topic", msg.Topic).Msg("[MQTT] Observation saved")
+	return outcome{status: service.RawMessageStatusStored, stationID: stationID}, nil
+}
+
+func (l *Listener) storeHealth(ctx context.Context, stationID int64, msg Message) (outcome, error) {
+	var health sensor.StationHealth
+	if err := json.Unmarshal(msg.Payload, &health); err != nil {
+		l.logger.Warn().Err(err).Str("topic", msg.Topic).Msg("[MQTT] Invalid health")
+		return outcome{status: service.RawMessageStatusRejected, err: err}, nil
+	}
+	if health.Timestamp.IsZero() {
+		l.logger.Warn().Str("topic", msg.Topic).Msg("[MQTT] Health without timestamp")
+		return outcome{status: service.RawMessageStatusRejected, err: errNoTimestamp}, nil
+	}
+
+	res, err := l.store.CreateStationHealthIfNotExists(ctx, db.CreateStationHealthIfNotExistsParams{
+		StationID:         stationID,
+		Vb1:               util.ToFloat4(health.Vb1),
+		Vb2:               util.ToFloat4(health.Vb2),
+		Curr:              util.ToFloat4(health.Curr),
+		Bp1:               util.ToFloat4(health.Bp1),
+		Bp2:               util.ToFloat4(health.Bp2),
+		Cm:                util.ToPgText(health.Cm),
+		Ss:                util.ToInt4(health.Ss),
+		TempArq:           util.ToFloat4(health.TempArq),
+		RhArq:             util.ToFloat4(health.RhArq),
+		Fpm:               util.ToPgText(health.Fpm),
+		MinutesDifference: util.ToInt4(&health.MinutesDifference),
+		DataCount:         util.ToInt4(&health.DataCount),
+		DataStatus:        util.ToPgText(health.DataStatus),
+		Timestamp: pgtype.Timestamptz{
+			Time:  health.Timestamp,
+			Valid: true,
+		},
+		Message:  util.ToPgText(health.Message),
+		ErrorMsg: util.ToPgText(health.ErrorMsg),
+	})
+	if err != nil || res.ID == 0 {
+		return l.checkStoreErr(err, stationID, msg, "health")
+	}
+
+	// Alerts are recorded; texting them is left to the SMS handlers.
+	alerts, err := l.alertEngine.Apply(ctx, l.store, res)
+	if err != nil {
+		l.logger.Error().Err(err).Int64("station_id", stationID).Msg("[Alert] Cannot apply health alert rules")
+	}
+	for _, a := range alerts {
+		l.logger.Info().
+			Int64("station_id", a.StationID).
+			Str("rule", a.Rule).
+			Str("status", a.Status).
+			Msg("[Alert] " + a.Message)
+	}
+	l.logger.Debug().Str("topic", msg.Topic).Msg("[MQTT] Health saved")
+	return outcome{status: service.RawMessageStatusStored, stationID: stationID}, nil
+}
+
+// checkStoreErr logs why a message was not stored, and returns the error
+// when storing the message again may succeed.
+func (l *Listener) checkStoreErr(err error, stationID int64, msg Message, kind string) (outcome, error) {
+	switch {
+	case err == nil || errors.Is(err, db.ErrRecordNotFound):
+		l.logger.Debug().Str("topic", msg.Topic).Msgf("[MQTT] Station %s already stored", kind)
+		return outcome{status: service.RawMessageStatusDuplicate, stationID: stationID}, nil
+	case db.ErrorCode(err) == db.ForeignKeyViolation:
+		l.logger.Warn().Str("topic", msg.Topic).Msg("[MQTT] Station not found")
+		return outcome{status: service.RawMessageStatusRejected, err: errStationNotFound}, nil
+	default:
+		l.logger.Error().Err(err).Str("topic", msg.Topic).Msgf("[MQTT] Cannot store station %s", kind)
+		return outcome{status: service.RawMessageStatusFailed, stationID: stationID, err: err}, err
+	}
+}
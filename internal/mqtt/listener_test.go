@@ -0,0 +1,288 @@
+package mqtt
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestListener(t *testing.T, broker string, store db.Store) *Listener {
+	logger := zerolog.Nop()
+	l, err := NewListener(Options{Broker: broker, ClientID: "test", KeepAlive: time.Second}, "", store, &logger)
+	require.NoError(t, err)
+	return l
+}
+
+func stubQc(store *mockdb.MockStore, obs db.ObservationsObservation) {
+	store.EXPECT().ListPreviousStationObservations(mock.Anything, mock.AnythingOfType("db.ListPreviousStationObservationsParams")).
+		Return([]db.ObservationsObservation{}, nil)
+	store.EXPECT().UpdateObservationQcTx(mock.Anything, mock.AnythingOfType("db.UpdateObservationQcTxParams")).
+		Return(db.UpdateObservationQcTxResult{Observation: obs}, nil)
+}
+
+// stubArchive expects a message on topic to be archived with the outcome
+// status.
+func stubArchive(store *mockdb.MockStore, topic, status string) {
+	raw := db.RawMessage{ID: 1, Provider: RawMessageProvider}
+	store.EXPECT().CreateRawMessage(mock.Anything, mock.MatchedBy(func(arg db.CreateRawMessageParams) bool {
+		return arg.Provider == RawMessageProvider && arg.Recipient.String == topic && len(arg.Body) > 0
+	})).
+		Return(raw, nil)
+	store.EXPECT().UpdateRawMessageOutcome(mock.Anything, mock.MatchedBy(func(arg db.UpdateRawMessageOutcomeParams) bool {
+		return arg.ID == raw.ID && arg.Status == status
+	})).
+		Return(raw, nil)
+}
+
+func TestNewListener(t *testing.T) {
+	logger := zerolog.Nop()
+	_, err := NewListener(Options{Broker: "tcp://localhost:1883"}, "", nil, &logger)
+	require.ErrorIs(t, err, ErrNoClientID)
+}
+
+func TestListenerHandle(t *testing.T) {
+	timestamp := time.Now().Add(-time.Hour).Truncate(time.Second)
+	obsPayload := `{"temp": 27.5, "rh": 80, "timestamp": "` + timestamp.Format(time.RFC3339) + `"}`
+	healthPayload := `{"vb1": 12.8, "ss": 21, "fpm": "FW3.2", "timestamp": "` + timestamp.Format(time.RFC3339) + `"}`
+	obs := db.ObservationsObservation{ID: 1, StationID: 12}
+
+	testCases := []struct {
+		name       string
+		msg        Message
+		outcome    string
+		buildStubs func(store *mockdb.MockStore)
+		wantErr    bool
+	}{
+		{
+			name:    "Observation",
+			msg:     Message{Topic: "panahon/12/obs", Payload: []byte(obsPayload), QoS: 1},
+			outcome: service.RawMessageStatusStored,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationObservationIfNotExists(mock.Anything, mock.MatchedBy(func(arg db.CreateStationObservationIfNotExistsParams) bool {
+					return arg.StationID == 12 && arg.Temp.Float32 == 27.5 && arg.Rh.Valid && !arg.Pres.Valid &&
+						arg.Timestamp.Time.Equal(timestamp)
+				})).
+					Return(obs, nil)
+				stubQc(store, obs)
+			},
+		},
+		{
+			name:    "Health",
+			msg:     Message{Topic: "panahon/12/health", Payload: []byte(healthPayload), QoS: 1},
+			outcome: service.RawMessageStatusStored,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationHealthIfNotExists(mock.Anything, mock.MatchedBy(func(arg db.CreateStationHealthIfNotExistsParams) bool {
+					return arg.StationID == 12 && arg.Vb1.Valid && arg.Ss.Int32 == 21 && arg.Fpm.String == "FW3.2"
+				})).
+					Return(db.ObservationsStationhealth{ID: 1, StationID: 12}, nil)
+				store.EXPECT().ListActiveStationHealthAlerts(mock.Anything, int64(12)).
+					Return([]db.ObservationsStationhealthAlert{}, nil)
+				store.EXPECT().CreateStationHealthAlert(mock.Anything, mock.Anything).
+					Return(db.ObservationsStationhealthAlert{}, nil).
+					Maybe()
+			},
+		},
+		{
+			name:    "Duplicate",
+			msg:     Message{Topic: "panahon/12/obs", Payload: []byte(obsPayload), QoS: 1},
+			outcome: service.RawMessageStatusDuplicate,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationObservationIfNotExists(mock.Anything, mock.Anything).
+					Return(db.ObservationsObservation{}, db.ErrRecordNotFound)
+			},
+		},
+		{
+			name:    "StationNotFound",
+			msg:     Message{Topic: "panahon/12/health", Payload: []byte(healthPayload), QoS: 1},
+			outcome: service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationHealthIfNotExists(mock.Anything, mock.Anything).
+					Return(db.ObservationsStationhealth{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+		},
+		{
+			name:    "StoreError",
+			msg:     Message{Topic: "panahon/12/obs", Payload: []byte(obsPayload), QoS: 1},
+			outcome: service.RawMessageStatusFailed,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateStationObservationIfNotExists(mock.Anything, mock.Anything).
+					Return(db.ObservationsObservation{}, sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "InvalidPayload",
+			msg:        Message{Topic: "panahon/12/obs", Payload: []byte(`{"temp": "hot"}`), QoS: 1},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+		},
+		{
+			name:       "MissingTimestamp",
+			msg:        Message{Topic: "panahon/12/health", Payload: []byte(`{"vb1": 12.8}`), QoS: 1},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+		},
+		{
+			name:       "InvalidStationID",
+			msg:        Message{Topic: "panahon/abc/obs", Payload: []byte(obsPayload), QoS: 1},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+		},
+		{
+			name:       "UnknownTopic",
+			msg:        Message{Topic: "panahon/12/status", Payload: []byte(obsPayload), QoS: 1},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+		},
+		{
+			name:       "OtherPrefix",
+			msg:        Message{Topic: "other/12/obs", Payload: []byte(obsPayload), QoS: 1},
+			outcome:    service.RawMessageStatusRejected,
+			buildStubs: func(store *mockdb.MockStore) {},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			stubArchive(store, tc.msg.Topic, tc.outcome)
+			tc.buildStubs(store)
+
+			err := newTestListener(t, "", store).handle(context.Background(), tc.msg)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListenerRun(t *testing.T) {
+	broker := newTestBroker(t)
+	timestamp := time.Now().Truncate(time.Second)
+	payload := []byte(`{"temp": 27.5, "timestamp": "` + timestamp.Format(time.RFC3339) + `"}`)
+	broker.queued = []Message{{Topic: "panahon/12/obs", Payload: payload, QoS: 1, PacketID: 100}}
+
+	store := mockdb.NewMockStore(t)
+	obs := db.ObservationsObservation{ID: 1, StationID: 12}
+	store.EXPECT().CreateStationObservationIfNotExists(mock.Anything, mock.Anything).
+		Return(obs, nil).
+		Twice()
+	store.EXPECT().CreateStationObservationIfNotExists(mock.Anything, mock.Anything).
+		Return(db.ObservationsObservation{}, sql.ErrConnDone).
+		Once()
+	stubQc(store, obs)
+	store.EXPECT().CreateRawMessage(mock.Anything, mock.Anything).
+		Return(db.RawMessage{ID: 1}, nil)
+	store.EXPECT().UpdateRawMessageOutcome(mock.Anything, mock.Anything).
+		Return(db.RawMessage{ID: 1}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		newTestListener(t, broker.url(), store).Run(ctx)
+		close(stopped)
+	}()
+
+	require.Equal(t, "test", requireReceive(t, broker.connects).ClientIdentifier)
+	require.ElementsMatch(t, []string{"panahon/+/obs", "panahon/+/health"}, requireReceive(t, broker.subscriptions))
+	// Queued by the broker while disconnected.
+	require.Equal(t, uint16(100), requireReceive(t, broker.pubacks))
+
+	broker.publish(Message{Topic: "panahon/12/obs", Payload: payload, QoS: 1, PacketID: 1})
+	require.Equal(t, uint16(1), requireReceive(t, broker.pubacks))
+
+	// Left to the broker to deliver again, once reconnected.
+	broker.publish(Message{Topic: "panahon/12/obs", Payload: payload, QoS: 1, PacketID: 2})
+	requireReceive(t, broker.disconnects)
+	requireReceive(t, broker.subscriptions)
+	requireNothing(t, broker.pubacks)
+
+	cancel()
+	requireReceive(t, broker.disconnects)
+	requireReceive(t, stopped)
+}
+
+func TestListenerShutdown(t *testing.T) {
+	broker := newTestBroker(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handling := make(chan struct{})
+	store := mockdb.NewMockStore(t)
+	obs := db.ObservationsObservation{ID: 1, StationID: 12}
+	store.EXPECT().CreateStationObservationIfNotExists(mock.Anything, mock.Anything).
+		RunAndReturn(func(hctx context.Context, _ db.CreateStationObservationIfNotExistsParams) (db.ObservationsObservation, error) {
+			close(handling)
+			<-ctx.Done()
+			// The message at hand is stored through shutdown.
+			return obs, hctx.Err()
+		})
+	stubQc(store, obs)
+	stubArchive(store, "panahon/12/obs", service.RawMessageStatusStored)
+
+	stopped := make(chan struct{})
+	go func() {
+		newTestListener(t, broker.url(), store).Run(ctx)
+		close(stopped)
+	}()
+	requireReceive(t, broker.subscriptions)
+
+	payload := []byte(`{"temp": 27.5, "timestamp": "` + time.Now().Format(time.RFC3339) + `"}`)
+	broker.publish(Message{Topic: "panahon/12/obs", Payload: payload, QoS: 1, PacketID: 9})
+	requireReceive(t, handling)
+	cancel()
+
+	require.Equal(t, uint16(9), requireReceive(t, broker.pubacks))
+	requireReceive(t, broker.disconnects)
+	requireReceive(t, stopped)
+}
+
+func TestListenerSession(t *testing.T) {
+	t.Run("ConnectionLost", func(t *testing.T) {
+		broker := newTestBroker(t)
+		l := newTestListener(t, broker.url(), mockdb.NewMockStore(t))
+
+		type result struct {
+			connected bool
+			err       error
+		}
+		ended := make(chan result, 1)
+		go func() {
+			connected, err := l.session(context.Background())
+			ended <- result{connected, err}
+		}()
+
+		requireReceive(t, broker.subscriptions)
+		broker.closeConn()
+		res := requireReceive(t, ended)
+		require.True(t, res.connected)
+		require.Error(t, res.err)
+	})
+
+	t.Run("ConnectionRefused", func(t *testing.T) {
+		broker := newTestBroker(t)
+		broker.connackCode = packets.ErrRefusedNotAuthorised
+		l := newTestListener(t, broker.url(), mockdb.NewMockStore(t))
+
+		connected, err := l.session(context.Background())
+		require.False(t, connected)
+		require.ErrorIs(t, err, packets.ErrorRefusedNotAuthorised)
+	})
+}
@@ -0,0 +1,212 @@
+package qc
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Limit is an inclusive range of plausible values.
+type Limit struct {
+	Min float32
+	Max float32
+}
+
+// DefaultLimits are climatological limits for stations in the Philippines.
+var DefaultLimits = map[Variable]Limit{
+	Pres:   {Min: 500, Max: 1100},
+	Mslp:   {Min: 870, Max: 1090},
+	Rr:     {Min: 0, Max: 500},
+	Rh:     {Min: 0, Max: 100},
+	Temp:   {Min: 5, Max: 45},
+	Td:     {Min: -5, Max: 35},
+	Wdir:   {Min: 0, Max: 360},
+	Wspd:   {Min: 0, Max: 75},
+	Wspdx:  {Min: 0, Max: 100},
+	Srad:   {Min: 0, Max: 1600},
+	Hi:     {Min: 5, Max: 80},
+	Wchill: {Min: 0, Max: 45},
+}
+
+// DefaultMaxSteps are the largest changes allowed between consecutive readings.
+var DefaultMaxSteps = map[Variable]float32{
+	Pres: 5,
+	Mslp: 5,
+	Rh:   30,
+	Temp: 6,
+	Td:   6,
+}
+
+// DefaultPersistenceVariables are checked for stuck sensors.
+var DefaultPersistenceVariables = []Variable{Pres, Rh, Temp, Td}
+
+// RangeCheck flags values outside of physical or climatological limits.
+type RangeCheck struct {
+	limits map[Variable]Limit
+}
+
+// NewRangeCheck creates a new RangeCheck
+func NewRangeCheck(limits map[Variable]Limit) *RangeCheck {
+	return &RangeCheck{limits: limits}
+}
+
+func (c *RangeCheck) Name() string { return "range" }
+
+func (c *RangeCheck) Level() int32 { return LevelRange }
+
+func (c *RangeCheck) Run(obs Observation, _ []Observation) []Flag {
+	var flags []Flag
+	for v, lim := range c.limits {
+		f, ok := obs.Value(v)
+		if !ok {
+			continue
+		}
+		if f < lim.Min || f > lim.Max {
+			flags = append(flags, Flag{
+				Check:    c.Name(),
+				Variable: v,
+				Level:    c.Level(),
+				Reason:   fmt.Sprintf("%.2f is outside [%.2f, %.2f]", f, lim.Min, lim.Max),
+			})
+		}
+	}
+	return flags
+}
+
+// StepCheck flags spikes relative to the previous reading.
+//
+// The previous reading is only used when it is no older than maxGap.
+type StepCheck struct {
+	maxSteps map[Variable]float32
+	maxGap   time.Duration
+}
+
+// NewStepCheck creates a new StepCheck
+func NewStepCheck(maxSteps map[Variable]float32, maxGap time.Duration) *StepCheck {
+	return &StepCheck{maxSteps: maxSteps, maxGap: maxGap}
+}
+
+func (c *StepCheck) Name() string { return "step" }
+
+func (c *StepCheck) Level() int32 { return LevelTemporal }
+
+func (c *StepCheck) Run(obs Observation, history []Observation) []Flag {
+	if len(history) == 0 {
+		return nil
+	}
+	prev := history[0]
+	if obs.Timestamp.Sub(prev.Timestamp) > c.maxGap {
+		return nil
+	}
+
+	var flags []Flag
+	for v, maxStep := range c.maxSteps {
+		f, ok := obs.Value(v)
+		if !ok {
+			continue
+		}
+		p, ok := prev.Value(v)
+		if !ok {
+			continue
+		}
+		if step := math.Abs(float64(f - p)); step > float64(maxStep) {
+			flags = append(flags, Flag{
+				Check:    c.Name(),
+				Variable: v,
+				Level:    c.Level(),
+				Reason:   fmt.Sprintf("changed by %.2f since %s, max is %.2f", step, prev.Timestamp.Format(time.RFC3339), maxStep),
+			})
+		}
+	}
+	return flags
+}
+
+// PersistenceCheck flags variables that did not change over a time window.
+type PersistenceCheck struct {
+	variables []Variable
+	window    time.Duration
+}
+
+// NewPersistenceCheck creates a new PersistenceCheck
+func NewPersistenceCheck(variables []Variable, window time.Duration) *PersistenceCheck {
+	return &PersistenceCheck{variables: variables, window: window}
+}
+
+func (c *PersistenceCheck) Name() string { return "persistence" }
+
+func (c *PersistenceCheck) Level() int32 { return LevelTemporal }
+
+func (c *PersistenceCheck) Run(obs Observation, history []Observation) []Flag {
+	var flags []Flag
+	for _, v := range c.variables {
+		f, ok := obs.Value(v)
+		if !ok {
+			continue
+		}
+
+		// the window must be fully covered by readings that all hold the same value
+		covered := false
+		stuck := true
+		for _, h := range history {
+			p, ok := h.Value(v)
+			if !ok || p != f {
+				stuck = false
+				break
+			}
+			if obs.Timestamp.Sub(h.Timestamp) >= c.window {
+				covered = true
+				break
+			}
+		}
+
+		if stuck && covered {
+			flags = append(flags, Flag{
+				Check:    c.Name(),
+				Variable: v,
+				Level:    c.Level(),
+				Reason:   fmt.Sprintf("value %.2f unchanged for at least %s", f, c.window),
+			})
+		}
+	}
+	return flags
+}
+
+// ConsistencyCheck flags readings whose variables contradict each other.
+type ConsistencyCheck struct{}
+
+// NewConsistencyCheck creates a new ConsistencyCheck
+func NewConsistencyCheck() *ConsistencyCheck {
+	return &ConsistencyCheck{}
+}
+
+func (c *ConsistencyCheck) Name() string { return "consistency" }
+
+func (c *ConsistencyCheck) Level() int32 { return LevelConsistency }
+
+func (c *ConsistencyCheck) Run(obs Observation, _ []Observation) []Flag {
+	var flags []Flag
+
+	temp, okTemp := obs.Value(Temp)
+	td, okTd := obs.Value(Td)
+	if okTemp && okTd && td > temp {
+		flags = append(flags, Flag{
+			Check:    c.Name(),
+			Variable: Td,
+			Level:    c.Level(),
+			Reason:   fmt.Sprintf("dew point %.2f is above temperature %.2f", td, temp),
+		})
+	}
+
+	wspd, okWspd := obs.Value(Wspd)
+	wspdx, okWspdx := obs.Value(Wspdx)
+	if okWspd && okWspdx && wspdx < wspd {
+		flags = append(flags, Flag{
+			Check:    c.Name(),
+			Variable: Wspdx,
+			Level:    c.Level(),
+			Reason:   fmt.Sprintf("gust %.2f is below mean wind speed %.2f", wspdx, wspd),
+		})
+	}
+
+	return flags
+}
@@ -0,0 +1,333 @@
+package qc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Levels a reading can reach. An observation is promoted to a level only
+// when every check of that level and all levels below it passed.
+const (
+	LevelNone        int32 = 0 // not checked or failed the range check
+	LevelRange       int32 = 1 // passed range limits
+	LevelTemporal    int32 = 2 // passed step and persistence tests
+	LevelConsistency int32 = 3 // passed internal consistency tests
+)
+
+// HistorySize is the number of previous readings fetched for the temporal checks.
+const HistorySize = 144
+
+// Variable is the name of an observed quantity, as used in the db columns.
+type Variable string
+
+const (
+	Pres   Variable = "pres"
+	Rr     Variable = "rr"
+	Rh     Variable = "rh"
+	Temp   Variable = "temp"
+	Td     Variable = "td"
+	Wdir   Variable = "wdir"
+	Wspd   Variable = "wspd"
+	Wspdx  Variable = "wspdx"
+	Srad   Variable = "srad"
+	Mslp   Variable = "mslp"
+	Hi     Variable = "hi"
+	Wchill Variable = "wchill"
+)
+
+// Observation is the view of a reading the checks operate on.
+type Observation struct {
+	Timestamp time.Time
+	Values    map[Variable]float32
+}
+
+// NewObservation creates new Observation from db.ObservationsObservation
+func NewObservation(obs db.ObservationsObservation) Observation {
+	res := Observation{
+		Timestamp: obs.Timestamp.Time,
+		Values:    make(map[Variable]float32),
+	}
+
+	for v, f := range map[Variable]pgtype.Float4{
+		Pres:   obs.Pres,
+		Rr:     obs.Rr,
+		Rh:     obs.Rh,
+		Temp:   obs.Temp,
+		Td:     obs.Td,
+		Wdir:   obs.Wdir,
+		Wspd:   obs.Wspd,
+		Wspdx:  obs.Wspdx,
+		Srad:   obs.Srad,
+		Mslp:   obs.Mslp,
+		Hi:     obs.Hi,
+		Wchill: obs.Wchill,
+	} {
+		if f.Valid {
+			res.Values[v] = f.Float32
+		}
+	}
+
+	return res
+}
+
+// NewMoObservation creates new Observation from db.ObservationsMoObservation
+func NewMoObservation(obs db.ObservationsMoObservation) Observation {
+	res := Observation{
+		Timestamp: obs.Timestamp.Time,
+		Values:    make(map[Variable]float32),
+	}
+
+	for v, f := range map[Variable]pgtype.Float4{
+		Pres:   obs.Pres,
+		Rr:     obs.Rr,
+		Rh:     obs.Rh,
+		Temp:   obs.Temp,
+		Td:     obs.Td,
+		Wdir:   obs.Wdir,
+		Wspd:   obs.Wspd,
+		Wspdx:  obs.Wspdx,
+		Srad:   obs.Srad,
+		Hi:     obs.Hi,
+		Wchill: obs.Wchill,
+	} {
+		if f.Valid {
+			res.Values[v] = f.Float32
+		}
+	}
+
+	return res
+}
+
+// Value returns the value of v and whether it is present.
+func (o Observation) Value(v Variable) (float32, bool) {
+	f, ok := o.Values[v]
+	return f, ok
+}
+
+// Flag records why a check rejected a variable.
+type Flag struct {
+	Check    string   `json:"check"`
+	Variable Variable `json:"variable"`
+	Level    int32    `json:"level"`
+	Reason   string   `json:"reason"`
+}
+
+// Check is a single quality-control test.
+//
+// history holds earlier readings of the same station, most recent first.
+type Check interface {
+	Name() string
+	Level() int32
+	Run(obs Observation, history []Observation) []Flag
+}
+
+// Result is the outcome of running all checks on an observation.
+type Result struct {
+	Level int32
+	Flags []Flag
+}
+
+// Checker runs a set of checks against observations.
+type Checker struct {
+	checks []Check
+}
+
+// NewChecker creates a new Checker with the given checks
+func NewChecker(checks ...Check) *Checker {
+	return &Checker{checks: checks}
+}
+
+// NewDefaultChecker creates a Checker with the default set of checks
+func NewDefaultChecker() *Checker {
+	return NewChecker(
+		NewRangeCheck(DefaultLimits),
+		NewStepCheck(DefaultMaxSteps, time.Hour),
+		NewPersistenceCheck(DefaultPersistenceVariables, 6*time.Hour),
+		NewConsistencyCheck(),
+	)
+}
+
+// Evaluate runs every check and computes the qc level of obs.
+func (c *Checker) Evaluate(obs Observation, history []Observation) Result {
+	var res Result
+
+	maxLevel := LevelNone
+	for _, chk := range c.checks {
+		if chk.Level() > maxLevel {
+			maxLevel = chk.Level()
+		}
+		res.Flags = append(res.Flags, chk.Run(obs, history)...)
+	}
+
+	sort.SliceStable(res.Flags, func(i, j int) bool {
+		if res.Flags[i].Level != res.Flags[j].Level {
+			return res.Flags[i].Level < res.Flags[j].Level
+		}
+		return res.Flags[i].Variable < res.Flags[j].Variable
+	})
+
+	res.Level = maxLevel
+	for _, f := range res.Flags {
+		if f.Level-1 < res.Level {
+			res.Level = f.Level - 1
+		}
+	}
+
+	return res
+}
+
+// Apply evaluates obs against the previous readings of its station and
+// stores the resulting level and flags.
+func (c *Checker) Apply(ctx context.Context, store db.Store, obs db.ObservationsObservation) (db.UpdateObservationQcTxResult, error) {
+	prev, err := store.ListPreviousStationObservations(ctx, db.ListPreviousStationObservationsParams{
+		StationID: obs.StationID,
+		Timestamp: obs.Timestamp,
+		Limit:     HistorySize,
+	})
+	if err != nil {
+		return db.UpdateObservationQcTxResult{}, err
+	}
+
+	history := make([]Observation, len(prev))
+	for i, p := range prev {
+		history[i] = NewObservation(p)
+	}
+
+	res := c.Evaluate(NewObservation(obs), history)
+
+	return store.UpdateObservationQcTx(ctx, res.updateParams(obs.ID))
+}
+
+// ApplyBatch evaluates stored observations, possibly of several stations, and
+// stores the resulting levels and flags in one transaction. The history of
+// each station is read once for the whole batch instead of once per reading.
+func (c *Checker) ApplyBatch(ctx context.Context, store db.Store, obs []db.ObservationsObservation) error {
+	var stationIDs []int64
+	byStation := make(map[int64][]db.ObservationsObservation)
+	for _, o := range obs {
+		if _, ok := byStation[o.StationID]; !ok {
+			stationIDs = append(stationIDs, o.StationID)
+		}
+		byStation[o.StationID] = append(byStation[o.StationID], o)
+	}
+
+	arg := make([]db.UpdateObservationQcTxParams, 0, len(obs))
+	for _, stationID := range stationIDs {
+		stnObs := byStation[stationID]
+		sort.SliceStable(stnObs, func(i, j int) bool {
+			return stnObs[i].Timestamp.Time.Before(stnObs[j].Timestamp.Time)
+		})
+
+		prev, err := store.ListPreviousStationObservations(ctx, db.ListPreviousStationObservationsParams{
+			StationID: stationID,
+			Timestamp: stnObs[0].Timestamp,
+			Limit:     HistorySize,
+		})
+		if err != nil {
+			return err
+		}
+		stored, err := store.ListStationObservationsForQc(ctx, db.ListStationObservationsForQcParams{
+			StationID: stationID,
+			StartDate: stnObs[0].Timestamp,
+			EndDate:   stnObs[len(stnObs)-1].Timestamp,
+		})
+		if err != nil {
+			return err
+		}
+
+		// every reading of the station up to the last of the batch, oldest first
+		readings := make([]Observation, 0, len(prev)+len(stored))
+		for i := len(prev) - 1; i >= 0; i-- {
+			readings = append(readings, NewObservation(prev[i]))
+		}
+		for _, s := range stored {
+			readings = append(readings, NewObservation(s))
+		}
+
+		for _, o := range stnObs {
+			cur := NewObservation(o)
+			n := sort.Search(len(readings), func(i int) bool {
+				return !readings[i].Timestamp.Before(cur.Timestamp)
+			})
+
+			res := c.Evaluate(cur, historyBefore(readings, n))
+			arg = append(arg, res.updateParams(o.ID))
+		}
+	}
+
+	if len(arg) == 0 {
+		return nil
+	}
+	return store.BulkUpdateObservationQc(ctx, arg)
+}
+
+// ApplyMoRange evaluates the stored MO observations of a station between
+// start and end, inclusive, and stores the resulting levels and flags in one
+// transaction.
+func (c *Checker) ApplyMoRange(ctx context.Context, store db.Store, stationID int64, start, end time.Time) error {
+	prev, err := store.ListPreviousStationMoObservations(ctx, db.ListPreviousStationMoObservationsParams{
+		StationID: stationID,
+		Timestamp: pgtype.Timestamptz{Time: start, Valid: true},
+		Limit:     HistorySize,
+	})
+	if err != nil {
+		return err
+	}
+	stored, err := store.ListStationMoObservationsForQc(ctx, db.ListStationMoObservationsForQcParams{
+		StationID: stationID,
+		StartDate: pgtype.Timestamptz{Time: start, Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: end, Valid: true},
+	})
+	if err != nil || len(stored) == 0 {
+		return err
+	}
+
+	readings := make([]Observation, 0, len(prev)+len(stored))
+	for i := len(prev) - 1; i >= 0; i-- {
+		readings = append(readings, NewMoObservation(prev[i]))
+	}
+	for _, s := range stored {
+		readings = append(readings, NewMoObservation(s))
+	}
+
+	arg := make([]db.UpdateObservationQcTxParams, len(stored))
+	for i, s := range stored {
+		n := len(prev) + i
+		res := c.Evaluate(readings[n], historyBefore(readings, n))
+		arg[i] = res.updateParams(s.ID)
+	}
+
+	return store.BulkUpdateMoObservationQc(ctx, arg)
+}
+
+// historyBefore returns up to HistorySize readings before readings[n], most
+// recent first. readings are sorted oldest first.
+func historyBefore(readings []Observation, n int) []Observation {
+	history := make([]Observation, 0, min(n, HistorySize))
+	for i := n - 1; i >= 0 && len(history) < HistorySize; i-- {
+		history = append(history, readings[i])
+	}
+	return history
+}
+
+func (r Result) updateParams(observationID int64) db.UpdateObservationQcTxParams {
+	flags := make([]db.CreateObservationQcFlagParams, len(r.Flags))
+	for i, f := range r.Flags {
+		flags[i] = db.CreateObservationQcFlagParams{
+			CheckName: f.Check,
+			Variable:  string(f.Variable),
+			Level:     f.Level,
+			Reason:    f.Reason,
+		}
+	}
+
+	return db.UpdateObservationQcTxParams{
+		ObservationID: observationID,
+		QcLevel:       r.Level,
+		Flags:         flags,
+	}
+}
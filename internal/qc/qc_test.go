@@ -0,0 +1,236 @@
+package qc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestObservation(ts time.Time, values map[Variable]float32) Observation {
+	return Observation{Timestamp: ts, Values: values}
+}
+
+func TestChecker(t *testing.T) {
+	now := time.Now()
+	checker := NewDefaultChecker()
+
+	testCases := []struct {
+		name        string
+		obs         Observation
+		history     []Observation
+		checkResult func(res Result)
+	}{
+		{
+			name: "OK",
+			obs:  newTestObservation(now, map[Variable]float32{Temp: 30, Td: 24, Rh: 70, Wspd: 2, Wspdx: 5}),
+			history: []Observation{
+				newTestObservation(now.Add(-10*time.Minute), map[Variable]float32{Temp: 29.5, Td: 24, Rh: 72}),
+			},
+			checkResult: func(res Result) {
+				require.Equal(t, LevelConsistency, res.Level)
+				require.Empty(t, res.Flags)
+			},
+		},
+		{
+			name: "OutOfRange",
+			obs:  newTestObservation(now, map[Variable]float32{Temp: 60, Rh: 70}),
+			checkResult: func(res Result) {
+				require.Equal(t, LevelNone, res.Level)
+				require.Len(t, res.Flags, 1)
+				require.Equal(t, "range", res.Flags[0].Check)
+				require.Equal(t, Temp, res.Flags[0].Variable)
+			},
+		},
+		{
+			name: "Spike",
+			obs:  newTestObservation(now, map[Variable]float32{Temp: 35}),
+			history: []Observation{
+				newTestObservation(now.Add(-10*time.Minute), map[Variable]float32{Temp: 25}),
+			},
+			checkResult: func(res Result) {
+				require.Equal(t, LevelRange, res.Level)
+				require.Len(t, res.Flags, 1)
+				require.Equal(t, "step", res.Flags[0].Check)
+			},
+		},
+		{
+			name: "SpikeAfterGap",
+			obs:  newTestObservation(now, map[Variable]float32{Temp: 35}),
+			history: []Observation{
+				newTestObservation(now.Add(-3*time.Hour), map[Variable]float32{Temp: 25}),
+			},
+			checkResult: func(res Result) {
+				require.Equal(t, LevelConsistency, res.Level)
+				require.Empty(t, res.Flags)
+			},
+		},
+		{
+			name: "Stuck",
+			obs:  newTestObservation(now, map[Variable]float32{Rh: 88}),
+			history: []Observation{
+				newTestObservation(now.Add(-2*time.Hour), map[Variable]float32{Rh: 88}),
+				newTestObservation(now.Add(-4*time.Hour), map[Variable]float32{Rh: 88}),
+				newTestObservation(now.Add(-6*time.Hour), map[Variable]float32{Rh: 88}),
+			},
+			checkResult: func(res Result) {
+				require.Equal(t, LevelRange, res.Level)
+				require.Len(t, res.Flags, 1)
+				require.Equal(t, "persistence", res.Flags[0].Check)
+				require.Equal(t, Rh, res.Flags[0].Variable)
+			},
+		},
+		{
+			name: "StuckWindowNotCovered",
+			obs:  newTestObservation(now, map[Variable]float32{Rh: 88}),
+			history: []Observation{
+				newTestObservation(now.Add(-2*time.Hour), map[Variable]float32{Rh: 88}),
+			},
+			checkResult: func(res Result) {
+				require.Equal(t, LevelConsistency, res.Level)
+				require.Empty(t, res.Flags)
+			},
+		},
+		{
+			name: "Inconsistent",
+			obs:  newTestObservation(now, map[Variable]float32{Temp: 25, Td: 27, Wspd: 5, Wspdx: 3}),
+			checkResult: func(res Result) {
+				require.Equal(t, LevelTemporal, res.Level)
+				require.Len(t, res.Flags, 2)
+				for _, f := range res.Flags {
+					require.Equal(t, "consistency", f.Check)
+				}
+			},
+		},
+		{
+			name: "LowestLevelWins",
+			obs:  newTestObservation(now, map[Variable]float32{Temp: 50, Td: 27}),
+			history: []Observation{
+				newTestObservation(now.Add(-10*time.Minute), map[Variable]float32{Td: 20}),
+			},
+			checkResult: func(res Result) {
+				require.Equal(t, LevelNone, res.Level)
+				require.Len(t, res.Flags, 2)
+				require.Equal(t, "range", res.Flags[0].Check)
+				require.Equal(t, "step", res.Flags[1].Check)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			res := checker.Evaluate(tc.obs, tc.history)
+			tc.checkResult(res)
+		})
+	}
+}
+
+func TestApplyBatch(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	newObs := func(id, stationID int64, ts time.Time, temp float32) db.ObservationsObservation {
+		return db.ObservationsObservation{
+			ID:        id,
+			StationID: stationID,
+			Temp:      pgtype.Float4{Float32: temp, Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+		}
+	}
+
+	prev := newObs(1, 10, now.Add(-10*time.Minute), 25)
+	stored := newObs(3, 10, now.Add(10*time.Minute), 35)
+	batch := []db.ObservationsObservation{
+		newObs(4, 10, now.Add(20*time.Minute), 35),
+		newObs(2, 10, now, 25),
+		newObs(5, 20, now, 60),
+	}
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ListPreviousStationObservations(mock.Anything, db.ListPreviousStationObservationsParams{
+		StationID: 10, Timestamp: batch[1].Timestamp, Limit: HistorySize,
+	}).Return([]db.ObservationsObservation{prev}, nil).Once()
+	store.EXPECT().ListStationObservationsForQc(mock.Anything, db.ListStationObservationsForQcParams{
+		StationID: 10, StartDate: batch[1].Timestamp, EndDate: batch[0].Timestamp,
+	}).Return([]db.ObservationsObservation{batch[1], stored, batch[0]}, nil).Once()
+	store.EXPECT().ListPreviousStationObservations(mock.Anything, mock.MatchedBy(func(arg db.ListPreviousStationObservationsParams) bool {
+		return arg.StationID == 20
+	})).Return([]db.ObservationsObservation{}, nil).Once()
+	store.EXPECT().ListStationObservationsForQc(mock.Anything, mock.MatchedBy(func(arg db.ListStationObservationsForQcParams) bool {
+		return arg.StationID == 20
+	})).Return([]db.ObservationsObservation{batch[2]}, nil).Once()
+
+	var got []db.UpdateObservationQcTxParams
+	store.EXPECT().BulkUpdateObservationQc(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, arg []db.UpdateObservationQcTxParams) error {
+			got = arg
+			return nil
+		}).Once()
+
+	err := NewDefaultChecker().ApplyBatch(context.Background(), store, batch)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	levels := make(map[int64]int32)
+	for _, arg := range got {
+		levels[arg.ObservationID] = arg.QcLevel
+	}
+	require.Equal(t, LevelConsistency, levels[2])
+	// compared against the stored reading 10 minutes before it, not the one before the batch
+	require.Equal(t, LevelConsistency, levels[4])
+	require.Equal(t, LevelNone, levels[5])
+}
+
+func TestApplyMoRange(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	newObs := func(id int64, ts time.Time, temp float32) db.ObservationsMoObservation {
+		return db.ObservationsMoObservation{
+			ID:        id,
+			StationID: 10,
+			Temp:      pgtype.Float4{Float32: temp, Valid: true},
+			Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+		}
+	}
+
+	start, end := now, now.Add(20*time.Minute)
+	prev := newObs(1, now.Add(-10*time.Minute), 25)
+	stored := []db.ObservationsMoObservation{
+		newObs(2, now, 35),
+		newObs(3, now.Add(10*time.Minute), 35),
+		newObs(4, end, 60),
+	}
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ListPreviousStationMoObservations(mock.Anything, db.ListPreviousStationMoObservationsParams{
+		StationID: 10, Timestamp: pgtype.Timestamptz{Time: start, Valid: true}, Limit: HistorySize,
+	}).Return([]db.ObservationsMoObservation{prev}, nil).Once()
+	store.EXPECT().ListStationMoObservationsForQc(mock.Anything, db.ListStationMoObservationsForQcParams{
+		StationID: 10,
+		StartDate: pgtype.Timestamptz{Time: start, Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: end, Valid: true},
+	}).Return(stored, nil).Once()
+
+	var got []db.UpdateObservationQcTxParams
+	store.EXPECT().BulkUpdateMoObservationQc(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, arg []db.UpdateObservationQcTxParams) error {
+			got = arg
+			return nil
+		}).Once()
+
+	err := NewDefaultChecker().ApplyMoRange(context.Background(), store, 10, start, end)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	// a step from the previous reading, then none, then out of range
+	require.Equal(t, int64(2), got[0].ObservationID)
+	require.Equal(t, LevelRange, got[0].QcLevel)
+	require.Equal(t, int64(3), got[1].ObservationID)
+	require.Equal(t, LevelConsistency, got[1].QcLevel)
+	require.Equal(t, int64(4), got[2].ObservationID)
+	require.Equal(t, LevelNone, got[2].QcLevel)
+}
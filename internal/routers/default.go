@@ -1,6 +1,8 @@
 package routers
 
 import (
+	"strings"
+
 	"github.com/emiliogozo/panahon-api-go/internal/handlers"
 	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
@@ -21,6 +23,19 @@ type DefaultRouter struct {
 func NewDefaultRouter(config util.Config, handler *handlers.DefaultHandler, tokenMaker token.Maker, logger *zerolog.Logger) *DefaultRouter {
 	gin.SetMode(config.GinMode)
 	g := gin.New()
+	// The webhook IP allowlists rely on the client IP forwarded by the
+	// proxies. Without any, the forwarded headers are ignored, as gin trusts
+	// every proxy by default.
+	var proxies []string
+	if len(config.TrustedProxies) > 0 {
+		proxies = strings.Split(config.TrustedProxies, ",")
+		for i := range proxies {
+			proxies[i] = strings.TrimSpace(proxies[i])
+		}
+	}
+	if err := g.SetTrustedProxies(proxies); err != nil {
+		logger.Fatal().Err(err).Msg("[Router] Invalid trusted proxies")
+	}
 	g.Use(mw.Zerologger(logger), gin.Recovery())
 
 	corsConfig := cors.DefaultConfig()
@@ -44,7 +59,13 @@ func NewDefaultRouter(config util.Config, handler *handlers.DefaultHandler, toke
 	r.observationRouter(api)
 	r.glabsRouter(api)
 	r.ptexterRouter(api)
+	r.smsRouter(api)
 	r.lufftRouter(api)
+	r.jobRouter(api)
+	r.simRouter(api)
+	r.webhookRouter(api)
+	r.rawMessageRouter(api)
+	r.uploadRouter(api)
 
 	api.POST("/tokens/renew", r.handler.RenewAccessToken)
 
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
 	"github.com/gin-gonic/gin"
 )
 
@@ -8,7 +9,10 @@ func (r *DefaultRouter) glabsRouter(gr *gin.RouterGroup) {
 	glabs := gr.Group("/glabs")
 	{
 		glabs.GET("", r.handler.GLabsOptIn)
-		glabs.POST("", r.handler.GLabsUnsubscribe)
-		glabs.POST("/load", r.handler.CreateGLabsLoad)
+
+		glabsHook := glabs.Group("", r.handler.VerifyWebhook(sms.GLabsKey))
+		glabsHook.POST("", r.handler.GLabsUnsubscribe)
+		glabsHook.POST("/load", r.handler.CreateGLabsLoad)
+		glabsHook.POST("/inbound", r.handler.GLabsInbound)
 	}
 }
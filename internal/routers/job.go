@@ -0,0 +1,20 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) jobRouter(gr *gin.RouterGroup) {
+	jobs := gr.Group("/admin/jobs")
+	{
+		jobsAuth := addMiddleware(jobs,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		jobsAuth.GET("", r.handler.ListJobs)
+		jobsAuth.GET("/runs", r.handler.ListJobRuns)
+		jobsAuth.POST(":name/run", r.handler.RunJob)
+		jobsAuth.PUT(":name/pause", r.handler.PauseJob)
+		jobsAuth.PUT(":name/resume", r.handler.ResumeJob)
+	}
+}
@@ -1,6 +1,7 @@
 package routers
 
 import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
 	"github.com/gin-gonic/gin"
 )
 
@@ -9,5 +10,10 @@ func (r *DefaultRouter) observationRouter(gr *gin.RouterGroup) {
 	{
 		observations.GET("", r.handler.ListObservations)
 		observations.GET("/latest", r.handler.ListLatestObservations)
+
+		obsAuth := addMiddleware(observations,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		obsAuth.POST("/batch", r.handler.CreateObservationBatch)
 	}
 }
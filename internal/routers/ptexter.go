@@ -1,12 +1,14 @@
 package routers
 
 import (
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
 	"github.com/gin-gonic/gin"
 )
 
 func (r *DefaultRouter) ptexterRouter(gr *gin.RouterGroup) {
 	ptexter := gr.Group("/ptexter")
 	{
-		ptexter.POST("", r.handler.PromoTexterStoreLufft)
+		ptexterHook := ptexter.Group("", r.handler.VerifyWebhook(sms.PromoTexterKey))
+		ptexterHook.POST("", r.handler.PromoTexterStoreLufft)
 	}
 }
@@ -0,0 +1,17 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) rawMessageRouter(gr *gin.RouterGroup) {
+	rawMessages := gr.Group("/admin/raw-messages")
+	{
+		rawMessagesAuth := addMiddleware(rawMessages,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		rawMessagesAuth.GET("", r.handler.ListRawMessages)
+		rawMessagesAuth.POST("/replay", r.handler.ReplayRawMessages)
+	}
+}
@@ -0,0 +1,24 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) simRouter(gr *gin.RouterGroup) {
+	sims := gr.Group("/admin/sims")
+	{
+		simsAuth := addMiddleware(sims,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		simsAuth.GET("", r.handler.ListSimCards)
+		simsAuth.POST("", r.handler.CreateSimCard)
+		simsAuth.GET("/at-risk", r.handler.ListStationsAtRisk)
+		simsAuth.GET("/load-requests", r.handler.ListLoadRequests)
+		simsAuth.GET("/:mobile_number", r.handler.GetSimCard)
+		simsAuth.PUT("/:mobile_number", r.handler.UpdateSimCard)
+		simsAuth.DELETE("/:mobile_number", r.handler.DeleteSimCard)
+		simsAuth.GET("/:mobile_number/loads", r.handler.ListSimCardLoads)
+		simsAuth.POST("/:mobile_number/top-up", r.handler.TopUpSimCard)
+	}
+}
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) smsRouter(gr *gin.RouterGroup) {
+	sms := gr.Group("/sms")
+	{
+		smsHook := sms.Group("", r.handler.VerifyWebhook(""))
+		smsHook.POST("/:provider", r.handler.SmsInbound)
+	}
+
+	smsAdmin := gr.Group("/admin/sms")
+	{
+		smsAdminAuth := addMiddleware(smsAdmin,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		smsAdminAuth.GET("/subscriptions", r.handler.ListSmsSubscriptions)
+		smsAdminAuth.POST("/subscriptions", r.handler.CreateSmsSubscription)
+		smsAdminAuth.DELETE("/subscriptions/:id", r.handler.DeleteSmsSubscription)
+		smsAdminAuth.GET("/messages", r.handler.ListSmsMessages)
+		smsAdminAuth.GET("/queries", r.handler.ListSmsQueries)
+	}
+}
@@ -16,7 +16,24 @@ func (r *DefaultRouter) stationRouter(gr *gin.RouterGroup) {
 		{
 			stnObs.GET("", r.handler.ListStationObservations)
 			stnObs.GET("/latest", r.handler.GetLatestStationObservation)
+			stnObs.GET("/hourly", r.handler.ListStationHourlyObservations)
+			stnObs.GET("/daily", r.handler.ListStationDailyObservations)
 			stnObs.GET(":id", r.handler.GetStationObservation)
+			stnObs.GET(":id/qc", r.handler.GetStationObservationQc)
+		}
+
+		stnMoObs := stations.Group(":station_id/mo-observations")
+		{
+			stnMoObs.GET("", r.handler.ListStationMoObservations)
+			stnMoObs.GET("/latest", r.handler.GetLatestStationMoObservation)
+			stnMoObs.GET(":id", r.handler.GetStationMoObservation)
+		}
+
+		stnHealth := stations.Group(":station_id/health")
+		{
+			stnHealth.GET("", r.handler.ListStationHealths)
+			stnHealth.GET("/latest", r.handler.GetLatestStationHealth)
+			stnHealth.GET("/alerts", r.handler.ListStationHealthAlerts)
 		}
 
 		stnAuth := addMiddleware(stations,
@@ -25,14 +42,36 @@ func (r *DefaultRouter) stationRouter(gr *gin.RouterGroup) {
 		stnAuth.POST("", r.handler.CreateStation)
 		stnAuth.PUT(":station_id", r.handler.UpdateStation)
 		stnAuth.DELETE(":station_id", r.handler.DeleteStation)
+		stnAuth.PUT(":station_id/credentials", r.handler.UpdateStationCredential)
+		stnAuth.DELETE(":station_id/credentials", r.handler.DeleteStationCredential)
+		stnAuth.PUT(":station_id/upload-key", r.handler.UpdateStationUploadKey)
+		stnAuth.DELETE(":station_id/upload-key", r.handler.DeleteStationUploadKey)
 
 		stnObsAuth := addMiddleware(stnObs,
 			mw.AuthMiddleware(r.tokenMaker, false),
 			mw.AdminMiddleware())
 		{
 			stnObsAuth.POST("", r.handler.CreateStationObservation)
+			stnObsAuth.POST("/batch", r.handler.CreateStationObservationBatch)
 			stnObsAuth.PUT(":id", r.handler.UpdateStationObservation)
 			stnObsAuth.DELETE(":id", r.handler.DeleteStationObservation)
 		}
+
+		stnMoObsAuth := addMiddleware(stnMoObs,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		{
+			stnMoObsAuth.POST("", r.handler.CreateStationMoObservation)
+			stnMoObsAuth.PUT(":id", r.handler.UpdateStationMoObservation)
+			stnMoObsAuth.DELETE(":id", r.handler.DeleteStationMoObservation)
+		}
+
+		stnHealthAuth := addMiddleware(stnHealth,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		{
+			stnHealthAuth.PUT("/alerts/:id/acknowledge", r.handler.AcknowledgeStationHealthAlert)
+			stnHealthAuth.PUT("/alerts/:id/resolve", r.handler.ResolveStationHealthAlert)
+		}
 	}
 }
@@ -0,0 +1,13 @@
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) uploadRouter(gr *gin.RouterGroup) {
+	upload := gr.Group("/upload")
+	{
+		upload.GET("/wunderground/updateweatherstation.php", r.handler.UploadWunderground)
+		upload.POST("/ecowitt", r.handler.UploadEcowitt)
+	}
+}
@@ -0,0 +1,16 @@
+package routers
+
+import (
+	mw "github.com/emiliogozo/panahon-api-go/internal/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *DefaultRouter) webhookRouter(gr *gin.RouterGroup) {
+	webhooks := gr.Group("/admin/webhooks")
+	{
+		webhooksAuth := addMiddleware(webhooks,
+			mw.AuthMiddleware(r.tokenMaker, false),
+			mw.AdminMiddleware())
+		webhooksAuth.GET("/rejections", r.handler.ListWebhookRejections)
+	}
+}
@@ -0,0 +1,104 @@
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/handlers"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(t *testing.T, config util.Config) *DefaultRouter {
+	config.GinMode = gin.TestMode
+	config.APIBasePath = "/"
+	logger := zerolog.Nop()
+	store := mockdb.NewMockStore(t)
+	// Rejected webhook requests are archived.
+	store.EXPECT().CreateRawMessage(mock.Anything, mock.Anything).
+		Return(db.RawMessage{}, nil).
+		Maybe()
+	handler := handlers.NewDefaultHandler(config, store, nil, nil, service.NewServices(config, store, &logger), &logger)
+	return NewDefaultRouter(config, handler, nil, &logger)
+}
+
+func TestWebhookRoutes(t *testing.T) {
+	router := newTestRouter(t, util.Config{
+		GlabsWebhookSecret:   util.RandomString(32),
+		GlabsWebhookIPs:      "10.0.0.1",
+		PtexterWebhookSecret: util.RandomString(32),
+		SmsHTTPWebhookSecret: util.RandomString(32),
+	})
+
+	testCases := []struct {
+		name       string
+		url        string
+		wantStatus int
+	}{
+		{name: "GLabsInbound", url: "/glabs/inbound", wantStatus: http.StatusForbidden},
+		{name: "GLabsLoad", url: "/glabs/load", wantStatus: http.StatusForbidden},
+		{name: "GLabsUnsubscribe", url: "/glabs", wantStatus: http.StatusForbidden},
+		{name: "PromoTexter", url: "/ptexter", wantStatus: http.StatusUnauthorized},
+		{name: "SmsHTTP", url: "/sms/HTTP", wantStatus: http.StatusUnauthorized},
+		{name: "SmsUnknown", url: "/sms/UNKNOWN", wantStatus: http.StatusNotFound},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, tc.url, nil)
+			require.NoError(t, err)
+			request.RemoteAddr = "192.168.1.10:4321"
+
+			router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.wantStatus, recorder.Code)
+		})
+	}
+}
+
+func TestWebhookForwardedFor(t *testing.T) {
+	config := util.Config{
+		GlabsWebhookSecret: util.RandomString(32),
+		GlabsWebhookIPs:    "10.0.0.1",
+	}
+
+	testCases := []struct {
+		name           string
+		trustedProxies string
+		wantStatus     int
+	}{
+		// A forged X-Forwarded-For is ignored without trusted proxies.
+		{name: "NoTrustedProxies", wantStatus: http.StatusForbidden},
+		{name: "UntrustedProxy", trustedProxies: "192.168.1.1", wantStatus: http.StatusForbidden},
+		// Past the allowlist, the unsigned request is rejected.
+		{name: "TrustedProxy", trustedProxies: "192.168.1.10", wantStatus: http.StatusUnauthorized},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			config := config
+			config.TrustedProxies = tc.trustedProxies
+			router := newTestRouter(t, config)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/glabs/inbound", nil)
+			require.NoError(t, err)
+			request.RemoteAddr = "192.168.1.10:4321"
+			request.Header.Set("X-Forwarded-For", "10.0.0.1")
+
+			router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.wantStatus, recorder.Code)
+		})
+	}
+}
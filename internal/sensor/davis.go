@@ -1,6 +1,7 @@
 package sensor
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -15,21 +16,32 @@ import (
 type Davis struct {
 	Url    string
 	client Fetcher
-	sleep  time.Duration
+	// loc is the time zone of the station, for observations without one.
+	loc *time.Location
 }
 
-type DavisCurrentObservation struct {
+type CurrentObservation struct {
 	Rain          pgtype.Float4      `json:"rain"`
 	Temp          pgtype.Float4      `json:"temp"`
 	Rh            pgtype.Float4      `json:"rh"`
+	Td            pgtype.Float4      `json:"td"`
 	Wdir          pgtype.Float4      `json:"wdir"`
 	Wspd          pgtype.Float4      `json:"wspd"`
 	Srad          pgtype.Float4      `json:"srad"`
+	Pres          pgtype.Float4      `json:"pres"`
 	Mslp          pgtype.Float4      `json:"mslp"`
 	Tn            pgtype.Float4      `json:"tn"`
 	Tx            pgtype.Float4      `json:"tx"`
 	Gust          pgtype.Float4      `json:"gust"`
 	Hi            pgtype.Float4      `json:"hi"`
+	Wchill        pgtype.Float4      `json:"wchill"`
+	Uvi           pgtype.Float4      `json:"uvi"`
+	Et            pgtype.Float4      `json:"et"`
+	Thwi          pgtype.Float4      `json:"thwi"`
+	Senergy       pgtype.Float4      `json:"senergy"`
+	Uvdose        pgtype.Float4      `json:"uvdose"`
+	Hdd           pgtype.Float4      `json:"hdd"`
+	Cdd           pgtype.Float4      `json:"cdd"`
 	RainAccum     pgtype.Float4      `json:"rain_accum"`
 	TnTimestamp   pgtype.Timestamptz `json:"tn_timestamp"`
 	TxTimestamp   pgtype.Timestamptz `json:"tx_timestamp"`
@@ -49,6 +61,7 @@ type davisRawResponse struct {
 	WindDeg    json.Number                `json:"wind_degrees"`
 	WindMPH    json.Number                `json:"wind_mph"`
 	HeatIndexC json.Number                `json:"heat_index_c"`
+	WindchillC json.Number                `json:"windchill_c"`
 	Obs        davisRawCurrentObservation `json:"davis_current_observation"`
 }
 
@@ -57,6 +70,7 @@ type davisRawCurrentObservation struct {
 	RainDayIn       json.Number `json:"rain_day_in"`
 	Srad            json.Number `json:"solar_radiation"`
 	UVIndex         json.Number `json:"uv_index"`
+	EtDayIn         json.Number `json:"et_day"`
 	TempDayHighF    json.Number `json:"temp_day_high_f"`
 	TempDayLowF     json.Number `json:"temp_day_low_f"`
 	WindDayHighMPH  json.Number `json:"wind_day_high_mph"`
@@ -65,17 +79,28 @@ type davisRawCurrentObservation struct {
 	WindDayHighTime string      `json:"wind_day_high_time"`
 }
 
-func NewDavis(url string, sleep time.Duration) *Davis {
+func NewDavis(url string) *Davis {
 	client := &http.Client{Timeout: 10 * time.Second}
 	return &Davis{
 		Url:    url,
 		client: client,
-		sleep:  sleep,
+		loc:    Location(DefaultTimezone),
 	}
 }
 
-func newDavisObservation(rawObs davisRawResponse) *DavisCurrentObservation {
-	obs := new(DavisCurrentObservation)
+// newDavisObservation converts a v1 response. The day high and low times are
+// resolved on the day of the observation, in loc when the observation time
+// cannot be read.
+func newDavisObservation(rawObs davisRawResponse, loc *time.Location) *CurrentObservation {
+	obs := new(CurrentObservation)
+
+	layout := "Mon, 02 Jan 2006 15:04:05 -0700"
+	ref := time.Now().In(loc)
+	if dt, err := time.Parse(layout, rawObs.Time); err == nil {
+		obs.Timestamp = pgtype.Timestamptz{Time: dt, Valid: true}
+		ref = dt
+	}
+
 	f, err := rawObs.Obs.RRInPerHr.Float64()
 	obs.Rain = pgtype.Float4{Float32: float32(f) * 25.4, Valid: err == nil}
 	f, err = rawObs.Obs.RainDayIn.Float64()
@@ -84,6 +109,8 @@ func newDavisObservation(rawObs davisRawResponse) *DavisCurrentObservation {
 	obs.Temp = pgtype.Float4{Float32: float32(f), Valid: err == nil && math.Abs(-999.0-f) > 0.001}
 	f, err = rawObs.Rh.Float64()
 	obs.Rh = pgtype.Float4{Float32: float32(f), Valid: err == nil && f >= 0 && f <= 100}
+	f, err = rawObs.TdC.Float64()
+	obs.Td = pgtype.Float4{Float32: float32(f), Valid: err == nil && math.Abs(-999.0-f) > 0.001}
 	f, err = rawObs.WindDeg.Float64()
 	obs.Wdir = pgtype.Float4{Float32: float32(f), Valid: err == nil && f >= 0.0 && f <= 360.0}
 	f, err = rawObs.WindMPH.Float64()
@@ -95,33 +122,34 @@ func newDavisObservation(rawObs davisRawResponse) *DavisCurrentObservation {
 	f, err = rawObs.Obs.TempDayHighF.Float64()
 	obs.Tx = pgtype.Float4{Float32: (float32(f) - 32.0) * (5.0 / 9.0), Valid: err == nil && math.Abs(-999.0-f) > 0.001}
 	obs.TxTimestamp = pgtype.Timestamptz{Valid: true}
-	if dt, err := parseTimeStrToDateTime(rawObs.Obs.TempDayHighTime); err == nil {
+	if dt, err := parseTimeStrToDateTime(rawObs.Obs.TempDayHighTime, ref); err == nil {
 		obs.TxTimestamp.Time = dt
 	}
 	f, err = rawObs.Obs.TempDayLowF.Float64()
 	obs.Tn = pgtype.Float4{Float32: (float32(f) - 32.0) * (5.0 / 9.0), Valid: err == nil && math.Abs(-999.0-f) > 0.001}
 	obs.TnTimestamp = pgtype.Timestamptz{Valid: true}
-	if dt, err := parseTimeStrToDateTime(rawObs.Obs.TempDayLowTime); err == nil {
+	if dt, err := parseTimeStrToDateTime(rawObs.Obs.TempDayLowTime, ref); err == nil {
 		obs.TnTimestamp.Time = dt
 	}
 	f, err = rawObs.Obs.WindDayHighMPH.Float64()
 	obs.Gust = pgtype.Float4{Float32: float32(f) * 0.44704, Valid: err == nil && math.Abs(-999.0-f) > 0.001}
 	obs.GustTimestamp = pgtype.Timestamptz{Valid: true}
-	if dt, err := parseTimeStrToDateTime(rawObs.Obs.WindDayHighTime); err == nil {
+	if dt, err := parseTimeStrToDateTime(rawObs.Obs.WindDayHighTime, ref); err == nil {
 		obs.GustTimestamp.Time = dt
 	}
 	f, err = rawObs.HeatIndexC.Float64()
 	obs.Hi = pgtype.Float4{Float32: float32(f), Valid: err == nil && math.Abs(-999.0-f) > 0.001}
-
-	layout := "Mon, 02 Jan 2006 15:04:05 -0700"
-	if dt, err := time.Parse(layout, rawObs.Time); err == nil {
-		obs.Timestamp = pgtype.Timestamptz{Time: dt, Valid: true}
-	}
+	f, err = rawObs.WindchillC.Float64()
+	obs.Wchill = pgtype.Float4{Float32: float32(f), Valid: err == nil && math.Abs(-999.0-f) > 0.001}
+	f, err = rawObs.Obs.UVIndex.Float64()
+	obs.Uvi = pgtype.Float4{Float32: float32(f), Valid: err == nil && f >= 0}
+	f, err = rawObs.Obs.EtDayIn.Float64()
+	obs.Et = pgtype.Float4{Float32: float32(f) * 25.4, Valid: err == nil && f >= 0}
 
 	return obs
 }
 
-func (d Davis) FetchLatest() (*DavisCurrentObservation, error) {
+func (d Davis) FetchLatest(ctx context.Context) (*CurrentObservation, error) {
 	parsedURL, err := url.Parse(d.Url)
 	if err != nil {
 		return nil, err
@@ -142,15 +170,13 @@ func (d Davis) FetchLatest() (*DavisCurrentObservation, error) {
 	}.Encode()
 	encodedURL := parsedURL.String()
 
-	req, err := http.NewRequest("GET", encodedURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", encodedURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36")
 
-	time.Sleep(d.sleep)
-
 	res, err := d.client.Do(req)
 	if err != nil {
 		return nil, err
@@ -163,20 +189,28 @@ func (d Davis) FetchLatest() (*DavisCurrentObservation, error) {
 		return nil, err
 	}
 
-	obs := newDavisObservation(rawObs)
+	loc := d.loc
+	if loc == nil {
+		loc = Location(DefaultTimezone)
+	}
+	obs := newDavisObservation(rawObs, loc)
 	return obs, nil
 }
 
-func parseTimeStrToDateTime(timeStr string) (time.Time, error) {
+// parseTimeStrToDateTime resolves a time of day, e.g. "3:04pm", to the latest
+// such time not after ref, in the time zone of ref.
+func parseTimeStrToDateTime(timeStr string, ref time.Time) (time.Time, error) {
 	layout := "3:04pm"
-	currentDate := time.Now()
 
 	t, err := time.Parse(layout, timeStr)
 	if err != nil {
-		return currentDate, err
+		return ref, err
 	}
 
-	newDateTime := time.Date(currentDate.Year(), currentDate.Month(), currentDate.Day(), t.Hour(), t.Minute(), 0, 0, currentDate.Location())
+	newDateTime := time.Date(ref.Year(), ref.Month(), ref.Day(), t.Hour(), t.Minute(), 0, 0, ref.Location())
+	if newDateTime.After(ref) {
+		newDateTime = newDateTime.AddDate(0, 0, -1)
+	}
 	return newDateTime, nil
 }
 
@@ -192,11 +226,13 @@ func RandomDavisRawResponse() davisRawResponse {
 		WindDeg:    json.Number(fmt.Sprintf("%d", int32(util.RandomInt(0, 360)))),
 		WindMPH:    json.Number(fmt.Sprintf("%.2f", util.RandomFloat(0.0, 10.0))),
 		HeatIndexC: json.Number(fmt.Sprintf("%.2f", util.RandomFloat(30.0, 50.0))),
+		WindchillC: json.Number(fmt.Sprintf("%.2f", util.RandomFloat(25.0, 33.0))),
 		Obs: davisRawCurrentObservation{
 			RRInPerHr:       json.Number(fmt.Sprintf("%.2f", util.RandomFloat(0.0, 5.0))),
 			RainDayIn:       json.Number(fmt.Sprintf("%.2f", util.RandomFloat(0.0, 100.0))),
 			Srad:            json.Number(fmt.Sprintf("%d", int32(util.RandomInt(0, 400)))),
 			UVIndex:         json.Number(fmt.Sprintf("%.2f", util.RandomFloat(0.0, 1.0))),
+			EtDayIn:         json.Number(fmt.Sprintf("%.3f", util.RandomFloat(0.0, 0.3))),
 			TempDayHighF:    json.Number(fmt.Sprintf("%.2f", util.RandomFloat(77.0, 104.0))),
 			TempDayLowF:     json.Number(fmt.Sprintf("%.2f", util.RandomFloat(60.0, 104.0))),
 			WindDayHighMPH:  json.Number(fmt.Sprintf("%.2f", util.RandomFloat(0.0, 20.0))),
@@ -2,6 +2,7 @@ package sensor
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,7 +22,7 @@ func TestFetchLatest(t *testing.T) {
 		name          string
 		url           string
 		builStubs     func(client *mocksensor.MockFetcher)
-		checkResponse func(client *mocksensor.MockFetcher, obs *DavisCurrentObservation, err error)
+		checkResponse func(client *mocksensor.MockFetcher, obs *CurrentObservation, err error)
 	}{
 		{
 			name: "Default",
@@ -33,7 +34,7 @@ func TestFetchLatest(t *testing.T) {
 					Body: io.NopCloser(bodyReader),
 				}, nil)
 			},
-			checkResponse: func(client *mocksensor.MockFetcher, obs *DavisCurrentObservation, err error) {
+			checkResponse: func(client *mocksensor.MockFetcher, obs *CurrentObservation, err error) {
 				client.AssertExpectations(t)
 				assert.NoError(t, err)
 				requireDavisEqual(t, rawObs, *obs)
@@ -44,7 +45,7 @@ func TestFetchLatest(t *testing.T) {
 			url:  "api.weatherlink.com/v1/NoaaExt.json?user=00DE01CE1D&pass=p@ssW0rd",
 			builStubs: func(client *mocksensor.MockFetcher) {
 			},
-			checkResponse: func(client *mocksensor.MockFetcher, obs *DavisCurrentObservation, err error) {
+			checkResponse: func(client *mocksensor.MockFetcher, obs *CurrentObservation, err error) {
 				client.AssertNotCalled(t, "Do")
 				assert.Error(t, err)
 				assert.Empty(t, obs)
@@ -55,7 +56,7 @@ func TestFetchLatest(t *testing.T) {
 			url:  "https://api.weatherlink.com/v1/NoaaExt.json?pass=p@ssW0rd",
 			builStubs: func(client *mocksensor.MockFetcher) {
 			},
-			checkResponse: func(client *mocksensor.MockFetcher, obs *DavisCurrentObservation, err error) {
+			checkResponse: func(client *mocksensor.MockFetcher, obs *CurrentObservation, err error) {
 				client.AssertExpectations(t)
 				assert.Error(t, err)
 				assert.Empty(t, obs)
@@ -66,7 +67,7 @@ func TestFetchLatest(t *testing.T) {
 			url:  "https://api.weatherlink.com/v1/NoaaExt.json?user=00DE01CE1D",
 			builStubs: func(client *mocksensor.MockFetcher) {
 			},
-			checkResponse: func(client *mocksensor.MockFetcher, obs *DavisCurrentObservation, err error) {
+			checkResponse: func(client *mocksensor.MockFetcher, obs *CurrentObservation, err error) {
 				client.AssertExpectations(t)
 				assert.Error(t, err)
 				assert.Empty(t, obs)
@@ -81,18 +82,17 @@ func TestFetchLatest(t *testing.T) {
 			testSensor := &Davis{
 				Url:    tc.url,
 				client: testFetcher,
-				sleep:  0,
 			}
 
 			tc.builStubs(testFetcher)
-			obs, err := testSensor.FetchLatest()
+			obs, err := testSensor.FetchLatest(context.Background())
 
 			tc.checkResponse(testFetcher, obs, err)
 		})
 	}
 }
 
-func requireDavisEqual(t *testing.T, rawObs davisRawResponse, obs DavisCurrentObservation) {
+func requireDavisEqual(t *testing.T, rawObs davisRawResponse, obs CurrentObservation) {
 	f, err := rawObs.PressureMb.Float64()
 	require.NoError(t, err)
 	require.InDelta(t, f, obs.Mslp.Float32, 0.001)
@@ -102,6 +102,15 @@ func requireDavisEqual(t *testing.T, rawObs davisRawResponse, obs DavisCurrentOb
 	f, err = rawObs.TempC.Float64()
 	require.NoError(t, err)
 	require.InDelta(t, f, obs.Temp.Float32, 0.001)
+	f, err = rawObs.TdC.Float64()
+	require.NoError(t, err)
+	require.InDelta(t, f, obs.Td.Float32, 0.001)
+	f, err = rawObs.Obs.UVIndex.Float64()
+	require.NoError(t, err)
+	require.InDelta(t, f, obs.Uvi.Float32, 0.001)
+	f, err = rawObs.Obs.EtDayIn.Float64()
+	require.NoError(t, err)
+	require.InDelta(t, f*25.4, obs.Et.Float32, 0.001)
 	f, err = rawObs.WindDeg.Float64()
 	require.NoError(t, err)
 	require.InDelta(t, f, obs.Wdir.Float32, 0.001)
@@ -120,3 +129,89 @@ func requireDavisEqual(t *testing.T, rawObs davisRawResponse, obs DavisCurrentOb
 func datetimeToTimeStr(dt time.Time) string {
 	return fmt.Sprintf("%s:%02d%s", dt.Format("3"), dt.Minute(), dt.Format("pm"))
 }
+
+func TestParseTimeStrToDateTime(t *testing.T) {
+	pht := time.FixedZone("", 8*60*60)
+
+	testCases := []struct {
+		name    string
+		timeStr string
+		ref     time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "SameDay",
+			timeStr: "9:15am",
+			ref:     time.Date(2024, 3, 10, 14, 0, 0, 0, pht),
+			want:    time.Date(2024, 3, 10, 9, 15, 0, 0, pht),
+		},
+		{
+			name:    "SameMinute",
+			timeStr: "2:00pm",
+			ref:     time.Date(2024, 3, 10, 14, 0, 30, 0, pht),
+			want:    time.Date(2024, 3, 10, 14, 0, 0, 0, pht),
+		},
+		{
+			name:    "AfterMidnight",
+			timeStr: "11:50pm",
+			ref:     time.Date(2024, 3, 10, 0, 5, 0, 0, pht),
+			want:    time.Date(2024, 3, 9, 23, 50, 0, 0, pht),
+		},
+		{
+			name:    "NewYear",
+			timeStr: "11:59pm",
+			ref:     time.Date(2024, 1, 1, 0, 1, 0, 0, pht),
+			want:    time.Date(2023, 12, 31, 23, 59, 0, 0, pht),
+		},
+		{
+			name:    "UTCServer",
+			timeStr: "7:30am",
+			ref:     time.Date(2024, 3, 10, 8, 0, 0, 0, pht),
+			want:    time.Date(2024, 3, 9, 23, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "Invalid",
+			timeStr: "25:00",
+			ref:     time.Date(2024, 3, 10, 8, 0, 0, 0, pht),
+			wantErr: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseTimeStrToDateTime(tc.timeStr, tc.ref)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.want.Equal(got), "want %s, got %s", tc.want, got)
+		})
+	}
+}
+
+func TestNewDavisObservationDayTimes(t *testing.T) {
+	rawObs := RandomDavisRawResponse()
+	rawObs.Time = "Sun, 10 Mar 2024 00:05:00 +0800"
+	rawObs.Obs.TempDayHighTime = "12:02am"
+	rawObs.Obs.TempDayLowTime = "11:58pm"
+	rawObs.Obs.WindDayHighTime = "invalid"
+
+	obs := newDavisObservation(rawObs, Location("UTC"))
+	pht := time.FixedZone("", 8*60*60)
+	require.True(t, obs.Timestamp.Time.Equal(time.Date(2024, 3, 10, 0, 5, 0, 0, pht)))
+	require.True(t, obs.TxTimestamp.Time.Equal(time.Date(2024, 3, 10, 0, 2, 0, 0, pht)))
+	require.True(t, obs.TnTimestamp.Time.Equal(time.Date(2024, 3, 9, 23, 58, 0, 0, pht)))
+	require.True(t, obs.GustTimestamp.Time.IsZero())
+
+	rawObs.Time = ""
+	rawObs.Obs.TempDayHighTime = "12:00am"
+	loc := Location("Asia/Tokyo")
+	obs = newDavisObservation(rawObs, loc)
+	require.False(t, obs.Timestamp.Valid)
+	require.Equal(t, loc, obs.TxTimestamp.Time.Location())
+	require.Equal(t, 0, obs.TxTimestamp.Time.Hour())
+	require.False(t, obs.TxTimestamp.Time.After(time.Now()))
+}
@@ -0,0 +1,125 @@
+package sensor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotSupported is returned by a Driver for an operation its logger does not offer.
+var ErrNotSupported = errors.New("operation not supported by driver")
+
+// Station holds the station attributes a Driver needs to reach its logger.
+type Station struct {
+	ID          int64
+	MoStationID string
+	Url         string
+	// Timezone of the logger clock, e.g. "Asia/Manila".
+	Timezone string
+	// Credentials of the logger's cloud service, if any.
+	ApiKey       string
+	ApiSecret    string
+	ApiStationID string
+}
+
+// Reading is an observation, and the logger health if any, decoded from a
+// message pushed by a station.
+type Reading struct {
+	Obs    StationObservation
+	Health *StationHealth
+}
+
+// Driver decodes or retrieves observations for one brand of data logger.
+type Driver interface {
+	// Parse decodes a message pushed by the logger, e.g. an SMS, received at
+	// receivedAt, or now when zero. Times without a zone are read in loc.
+	Parse(msg string, loc *time.Location, receivedAt time.Time) (*Reading, error)
+	// Fetch pulls the latest observation of the station from the logger or its cloud service.
+	Fetch(ctx context.Context, stn Station) (*CurrentObservation, error)
+}
+
+// LayoutParser is implemented by drivers whose messages come in several
+// layouts, e.g. one per logger firmware version.
+type LayoutParser interface {
+	// ParseLayout decodes a message of the named layout.
+	ParseLayout(msg, layout string, loc *time.Location, receivedAt time.Time) (*Reading, error)
+}
+
+// Archiver is implemented by drivers able to pull the archived records of a
+// logger, e.g. to backfill the observations missed while a station was offline.
+type Archiver interface {
+	// FetchArchive pulls the records of the station from start, exclusive, to end, inclusive.
+	FetchArchive(ctx context.Context, stn Station, start, end time.Time) ([]CurrentObservation, error)
+}
+
+// DefaultTimezone is the time zone of the stations without one.
+const DefaultTimezone = "Asia/Manila"
+
+// Location returns the time zone named tz, or DefaultTimezone when tz is
+// empty or unknown.
+func Location(tz string) *time.Location {
+	if len(tz) > 0 {
+		if loc, err := time.LoadLocation(tz); err == nil {
+			return loc
+		}
+	}
+	if loc, err := time.LoadLocation(DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.FixedZone("PHT", 8*60*60)
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]Driver)
+)
+
+func normalizeKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
+
+// Register makes a driver available under key, which is matched against a
+// station's station_type or sms_system_type. It panics if key is empty,
+// driver is nil or key is already registered.
+func Register(key string, driver Driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+
+	key = normalizeKey(key)
+	if len(key) == 0 {
+		panic("sensor: Register key is empty")
+	}
+	if driver == nil {
+		panic("sensor: Register driver is nil")
+	}
+	if _, dup := drivers[key]; dup {
+		panic(fmt.Sprintf("sensor: Register called twice for driver %s", key))
+	}
+	drivers[key] = driver
+}
+
+// Lookup returns the driver registered under key. Keys are case-insensitive.
+func Lookup(key string) (Driver, bool) {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+
+	d, ok := drivers[normalizeKey(key)]
+	return d, ok
+}
+
+// Drivers returns the sorted keys of the registered drivers.
+func Drivers() []string {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+
+	keys := make([]string, 0, len(drivers))
+	for k := range drivers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,120 @@
+package sensor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	require.Contains(t, Drivers(), DavisKey)
+	require.Contains(t, Drivers(), LufftKey)
+
+	d, ok := Lookup("lufft")
+	require.True(t, ok)
+	require.IsType(t, LufftDriver{}, d)
+
+	_, ok = Lookup("unknown")
+	require.False(t, ok)
+
+	require.Panics(t, func() { Register(LufftKey, LufftDriver{}) })
+	require.Panics(t, func() { Register(" ", LufftDriver{}) })
+	require.Panics(t, func() { Register("NIL", nil) })
+}
+
+// TestDriverConformance checks that every registered driver either implements
+// an operation or reports ErrNotSupported for it.
+func TestDriverConformance(t *testing.T) {
+	rawObs := RandomDavisRawResponse()
+	lufftMsg := RandomLufft(time.Now()).String(23)
+
+	testCases := []struct {
+		name       string
+		key        string
+		msg        string
+		station    Station
+		buildStubs func(client *mocksensor.MockFetcher)
+		checkParse func(r *Reading, err error)
+		checkFetch func(obs *CurrentObservation, err error)
+	}{
+		{
+			name: "Davis",
+			key:  DavisKey,
+			msg:  lufftMsg,
+			station: Station{
+				ID:  1,
+				Url: "https://api.weatherlink.com/v1/NoaaExt.xml?user=00DE01CE1D&pass=p@ssW0rd",
+			},
+			buildStubs: func(client *mocksensor.MockFetcher) {
+				body, _ := json.Marshal(rawObs)
+				client.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+					return req.URL.Path == "/v1/NoaaExt.json"
+				})).Return(&http.Response{Body: io.NopCloser(bytes.NewReader(body))}, nil)
+			},
+			checkParse: func(r *Reading, err error) {
+				require.ErrorIs(t, err, ErrNotSupported)
+				require.Nil(t, r)
+			},
+			checkFetch: func(obs *CurrentObservation, err error) {
+				require.NoError(t, err)
+				requireDavisEqual(t, rawObs, *obs)
+			},
+		},
+		{
+			name:       "Lufft",
+			key:        LufftKey,
+			msg:        lufftMsg,
+			station:    Station{ID: 1},
+			buildStubs: func(client *mocksensor.MockFetcher) {},
+			checkParse: func(r *Reading, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, r.Health)
+				require.Equal(t, lufftMsg, r.Health.Message)
+				require.False(t, r.Obs.Timestamp.IsZero())
+			},
+			checkFetch: func(obs *CurrentObservation, err error) {
+				require.ErrorIs(t, err, ErrNotSupported)
+				require.Nil(t, obs)
+			},
+		},
+		{
+			name:       "LufftInvalidMessage",
+			key:        LufftKey,
+			msg:        "0+1+2",
+			station:    Station{ID: 1},
+			buildStubs: func(client *mocksensor.MockFetcher) {},
+			checkParse: func(r *Reading, err error) {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, ErrNotSupported)
+			},
+			checkFetch: func(obs *CurrentObservation, err error) {
+				require.ErrorIs(t, err, ErrNotSupported)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			driver, ok := Lookup(tc.key)
+			require.True(t, ok)
+
+			client := mocksensor.NewMockFetcher(t)
+			tc.buildStubs(client)
+			if _, isDavis := driver.(*DavisDriver); isDavis {
+				driver = &DavisDriver{client: client}
+			}
+
+			tc.checkParse(driver.Parse(tc.msg, nil, time.Time{}))
+			tc.checkFetch(driver.Fetch(context.Background(), tc.station))
+		})
+	}
+}
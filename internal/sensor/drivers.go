@@ -0,0 +1,96 @@
+package sensor
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DavisKey is the station_type of stations polled through Davis WeatherLink.
+	DavisKey = "MO"
+	// LufftKey is the sms_system_type of stations sending Lufft SMS messages.
+	LufftKey = "LUFFT"
+)
+
+func init() {
+	Register(DavisKey, NewDavisDriver(nil))
+	Register(LufftKey, LufftDriver{})
+}
+
+// DavisDriver fetches current observations from the WeatherLink v2 API when
+// the station has api credentials, and from the legacy v1 API otherwise.
+type DavisDriver struct {
+	client Fetcher
+}
+
+// NewDavisDriver creates a new DavisDriver. When client is nil, a default
+// HTTP client rate limited per host is used.
+func NewDavisDriver(client Fetcher) *DavisDriver {
+	if client == nil {
+		client = NewRateLimitedClient(&http.Client{Timeout: 10 * time.Second}, defaultHostRate, defaultHostBurst)
+	}
+	return &DavisDriver{client: client}
+}
+
+func (d *DavisDriver) Parse(msg string, loc *time.Location, receivedAt time.Time) (*Reading, error) {
+	return nil, ErrNotSupported
+}
+
+func (d *DavisDriver) Fetch(ctx context.Context, stn Station) (*CurrentObservation, error) {
+	if len(stn.ApiKey) > 0 {
+		return NewWeatherLink(stn.ApiKey, stn.ApiSecret, d.client).Current(ctx, stn.ApiStationID)
+	}
+
+	davis := &Davis{
+		Url:    strings.Replace(stn.Url, ".xml", ".json", 1),
+		client: d.client,
+		loc:    Location(stn.Timezone),
+	}
+	return davis.FetchLatest(ctx)
+}
+
+// FetchArchive pulls archive records from the WeatherLink v2 API. Stations
+// without api credentials are not supported.
+func (d *DavisDriver) FetchArchive(ctx context.Context, stn Station, start, end time.Time) ([]CurrentObservation, error) {
+	if len(stn.ApiKey) == 0 {
+		return nil, ErrNotSupported
+	}
+	return NewWeatherLink(stn.ApiKey, stn.ApiSecret, d.client).Historic(ctx, stn.ApiStationID, start, end)
+}
+
+// LufftDriver decodes Lufft SMS messages of Layout, or of any built-in
+// layout when Layout is empty.
+type LufftDriver struct {
+	Layout string
+}
+
+func (d LufftDriver) Parse(msg string, loc *time.Location, receivedAt time.Time) (*Reading, error) {
+	return d.ParseLayout(msg, d.Layout, loc, receivedAt)
+}
+
+// ParseLayout decodes a message of the named layout. When that layout is not
+// registered, the layout of the driver is used, or else any built-in layout.
+func (d LufftDriver) ParseLayout(msg, layout string, loc *time.Location, receivedAt time.Time) (*Reading, error) {
+	lo, ok := LookupLufftLayout(layout)
+	if !ok {
+		lo, ok = LookupLufftLayout(d.Layout)
+	}
+
+	var l *Lufft
+	var err error
+	if ok {
+		l, err = lo.Decode(msg, loc, receivedAt)
+	} else {
+		l, err = DecodeLufft(msg, loc, receivedAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Reading{Obs: l.Obs, Health: &l.Health}, nil
+}
+
+func (LufftDriver) Fetch(ctx context.Context, stn Station) (*CurrentObservation, error) {
+	return nil, ErrNotSupported
+}
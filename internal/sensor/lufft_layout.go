@@ -0,0 +1,574 @@
+package sensor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Names of the built-in Lufft layouts, after their number of values.
+const (
+	Lufft19Key = "LUFFT19"
+	Lufft20Key = "LUFFT20"
+	Lufft23Key = "LUFFT23"
+	Lufft24Key = "LUFFT24"
+)
+
+const (
+	defaultLufftSeparator  = "+"
+	defaultLufftTimeFormat = "20060102/150405"
+
+	// LufftFieldTimestamp names the value holding the time of the reading.
+	LufftFieldTimestamp = "timestamp"
+)
+
+// LufftLayoutField describes one value of a Lufft message. A field without
+// a name is a filler: its value is ignored when decoding and Fill is
+// written in its place when encoding.
+type LufftLayoutField struct {
+	// Name is the json name of the StationObservation or StationHealth
+	// field the value goes to, e.g. "temp" or "vb1", or "timestamp".
+	Name string `json:"name"`
+	// Unit of the value in the message, e.g. "km/h". It sets the scale
+	// when Scale is zero.
+	Unit string `json:"unit"`
+	// Scale converts the value in the message into the stored unit. Zero
+	// means no conversion.
+	Scale float64 `json:"scale"`
+	// Suffix trailing the value, e.g. "#".
+	Suffix string `json:"suffix"`
+	// Fill is the value written for a filler.
+	Fill string `json:"fill"`
+	// Integer writes the value without decimals.
+	Integer bool `json:"integer"`
+	// KeepMissing keeps the missing value marker, 999.9, as a value.
+	KeepMissing bool `json:"keep_missing"`
+}
+
+// LufftLayout describes the values of a Lufft message, in order.
+type LufftLayout struct {
+	Name string `json:"name"`
+	// Separator between values. Defaults to "+".
+	Separator string `json:"separator"`
+	// TimeFormat of the timestamp value, as a Go time layout. Defaults to
+	// "20060102/150405".
+	TimeFormat string             `json:"time_format"`
+	Fields     []LufftLayoutField `json:"fields"`
+}
+
+// maxLufftValue bounds the magnitude of a value, well above anything a
+// sensor reads.
+const maxLufftValue = 1e6
+
+// LufftFieldError describes a value of a Lufft message rejected by the
+// decoder.
+type LufftFieldError struct {
+	// Position of the value in the message, from 0.
+	Position int `json:"position"`
+	// Field is the layout field of the value, e.g. "temp".
+	Field  string `json:"field"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+func (e LufftFieldError) Error() string {
+	return fmt.Sprintf("value %d (%s) %q: %s", e.Position, e.Field, e.Value, e.Reason)
+}
+
+// LufftParseError is returned for a Lufft message that cannot be decoded.
+// Either the message as a whole is rejected, with a Reason, or Fields lists
+// each rejected value.
+type LufftParseError struct {
+	// Layout tried, if any.
+	Layout string            `json:"layout,omitempty"`
+	Reason string            `json:"reason,omitempty"`
+	Fields []LufftFieldError `json:"fields,omitempty"`
+}
+
+func (e *LufftParseError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("invalid string")
+	if len(e.Layout) > 0 {
+		sb.WriteString(": layout " + e.Layout)
+	}
+	if len(e.Reason) > 0 {
+		sb.WriteString(": " + e.Reason)
+	}
+	for i, f := range e.Fields {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(f.Error())
+	}
+	return sb.String()
+}
+
+// lufftTarget points to the Lufft field a message value goes to.
+type lufftTarget struct {
+	float func(l *Lufft) **float32
+	int   func(l *Lufft) **int32
+	str   func(l *Lufft) *string
+}
+
+var lufftTargets = map[string]lufftTarget{
+	"pres":     {float: func(l *Lufft) **float32 { return &l.Obs.Pres }},
+	"rr":       {float: func(l *Lufft) **float32 { return &l.Obs.Rr }},
+	"rh":       {float: func(l *Lufft) **float32 { return &l.Obs.Rh }},
+	"temp":     {float: func(l *Lufft) **float32 { return &l.Obs.Temp }},
+	"td":       {float: func(l *Lufft) **float32 { return &l.Obs.Td }},
+	"wdir":     {float: func(l *Lufft) **float32 { return &l.Obs.Wdir }},
+	"wspd":     {float: func(l *Lufft) **float32 { return &l.Obs.Wspd }},
+	"wspdx":    {float: func(l *Lufft) **float32 { return &l.Obs.Wspdx }},
+	"srad":     {float: func(l *Lufft) **float32 { return &l.Obs.Srad }},
+	"mslp":     {float: func(l *Lufft) **float32 { return &l.Obs.Mslp }},
+	"hi":       {float: func(l *Lufft) **float32 { return &l.Obs.Hi }},
+	"wchill":   {float: func(l *Lufft) **float32 { return &l.Obs.Wchill }},
+	"vb1":      {float: func(l *Lufft) **float32 { return &l.Health.Vb1 }},
+	"vb2":      {float: func(l *Lufft) **float32 { return &l.Health.Vb2 }},
+	"curr":     {float: func(l *Lufft) **float32 { return &l.Health.Curr }},
+	"bp1":      {float: func(l *Lufft) **float32 { return &l.Health.Bp1 }},
+	"bp2":      {float: func(l *Lufft) **float32 { return &l.Health.Bp2 }},
+	"temp_arq": {float: func(l *Lufft) **float32 { return &l.Health.TempArq }},
+	"rh_arq":   {float: func(l *Lufft) **float32 { return &l.Health.RhArq }},
+	"ss":       {int: func(l *Lufft) **int32 { return &l.Health.Ss }},
+	"cm":       {str: func(l *Lufft) *string { return &l.Health.Cm }},
+	"fpm":      {str: func(l *Lufft) *string { return &l.Health.Fpm }},
+}
+
+// lufftUnitScales converts the units a logger may send into the stored ones.
+var lufftUnitScales = map[string]float64{
+	"m/s":  1,
+	"km/h": 1 / 3.6,
+	"kn":   1852.0 / 3600,
+	"mph":  0.44704,
+	"hpa":  1,
+	"kpa":  10,
+	"inhg": 33.8639,
+	"mm":   1,
+	"in":   25.4,
+}
+
+var (
+	lufftObsFields = []LufftLayoutField{
+		{Name: "temp"},
+		{Name: "rh"},
+		{Name: "pres", KeepMissing: true},
+		{Name: "wspd", Unit: "km/h"},
+		{Name: "wspdx", Unit: "km/h"},
+		{Name: "wdir"},
+		{Name: "srad"},
+		{Name: "td"},
+		{Name: "wchill"},
+		{Name: "rr", Scale: 0.2 * 6.0, Integer: true},
+	}
+	lufftFill = LufftLayoutField{Fill: "0"}
+)
+
+func newBuiltinLufftLayout(name, timeFormat string, extraFill bool, health ...LufftLayoutField) LufftLayout {
+	fields := []LufftLayoutField{lufftFill}
+	fields = append(fields, lufftObsFields[:5]...)
+	if extraFill {
+		fields = append(fields, lufftFill)
+	}
+	fields = append(fields, lufftObsFields[5:]...)
+	fields = append(fields, lufftFill)
+	fields = append(fields, health...)
+	fields = append(fields, LufftLayoutField{Name: LufftFieldTimestamp})
+
+	return LufftLayout{
+		Name:       name,
+		Separator:  defaultLufftSeparator,
+		TimeFormat: timeFormat,
+		Fields:     fields,
+	}
+}
+
+var (
+	lufftFullHealth = []LufftLayoutField{
+		{Name: "vb1"}, {Name: "vb2"}, {Name: "curr"}, {Name: "bp1"}, {Name: "bp2"},
+		{Name: "cm"}, {Name: "ss"}, {Name: "temp_arq"}, {Name: "rh_arq"}, {Name: "fpm"},
+	}
+
+	// lufftBuiltinLayouts are tried in turn, by number of values, when a
+	// message is decoded without a layout.
+	lufftBuiltinLayouts = []LufftLayout{
+		newBuiltinLufftLayout(Lufft19Key, "2006:01:02:15:04:05", false,
+			LufftLayoutField{Name: "temp_arq"}, LufftLayoutField{Name: "rh_arq"}, LufftLayoutField{Name: "ss"},
+			LufftLayoutField{Name: "vb1", Suffix: "#"}, LufftLayoutField{Name: "bp1"}, LufftLayoutField{Name: "fpm"}),
+		newBuiltinLufftLayout(Lufft20Key, "2006:01:02:15:04:05", true,
+			LufftLayoutField{Name: "ss"}, LufftLayoutField{Name: "vb1", Suffix: "#"}, LufftLayoutField{Name: "bp1"},
+			LufftLayoutField{Name: "temp_arq"}, LufftLayoutField{Name: "rh_arq"}, LufftLayoutField{Name: "fpm"}),
+		newBuiltinLufftLayout(Lufft23Key, defaultLufftTimeFormat, false, lufftFullHealth...),
+		newBuiltinLufftLayout(Lufft24Key, defaultLufftTimeFormat, true, lufftFullHealth...),
+	}
+)
+
+var (
+	lufftLayoutsMu sync.RWMutex
+	lufftLayouts   = make(map[string]LufftLayout)
+)
+
+func init() {
+	for _, layout := range lufftBuiltinLayouts {
+		if err := RegisterLufftLayout(layout); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterLufftLayout makes a layout available under its name, which is
+// matched against a station's logger_version. A driver decoding only that
+// layout is registered under the same name, to be picked through the
+// station's sms_system_type.
+func RegisterLufftLayout(layout LufftLayout) error {
+	layout.Name = normalizeKey(layout.Name)
+	if len(layout.Separator) == 0 {
+		layout.Separator = defaultLufftSeparator
+	}
+	if len(layout.TimeFormat) == 0 {
+		layout.TimeFormat = defaultLufftTimeFormat
+	}
+	if err := layout.validate(); err != nil {
+		return err
+	}
+
+	lufftLayoutsMu.Lock()
+	defer lufftLayoutsMu.Unlock()
+
+	if _, dup := lufftLayouts[layout.Name]; dup {
+		return fmt.Errorf("lufft layout %s already registered", layout.Name)
+	}
+	if _, dup := Lookup(layout.Name); dup {
+		return fmt.Errorf("driver %s already registered", layout.Name)
+	}
+	lufftLayouts[layout.Name] = layout
+	Register(layout.Name, LufftDriver{Layout: layout.Name})
+	return nil
+}
+
+// LookupLufftLayout returns the layout registered under name. Names are
+// case-insensitive.
+func LookupLufftLayout(name string) (LufftLayout, bool) {
+	lufftLayoutsMu.RLock()
+	defer lufftLayoutsMu.RUnlock()
+
+	l, ok := lufftLayouts[normalizeKey(name)]
+	return l, ok
+}
+
+// LufftLayouts returns the sorted names of the registered layouts.
+func LufftLayouts() []string {
+	lufftLayoutsMu.RLock()
+	defer lufftLayoutsMu.RUnlock()
+
+	names := make([]string, 0, len(lufftLayouts))
+	for k := range lufftLayouts {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadLufftLayouts registers the layouts of a json array.
+func LoadLufftLayouts(r io.Reader) error {
+	var layouts []LufftLayout
+	if err := json.NewDecoder(r).Decode(&layouts); err != nil {
+		return fmt.Errorf("cannot decode lufft layouts: %w", err)
+	}
+	for _, layout := range layouts {
+		if err := RegisterLufftLayout(layout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadLufftLayoutsFile registers the layouts of a json file.
+func LoadLufftLayoutsFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return LoadLufftLayouts(f)
+}
+
+func (layout LufftLayout) validate() error {
+	if len(layout.Name) == 0 {
+		return fmt.Errorf("lufft layout name is empty")
+	}
+	if len(layout.Fields) == 0 {
+		return fmt.Errorf("lufft layout %s has no fields", layout.Name)
+	}
+
+	nTimestamp := 0
+	for i, f := range layout.Fields {
+		if f.Name == LufftFieldTimestamp {
+			nTimestamp++
+			continue
+		}
+		if len(f.Name) == 0 {
+			continue
+		}
+		if _, ok := lufftTargets[f.Name]; !ok {
+			return fmt.Errorf("lufft layout %s: unknown field %d: %s", layout.Name, i, f.Name)
+		}
+		if f.Scale < 0 {
+			return fmt.Errorf("lufft layout %s: negative scale of %s", layout.Name, f.Name)
+		}
+		if _, ok := lufftUnitScales[strings.ToLower(f.Unit)]; len(f.Unit) > 0 && !ok {
+			return fmt.Errorf("lufft layout %s: unknown unit of %s: %s", layout.Name, f.Name, f.Unit)
+		}
+	}
+	if nTimestamp != 1 {
+		return fmt.Errorf("lufft layout %s must have one timestamp field", layout.Name)
+	}
+	return nil
+}
+
+func (f LufftLayoutField) scale() float64 {
+	if f.Scale > 0 {
+		return f.Scale
+	}
+	if s, ok := lufftUnitScales[strings.ToLower(f.Unit)]; ok {
+		return s
+	}
+	return 1
+}
+
+// unscale converts a stored value back into the unit of the message.
+func (f LufftLayoutField) unscale(v float32) float64 {
+	if f.Scale > 0 {
+		return float64(v / float32(f.Scale))
+	}
+	if s, ok := lufftUnitScales[strings.ToLower(f.Unit)]; ok {
+		return float64(v * float32(1/s))
+	}
+	return float64(v)
+}
+
+func (layout LufftLayout) split(msg string) (string, []string) {
+	msg = strings.ReplaceAll(msg, ">", "")
+	msg = strings.ReplaceAll(msg, "%20", layout.Separator)
+	msg = strings.TrimSpace(msg)
+	return msg, strings.Split(msg, layout.Separator)
+}
+
+// Decode parses a message of the layout, whose timestamp is in loc, received
+// at receivedAt. A nil loc means DefaultTimezone, and a zero receivedAt now.
+// A message with the wrong number of values or with rejected values returns
+// a *LufftParseError.
+func (layout LufftLayout) Decode(msg string, loc *time.Location, receivedAt time.Time) (*Lufft, error) {
+	msg, vals := layout.split(msg)
+	if len(vals) != len(layout.Fields) {
+		return nil, &LufftParseError{
+			Layout: layout.Name,
+			Reason: fmt.Sprintf("%d values, want %d", len(vals), len(layout.Fields)),
+		}
+	}
+	return layout.decode(msg, vals, loc, receivedAt)
+}
+
+func (layout LufftLayout) decode(msg string, vals []string, loc *time.Location, receivedAt time.Time) (*Lufft, error) {
+	if loc == nil {
+		loc = Location(DefaultTimezone)
+	}
+
+	l := new(Lufft)
+	var timestamp time.Time
+	var rejected []LufftFieldError
+	reject := func(i int, f LufftLayoutField, reason string) {
+		rejected = append(rejected, LufftFieldError{
+			Position: i,
+			Field:    f.Name,
+			Value:    vals[i],
+			Reason:   reason,
+		})
+	}
+
+	for i, f := range layout.Fields {
+		v := strings.TrimSuffix(vals[i], f.Suffix)
+		if f.Name == LufftFieldTimestamp {
+			if len(v) == 0 {
+				reject(i, f, "missing timestamp")
+				continue
+			}
+			timestamp = parseTimestampIn(v, loc, layout.TimeFormat)
+			if timestamp.IsZero() {
+				reject(i, f, "not a time of format "+layout.TimeFormat)
+			}
+			continue
+		}
+
+		t, ok := lufftTargets[f.Name]
+		if !ok || len(v) == 0 {
+			continue
+		}
+		switch {
+		case t.float != nil:
+			if reason := validateLufftFloat(v); len(reason) > 0 {
+				reject(i, f, reason)
+				continue
+			}
+			*t.float(l) = parseFloatWithCF(v, f.KeepMissing, float32(f.scale()))
+		case t.int != nil:
+			if _, err := strconv.ParseInt(v, 10, 32); err != nil {
+				reject(i, f, "not an integer")
+				continue
+			}
+			*t.int(l) = parseInt(v)
+		case t.str != nil:
+			*t.str(l) = v
+		}
+	}
+	if len(rejected) > 0 {
+		return nil, &LufftParseError{Layout: layout.Name, Fields: rejected}
+	}
+
+	l.setTimestamp(timestamp, receivedAt)
+	l.setDataStatus()
+	l.Health.Message = msg
+	return l, nil
+}
+
+// validateLufftFloat returns why v is not an acceptable value, or an empty
+// string.
+func validateLufftFloat(v string) string {
+	val, err := strconv.ParseFloat(v, 32)
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return "out of range"
+	case err != nil:
+		return "not a number"
+	case math.IsNaN(val) || math.IsInf(val, 0):
+		return "not a finite number"
+	case math.Abs(val) > maxLufftValue:
+		return "out of range"
+	}
+	return ""
+}
+
+// Encode writes l as a message of the layout, with its timestamp in loc. A
+// nil loc means DefaultTimezone.
+func (layout LufftLayout) Encode(l Lufft, loc *time.Location) string {
+	if loc == nil {
+		loc = Location(DefaultTimezone)
+	}
+
+	vals := make([]string, len(layout.Fields))
+	for i, f := range layout.Fields {
+		if f.Name == LufftFieldTimestamp {
+			vals[i] = l.Obs.Timestamp.In(loc).Format(layout.TimeFormat) + f.Suffix
+			continue
+		}
+
+		t, ok := lufftTargets[f.Name]
+		switch {
+		case !ok:
+			vals[i] = f.Fill
+			continue
+		case t.float != nil:
+			if p := *t.float(&l); p != nil {
+				v := f.unscale(*p)
+				if f.Integer {
+					vals[i] = strconv.Itoa(int(math.Round(v)))
+				} else {
+					vals[i] = fmt.Sprintf("%.2f", math.Round(v*100)/100)
+				}
+			}
+		case t.int != nil:
+			if p := *t.int(&l); p != nil {
+				vals[i] = strconv.Itoa(int(*p))
+			}
+		case t.str != nil:
+			vals[i] = *t.str(&l)
+		}
+		if len(vals[i]) > 0 {
+			vals[i] += f.Suffix
+		}
+	}
+
+	return strings.Join(vals, layout.Separator)
+}
+
+// DecodeLufft parses a message of one of the built-in layouts, picked by its
+// number of values, received at receivedAt, or now when zero. Its timestamp
+// is in loc, or DefaultTimezone when nil. A message it cannot decode returns
+// a *LufftParseError.
+func DecodeLufft(msg string, loc *time.Location, receivedAt time.Time) (*Lufft, error) {
+	var nVals []string
+	for _, layout := range lufftBuiltinLayouts {
+		normMsg, vals := layout.split(msg)
+		if len(vals) == len(layout.Fields) {
+			return layout.decode(normMsg, vals, loc, receivedAt)
+		}
+		nVals = append(nVals, strconv.Itoa(len(layout.Fields)))
+	}
+
+	_, vals := LufftLayout{Separator: defaultLufftSeparator}.split(msg)
+	return nil, &LufftParseError{
+		Reason: fmt.Sprintf("%d values, want one of %s", len(vals), strings.Join(nVals, ", ")),
+	}
+}
+
+// setTimestamp sets the time of the reading. A timestamp more than 90 days
+// behind or 1 day ahead of the time the message was received is replaced by
+// the latter, and the gap noted in the health. Timestamps within are kept, so
+// that a message delivered late is stored at its own time, and recognized
+// when delivered or replayed again.
+func (l *Lufft) setTimestamp(timestamp, receivedAt time.Time) {
+	timeNow := receivedAt
+	if timeNow.IsZero() {
+		timeNow = time.Now()
+	}
+	errMsg := ""
+	minutesDiff := 0.0
+
+	if !timestamp.IsZero() {
+		minutesDiff = timeNow.Sub(timestamp).Minutes()
+		if minutesDiff > maxMinutesBehind {
+			errMsg = fmt.Sprintf("timestamp is %f minutes behind", minutesDiff)
+			timestamp = timeNow
+		} else if minutesDiff < -maxMinutesAhead {
+			errMsg = fmt.Sprintf("timestamp is %f minutes ahead", math.Abs(minutesDiff))
+			timestamp = timeNow
+		}
+	}
+
+	l.Obs.Timestamp = timestamp
+	l.Health.Timestamp = timestamp
+	l.Health.MinutesDifference = int32(minutesDiff)
+	l.Health.ErrorMsg = errMsg
+}
+
+// setDataStatus counts the observation values present.
+func (l *Lufft) setDataStatus() {
+	dataCount := 0
+	dataStatus := ""
+	for _, v := range []*float32{
+		l.Obs.Temp, l.Obs.Rh, l.Obs.Pres, l.Obs.Wspd, l.Obs.Wspdx,
+		l.Obs.Wdir, l.Obs.Srad, l.Obs.Td, l.Obs.Wchill, l.Obs.Rr,
+	} {
+		b := 0
+		if v != nil {
+			dataCount++
+			b = 1
+		}
+
+		dataStatus += fmt.Sprintf("%d", b)
+	}
+
+	l.Health.DataCount = int32(dataCount)
+	l.Health.DataStatus = dataStatus
+}
@@ -0,0 +1,214 @@
+package sensor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLufftBuiltinLayouts(t *testing.T) {
+	for _, key := range []string{Lufft19Key, Lufft20Key, Lufft23Key, Lufft24Key} {
+		layout, ok := LookupLufftLayout(strings.ToLower(key))
+		require.True(t, ok)
+		require.Equal(t, key, layout.Name)
+		require.Contains(t, LufftLayouts(), key)
+
+		d, ok := Lookup(key)
+		require.True(t, ok)
+		require.Equal(t, LufftDriver{Layout: key}, d)
+	}
+
+	_, ok := LookupLufftLayout("unknown")
+	require.False(t, ok)
+}
+
+func TestRegisterLufftLayout(t *testing.T) {
+	testCases := []struct {
+		name   string
+		layout LufftLayout
+		check  func(err error)
+	}{
+		{
+			name: "OK",
+			layout: LufftLayout{
+				Name:       "test-knots",
+				Separator:  ";",
+				TimeFormat: "2006-01-02T15:04:05",
+				Fields: []LufftLayoutField{
+					{Name: LufftFieldTimestamp},
+					{Name: "temp"},
+					{Name: "wspd", Unit: "kn"},
+					{Fill: "X"},
+					{Name: "vb1", Suffix: "V"},
+				},
+			},
+			check: func(err error) {
+				require.NoError(t, err)
+				require.Contains(t, LufftLayouts(), "TEST-KNOTS")
+			},
+		},
+		{
+			name:   "EmptyName",
+			layout: LufftLayout{Fields: []LufftLayoutField{{Name: LufftFieldTimestamp}}},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:   "NoTimestamp",
+			layout: LufftLayout{Name: "test-no-timestamp", Fields: []LufftLayoutField{{Name: "temp"}}},
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "UnknownField",
+			layout: LufftLayout{Name: "test-unknown-field", Fields: []LufftLayoutField{
+				{Name: LufftFieldTimestamp}, {Name: "snow"},
+			}},
+			check: func(err error) {
+				require.ErrorContains(t, err, "unknown field")
+			},
+		},
+		{
+			name: "UnknownUnit",
+			layout: LufftLayout{Name: "test-unknown-unit", Fields: []LufftLayoutField{
+				{Name: LufftFieldTimestamp}, {Name: "wspd", Unit: "furlong/fortnight"},
+			}},
+			check: func(err error) {
+				require.ErrorContains(t, err, "unknown unit")
+			},
+		},
+		{
+			name:   "Duplicate",
+			layout: LufftLayout{Name: Lufft23Key, Fields: []LufftLayoutField{{Name: LufftFieldTimestamp}}},
+			check: func(err error) {
+				require.ErrorContains(t, err, "already registered")
+			},
+		},
+		{
+			name:   "DriverExists",
+			layout: LufftLayout{Name: DavisKey, Fields: []LufftLayoutField{{Name: LufftFieldTimestamp}}},
+			check: func(err error) {
+				require.ErrorContains(t, err, "already registered")
+				_, ok := LookupLufftLayout(DavisKey)
+				require.False(t, ok)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(RegisterLufftLayout(tc.layout))
+		})
+	}
+}
+
+func TestLufftLayoutEncodeDecode(t *testing.T) {
+	loc := Location("America/New_York")
+
+	err := LoadLufftLayouts(strings.NewReader(`[{
+		"name": "test-json",
+		"separator": ",",
+		"time_format": "2006:01:02:15:04:05",
+		"fields": [
+			{"name": "temp"},
+			{"name": "wspd", "unit": "mph"},
+			{"name": "rr", "scale": 0.5},
+			{"fill": "0"},
+			{"name": "ss"},
+			{"name": "vb1", "suffix": "#"},
+			{"name": "fpm"},
+			{"name": "timestamp"}
+		]
+	}]`))
+	require.NoError(t, err)
+
+	layout, ok := LookupLufftLayout("TEST-JSON")
+	require.True(t, ok)
+
+	lufft := RandomLufft(time.Now().Add(-time.Hour).Truncate(time.Second))
+	msg := layout.Encode(lufft, loc)
+	require.Len(t, strings.Split(msg, ","), 8)
+	require.Equal(t, "0", strings.Split(msg, ",")[3])
+	require.True(t, strings.HasSuffix(strings.Split(msg, ",")[5], "#"))
+
+	l, err := layout.Decode(msg, loc, time.Time{})
+	require.NoError(t, err)
+	require.InDelta(t, *lufft.Obs.Temp, *l.Obs.Temp, 0.01)
+	require.InDelta(t, *lufft.Obs.Wspd, *l.Obs.Wspd, 0.01)
+	require.InDelta(t, *lufft.Obs.Rr, *l.Obs.Rr, 0.01)
+	require.Equal(t, *lufft.Health.Ss, *l.Health.Ss)
+	require.InDelta(t, *lufft.Health.Vb1, *l.Health.Vb1, 0.01)
+	require.Equal(t, lufft.Health.Fpm, l.Health.Fpm)
+	require.True(t, lufft.Obs.Timestamp.Equal(l.Obs.Timestamp))
+	require.Nil(t, l.Obs.Pres)
+	require.Equal(t, int32(3), l.Health.DataCount)
+	require.Equal(t, msg, l.Health.Message)
+
+	_, err = layout.Decode(lufft.String(23), loc, time.Time{})
+	require.Error(t, err)
+
+	err = LoadLufftLayouts(strings.NewReader(`{"name": "test-object"}`))
+	require.Error(t, err)
+}
+
+func TestLufftDriverParseLayout(t *testing.T) {
+	lufft := RandomLufft(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	testCases := []struct {
+		name   string
+		driver LufftDriver
+		layout string
+		msg    string
+		check  func(r *Reading, err error)
+	}{
+		{
+			name:   "LoggerVersion",
+			layout: "lufft19",
+			msg:    lufft.String(19),
+			check: func(r *Reading, err error) {
+				require.NoError(t, err)
+				require.InDelta(t, *lufft.Health.Vb1, *r.Health.Vb1, 0.01)
+			},
+		},
+		{
+			name:   "LoggerVersionMismatch",
+			layout: Lufft19Key,
+			msg:    lufft.String(23),
+			check: func(r *Reading, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:   "UnknownLoggerVersion",
+			layout: "v1.2",
+			msg:    lufft.String(23),
+			check: func(r *Reading, err error) {
+				require.NoError(t, err)
+				require.InDelta(t, *lufft.Health.Vb2, *r.Health.Vb2, 0.01)
+			},
+		},
+		{
+			name:   "DriverLayout",
+			driver: LufftDriver{Layout: Lufft20Key},
+			layout: "v1.2",
+			msg:    lufft.String(23),
+			check: func(r *Reading, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(tc.driver.ParseLayout(tc.msg, tc.layout, nil, time.Time{}))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package sensor
 
 import (
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -134,6 +135,241 @@ func TestLufft(t *testing.T) {
 	}
 }
 
+func TestLufftTimezone(t *testing.T) {
+	timestamp := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	lufft := RandomLufft(timestamp)
+	msg := lufft.String(23)
+
+	l, err := DecodeLufft(msg, Location(DefaultTimezone), time.Time{})
+	require.NoError(t, err)
+	require.True(t, timestamp.Equal(l.Obs.Timestamp))
+	require.True(t, timestamp.Equal(l.Health.Timestamp))
+
+	// The same clock reading, from a logger running in UTC.
+	l, err = DecodeLufft(msg, Location("UTC"), time.Time{})
+	require.NoError(t, err)
+	require.True(t, timestamp.Add(8*time.Hour).Equal(l.Obs.Timestamp))
+	require.Equal(t, time.UTC, l.Obs.Timestamp.Location())
+
+	layout, ok := LookupLufftLayout(Lufft23Key)
+	require.True(t, ok)
+	require.Equal(t, msg, layout.Encode(lufft, nil))
+	require.NotEqual(t, msg, layout.Encode(lufft, time.UTC))
+}
+
+func TestLufftTimestampRange(t *testing.T) {
+	testCases := []struct {
+		name   string
+		offset time.Duration
+		kept   bool
+	}{
+		{name: "Now", kept: true},
+		{name: "DaysBehind", offset: -3 * 24 * time.Hour, kept: true},
+		{name: "HoursAhead", offset: 2 * time.Hour, kept: true},
+		{name: "MonthsBehind", offset: -100 * 24 * time.Hour},
+		{name: "DaysAhead", offset: 2 * 24 * time.Hour},
+	}
+
+	// Timestamps are checked against the time the message was received, as
+	// on a replay.
+	receivedAt := time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			timestamp := receivedAt.Add(tc.offset)
+			l, err := DecodeLufft(RandomLufft(timestamp).String(23), nil, receivedAt)
+			require.NoError(t, err)
+			require.Equal(t, int32(-tc.offset.Minutes()), l.Health.MinutesDifference)
+
+			if tc.kept {
+				require.True(t, timestamp.Equal(l.Obs.Timestamp))
+				require.Empty(t, l.Health.ErrorMsg)
+			} else {
+				require.True(t, receivedAt.Equal(l.Obs.Timestamp))
+				require.NotEmpty(t, l.Health.ErrorMsg)
+			}
+			require.True(t, l.Obs.Timestamp.Equal(l.Health.Timestamp))
+		})
+	}
+}
+
+func TestLufftParseError(t *testing.T) {
+	lufft := RandomLufft(time.Now().Add(-time.Hour).Truncate(time.Second))
+	withValues := func(nVal int, vals map[int]string) string {
+		parts := strings.Split(lufft.String(nVal), "+")
+		for i, v := range vals {
+			parts[i] = v
+		}
+		return strings.Join(parts, "+")
+	}
+
+	testCases := []struct {
+		name  string
+		msg   string
+		check func(l *Lufft, err error)
+	}{
+		{
+			name: "ValueCount",
+			msg:  "1+2+3",
+			check: func(l *Lufft, err error) {
+				var parseErr *LufftParseError
+				require.ErrorAs(t, err, &parseErr)
+				require.Nil(t, l)
+				require.Empty(t, parseErr.Layout)
+				require.Equal(t, "3 values, want one of 19, 20, 23, 24", parseErr.Reason)
+				require.Empty(t, parseErr.Fields)
+			},
+		},
+		{
+			name: "RejectedValues",
+			msg:  withValues(23, map[int]string{1: "abc", 3: "NaN", 7: "1e9", 18: "x", 22: "2024-03-10"}),
+			check: func(l *Lufft, err error) {
+				var parseErr *LufftParseError
+				require.ErrorAs(t, err, &parseErr)
+				require.Nil(t, l)
+				require.Equal(t, Lufft23Key, parseErr.Layout)
+				require.Equal(t, []LufftFieldError{
+					{Position: 1, Field: "temp", Value: "abc", Reason: "not a number"},
+					{Position: 3, Field: "pres", Value: "NaN", Reason: "not a finite number"},
+					{Position: 7, Field: "srad", Value: "1e9", Reason: "out of range"},
+					{Position: 18, Field: "ss", Value: "x", Reason: "not an integer"},
+					{Position: 22, Field: LufftFieldTimestamp, Value: "2024-03-10", Reason: "not a time of format 20060102/150405"},
+				}, parseErr.Fields)
+				require.Contains(t, err.Error(), `value 18 (ss) "x": not an integer`)
+			},
+		},
+		{
+			name: "MissingTimestamp",
+			msg:  withValues(19, map[int]string{18: ""}),
+			check: func(l *Lufft, err error) {
+				var parseErr *LufftParseError
+				require.ErrorAs(t, err, &parseErr)
+				require.Equal(t, []LufftFieldError{
+					{Position: 18, Field: LufftFieldTimestamp, Reason: "missing timestamp"},
+				}, parseErr.Fields)
+			},
+		},
+		{
+			name: "EmptyValues",
+			msg:  withValues(20, map[int]string{1: "", 13: "", 14: "#"}),
+			check: func(l *Lufft, err error) {
+				require.NoError(t, err)
+				require.Nil(t, l.Obs.Temp)
+				require.Nil(t, l.Health.Vb1)
+				require.Nil(t, l.Health.Ss)
+				require.Equal(t, int32(9), l.Health.DataCount)
+			},
+		},
+		{
+			name: "Fillers",
+			msg:  withValues(24, map[int]string{0: "junk", 6: "junk", 12: "junk"}),
+			check: func(l *Lufft, err error) {
+				require.NoError(t, err)
+				requireLufftEqual(t, lufft, *l)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(DecodeLufft(tc.msg, nil, time.Time{}))
+		})
+	}
+}
+
+func TestLufftRoundTrip(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		lufft := RandomLufft(time.Now().Add(-time.Hour).Truncate(time.Second))
+		for _, layout := range lufftBuiltinLayouts {
+			msg := lufft.String(len(layout.Fields))
+			l, err := DecodeLufft(msg, nil, time.Time{})
+			require.NoError(t, err, msg)
+			requireLufftRoundTrip(t, layout, lufft, *l)
+		}
+	}
+}
+
+func FuzzDecodeLufft(f *testing.F) {
+	for _, msg := range []string{
+		">0+27.4+84.2+1008.6+5.4+9.7+112.0+245.3+24.6+27.4+0+0+13.21+12.87+0.32+27.1+26.8+ONLINE+21+31.2+62.0+FW3.2+20240310/141000",
+		"0%2026.1%2091.0%201009.2%200.0%200.0%200.0%200.0%2024.6%2026.1%200%200%2012.95%2012.60%200.41%2025.0%2024.7%20ONLINE%2018%2029.8%2074.5%20FW3.2%2020240311/000000",
+		"0+25.8+93.1+999.9+999.9+999.9+0+999.9+0.0+24.6+25.8+3+0+12.88+12.51+0.29+24.0+23.9+ONLINE+16+28.1+80.2+FW3.3+20240311/061000",
+		"0+29.3+70.4+1007.9+2.1+4.6+201.0+612.8+23.4+29.3+0+0+30.1+57.3+25+13.10#+26.5+FW2.1+2024:03:10:12:00:00",
+		"0+24.2+96.0+1010.1+0.8+1.9+0+35.0+0.0+23.5+24.2+12+0+25+12.55#+21.0+27.6+88.0+FW2.4+2024:03:11:05:30:00",
+		"0+26.5+88.0+1008.0+3.2+6.1+0+90.0+150.2+24.3+26.5+0+0+13.00+12.70+0.35+26.0+25.7+ONLINE+20+30.0+70.0+FW3.4+20240310/091000",
+		"0+++++++++++++++++++++20240310/091000",
+		"0+a+b+c+d+e+f+g+h+i+j+k+l+m+n+o+p+q+r+s+t+u+v",
+		"",
+	} {
+		f.Add(msg)
+	}
+
+	f.Fuzz(func(t *testing.T, msg string) {
+		l, err := DecodeLufft(msg, nil, time.Time{})
+		if err != nil {
+			var parseErr *LufftParseError
+			require.ErrorAs(t, err, &parseErr)
+			require.Nil(t, l)
+			return
+		}
+
+		nVal := len(strings.Split(l.Health.Message, defaultLufftSeparator))
+		msg2 := l.String(nVal)
+		l2, err := DecodeLufft(msg2, nil, time.Time{})
+		require.NoError(t, err, msg2)
+
+		for _, layout := range lufftBuiltinLayouts {
+			if len(layout.Fields) == nVal {
+				requireLufftRoundTrip(t, layout, *l, *l2)
+			}
+		}
+	})
+}
+
+// requireLufftRoundTrip checks that l2, decoded from a message of layout
+// encoding l, holds the values of l up to the precision of the message.
+func requireLufftRoundTrip(t *testing.T, layout LufftLayout, l, l2 Lufft) {
+	for _, f := range layout.Fields {
+		tgt, ok := lufftTargets[f.Name]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case tgt.float != nil:
+			want, got := *tgt.float(&l), *tgt.float(&l2)
+			if want == nil {
+				require.Nil(t, got, f.Name)
+				continue
+			}
+			if got == nil && !f.KeepMissing {
+				// Rounded into the missing value marker.
+				require.InDelta(t, missingValue, f.unscale(*want), 0.01, f.Name)
+				continue
+			}
+			require.NotNil(t, got, f.Name)
+			delta := 0.01 + 0.01*f.scale()
+			if f.Integer {
+				delta = 0.01 + f.scale()/2
+			}
+			delta += 1e-6 * math.Abs(float64(*want))
+			require.InDelta(t, *want, *got, delta, f.Name)
+		case tgt.int != nil:
+			require.Equal(t, *tgt.int(&l), *tgt.int(&l2), f.Name)
+		case tgt.str != nil:
+			require.Equal(t, *tgt.str(&l), *tgt.str(&l2), f.Name)
+		}
+	}
+
+	if len(l2.Health.ErrorMsg) == 0 {
+		require.True(t, l.Obs.Timestamp.Truncate(time.Second).Equal(l2.Obs.Timestamp))
+	}
+}
+
 func requireLufftEqual(t *testing.T, l, l2 Lufft) {
 	require.InDelta(t, *l.Obs.Temp, *l2.Obs.Temp, 0.01, "Temp value mismatch")
 	require.InDelta(t, *l.Obs.Rh, *l2.Obs.Rh, 0.01, "Rh value mismatch")
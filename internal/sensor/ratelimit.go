@@ -0,0 +1,114 @@
+package sensor
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultHostRate is the number of requests per second sent to a host.
+	defaultHostRate = 2
+	// defaultHostBurst is the number of requests sent to a host at once.
+	defaultHostBurst = 4
+)
+
+// tokenBucket hands out up to burst tokens at once, refilled at rate tokens
+// per second.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done. Tokens are reserved
+// in call order so waiting callers are served first come, first served.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// RateLimitedClient is a Fetcher spacing out the requests sent to each host
+// with a token bucket, so that polling many stations of the same cloud
+// service does not trip its rate limit.
+type RateLimitedClient struct {
+	client  Fetcher
+	rate    float64
+	burst   int
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewRateLimitedClient creates a client sending at most rate requests per
+// second, and burst at once, to each host.
+func NewRateLimitedClient(client Fetcher, rate float64, burst int) *RateLimitedClient {
+	return &RateLimitedClient{
+		client:  client,
+		rate:    rate,
+		burst:   burst,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+func (c *RateLimitedClient) bucket(host string) *tokenBucket {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buckets[host]
+	if !ok {
+		b = newTokenBucket(c.rate, c.burst)
+		c.buckets[host] = b
+	}
+	return b
+}
+
+// Do waits for the host of req to accept a request, or for the request
+// context to be done, then sends it.
+func (c *RateLimitedClient) Do(req *http.Request) (*http.Response, error) {
+	if err := c.bucket(req.URL.Host).wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
+
+func (c *RateLimitedClient) Get(url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
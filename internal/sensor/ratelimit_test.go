@@ -0,0 +1,96 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	mocksensor "github.com/emiliogozo/panahon-api-go/internal/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitedClient(t *testing.T) {
+	testCases := []struct {
+		name       string
+		rate       float64
+		burst      int
+		hosts      []string
+		timeout    time.Duration
+		buildStubs func(client *mocksensor.MockFetcher)
+		check      func(elapsed time.Duration, errs []error)
+	}{
+		{
+			name:  "SameHost",
+			rate:  20,
+			burst: 2,
+			hosts: []string{"a", "a", "a", "a", "a", "a"},
+			buildStubs: func(client *mocksensor.MockFetcher) {
+				client.EXPECT().Do(mock.Anything).Times(6).Return(&http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			check: func(elapsed time.Duration, errs []error) {
+				require.Empty(t, errs)
+				// 2 requests at once, then 4 more at 20 per second
+				require.GreaterOrEqual(t, elapsed, 150*time.Millisecond)
+			},
+		},
+		{
+			name:  "DifferentHosts",
+			rate:  1,
+			burst: 1,
+			hosts: []string{"a", "b", "c", "d", "e", "f"},
+			buildStubs: func(client *mocksensor.MockFetcher) {
+				client.EXPECT().Do(mock.Anything).Times(6).Return(&http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			check: func(elapsed time.Duration, errs []error) {
+				require.Empty(t, errs)
+				require.Less(t, elapsed, 500*time.Millisecond)
+			},
+		},
+		{
+			name:    "ContextDone",
+			rate:    1,
+			burst:   1,
+			hosts:   []string{"a", "a", "a"},
+			timeout: 50 * time.Millisecond,
+			buildStubs: func(client *mocksensor.MockFetcher) {
+				client.EXPECT().Do(mock.Anything).Once().Return(&http.Response{StatusCode: http.StatusOK}, nil)
+			},
+			check: func(elapsed time.Duration, errs []error) {
+				require.Len(t, errs, 2)
+				for _, err := range errs {
+					require.ErrorIs(t, err, context.DeadlineExceeded)
+				}
+				require.Less(t, elapsed, 500*time.Millisecond)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := mocksensor.NewMockFetcher(t)
+			tc.buildStubs(fetcher)
+			client := NewRateLimitedClient(fetcher, tc.rate, tc.burst)
+
+			var errs []error
+			start := time.Now()
+			for _, host := range tc.hosts {
+				ctx := context.Background()
+				if tc.timeout > 0 {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+					defer cancel()
+				}
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s.example.com/", host), nil)
+				require.NoError(t, err)
+				if _, err := client.Do(req); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			tc.check(time.Since(start), errs)
+		})
+	}
+}
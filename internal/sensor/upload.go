@@ -0,0 +1,121 @@
+package sensor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// uploadTimeLayout is the layout of the dateutc value of the uploads, in UTC.
+const uploadTimeLayout = "2006-01-02 15:04:05"
+
+// ErrEmptyUpload is returned for an upload without any observation value.
+var ErrEmptyUpload = errors.New("no observation values")
+
+// UploadObservation is an observation uploaded by a consumer station through
+// the Wunderground or the Ecowitt protocol, in metric units. Only Ecowitt
+// stations send the station pressure, Pres.
+type UploadObservation struct {
+	CurrentObservation
+}
+
+type uploadField struct {
+	// keys of the value, the first present wins.
+	keys  []string
+	cf    func(float64) float64
+	valid func(float64) bool
+}
+
+// uploadFields maps the upload values, in imperial units, to the
+// observation. Both protocols name most values alike; the rain of the past
+// hour of Wunderground stands for the rain rate.
+var uploadFields = map[string]uploadField{
+	"temp":       {keys: []string{"tempf"}, cf: fToC},
+	"td":         {keys: []string{"dewptf"}, cf: fToC},
+	"rh":         {keys: []string{"humidity"}, valid: inRange(0, 100)},
+	"wdir":       {keys: []string{"winddir"}, valid: inRange(0, 360)},
+	"wspd":       {keys: []string{"windspeedmph"}, cf: mphToMps, valid: nonNegative},
+	"gust":       {keys: []string{"windgustmph"}, cf: mphToMps, valid: nonNegative},
+	"rain":       {keys: []string{"rainratein", "rainin"}, cf: inToMm, valid: nonNegative},
+	"rain_accum": {keys: []string{"dailyrainin"}, cf: inToMm, valid: nonNegative},
+	"srad":       {keys: []string{"solarradiation"}, valid: nonNegative},
+	"uvi":        {keys: []string{"UV", "uv"}, valid: nonNegative},
+	"mslp":       {keys: []string{"baromin", "baromrelin"}, cf: inHgToHPa},
+	"pres":       {keys: []string{"baromabsin"}, cf: inHgToHPa},
+}
+
+func fToC(f float64) float64      { return (f - 32.0) * (5.0 / 9.0) }
+func mphToMps(f float64) float64  { return f * 0.44704 }
+func inToMm(f float64) float64    { return f * 25.4 }
+func inHgToHPa(f float64) float64 { return f * 33.8639 }
+
+func nonNegative(f float64) bool { return f >= 0 }
+
+func inRange(lo, hi float64) func(float64) bool {
+	return func(f float64) bool { return f >= lo && f <= hi }
+}
+
+// NewUploadObservation converts the values of a Wunderground
+// updateweatherstation.php request, or of an Ecowitt upload. A dateutc of
+// "now", or none, stands for now. Values that are not numbers, or the -9999
+// and -999 of a missing sensor, are left empty.
+func NewUploadObservation(values url.Values, now time.Time) (*UploadObservation, error) {
+	obs := new(UploadObservation)
+
+	switch dateutc := values.Get("dateutc"); dateutc {
+	case "", "now":
+		obs.Timestamp = pgtype.Timestamptz{Time: now.Truncate(time.Second), Valid: true}
+	default:
+		dt, err := time.ParseInLocation(uploadTimeLayout, dateutc, time.UTC)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dateutc %q", dateutc)
+		}
+		obs.Timestamp = pgtype.Timestamptz{Time: dt, Valid: true}
+	}
+
+	var count int
+	value := func(name string) pgtype.Float4 {
+		field := uploadFields[name]
+		for _, k := range field.keys {
+			if !values.Has(k) {
+				continue
+			}
+			f, err := strconv.ParseFloat(values.Get(k), 64)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) || f <= -999 {
+				return pgtype.Float4{}
+			}
+			if field.valid != nil && !field.valid(f) {
+				return pgtype.Float4{}
+			}
+			if field.cf != nil {
+				f = field.cf(f)
+			}
+			count++
+			return pgtype.Float4{Float32: float32(f), Valid: true}
+		}
+		return pgtype.Float4{}
+	}
+
+	obs.Temp = value("temp")
+	obs.Td = value("td")
+	obs.Rh = value("rh")
+	obs.Wdir = value("wdir")
+	obs.Wspd = value("wspd")
+	obs.Gust = value("gust")
+	obs.Rain = value("rain")
+	obs.RainAccum = value("rain_accum")
+	obs.Srad = value("srad")
+	obs.Uvi = value("uvi")
+	obs.Mslp = value("mslp")
+	obs.Pres = value("pres")
+
+	if count == 0 {
+		return nil, ErrEmptyUpload
+	}
+	return obs, nil
+}
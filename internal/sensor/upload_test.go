@@ -0,0 +1,131 @@
+package sensor
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUploadObservation(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+
+	testCases := []struct {
+		name   string
+		values url.Values
+		check  func(t *testing.T, obs *UploadObservation, err error)
+	}{
+		{
+			name: "Wunderground",
+			values: url.Values{
+				"ID":             {"IPANAHON12"},
+				"PASSWORD":       {"secret"},
+				"action":         {"updateraw"},
+				"dateutc":        {"2024-01-02 01:00:00"},
+				"tempf":          {"86"},
+				"dewptf":         {"77"},
+				"humidity":       {"75"},
+				"winddir":        {"270"},
+				"windspeedmph":   {"10"},
+				"windgustmph":    {"20"},
+				"rainin":         {"0.5"},
+				"dailyrainin":    {"2"},
+				"baromin":        {"29.92"},
+				"solarradiation": {"500.5"},
+				"UV":             {"7"},
+			},
+			check: func(t *testing.T, obs *UploadObservation, err error) {
+				require.NoError(t, err)
+				require.Equal(t, time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC), obs.Timestamp.Time)
+				require.InDelta(t, 30.0, obs.Temp.Float32, 0.001)
+				require.InDelta(t, 25.0, obs.Td.Float32, 0.001)
+				require.InDelta(t, 75.0, obs.Rh.Float32, 0.001)
+				require.InDelta(t, 270.0, obs.Wdir.Float32, 0.001)
+				require.InDelta(t, 4.4704, obs.Wspd.Float32, 0.001)
+				require.InDelta(t, 8.9408, obs.Gust.Float32, 0.001)
+				require.InDelta(t, 12.7, obs.Rain.Float32, 0.001)
+				require.InDelta(t, 50.8, obs.RainAccum.Float32, 0.001)
+				require.InDelta(t, 1013.21, obs.Mslp.Float32, 0.01)
+				require.InDelta(t, 500.5, obs.Srad.Float32, 0.001)
+				require.InDelta(t, 7.0, obs.Uvi.Float32, 0.001)
+				require.False(t, obs.Pres.Valid)
+			},
+		},
+		{
+			name: "Ecowitt",
+			values: url.Values{
+				"PASSKEY":     {"7A6D1F3E0C2B4A5968778695A4B3C2D1"},
+				"stationtype": {"GW1100A_V2.1.4"},
+				"dateutc":     {"2024-01-02 03:00:00"},
+				"tempf":       {"50"},
+				"humidity":    {"60"},
+				"rainratein":  {"0.1"},
+				"rainin":      {"0.2"},
+				"baromrelin":  {"30.00"},
+				"baromabsin":  {"29.50"},
+				"uv":          {"2"},
+			},
+			check: func(t *testing.T, obs *UploadObservation, err error) {
+				require.NoError(t, err)
+				require.InDelta(t, 10.0, obs.Temp.Float32, 0.001)
+				require.InDelta(t, 2.54, obs.Rain.Float32, 0.001)
+				require.InDelta(t, 1015.92, obs.Mslp.Float32, 0.01)
+				require.InDelta(t, 998.99, obs.Pres.Float32, 0.01)
+				require.InDelta(t, 2.0, obs.Uvi.Float32, 0.001)
+				require.False(t, obs.Wspd.Valid)
+			},
+		},
+		{
+			name:   "Now",
+			values: url.Values{"dateutc": {"now"}, "tempf": {"86"}},
+			check: func(t *testing.T, obs *UploadObservation, err error) {
+				require.NoError(t, err)
+				require.Equal(t, now.Truncate(time.Second), obs.Timestamp.Time)
+			},
+		},
+		{
+			name: "MissingValues",
+			values: url.Values{
+				"tempf":        {"-9999"},
+				"humidity":     {"101"},
+				"winddir":      {"-1"},
+				"windspeedmph": {"NaN"},
+				"dailyrainin":  {""},
+				"baromin":      {"29.92"},
+			},
+			check: func(t *testing.T, obs *UploadObservation, err error) {
+				require.NoError(t, err)
+				require.False(t, obs.Temp.Valid)
+				require.False(t, obs.Rh.Valid)
+				require.False(t, obs.Wdir.Valid)
+				require.False(t, obs.Wspd.Valid)
+				require.False(t, obs.RainAccum.Valid)
+				require.True(t, obs.Mslp.Valid)
+			},
+		},
+		{
+			name:   "NoValues",
+			values: url.Values{"ID": {"IPANAHON12"}, "tempf": {"-999"}},
+			check: func(t *testing.T, obs *UploadObservation, err error) {
+				require.ErrorIs(t, err, ErrEmptyUpload)
+			},
+		},
+		{
+			name:   "InvalidDate",
+			values: url.Values{"dateutc": {"2024-01-02T03:00:00Z"}, "tempf": {"86"}},
+			check: func(t *testing.T, obs *UploadObservation, err error) {
+				require.ErrorContains(t, err, "invalid dateutc")
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			obs, err := NewUploadObservation(tc.values, now)
+			tc.check(t, obs, err)
+		})
+	}
+}
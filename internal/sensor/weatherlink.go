@@ -0,0 +1,362 @@
+package sensor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	weatherLinkBaseUrl = "https://api.weatherlink.com/v2"
+	// weatherLinkMaxHistoricWindow is the longest time range of a historic request.
+	weatherLinkMaxHistoricWindow = 24 * time.Hour
+)
+
+// WeatherLink is a client of the WeatherLink v2 API.
+type WeatherLink struct {
+	BaseUrl   string
+	ApiKey    string
+	ApiSecret string
+	client    Fetcher
+	now       func() time.Time
+}
+
+type WeatherLinkStation struct {
+	StationID   int64   `json:"station_id"`
+	StationName string  `json:"station_name"`
+	Timezone    string  `json:"time_zone"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Elevation   float64 `json:"elevation"`
+}
+
+type WeatherLinkSensor struct {
+	Lsid              int64 `json:"lsid"`
+	SensorType        int32 `json:"sensor_type"`
+	DataStructureType int32 `json:"data_structure_type"`
+	StationID         int64 `json:"station_id"`
+}
+
+type weatherLinkSensorData struct {
+	Lsid              int64            `json:"lsid"`
+	SensorType        int32            `json:"sensor_type"`
+	DataStructureType int32            `json:"data_structure_type"`
+	Data              []map[string]any `json:"data"`
+}
+
+type weatherLinkCurrentResponse struct {
+	StationID int64                   `json:"station_id"`
+	Sensors   []weatherLinkSensorData `json:"sensors"`
+	Generated int64                   `json:"generated_at"`
+}
+
+// NewWeatherLink creates a new WeatherLink client. A default HTTP client is used when client is nil.
+func NewWeatherLink(apiKey, apiSecret string, client Fetcher) *WeatherLink {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WeatherLink{
+		BaseUrl:   weatherLinkBaseUrl,
+		ApiKey:    apiKey,
+		ApiSecret: apiSecret,
+		client:    client,
+		now:       time.Now,
+	}
+}
+
+// signature computes the api-signature of a request: the HMAC-SHA256, keyed
+// with the api secret, of every parameter name and value concatenated in
+// parameter name order.
+func (w *WeatherLink) signature(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for k := range params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, k := range names {
+		sb.WriteString(k)
+		sb.WriteString(params[k])
+	}
+
+	mac := hmac.New(sha256.New, []byte(w.ApiSecret))
+	mac.Write([]byte(sb.String()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// get sends a signed request. pathParams are appended to the path in the
+// given order and queryParams to the query; both are part of the signature.
+func (w *WeatherLink) get(ctx context.Context, endpoint string, pathParams [][2]string, queryParams map[string]string, out any) error {
+	params := map[string]string{
+		"api-key": w.ApiKey,
+		"t":       strconv.FormatInt(w.now().Unix(), 10),
+	}
+	path := strings.TrimRight(w.BaseUrl, "/") + "/" + endpoint
+	for _, p := range pathParams {
+		params[p[0]] = p[1]
+		path += "/" + url.PathEscape(p[1])
+	}
+
+	query := url.Values{
+		"api-key": {params["api-key"]},
+		"t":       {params["t"]},
+	}
+	for k, v := range queryParams {
+		params[k] = v
+		query.Set(k, v)
+	}
+	query.Set("api-signature", w.signature(params))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("weatherlink %s: %s", endpoint, res.Status)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// Stations lists the stations the api key has access to.
+func (w *WeatherLink) Stations(ctx context.Context) ([]WeatherLinkStation, error) {
+	var res struct {
+		Stations []WeatherLinkStation `json:"stations"`
+	}
+	if err := w.get(ctx, "stations", nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Stations, nil
+}
+
+// Sensors lists the sensors of the stations the api key has access to.
+func (w *WeatherLink) Sensors(ctx context.Context) ([]WeatherLinkSensor, error) {
+	var res struct {
+		Sensors []WeatherLinkSensor `json:"sensors"`
+	}
+	if err := w.get(ctx, "sensors", nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Sensors, nil
+}
+
+// Current returns the current conditions of a station. When stationID is
+// empty, the only station of the account is used.
+func (w *WeatherLink) Current(ctx context.Context, stationID string) (*CurrentObservation, error) {
+	stationID, err := w.resolveStationID(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res weatherLinkCurrentResponse
+	if err := w.get(ctx, "current", [][2]string{{"station-id", stationID}}, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return newWeatherLinkObservation(res), nil
+}
+
+// Historic returns the archive records of a station from start, exclusive,
+// to end, inclusive, one observation per record in timestamp order. The API
+// serves at most a day of records per request so longer windows are split.
+func (w *WeatherLink) Historic(ctx context.Context, stationID string, start, end time.Time) ([]CurrentObservation, error) {
+	stationID, err := w.resolveStationID(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var obs []CurrentObservation
+	for from := start; from.Before(end); from = from.Add(weatherLinkMaxHistoricWindow) {
+		to := from.Add(weatherLinkMaxHistoricWindow)
+		if to.After(end) {
+			to = end
+		}
+
+		var res weatherLinkCurrentResponse
+		err := w.get(ctx, "historic", [][2]string{{"station-id", stationID}}, map[string]string{
+			"start-timestamp": strconv.FormatInt(from.Unix(), 10),
+			"end-timestamp":   strconv.FormatInt(to.Unix(), 10),
+		}, &res)
+		if err != nil {
+			return obs, err
+		}
+		obs = append(obs, newWeatherLinkArchive(res)...)
+	}
+
+	return obs, nil
+}
+
+// resolveStationID returns stationID, or the id of the only station of the
+// account when stationID is empty.
+func (w *WeatherLink) resolveStationID(ctx context.Context, stationID string) (string, error) {
+	if len(stationID) > 0 {
+		return stationID, nil
+	}
+
+	stations, err := w.Stations(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(stations) != 1 {
+		return "", fmt.Errorf("weatherlink station id is required, account has %d stations", len(stations))
+	}
+	return strconv.FormatInt(stations[0].StationID, 10), nil
+}
+
+// weatherLinkFields lists, for each observation variable, the data keys used
+// by the different data structure types, e.g. the WeatherLink Live ISS record
+// and the Vantage console record, current conditions first then archive.
+var weatherLinkFields = struct {
+	temp, rh, td, hi, wchill, wdir, wspd, gust, rain, rainAccum, srad, uvi, pres, mslp, et []string
+	thwi, senergy, uvdose, hdd, cdd                                                        []string
+}{
+	temp:      []string{"temp", "temp_out", "temp_last", "temp_avg"},
+	rh:        []string{"hum", "hum_out", "hum_last"},
+	td:        []string{"dew_point", "dew_point_out", "dew_point_last"},
+	hi:        []string{"heat_index", "heat_index_out", "heat_index_last"},
+	wchill:    []string{"wind_chill", "wind_chill_last"},
+	wdir:      []string{"wind_dir_last", "wind_dir", "wind_dir_of_prevail"},
+	wspd:      []string{"wind_speed_last", "wind_speed", "wind_speed_avg"},
+	gust:      []string{"wind_speed_hi_last_10_min", "wind_gust_10_min", "wind_speed_hi"},
+	rain:      []string{"rain_rate_last_mm", "rain_rate_mm", "rain_rate_hi_mm"},
+	rainAccum: []string{"rainfall_daily_mm", "rain_day_mm"},
+	srad:      []string{"solar_rad", "solar_rad_avg"},
+	uvi:       []string{"uv_index", "uv", "uv_index_avg"},
+	pres:      []string{"bar_absolute", "abs_press"},
+	mslp:      []string{"bar_sea_level", "bar"},
+	et:        []string{"et_day"},
+	thwi:      []string{"thw_index", "thw_index_last"},
+	senergy:   []string{"solar_energy"},
+	uvdose:    []string{"uv_dose"},
+	hdd:       []string{"hdd"},
+	cdd:       []string{"cdd"},
+}
+
+// newWeatherLinkObservation merges the latest record of every sensor into a
+// single observation.
+func newWeatherLinkObservation(res weatherLinkCurrentResponse) *CurrentObservation {
+	records := make([]map[string]any, 0, len(res.Sensors))
+	var ts int64
+	for _, s := range res.Sensors {
+		if len(s.Data) == 0 {
+			continue
+		}
+		rec := s.Data[len(s.Data)-1]
+		records = append(records, rec)
+		if v, ok := weatherLinkNumber(rec, "ts"); ok && int64(v) > ts {
+			ts = int64(v)
+		}
+	}
+	if ts == 0 {
+		ts = res.Generated
+	}
+
+	return newWeatherLinkRecordObservation(records, ts)
+}
+
+// newWeatherLinkArchive merges the archive records of every sensor sharing
+// the same timestamp into one observation each, in timestamp order.
+func newWeatherLinkArchive(res weatherLinkCurrentResponse) []CurrentObservation {
+	recordsByTs := make(map[int64][]map[string]any)
+	for _, s := range res.Sensors {
+		for _, rec := range s.Data {
+			v, ok := weatherLinkNumber(rec, "ts")
+			if !ok {
+				continue
+			}
+			recordsByTs[int64(v)] = append(recordsByTs[int64(v)], rec)
+		}
+	}
+
+	tss := make([]int64, 0, len(recordsByTs))
+	for ts := range recordsByTs {
+		tss = append(tss, ts)
+	}
+	sort.Slice(tss, func(i, j int) bool { return tss[i] < tss[j] })
+
+	obs := make([]CurrentObservation, len(tss))
+	for i, ts := range tss {
+		obs[i] = *newWeatherLinkRecordObservation(recordsByTs[ts], ts)
+	}
+	return obs
+}
+
+// newWeatherLinkRecordObservation maps records into an observation taken at
+// ts. The first record reporting a variable wins.
+func newWeatherLinkRecordObservation(records []map[string]any, ts int64) *CurrentObservation {
+	value := func(keys []string, cf func(float64) float64) pgtype.Float4 {
+		for _, rec := range records {
+			if v, ok := weatherLinkNumber(rec, keys...); ok {
+				if cf != nil {
+					v = cf(v)
+				}
+				return pgtype.Float4{Float32: float32(v), Valid: true}
+			}
+		}
+		return pgtype.Float4{}
+	}
+
+	fToC := func(f float64) float64 { return (f - 32.0) * (5.0 / 9.0) }
+	mphToMps := func(f float64) float64 { return f * 0.44704 }
+	inHgToHPa := func(f float64) float64 { return f * 33.8639 }
+	inToMm := func(f float64) float64 { return f * 25.4 }
+	fDaysToCDays := func(f float64) float64 { return f * (5.0 / 9.0) }
+
+	fields := weatherLinkFields
+	obs := &CurrentObservation{
+		Temp:      value(fields.temp, fToC),
+		Rh:        value(fields.rh, nil),
+		Td:        value(fields.td, fToC),
+		Hi:        value(fields.hi, fToC),
+		Wchill:    value(fields.wchill, fToC),
+		Wdir:      value(fields.wdir, nil),
+		Wspd:      value(fields.wspd, mphToMps),
+		Gust:      value(fields.gust, mphToMps),
+		Rain:      value(fields.rain, nil),
+		RainAccum: value(fields.rainAccum, nil),
+		Srad:      value(fields.srad, nil),
+		Uvi:       value(fields.uvi, nil),
+		Pres:      value(fields.pres, inHgToHPa),
+		Mslp:      value(fields.mslp, inHgToHPa),
+		Et:        value(fields.et, inToMm),
+		Thwi:      value(fields.thwi, fToC),
+		Senergy:   value(fields.senergy, nil),
+		Uvdose:    value(fields.uvdose, nil),
+		Hdd:       value(fields.hdd, fDaysToCDays),
+		Cdd:       value(fields.cdd, fDaysToCDays),
+	}
+	if ts > 0 {
+		obs.Timestamp = pgtype.Timestamptz{Time: time.Unix(ts, 0), Valid: true}
+	}
+
+	return obs
+}
+
+// weatherLinkNumber returns the first of keys holding a number in rec.
+func weatherLinkNumber(rec map[string]any, keys ...string) (float64, bool) {
+	for _, k := range keys {
+		if v, ok := rec[k].(float64); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
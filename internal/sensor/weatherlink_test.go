@@ -0,0 +1,281 @@
+package sensor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApiKey    = "987654321"
+	testApiSecret = "ABC123"
+)
+
+// newTestWeatherLinkServer is a stand-in for the WeatherLink v2 API that
+// rejects requests with an invalid api-signature.
+func newTestWeatherLinkServer(t *testing.T, stations []WeatherLinkStation, current map[string]any) *httptest.Server {
+	wl := &WeatherLink{ApiSecret: testApiSecret}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		params := map[string]string{
+			"api-key": q.Get("api-key"),
+			"t":       q.Get("t"),
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v2/")
+		for _, endpoint := range []string{"current", "historic"} {
+			if id, ok := strings.CutPrefix(path, endpoint+"/"); ok {
+				params["station-id"] = id
+				path = endpoint
+			}
+		}
+		for _, k := range []string{"start-timestamp", "end-timestamp"} {
+			if q.Has(k) {
+				params[k] = q.Get(k)
+			}
+		}
+		if q.Get("api-key") != testApiKey || q.Get("api-signature") != wl.signature(params) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var body any
+		switch path {
+		case "stations":
+			body = map[string]any{"stations": stations}
+		case "sensors":
+			body = map[string]any{"sensors": []WeatherLinkSensor{{Lsid: 1, SensorType: 45, DataStructureType: 10, StationID: stations[0].StationID}}}
+		case "current":
+			body = current
+		case "historic":
+			start, _ := strconv.ParseInt(q.Get("start-timestamp"), 10, 64)
+			end, _ := strconv.ParseInt(q.Get("end-timestamp"), 10, 64)
+			if end-start > int64(weatherLinkMaxHistoricWindow.Seconds()) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body = randomWeatherLinkHistoric(start, end)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(body))
+	}))
+}
+
+// randomWeatherLinkHistoric returns WeatherLink Live ISS and barometer
+// archive records every 15 minutes from start, exclusive, to end, inclusive.
+func randomWeatherLinkHistoric(start, end int64) map[string]any {
+	var iss, bar []map[string]any
+	for ts := start - start%900 + 900; ts <= end; ts += 900 {
+		iss = append(iss, map[string]any{
+			"ts":                  ts,
+			"temp_last":           util.RandomFloat[float64](70, 95),
+			"hum_last":            util.RandomFloat[float64](50, 100),
+			"dew_point_last":      util.RandomFloat[float64](60, 80),
+			"wind_dir_of_prevail": util.RandomFloat[float64](0, 360),
+			"wind_speed_avg":      util.RandomFloat[float64](0, 20),
+			"wind_speed_hi":       util.RandomFloat[float64](20, 40),
+			"rain_rate_hi_mm":     util.RandomFloat[float64](0, 50),
+			"solar_rad_avg":       util.RandomFloat[float64](0, 1000),
+			"thw_index_last":      util.RandomFloat[float64](70, 100),
+			"solar_energy":        util.RandomFloat[float64](0, 20),
+			"uv_dose":             util.RandomFloat[float64](0, 5),
+			"hdd":                 0.0,
+			"cdd":                 util.RandomFloat[float64](0, 1),
+		})
+		bar = append(bar, map[string]any{
+			"ts":            ts,
+			"bar_sea_level": util.RandomFloat[float64](29, 31),
+		})
+	}
+
+	return map[string]any{
+		"station_id": 1234,
+		"sensors": []map[string]any{
+			{"lsid": 1, "sensor_type": 45, "data_structure_type": 11, "data": iss},
+			{"lsid": 2, "sensor_type": 242, "data_structure_type": 13, "data": bar},
+		},
+	}
+}
+
+func TestWeatherLink(t *testing.T) {
+	ts := time.Now().Truncate(time.Second)
+	stations := []WeatherLinkStation{{StationID: 1234, StationName: "Test Station"}}
+	current := map[string]any{
+		"station_id": 1234,
+		"sensors": []map[string]any{
+			{
+				"lsid":                1,
+				"sensor_type":         45,
+				"data_structure_type": 10,
+				"data": []map[string]any{{
+					"ts":                        ts.Unix(),
+					"temp":                      86.0,
+					"hum":                       70.0,
+					"dew_point":                 75.2,
+					"wind_dir_last":             90,
+					"wind_speed_last":           10.0,
+					"wind_speed_hi_last_10_min": 20.0,
+					"rain_rate_last_mm":         2.4,
+					"rainfall_daily_mm":         12.2,
+					"solar_rad":                 500,
+					"uv_index":                  6.1,
+					"heat_index":                95.0,
+					"thw_index":                 93.2,
+					"solar_energy":              12.5,
+					"uv_dose":                   1.8,
+					"hdd":                       0.0,
+					"cdd":                       9.0,
+				}},
+			},
+			{
+				"lsid":                2,
+				"sensor_type":         242,
+				"data_structure_type": 12,
+				"data": []map[string]any{{
+					"ts":            ts.Unix(),
+					"bar_sea_level": 29.92,
+					"bar_absolute":  29.5,
+				}},
+			},
+		},
+	}
+
+	server := newTestWeatherLinkServer(t, stations, current)
+	defer server.Close()
+
+	newClient := func(secret string) *WeatherLink {
+		wl := NewWeatherLink(testApiKey, secret, server.Client())
+		wl.BaseUrl = server.URL + "/v2"
+		return wl
+	}
+
+	testCases := []struct {
+		name        string
+		run         func(wl *WeatherLink) (any, error)
+		secret      string
+		checkResult func(res any, err error)
+	}{
+		{
+			name:   "Current",
+			secret: testApiSecret,
+			run: func(wl *WeatherLink) (any, error) {
+				return wl.Current(context.Background(), "1234")
+			},
+			checkResult: func(res any, err error) {
+				require.NoError(t, err)
+				obs := res.(*CurrentObservation)
+				require.InDelta(t, 30, obs.Temp.Float32, 0.01)
+				require.InDelta(t, 70, obs.Rh.Float32, 0.01)
+				require.InDelta(t, 24, obs.Td.Float32, 0.01)
+				require.InDelta(t, 35, obs.Hi.Float32, 0.01)
+				require.InDelta(t, 90, obs.Wdir.Float32, 0.01)
+				require.InDelta(t, 4.4704, obs.Wspd.Float32, 0.001)
+				require.InDelta(t, 8.9408, obs.Gust.Float32, 0.001)
+				require.InDelta(t, 2.4, obs.Rain.Float32, 0.01)
+				require.InDelta(t, 12.2, obs.RainAccum.Float32, 0.01)
+				require.InDelta(t, 6.1, obs.Uvi.Float32, 0.01)
+				require.InDelta(t, 1013.21, obs.Mslp.Float32, 0.01)
+				require.InDelta(t, 998.99, obs.Pres.Float32, 0.01)
+				require.InDelta(t, 34, obs.Thwi.Float32, 0.01)
+				require.InDelta(t, 12.5, obs.Senergy.Float32, 0.01)
+				require.InDelta(t, 1.8, obs.Uvdose.Float32, 0.01)
+				require.True(t, obs.Hdd.Valid)
+				require.InDelta(t, 0, obs.Hdd.Float32, 0.01)
+				require.InDelta(t, 5, obs.Cdd.Float32, 0.01)
+				require.False(t, obs.Wchill.Valid)
+				require.True(t, obs.Timestamp.Time.Equal(ts))
+			},
+		},
+		{
+			name:   "CurrentSingleStation",
+			secret: testApiSecret,
+			run: func(wl *WeatherLink) (any, error) {
+				return wl.Current(context.Background(), "")
+			},
+			checkResult: func(res any, err error) {
+				require.NoError(t, err)
+				require.True(t, res.(*CurrentObservation).Temp.Valid)
+			},
+		},
+		{
+			name:   "Historic",
+			secret: testApiSecret,
+			run: func(wl *WeatherLink) (any, error) {
+				return wl.Historic(context.Background(), "1234", ts.Add(-36*time.Hour), ts)
+			},
+			checkResult: func(res any, err error) {
+				require.NoError(t, err)
+				obs := res.([]CurrentObservation)
+				require.Len(t, obs, 36*4)
+				for i, o := range obs {
+					require.True(t, o.Temp.Valid)
+					require.True(t, o.Wspd.Valid)
+					require.True(t, o.Mslp.Valid)
+					require.Greater(t, o.Mslp.Float32, float32(900))
+					require.True(t, o.Thwi.Valid)
+					require.True(t, o.Senergy.Valid)
+					require.True(t, o.Uvdose.Valid)
+					require.True(t, o.Hdd.Valid)
+					require.True(t, o.Cdd.Valid)
+					if i > 0 {
+						require.Equal(t, 15*time.Minute, o.Timestamp.Time.Sub(obs[i-1].Timestamp.Time))
+					}
+				}
+				require.False(t, obs[len(obs)-1].Timestamp.Time.After(ts))
+			},
+		},
+		{
+			name:   "Sensors",
+			secret: testApiSecret,
+			run: func(wl *WeatherLink) (any, error) {
+				return wl.Sensors(context.Background())
+			},
+			checkResult: func(res any, err error) {
+				require.NoError(t, err)
+				sensors := res.([]WeatherLinkSensor)
+				require.Len(t, sensors, 1)
+				require.Equal(t, int32(10), sensors[0].DataStructureType)
+			},
+		},
+		{
+			name:   "InvalidSignature",
+			secret: "wrong",
+			run: func(wl *WeatherLink) (any, error) {
+				return wl.Stations(context.Background())
+			},
+			checkResult: func(res any, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "401")
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.run(newClient(tc.secret))
+			tc.checkResult(res, err)
+		})
+	}
+}
+
+func TestWeatherLinkSignature(t *testing.T) {
+	wl := NewWeatherLink(testApiKey, testApiSecret, nil)
+	sig := wl.signature(map[string]string{
+		"t":          "1558729481",
+		"api-key":    testApiKey,
+		"station-id": "2",
+	})
+	// HMAC-SHA256 of "api-key987654321station-id2t1558729481" keyed with "ABC123"
+	require.Equal(t, "9de393b0c939545065b67c3560ac900fd3f83fb5b70c67f3cd6b5d2f6a806d9d", sig)
+}
@@ -7,6 +7,7 @@ import (
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	"github.com/emiliogozo/panahon-api-go/internal/handlers"
 	"github.com/emiliogozo/panahon-api-go/internal/routers"
+	"github.com/emiliogozo/panahon-api-go/internal/service"
 	"github.com/emiliogozo/panahon-api-go/internal/token"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/rs/zerolog"
@@ -20,13 +21,13 @@ type Server struct {
 }
 
 // NewServer creates a new HTTP server and setup routing
-func NewServer(config util.Config, store db.Store, tokenMaker token.Maker, logger *zerolog.Logger) (*Server, error) {
+func NewServer(config util.Config, store db.Store, tokenMaker token.Maker, scheduler *service.Scheduler, svc service.Services, logger *zerolog.Logger) (*Server, error) {
 	server := &Server{
 		config: config,
 		logger: logger,
 	}
 
-	handler := handlers.NewDefaultHandler(config, store, tokenMaker, logger)
+	handler := handlers.NewDefaultHandler(config, store, tokenMaker, scheduler, svc, logger)
 
 	server.router = routers.NewDefaultRouter(config, handler, tokenMaker, logger)
 
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// AggregateObservations refreshes the hourly aggregates of the last three hours
+// and the daily aggregates of yesterday and today, in the time zone of each
+// station, so that late readings are included.
+func AggregateObservations(ctx context.Context, store db.Store, logger *zerolog.Logger) (JobStats, error) {
+	serviceName := "AggregateObservations"
+	now := time.Now()
+	endDate := pgtype.Timestamptz{Time: now, Valid: true}
+
+	hourStart := now.Truncate(time.Hour).Add(-2 * time.Hour)
+	numHourly, err := store.UpsertHourlyObservations(ctx, db.UpsertHourlyObservationsParams{
+		StartDate: pgtype.Timestamptz{Time: hourStart, Valid: true},
+		EndDate:   endDate,
+	})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("hourly aggregation error")
+		return JobStats{}, err
+	}
+
+	// The daily aggregates start at the midnight before StartDate in the
+	// time zone of each station.
+	numDaily, err := store.UpsertDailyObservations(ctx, db.UpsertDailyObservationsParams{
+		StartDate: pgtype.Timestamptz{Time: now.AddDate(0, 0, -1), Valid: true},
+		EndDate:   endDate,
+	})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("daily aggregation error")
+		return JobStats{}, err
+	}
+
+	logger.Info().Str("service", serviceName).Int64("hourly", numHourly).Int64("daily", numDaily).Msg("aggregation successful")
+	count := int(numHourly + numDaily)
+	return JobStats{Count: count, CountSuccess: count}, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateObservations(t *testing.T) {
+	dbErr := errors.New("db error")
+
+	stubHourly := func(store *mockdb.MockStore, n int64, err error) {
+		store.EXPECT().UpsertHourlyObservations(mock.Anything, mock.MatchedBy(func(arg db.UpsertHourlyObservationsParams) bool {
+			start := arg.StartDate.Time
+			// The last three hours, from the start of the hour.
+			return start.Equal(start.Truncate(time.Hour)) &&
+				arg.EndDate.Time.Sub(start) >= 2*time.Hour &&
+				arg.EndDate.Time.Sub(start) < 3*time.Hour
+		})).
+			Return(n, err).
+			Once()
+	}
+	stubDaily := func(store *mockdb.MockStore, n int64, err error) {
+		store.EXPECT().UpsertDailyObservations(mock.Anything, mock.MatchedBy(func(arg db.UpsertDailyObservationsParams) bool {
+			return arg.EndDate.Time.Equal(arg.StartDate.Time.AddDate(0, 0, 1))
+		})).
+			Return(n, err).
+			Once()
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		check      func(stats JobStats, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				stubHourly(store, 3, nil)
+				stubDaily(store, 2, nil)
+			},
+			check: func(stats JobStats, err error) {
+				require.NoError(t, err)
+				require.Equal(t, JobStats{Count: 5, CountSuccess: 5}, stats)
+			},
+		},
+		{
+			name: "HourlyError",
+			buildStubs: func(store *mockdb.MockStore) {
+				stubHourly(store, 0, dbErr)
+			},
+			check: func(stats JobStats, err error) {
+				require.ErrorIs(t, err, dbErr)
+				require.Zero(t, stats)
+			},
+		},
+		{
+			name: "DailyError",
+			buildStubs: func(store *mockdb.MockStore) {
+				stubHourly(store, 3, nil)
+				stubDaily(store, 0, dbErr)
+			},
+			check: func(stats JobStats, err error) {
+				require.ErrorIs(t, err, dbErr)
+				require.Zero(t, stats)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			logger := zerolog.Nop()
+			tc.check(AggregateObservations(context.Background(), store, &logger))
+		})
+	}
+}
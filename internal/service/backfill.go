@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/qc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// backfillBatchSize is the number of archive records inserted per batch.
+	backfillBatchSize = 500
+	// backfillDefaultWindow is how far back an automatic backfill goes for a
+	// station without stored observations.
+	backfillDefaultWindow = 24 * time.Hour
+	// backfillMaxWindow caps how far back an automatic backfill goes.
+	backfillMaxWindow = 7 * 24 * time.Hour
+	// backfillTimeout caps the automatic backfills started by a poll.
+	backfillTimeout = 10 * time.Minute
+)
+
+// stationGap is the range of observations missed by a polled station that is
+// back online, from start to the polled observation.
+type stationGap struct {
+	poll  *stationPoll
+	start time.Time
+}
+
+// BackfillStationObservations pulls the archive records of a station from
+// start to end and stores the missing ones as MO observations. Records
+// already stored for the same timestamp are skipped. It returns the number
+// of inserted observations.
+func BackfillStationObservations(ctx context.Context, store db.Store, logger *zerolog.Logger, stationID int64, start, end time.Time) (int, error) {
+	serviceName := "BackfillStationObservations"
+	stn, err := store.GetStation(ctx, stationID)
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return 0, err
+	}
+
+	driver, ok := sensor.Lookup(stn.StationType.String)
+	if !ok {
+		return 0, fmt.Errorf("no sensor driver for station type %q: %w", stn.StationType.String, sensor.ErrNotSupported)
+	}
+	archiver, ok := driver.(sensor.Archiver)
+	if !ok {
+		return 0, fmt.Errorf("station type %q has no archive: %w", stn.StationType.String, sensor.ErrNotSupported)
+	}
+
+	sensorStn, err := newSensorStation(ctx, store, stn)
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("cannot get station credential")
+		return 0, err
+	}
+
+	n, err := backfillStation(ctx, store, archiver, sensorStn, start, end)
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Int64("station_id", stationID).Int("inserted", n).Msg("backfill error")
+		return n, err
+	}
+	logger.Info().Str("service", serviceName).Int64("station_id", stationID).Int("inserted", n).Msg("backfill successful")
+	return n, nil
+}
+
+// backfillStation fetches the archive of stn, inserts it in batches and runs
+// the quality-control checks on the inserted range.
+func backfillStation(ctx context.Context, store db.Store, archiver sensor.Archiver, stn sensor.Station, start, end time.Time) (int, error) {
+	records, err := archiver.FetchArchive(ctx, stn, start, end)
+	if err != nil && len(records) == 0 {
+		return 0, err
+	}
+
+	inserted := 0
+	for i := 0; i < len(records); i += backfillBatchSize {
+		batch := records[i:min(i+backfillBatchSize, len(records))]
+		arg := make([]db.BatchCreateStationMoObservationsParams, 0, len(batch))
+		for _, r := range batch {
+			if !r.Timestamp.Valid {
+				continue
+			}
+			arg = append(arg, db.BatchCreateStationMoObservationsParams{
+				StationID: stn.ID,
+				Pres:      r.Pres,
+				Rr:        r.Rain,
+				Rh:        r.Rh,
+				Temp:      r.Temp,
+				Td:        r.Td,
+				Wdir:      r.Wdir,
+				Wspd:      r.Wspd,
+				Wspdx:     r.Gust,
+				Srad:      r.Srad,
+				Hi:        r.Hi,
+				Wchill:    r.Wchill,
+				Rain:      r.RainAccum,
+				Tx:        r.Tx,
+				Tn:        r.Tn,
+				Uvi:       r.Uvi,
+				Et:        r.Et,
+				Thwi:      r.Thwi,
+				Senergy:   r.Senergy,
+				Uvdose:    r.Uvdose,
+				Hdd:       r.Hdd,
+				Cdd:       r.Cdd,
+				Timestamp: r.Timestamp,
+			})
+		}
+
+		n, errs := store.BulkCreateStationMoObservations(ctx, arg)
+		inserted += n
+		if len(errs) > 0 {
+			return inserted, errors.Join(errs...)
+		}
+	}
+
+	if inserted > 0 {
+		if qcErr := qc.NewDefaultChecker().ApplyMoRange(ctx, store, stn.ID, start, end); qcErr != nil {
+			return inserted, errors.Join(err, qcErr)
+		}
+	}
+
+	return inserted, err
+}
+
+// backfillGaps recovers the observations missed by the stations back online,
+// one station at a time, within backfillTimeout.
+func backfillGaps(ctx context.Context, store db.Store, logger *zerolog.Logger, gaps []stationGap) {
+	serviceName := "InsertCurrentSensorObservations"
+	ctx, cancel := context.WithTimeout(ctx, backfillTimeout)
+	defer cancel()
+
+	for _, g := range gaps {
+		p := g.poll
+		n, err := backfillStation(ctx, store, p.driver.(sensor.Archiver), p.sensorStn, g.start, p.obs.Timestamp.Time)
+		if err != nil && !errors.Is(err, sensor.ErrNotSupported) {
+			logger.Error().Err(err).Str("service", serviceName).Int64("station_id", p.stn.ID).Msg("backfill error")
+		} else if n > 0 {
+			logger.Info().Str("service", serviceName).Int64("station_id", p.stn.ID).Int("inserted", n).Msg("backfill successful")
+		}
+	}
+}
+
+// backfillStart returns the time an automatic backfill of a station ending at
+// end starts from: its latest stored MO observation, within backfillMaxWindow.
+func backfillStart(ctx context.Context, store db.Store, stationID int64, end time.Time) (time.Time, error) {
+	start := end.Add(-backfillDefaultWindow)
+	latest, err := store.GetLatestStationMoObservation(ctx, stationID)
+	if err == nil {
+		start = latest.Timestamp.Time
+	} else if !errors.Is(err, db.ErrRecordNotFound) {
+		return time.Time{}, err
+	}
+
+	if earliest := end.Add(-backfillMaxWindow); start.Before(earliest) {
+		start = earliest
+	}
+	return start, nil
+}
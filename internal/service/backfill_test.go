@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeArchiver is a sensor driver with an archive. If fetched is set, every
+// archive request is sent on it before the records are returned.
+type fakeArchiver struct {
+	obs     *sensor.CurrentObservation
+	records []sensor.CurrentObservation
+	err     error
+	fetched chan archiveRequest
+}
+
+type archiveRequest struct {
+	stationID  int64
+	start, end time.Time
+}
+
+func (f *fakeArchiver) Parse(string, *time.Location, time.Time) (*sensor.Reading, error) {
+	return nil, sensor.ErrNotSupported
+}
+
+func (f *fakeArchiver) Fetch(context.Context, sensor.Station) (*sensor.CurrentObservation, error) {
+	return f.obs, nil
+}
+
+func (f *fakeArchiver) FetchArchive(ctx context.Context, stn sensor.Station, start, end time.Time) ([]sensor.CurrentObservation, error) {
+	if f.fetched != nil {
+		f.fetched <- archiveRequest{stationID: stn.ID, start: start, end: end}
+	}
+	return f.records, f.err
+}
+
+func archiveRecord(ts time.Time, temp float32) sensor.CurrentObservation {
+	return sensor.CurrentObservation{
+		Temp:      pgtype.Float4{Float32: temp, Valid: true},
+		Pres:      pgtype.Float4{Float32: 1005, Valid: true},
+		Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+	}
+}
+
+func TestBackfillStation(t *testing.T) {
+	stn := sensor.Station{ID: 7}
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-time.Hour)
+	records := []sensor.CurrentObservation{
+		archiveRecord(start.Add(15*time.Minute), 27),
+		{Temp: pgtype.Float4{Float32: 28, Valid: true}},
+		archiveRecord(start.Add(30*time.Minute), 29),
+	}
+	fetchErr := errors.New("archive error")
+	dbErr := errors.New("db error")
+
+	stubQc := func(store *mockdb.MockStore) {
+		store.EXPECT().ListPreviousStationMoObservations(mock.Anything, mock.Anything).
+			Return([]db.ObservationsMoObservation{}, nil).
+			Once()
+		store.EXPECT().ListStationMoObservationsForQc(mock.Anything, mock.MatchedBy(func(arg db.ListStationMoObservationsForQcParams) bool {
+			return arg.StationID == stn.ID && arg.StartDate.Time.Equal(start) && arg.EndDate.Time.Equal(end)
+		})).
+			Return([]db.ObservationsMoObservation{}, nil).
+			Once()
+	}
+
+	testCases := []struct {
+		name       string
+		archiver   *fakeArchiver
+		buildStubs func(store *mockdb.MockStore)
+		check      func(n int, err error)
+	}{
+		{
+			name:     "OK",
+			archiver: &fakeArchiver{records: records},
+			buildStubs: func(store *mockdb.MockStore) {
+				// The record without a timestamp is skipped.
+				store.EXPECT().BulkCreateStationMoObservations(mock.Anything, mock.MatchedBy(func(arg []db.BatchCreateStationMoObservationsParams) bool {
+					return len(arg) == 2 &&
+						arg[0].StationID == stn.ID && arg[0].Temp.Float32 == 27 && arg[0].Pres.Float32 == 1005 &&
+						arg[1].Timestamp.Time.Equal(records[2].Timestamp.Time)
+				})).
+					Return(2, nil).
+					Once()
+				stubQc(store)
+			},
+			check: func(n int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, n)
+			},
+		},
+		{
+			name:     "AlreadyStored",
+			archiver: &fakeArchiver{records: records[:1]},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BulkCreateStationMoObservations(mock.Anything, mock.Anything).
+					Return(0, nil).
+					Once()
+			},
+			check: func(n int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, n)
+			},
+		},
+		{
+			name:       "FetchError",
+			archiver:   &fakeArchiver{err: fetchErr},
+			buildStubs: func(store *mockdb.MockStore) {},
+			check: func(n int, err error) {
+				require.ErrorIs(t, err, fetchErr)
+				require.Zero(t, n)
+			},
+		},
+		{
+			name: "PartialFetch",
+			// The records fetched before the error are stored.
+			archiver: &fakeArchiver{records: records[:1], err: fetchErr},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BulkCreateStationMoObservations(mock.Anything, mock.Anything).
+					Return(1, nil).
+					Once()
+				stubQc(store)
+			},
+			check: func(n int, err error) {
+				require.ErrorIs(t, err, fetchErr)
+				require.Equal(t, 1, n)
+			},
+		},
+		{
+			name:     "InsertError",
+			archiver: &fakeArchiver{records: records},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BulkCreateStationMoObservations(mock.Anything, mock.Anything).
+					Return(1, []error{dbErr}).
+					Once()
+			},
+			check: func(n int, err error) {
+				require.ErrorIs(t, err, dbErr)
+				require.Equal(t, 1, n)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			tc.check(backfillStation(context.Background(), store, tc.archiver, stn, start, end))
+		})
+	}
+}
+
+func TestBackfillStart(t *testing.T) {
+	var stationID int64 = 7
+	end := time.Now().Truncate(time.Minute)
+	dbErr := errors.New("db error")
+
+	latestAt := func(ts time.Time) db.ObservationsMoObservation {
+		return db.ObservationsMoObservation{
+			StationID: stationID,
+			Timestamp: pgtype.Timestamptz{Time: ts, Valid: true},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		latest    db.ObservationsMoObservation
+		err       error
+		wantStart time.Time
+		wantErr   error
+	}{
+		{
+			name:      "Latest",
+			latest:    latestAt(end.Add(-3 * time.Hour)),
+			wantStart: end.Add(-3 * time.Hour),
+		},
+		{
+			name:      "NoObservation",
+			err:       db.ErrRecordNotFound,
+			wantStart: end.Add(-backfillDefaultWindow),
+		},
+		{
+			name:      "OverMaxWindow",
+			latest:    latestAt(end.AddDate(0, -1, 0)),
+			wantStart: end.Add(-backfillMaxWindow),
+		},
+		{
+			name:    "InternalError",
+			err:     dbErr,
+			wantErr: dbErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			store.EXPECT().GetLatestStationMoObservation(mock.Anything, stationID).
+				Return(tc.latest, tc.err).
+				Once()
+
+			start, err := backfillStart(context.Background(), store, stationID, end)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.wantStart.Equal(start), "start %s, want %s", start, tc.wantStart)
+		})
+	}
+}
@@ -2,24 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/qc"
 	"github.com/emiliogozo/panahon-api-go/internal/sensor"
-	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 )
 
-func InsertCurrentObservations(ctx context.Context, store db.Store, logger *zerolog.Logger) error {
+func InsertCurrentObservations(ctx context.Context, store db.Store, logger *zerolog.Logger) (JobStats, error) {
 	serviceName := "InsertCurrentObservations"
 	obs, err := store.InsertCurrentObservations(ctx)
 	if err != nil {
 		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
-		return err
+		return JobStats{}, err
 	}
+	countSuccess := 0
 	for _, o := range obs {
 		statusStr := "OFFLINE"
 		if time.Since(o.Timestamp.Time) < time.Hour {
@@ -31,73 +32,179 @@ func InsertCurrentObservations(ctx context.Context, store db.Store, logger *zero
 		})
 		if err != nil {
 			logger.Error().Err(err).Str("service", serviceName).Msg("update status error")
+			continue
 		}
+		countSuccess++
 	}
 	logger.Info().Str("service", serviceName).Msg("insert data successful")
-	return nil
+	return JobStats{Count: len(obs), CountSuccess: countSuccess}, nil
 }
 
-func InsertCurrentDavisObservations(ctx context.Context, store db.Store, logger *zerolog.Logger) error {
-	serviceName := "InsertCurrentDavisObservations"
+// InsertCurrentSensorObservations polls every active station whose station_type
+// has a registered sensor driver able to fetch observations. Stations are
+// polled concurrently and the results are stored in batches.
+func InsertCurrentSensorObservations(ctx context.Context, store db.Store, logger *zerolog.Logger) (JobStats, error) {
+	serviceName := "InsertCurrentSensorObservations"
 	stations, err := store.ListStations(ctx, db.ListStationsParams{})
 	if err != nil {
 		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
-		return err
+		return JobStats{}, err
 	}
-	count := 0
-	countSuccess := 0
+
+	var polls []*stationPoll
 	for _, stn := range stations {
-		if stn.StationType.String == "MO" {
-			if !stn.StationUrl.Valid || stn.Status.String == "INACTIVE" {
-				continue
-			}
+		driver, ok := sensor.Lookup(stn.StationType.String)
+		if !ok {
+			continue
+		}
+		if stn.Status.String == "INACTIVE" {
+			continue
+		}
 
-			stnUrl := strings.Replace(stn.StationUrl.String, ".xml", ".json", 1)
-			sleepDuration := time.Duration(util.RandomInt[int](1, 5)) * time.Second
-			davis := sensor.NewDavis(stnUrl, sleepDuration)
-			davisObs, err := davis.FetchLatest()
-			if err != nil {
-				logger.Error().Err(err).Str("service", serviceName).Msg("api error")
-				continue
+		sensorStn, err := newSensorStation(ctx, store, stn)
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Msg("cannot get station credential")
+			continue
+		}
+		if len(sensorStn.Url) == 0 && len(sensorStn.ApiKey) == 0 {
+			continue
+		}
+
+		polls = append(polls, &stationPoll{stn: stn, sensorStn: sensorStn, driver: driver})
+	}
+
+	pollStations(ctx, polls, pollWorkers)
+
+	var (
+		currentArgs []db.BatchCreateCurrentObservationsParams
+		moArgs      []db.BatchUpsertStationMoObservationsParams
+		statusArgs  []db.BatchUpdateStationStatusParams
+		gaps        []stationGap
+	)
+	for _, p := range polls {
+		if p.err != nil {
+			if !errors.Is(p.err, sensor.ErrNotSupported) {
+				logger.Error().Err(p.err).Str("service", serviceName).Int64("station_id", p.stn.ID).Msg("api error")
 			}
-			count++
-
-			_, err = store.CreateCurrentObservation(ctx, db.CreateCurrentObservationParams{
-				StationID:     stn.ID,
-				Rain:          davisObs.Rain,
-				Temp:          davisObs.Temp,
-				Rh:            davisObs.Rh,
-				Wdir:          davisObs.Wdir,
-				Wspd:          davisObs.Wspd,
-				Srad:          davisObs.Srad,
-				Mslp:          davisObs.Mslp,
-				Tn:            davisObs.Tn,
-				Tx:            davisObs.Tx,
-				Gust:          davisObs.Gust,
-				RainAccum:     davisObs.RainAccum,
-				TnTimestamp:   davisObs.TnTimestamp,
-				TxTimestamp:   davisObs.TxTimestamp,
-				GustTimestamp: davisObs.GustTimestamp,
-				Timestamp:     davisObs.Timestamp,
-			})
+			continue
+		}
+		sensorObs := p.obs
+
+		statusStr := "OFFLINE"
+		if time.Since(sensorObs.Timestamp.Time) < time.Hour {
+			statusStr = "ONLINE"
+		}
+
+		// The station is back online, look up the gap before the new
+		// observation is stored.
+		if _, ok := p.driver.(sensor.Archiver); ok && p.stn.Status.String == "OFFLINE" && statusStr == "ONLINE" {
+			gapStart, err := backfillStart(ctx, store, p.stn.ID, sensorObs.Timestamp.Time)
 			if err != nil {
-				logger.Error().Err(err).Str("service", serviceName).Msg("cannot create new data")
-				continue
-			}
-			countSuccess++
-			statusStr := "OFFLINE"
-			if time.Since(davisObs.Timestamp.Time) < time.Hour {
-				statusStr = "ONLINE"
+				logger.Error().Err(err).Str("service", serviceName).Msg("cannot get latest full observation")
+			} else {
+				gaps = append(gaps, stationGap{poll: p, start: gapStart})
 			}
-			_, err = store.UpdateStation(ctx, db.UpdateStationParams{
-				ID:     stn.ID,
-				Status: pgtype.Text{String: statusStr, Valid: true},
-			})
-			if err != nil {
-				logger.Error().Err(err).Str("service", serviceName).Msg("update status error")
+		}
+
+		currentArgs = append(currentArgs, db.BatchCreateCurrentObservationsParams{
+			StationID:     p.stn.ID,
+			Rain:          sensorObs.Rain,
+			Temp:          sensorObs.Temp,
+			Rh:            sensorObs.Rh,
+			Td:            sensorObs.Td,
+			Wdir:          sensorObs.Wdir,
+			Wspd:          sensorObs.Wspd,
+			Srad:          sensorObs.Srad,
+			Mslp:          sensorObs.Mslp,
+			Uvi:           sensorObs.Uvi,
+			Tn:            sensorObs.Tn,
+			Tx:            sensorObs.Tx,
+			Gust:          sensorObs.Gust,
+			RainAccum:     sensorObs.RainAccum,
+			TnTimestamp:   sensorObs.TnTimestamp,
+			TxTimestamp:   sensorObs.TxTimestamp,
+			GustTimestamp: sensorObs.GustTimestamp,
+			Timestamp:     sensorObs.Timestamp,
+		})
+		moArgs = append(moArgs, db.BatchUpsertStationMoObservationsParams{
+			StationID: p.stn.ID,
+			Pres:      sensorObs.Pres,
+			Rr:        sensorObs.Rain,
+			Rh:        sensorObs.Rh,
+			Temp:      sensorObs.Temp,
+			Td:        sensorObs.Td,
+			Wdir:      sensorObs.Wdir,
+			Wspd:      sensorObs.Wspd,
+			Wspdx:     sensorObs.Gust,
+			Srad:      sensorObs.Srad,
+			Hi:        sensorObs.Hi,
+			Wchill:    sensorObs.Wchill,
+			Rain:      sensorObs.RainAccum,
+			Tx:        sensorObs.Tx,
+			Tn:        sensorObs.Tn,
+			Uvi:       sensorObs.Uvi,
+			Et:        sensorObs.Et,
+			Thwi:      sensorObs.Thwi,
+			Senergy:   sensorObs.Senergy,
+			Uvdose:    sensorObs.Uvdose,
+			Hdd:       sensorObs.Hdd,
+			Cdd:       sensorObs.Cdd,
+			Timestamp: sensorObs.Timestamp,
+		})
+		statusArgs = append(statusArgs, db.BatchUpdateStationStatusParams{
+			ID:     p.stn.ID,
+			Status: pgtype.Text{String: statusStr, Valid: true},
+		})
+	}
+
+	countSuccess := 0
+	if len(currentArgs) > 0 {
+		n, errs := store.BulkCreateCurrentObservations(ctx, currentArgs)
+		countSuccess = n
+		if len(errs) > 0 {
+			logger.Error().Err(errors.Join(errs...)).Str("service", serviceName).Msg("cannot create new data")
+		}
+		if errs := store.BulkUpsertStationMoObservations(ctx, moArgs); len(errs) > 0 {
+			logger.Error().Err(errors.Join(errs...)).Str("service", serviceName).Msg("cannot store full observation")
+		}
+		checker := qc.NewDefaultChecker()
+		for _, a := range moArgs {
+			ts := a.Timestamp.Time
+			if err := checker.ApplyMoRange(ctx, store, a.StationID, ts, ts); err != nil {
+				logger.Error().Err(err).Str("service", serviceName).Int64("station_id", a.StationID).Msg("cannot apply quality control")
 			}
 		}
+		if errs := store.BulkUpdateStationStatus(ctx, statusArgs); len(errs) > 0 {
+			logger.Error().Err(errors.Join(errs...)).Str("service", serviceName).Msg("update status error")
+		}
+	}
+
+	// Recover the observations missed by the stations back online without
+	// holding up the job; the backfill outlives its context.
+	if len(gaps) > 0 {
+		go backfillGaps(context.WithoutCancel(ctx), store, logger, gaps)
+	}
+
+	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, len(currentArgs))).Msg("insert data successful")
+	return JobStats{Count: len(currentArgs), CountSuccess: countSuccess}, nil
+}
+
+// newSensorStation returns the attributes, and api credentials if any, a
+// sensor driver needs to reach the logger of stn.
+func newSensorStation(ctx context.Context, store db.Store, stn db.ObservationsStation) (sensor.Station, error) {
+	sensorStn := sensor.Station{
+		ID:          stn.ID,
+		MoStationID: stn.MoStationID.String,
+		Url:         stn.StationUrl.String,
+		Timezone:    stn.Timezone,
+	}
+	cred, err := store.GetStationCredential(ctx, stn.ID)
+	if err == nil {
+		sensorStn.ApiKey = cred.ApiKey
+		sensorStn.ApiSecret = cred.ApiSecret
+		sensorStn.ApiStationID = cred.ApiStationID.String
+	} else if !errors.Is(err, db.ErrRecordNotFound) {
+		return sensorStn, err
 	}
-	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, count)).Msg("insert data successful")
-	return nil
+	return sensorStn, nil
 }
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertCurrentSensorObservationsBackfill(t *testing.T) {
+	now := time.Now().Truncate(time.Minute)
+	latest := now.Add(-5 * time.Hour)
+
+	// Archive requests are not buffered, so the job must not wait for them.
+	archiver := &fakeArchiver{
+		obs:     &sensor.CurrentObservation{Temp: pgtype.Float4{Float32: 28, Valid: true}, Timestamp: pgtype.Timestamptz{Time: now, Valid: true}},
+		fetched: make(chan archiveRequest),
+	}
+	stationType := "BACKFILL" + util.RandomString(8)
+	sensor.Register(stationType, archiver)
+
+	newStation := func(id int64, status string) db.ObservationsStation {
+		return db.ObservationsStation{
+			ID:          id,
+			StationType: pgtype.Text{String: stationType, Valid: true},
+			Status:      pgtype.Text{String: status, Valid: true},
+		}
+	}
+	offline := newStation(1, "OFFLINE")
+	online := newStation(2, "ONLINE")
+
+	store := mockdb.NewMockStore(t)
+	store.EXPECT().ListStations(mock.Anything, db.ListStationsParams{}).
+		Return([]db.ObservationsStation{offline, online}, nil)
+	store.EXPECT().GetStationCredential(mock.Anything, mock.Anything).
+		Return(db.ObservationsStationcredential{ApiKey: "key"}, nil)
+	// Only the station back online is backfilled.
+	store.EXPECT().GetLatestStationMoObservation(mock.Anything, offline.ID).
+		Return(db.ObservationsMoObservation{StationID: offline.ID, Timestamp: pgtype.Timestamptz{Time: latest, Valid: true}}, nil).
+		Once()
+	store.EXPECT().BulkCreateCurrentObservations(mock.Anything, mock.Anything).
+		Return(2, nil)
+	store.EXPECT().BulkUpsertStationMoObservations(mock.Anything, mock.Anything).
+		Return(nil)
+	store.EXPECT().ListPreviousStationMoObservations(mock.Anything, mock.Anything).
+		Return([]db.ObservationsMoObservation{}, nil)
+	store.EXPECT().ListStationMoObservationsForQc(mock.Anything, mock.Anything).
+		Return([]db.ObservationsMoObservation{}, nil)
+	store.EXPECT().BulkUpdateStationStatus(mock.Anything, mock.MatchedBy(func(arg []db.BatchUpdateStationStatusParams) bool {
+		return len(arg) == 2 && arg[0].Status.String == "ONLINE" && arg[1].Status.String == "ONLINE"
+	})).
+		Return(nil)
+
+	logger := zerolog.Nop()
+	stats, err := InsertCurrentSensorObservations(context.Background(), store, &logger)
+	require.NoError(t, err)
+	require.Equal(t, JobStats{Count: 2, CountSuccess: 2}, stats)
+
+	select {
+	case req := <-archiver.fetched:
+		require.Equal(t, offline.ID, req.stationID)
+		require.True(t, req.start.Equal(latest))
+		require.True(t, req.end.Equal(now))
+	case <-time.After(time.Second):
+		t.Fatal("station back online not backfilled")
+	}
+
+	select {
+	case req := <-archiver.fetched:
+		t.Fatalf("station %d backfilled", req.stationID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
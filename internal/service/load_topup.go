@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+const (
+	LoadTriggerSchedule = "SCHEDULE"
+	LoadTriggerHealth   = "HEALTH"
+	LoadTriggerManual   = "MANUAL"
+
+	LoadStatusPending   = "PENDING"
+	LoadStatusRequested = "REQUESTED"
+	LoadStatusSuccess   = "SUCCESS"
+	LoadStatusFailed    = "FAILED"
+
+	// DefaultLoadSilentAfter is how long a station goes without data before
+	// its SIM card is topped up.
+	DefaultLoadSilentAfter = 24 * time.Hour
+
+	// loadExpiryLead is how long before its load expires a SIM card is
+	// topped up.
+	loadExpiryLead = 24 * time.Hour
+	// loadActiveWithin excludes the stations silent for so long that a top-up
+	// is unlikely to bring them back.
+	loadActiveWithin = 30 * 24 * time.Hour
+)
+
+// LoadTopUp tops up the prepaid load of station SIM cards through the Globe
+// Labs rewards API. Every request is recorded in load_requests under an
+// idempotency key, so that a request is never sent twice, and counts against
+// a monthly budget. The outcome is recorded when Globe Labs posts it to the
+// load webhook.
+type LoadTopUp struct {
+	store       db.Store
+	rewards     *sms.GLabsRewards
+	promo       string
+	cost        int32
+	budget      int64
+	silentAfter time.Duration
+	logger      *zerolog.Logger
+}
+
+// NewLoadTopUp creates a top-up service from the GLABS_* config. It returns
+// nil if no rewards token or load promo is configured. A zero
+// GLABS_LOAD_BUDGET means no budget cap.
+func NewLoadTopUp(conf util.Config, store db.Store, logger *zerolog.Logger) *LoadTopUp {
+	if len(conf.GlabsRewardsToken) == 0 || len(conf.GlabsLoadPromo) == 0 {
+		return nil
+	}
+	silentAfter := conf.GlabsLoadSilentAfter
+	if silentAfter <= 0 {
+		silentAfter = DefaultLoadSilentAfter
+	}
+	return &LoadTopUp{
+		store:       store,
+		rewards:     sms.NewGLabsRewards(nil, conf.GlabsAPIURL, conf.GlabsAppID, conf.GlabsAppSecret, conf.GlabsRewardsToken),
+		promo:       conf.GlabsLoadPromo,
+		cost:        int32(conf.GlabsLoadCost),
+		budget:      int64(conf.GlabsLoadBudget),
+		silentAfter: silentAfter,
+		logger:      logger,
+	}
+}
+
+type LoadTopUpParams struct {
+	IdempotencyKey string
+	MobileNumber   string
+	StationID      pgtype.Int8
+	Trigger        string
+}
+
+// Request tops up the SIM card of arg.MobileNumber. If a request was already
+// made under arg.IdempotencyKey it is returned instead, and created is false.
+// A request Globe Labs refused is returned with the FAILED status. A request
+// that failed transiently, see sms.IsTransient, is deleted, so that it is
+// made again under the same key, and its error is returned. It returns
+// db.ErrLoadBudgetExceeded if the monthly budget is spent.
+func (t *LoadTopUp) Request(ctx context.Context, arg LoadTopUpParams) (req db.LoadRequest, created bool, err error) {
+	result, err := t.store.CreateLoadRequestTx(ctx, db.CreateLoadRequestTxParams{
+		CreateLoadRequestParams: db.CreateLoadRequestParams{
+			IdempotencyKey: arg.IdempotencyKey,
+			MobileNumber:   arg.MobileNumber,
+			StationID:      arg.StationID,
+			Trigger:        arg.Trigger,
+			Promo:          t.promo,
+			Cost:           t.cost,
+		},
+		Budget:      t.budget,
+		BudgetSince: pgtype.Timestamptz{Time: monthStart(time.Now()), Valid: true},
+	})
+	if err != nil || !result.IsCreated {
+		return result.LoadRequest, false, err
+	}
+	req = result.LoadRequest
+
+	updateArg := db.UpdateLoadRequestSentParams{
+		ID:     req.ID,
+		Status: LoadStatusRequested,
+	}
+	txID, sendErr := t.rewards.Send(ctx, arg.MobileNumber, t.promo)
+	if sms.IsTransient(sendErr) {
+		t.logger.Warn().Err(sendErr).
+			Str("mobile_number", arg.MobileNumber).
+			Str("promo", t.promo).
+			Msg("[LoadTopUp] Request failed, will retry")
+		// A cancelled ctx must not leave the key taken.
+		if err := t.store.DeleteLoadRequest(context.WithoutCancel(ctx), req.ID); err != nil {
+			return req, true, err
+		}
+		return db.LoadRequest{}, false, sendErr
+	}
+	if sendErr != nil {
+		t.logger.Error().Err(sendErr).
+			Str("mobile_number", arg.MobileNumber).
+			Str("promo", t.promo).
+			Msg("[LoadTopUp] Request refused")
+		updateArg.Status = LoadStatusFailed
+		updateArg.Error = util.ToPgText(sendErr.Error())
+	} else {
+		updateArg.TransactionID = pgtype.Int4{Int32: txID, Valid: true}
+	}
+
+	updated, err := t.store.UpdateLoadRequestSent(ctx, updateArg)
+	if err != nil {
+		return req, true, err
+	}
+	return updated, true, nil
+}
+
+// TopUp requests load for the SIM cards of the stations whose load is about
+// to expire, and of those silent for the configured time. A SIM card is
+// topped up once per load expiry and once per silence.
+func (t *LoadTopUp) TopUp(ctx context.Context) (JobStats, error) {
+	var stats JobStats
+
+	now := time.Now()
+	atRisk, err := t.store.ListStationsAtRisk(ctx, pgtype.Timestamptz{Time: now.Add(loadExpiryLead), Valid: true})
+	if err != nil {
+		return stats, err
+	}
+	silent, err := t.store.ListSilentStationSims(ctx, db.ListSilentStationSimsParams{
+		SilentSince: pgtype.Timestamptz{Time: now.Add(-t.silentAfter), Valid: true},
+		ActiveSince: pgtype.Timestamptz{Time: now.Add(-loadActiveWithin), Valid: true},
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	params := make([]LoadTopUpParams, 0, len(atRisk)+len(silent))
+	for _, s := range atRisk {
+		expiry := "none"
+		if s.LoadExpiresAt.Valid {
+			expiry = s.LoadExpiresAt.Time.UTC().Format(time.RFC3339)
+		}
+		params = append(params, LoadTopUpParams{
+			IdempotencyKey: fmt.Sprintf("expiry:%s:%s", s.MobileNumber, expiry),
+			MobileNumber:   s.MobileNumber,
+			StationID:      pgtype.Int8{Int64: s.StationID, Valid: true},
+			Trigger:        LoadTriggerSchedule,
+		})
+	}
+	for _, s := range silent {
+		params = append(params, LoadTopUpParams{
+			IdempotencyKey: fmt.Sprintf("silent:%s:%s", s.MobileNumber, s.LastObservedAt.Time.UTC().Format(time.RFC3339)),
+			MobileNumber:   s.MobileNumber,
+			StationID:      pgtype.Int8{Int64: s.StationID, Valid: true},
+			Trigger:        LoadTriggerHealth,
+		})
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range params {
+		if seen[p.MobileNumber] {
+			continue
+		}
+		seen[p.MobileNumber] = true
+		stats.Count++
+
+		req, created, err := t.Request(ctx, p)
+		if errors.Is(err, db.ErrLoadBudgetExceeded) {
+			t.logger.Warn().
+				Str("mobile_number", p.MobileNumber).
+				Int64("budget", t.budget).
+				Msg("[LoadTopUp] Monthly budget exceeded")
+			break
+		}
+		if err != nil {
+			t.logger.Error().Err(err).
+				Str("mobile_number", p.MobileNumber).
+				Msg("[LoadTopUp] Cannot request load")
+			continue
+		}
+		if !created || req.Status != LoadStatusFailed {
+			stats.CountSuccess++
+		}
+		if created {
+			t.logger.Info().
+				Str("mobile_number", p.MobileNumber).
+				Str("trigger", p.Trigger).
+				Str("status", req.Status).
+				Msg("[LoadTopUp] Load requested")
+		}
+	}
+
+	return stats, nil
+}
+
+// monthStart returns the start of the month of t.
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
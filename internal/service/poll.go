@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sensor"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// pollWorkers is the number of stations polled at the same time.
+	pollWorkers = 8
+	// pollTimeout bounds a single fetch attempt, including rate limit waits.
+	pollTimeout = 30 * time.Second
+	// pollMaxAttempts is the number of fetch attempts per station and run.
+	pollMaxAttempts = 3
+	// pollBaseBackoff is the longest wait before the first retry, doubled on every retry.
+	pollBaseBackoff = 2 * time.Second
+)
+
+// stationPoll is a station to poll and the outcome of the poll.
+type stationPoll struct {
+	stn       db.ObservationsStation
+	sensorStn sensor.Station
+	driver    sensor.Driver
+	obs       *sensor.CurrentObservation
+	err       error
+}
+
+// pollStations fetches the observation of every station with at most
+// workers fetches in flight.
+func pollStations(ctx context.Context, polls []*stationPoll, workers int) {
+	g := new(errgroup.Group)
+	g.SetLimit(workers)
+	for _, p := range polls {
+		g.Go(func() error {
+			p.obs, p.err = fetchWithRetry(ctx, p.driver, p.sensorStn)
+			return nil
+		})
+	}
+	g.Wait()
+}
+
+// fetchWithRetry fetches the observation of stn, each attempt bounded by
+// pollTimeout, and retries failures with exponential backoff and jitter.
+func fetchWithRetry(ctx context.Context, driver sensor.Driver, stn sensor.Station) (*sensor.CurrentObservation, error) {
+	var err error
+	for attempt := 0; attempt < pollMaxAttempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(pollBackoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		var obs *sensor.CurrentObservation
+		attemptCtx, cancel := context.WithTimeout(ctx, pollTimeout)
+		obs, err = driver.Fetch(attemptCtx, stn)
+		cancel()
+		if err == nil {
+			return obs, nil
+		}
+		if errors.Is(err, sensor.ErrNotSupported) || ctx.Err() != nil {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// pollBackoff returns a random wait of up to pollBaseBackoff doubled for every
+// retry after the first, so that stations failing together do not retry together.
+func pollBackoff(attempt int) time.Duration {
+	ceil := pollBaseBackoff << (attempt - 1)
+	return time.Duration(rand.Int63n(int64(ceil))) + time.Millisecond
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/qc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// RecheckObservationsQc re-runs the quality-control checks on the observations of the last day,
+// so that readings stored before their predecessors arrived are evaluated against a full history.
+func RecheckObservationsQc(ctx context.Context, store db.Store, logger *zerolog.Logger) (JobStats, error) {
+	serviceName := "RecheckObservationsQc"
+	now := time.Now()
+	obs, err := store.ListObservationsForQc(ctx, db.ListObservationsForQcParams{
+		StartDate: pgtype.Timestamptz{Time: now.Add(-24 * time.Hour), Valid: true},
+		EndDate:   pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+		return JobStats{}, err
+	}
+
+	checker := qc.NewDefaultChecker()
+	countSuccess := 0
+	for _, o := range obs {
+		if _, err := checker.Apply(ctx, store, o); err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Int64("observation_id", o.ID).Msg("cannot apply quality control")
+			continue
+		}
+		countSuccess++
+	}
+	logger.Info().Str("service", serviceName).Str("success", fmt.Sprintf("%d/%d", countSuccess, len(obs))).Msg("quality control successful")
+	return JobStats{Count: len(obs), CountSuccess: countSuccess}, nil
+}
@@ -0,0 +1,11 @@
+package service
+
+// Outcomes of an inbound message archived in raw_messages.
+const (
+	RawMessageStatusReceived  = "RECEIVED"
+	RawMessageStatusStored    = "STORED"
+	RawMessageStatusDuplicate = "DUPLICATE"
+	RawMessageStatusRejected  = "REJECTED"
+	RawMessageStatusFailed    = "FAILED"
+	RawMessageStatusQuery     = "QUERY"
+)
@@ -2,32 +2,343 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
 	"github.com/emiliogozo/panahon-api-go/internal/util"
 	"github.com/go-co-op/gocron"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 )
 
-func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, logger *zerolog.Logger) {
-	s := gocron.NewScheduler(time.Local)
+const (
+	JobTriggerSchedule = "SCHEDULE"
+	JobTriggerManual   = "MANUAL"
+
+	JobStatusRunning = "RUNNING"
+	JobStatusSuccess = "SUCCESS"
+	JobStatusFailed  = "FAILED"
+)
+
+var (
+	// ErrJobNotFound is returned for a job name that is not scheduled.
+	ErrJobNotFound = errors.New("job not found")
+	// ErrAlreadyRunning is returned when a job is started while its previous
+	// run is still in progress.
+	ErrAlreadyRunning = errors.New("previous run still in progress")
+)
+
+// JobStats are the counts reported by a job run, e.g. the number of
+// stations polled and how many of them were stored.
+type JobStats struct {
+	Count        int
+	CountSuccess int
+}
+
+// JobFunc is a service run by the scheduler.
+type JobFunc func(ctx context.Context, store db.Store, logger *zerolog.Logger) (JobStats, error)
+
+// JobInfo describes a scheduled job.
+type JobInfo struct {
+	Name     string
+	Schedule string
+	Paused   bool
+	Running  bool
+	NextRun  time.Time
+}
+
+type job struct {
+	name     string
+	schedule string
+	fn       JobFunc
+	cronJob  *gocron.Job
+	paused   atomic.Bool
+	running  atomic.Bool
+}
+
+// Scheduler runs the services on their cron schedule and records every run
+// in the job_runs table, under the instance of the server. Jobs can also be
+// run manually, and paused; a paused job is skipped by the schedule until it
+// is resumed.
+type Scheduler struct {
+	ctx      context.Context
+	cron     *gocron.Scheduler
+	store    db.Store
+	instance string
+	logger   *zerolog.Logger
+	mu       sync.RWMutex
+	jobs     []*job
+}
+
+// NewScheduler creates a scheduler recording its runs under instance. Jobs
+// run with ctx.
+func NewScheduler(ctx context.Context, store db.Store, instance string, logger *zerolog.Logger) *Scheduler {
+	return &Scheduler{
+		ctx:      ctx,
+		cron:     gocron.NewScheduler(time.Local),
+		store:    store,
+		instance: instance,
+		logger:   logger,
+	}
+}
+
+// ScheduleJobs starts the services whose cron expression is set in the
+// colon-separated CRON_JOBS config, in this order:
+// InsertCurrentObservations, InsertCurrentSensorObservations,
+// RecheckObservationsQc, AggregateObservations, SendSmsDailySummaries,
+// CheckSimLoad and TopUpSimLoad. An expression of "false" disables a service.
+// SendSmsDailySummaries also needs GLABS_SHORT_CODE, CheckSimLoad reads
+// SIM_LOAD_VALIDITY and SIM_PROMO_VALIDITY, and TopUpSimLoad needs
+// GLABS_REWARDS_TOKEN and GLABS_LOAD_PROMO. An invalid SIM_PROMO_VALIDITY
+// stops the server. The services of svc are shared with the handlers. Runs are recorded under INSTANCE_ID, or the host name if
+// it is not set.
+func ScheduleJobs(ctx context.Context, store db.Store, conf util.Config, svc Services, logger *zerolog.Logger) *Scheduler {
+	instance := conf.InstanceID
+	if len(instance) == 0 {
+		instance, _ = os.Hostname()
+	}
+	s := NewScheduler(ctx, store, instance, logger)
+
+	var sendSmsDailySummaries JobFunc
+	if notifier := svc.Notifier; notifier != nil {
+		sendSmsDailySummaries = func(ctx context.Context, _ db.Store, _ *zerolog.Logger) (JobStats, error) {
+			return notifier.SendDailySummaries(ctx)
+		}
+	}
+
+	var topUpSimLoad JobFunc
+	if topUp := svc.TopUp; topUp != nil {
+		topUpSimLoad = func(ctx context.Context, _ db.Store, _ *zerolog.Logger) (JobStats, error) {
+			return topUp.TopUp(ctx)
+		}
+	}
+
+	// A load of a mistyped promo would be checked against the default
+	// validity, so the server does not start without a valid list.
+	validity, err := NewSimLoadValidity(conf.SimLoadValidity, conf.SimPromoValidity)
+	if err != nil {
+		logger.Fatal().Err(err).
+			Str("promos", conf.SimPromoValidity).
+			Msg("[Scheduler] Invalid SIM promo validity")
+	}
+
+	jobs := []struct {
+		name string
+		fn   JobFunc
+	}{
+		{"InsertCurrentObservations", InsertCurrentObservations},
+		{"InsertCurrentSensorObservations", InsertCurrentSensorObservations},
+		{"RecheckObservationsQc", RecheckObservationsQc},
+		{"AggregateObservations", AggregateObservations},
+		{"SendSmsDailySummaries", sendSmsDailySummaries},
+		{"CheckSimLoad", CheckSimLoad(validity)},
+		{"TopUpSimLoad", topUpSimLoad},
+	}
 
 	cronExps := strings.Split(conf.CronJobs, ":")
-	numCronExps := len(cronExps)
+	for i, j := range jobs {
+		if i >= len(cronExps) || strings.ToLower(cronExps[i]) == "false" {
+			continue
+		}
+		if j.fn == nil {
+			logger.Warn().Str("service", j.name).Msg("[Scheduler] Service not configured")
+			continue
+		}
+		if err := s.Add(j.name, cronExps[i], j.fn); err != nil {
+			logger.Fatal().Err(err).Str("service", j.name).Msg("error scheduling job")
+		}
+	}
 
-	if (numCronExps > 0) && (strings.ToLower(cronExps[0]) != "false") {
-		if _, err := s.Cron(cronExps[0]).Tag("InsertCurrentObservations").Do(InsertCurrentObservations, ctx, store, logger); err != nil {
-			logger.Fatal().Err(err).Str("service", "InsertCurrentObservations").Msg("error scheduling job")
+	// Runs of this instance still marked as running were interrupted by a
+	// restart. The runs of other instances sharing the database are left
+	// alone, but not the runs recorded without an instance.
+	err = store.FailRunningJobRuns(ctx, db.FailRunningJobRunsParams{
+		Reason:   pgtype.Text{String: "interrupted by restart", Valid: true},
+		Instance: instance,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("[Scheduler] Cannot close interrupted job runs")
+	}
+
+	s.Start()
+	return s
+}
+
+// Add schedules fn under name with a cron expression.
+func (s *Scheduler) Add(name, cronExp string, fn JobFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, j := range s.jobs {
+		if j.name == name {
+			return fmt.Errorf("job %s already scheduled", name)
 		}
 	}
 
-	if (numCronExps > 1) && (strings.ToLower(cronExps[1]) != "false") {
-		if _, err := s.Cron(cronExps[1]).Tag("InsertCurrentDavisObservations").Do(InsertCurrentDavisObservations, ctx, store, logger); err != nil {
-			logger.Fatal().Err(err).Str("service", "InsertCurrentDavisObservations").Msg("error scheduling job")
+	j := &job{name: name, schedule: cronExp, fn: fn}
+	cronJob, err := s.cron.Cron(cronExp).Tag(name).Do(s.runScheduled, j)
+	if err != nil {
+		return err
+	}
+	j.cronJob = cronJob
+	s.jobs = append(s.jobs, j)
+	return nil
+}
+
+// Start starts the schedule.
+func (s *Scheduler) Start() {
+	s.cron.StartAsync()
+}
+
+// Stop stops the schedule. Running jobs are not interrupted.
+func (s *Scheduler) Stop() {
+	s.cron.Stop()
+}
+
+// Jobs describes the scheduled jobs in scheduling order.
+func (s *Scheduler) Jobs() []JobInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	infos := make([]JobInfo, len(s.jobs))
+	for i, j := range s.jobs {
+		infos[i] = j.info()
+	}
+	return infos
+}
+
+// Job describes the job scheduled under name.
+func (s *Scheduler) Job(name string) (JobInfo, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return JobInfo{}, err
+	}
+	return j.info(), nil
+}
+
+// RunJob starts the job scheduled under name in the background, even if it
+// is paused, and returns its run record.
+func (s *Scheduler) RunJob(name string) (db.JobRun, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return db.JobRun{}, err
+	}
+
+	if !j.running.CompareAndSwap(false, true) {
+		return db.JobRun{}, ErrAlreadyRunning
+	}
+	run, err := s.store.CreateJobRun(s.ctx, db.CreateJobRunParams{
+		JobName:  j.name,
+		Trigger:  JobTriggerManual,
+		Instance: s.instance,
+	})
+	if err != nil {
+		j.running.Store(false)
+		return db.JobRun{}, err
+	}
+
+	go s.exec(j, run)
+	return run, nil
+}
+
+// PauseJob stops the schedule from running the job under name.
+func (s *Scheduler) PauseJob(name string) (JobInfo, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return JobInfo{}, err
+	}
+	j.paused.Store(true)
+	s.logger.Info().Str("service", j.name).Msg("[Scheduler] Job paused")
+	return j.info(), nil
+}
+
+// ResumeJob lets the schedule run the job under name again.
+func (s *Scheduler) ResumeJob(name string) (JobInfo, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return JobInfo{}, err
+	}
+	j.paused.Store(false)
+	s.logger.Info().Str("service", j.name).Msg("[Scheduler] Job resumed")
+	return j.info(), nil
+}
+
+func (s *Scheduler) job(name string) (*job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, j := range s.jobs {
+		if j.name == name {
+			return j, nil
 		}
 	}
+	return nil, ErrJobNotFound
+}
+
+// runScheduled is called by the schedule. Paused jobs and jobs whose previous
+// run is still in progress are skipped.
+func (s *Scheduler) runScheduled(j *job) {
+	if j.paused.Load() {
+		return
+	}
+	if !j.running.CompareAndSwap(false, true) {
+		s.logger.Warn().Str("service", j.name).Msg("[Scheduler] Skipped, previous run still in progress")
+		return
+	}
+
+	run, err := s.store.CreateJobRun(s.ctx, db.CreateJobRunParams{
+		JobName:  j.name,
+		Trigger:  JobTriggerSchedule,
+		Instance: s.instance,
+	})
+	if err != nil {
+		// the run is not recorded but the job still runs
+		s.logger.Error().Err(err).Str("service", j.name).Msg("[Scheduler] Cannot create job run")
+	}
+
+	s.exec(j, run)
+}
+
+// exec runs the job and records its outcome in run, if any.
+func (s *Scheduler) exec(j *job, run db.JobRun) {
+	defer j.running.Store(false)
+
+	stats, jobErr := j.fn(s.ctx, s.store, s.logger)
+	if run.ID == 0 {
+		return
+	}
+
+	arg := db.FinishJobRunParams{
+		ID:           run.ID,
+		Status:       JobStatusSuccess,
+		Count:        pgtype.Int4{Int32: int32(stats.Count), Valid: true},
+		CountSuccess: pgtype.Int4{Int32: int32(stats.CountSuccess), Valid: true},
+	}
+	if jobErr != nil {
+		arg.Status = JobStatusFailed
+		arg.Error = pgtype.Text{String: jobErr.Error(), Valid: true}
+	}
+	// the job context may be done, e.g. on shutdown, but the run is still recorded
+	if _, err := s.store.FinishJobRun(context.WithoutCancel(s.ctx), arg); err != nil {
+		s.logger.Error().Err(err).Str("service", j.name).Msg("[Scheduler] Cannot finish job run")
+	}
+}
 
-	s.StartAsync()
+func (j *job) info() JobInfo {
+	info := JobInfo{
+		Name:     j.name,
+		Schedule: j.schedule,
+		Paused:   j.paused.Load(),
+		Running:  j.running.Load(),
+	}
+	if j.cronJob != nil {
+		info.NextRun = j.cronJob.NextRun()
+	}
+	return info
 }
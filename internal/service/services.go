@@ -0,0 +1,29 @@
+package service
+
+import (
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/rs/zerolog"
+)
+
+// Services are the services shared by the scheduler and the handlers. They
+// are created once, so that both send through the same gateways and top up
+// with the same budget.
+type Services struct {
+	Sms *sms.Registry
+	// Notifier is nil if Globe Labs cannot send.
+	Notifier *SmsNotifier
+	// TopUp is nil if Globe Labs rewards are not configured.
+	TopUp *LoadTopUp
+}
+
+// NewServices creates the services configured in conf.
+func NewServices(conf util.Config, store db.Store, logger *zerolog.Logger) Services {
+	reg := NewSmsRegistry(conf, store, logger)
+	return Services{
+		Sms:      reg,
+		Notifier: NewSmsNotifier(store, reg, logger),
+		TopUp:    NewLoadTopUp(conf, store, logger),
+	}
+}
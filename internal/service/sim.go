@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+// DefaultSimLoadValidity is the validity of a load whose promo has none configured.
+const DefaultSimLoadValidity = 30 * 24 * time.Hour
+
+// SimLoadValidity tells how long a prepaid load lasts, by promo.
+type SimLoadValidity struct {
+	Default time.Duration
+	Promos  map[string]time.Duration
+}
+
+// NewSimLoadValidity parses the comma-separated "PROMO=duration" list of
+// promos, e.g. "GOSURF50=72h,GOSAKTO90=168h". A zero def means
+// DefaultSimLoadValidity.
+func NewSimLoadValidity(def time.Duration, promos string) (SimLoadValidity, error) {
+	if def <= 0 {
+		def = DefaultSimLoadValidity
+	}
+	v := SimLoadValidity{
+		Default: def,
+		Promos:  make(map[string]time.Duration),
+	}
+
+	for _, item := range strings.Split(promos, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		promo, d, ok := strings.Cut(item, "=")
+		if !ok {
+			return v, fmt.Errorf("invalid promo validity: %s", item)
+		}
+		dur, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil || dur <= 0 {
+			return v, fmt.Errorf("invalid promo validity: %s", item)
+		}
+		v.Promos[strings.ToUpper(strings.TrimSpace(promo))] = dur
+	}
+
+	return v, nil
+}
+
+// Of returns the validity of a load of promo.
+func (v SimLoadValidity) Of(promo string) time.Duration {
+	if d, ok := v.Promos[strings.ToUpper(promo)]; ok {
+		return d
+	}
+	return v.Default
+}
+
+// CheckSimLoad returns a job that computes when the last successful load of
+// every SIM card expires, and flags the cards whose load has expired. A card
+// that was never loaded expires validity.Default after it was added.
+func CheckSimLoad(validity SimLoadValidity) JobFunc {
+	return func(ctx context.Context, store db.Store, logger *zerolog.Logger) (JobStats, error) {
+		serviceName := "CheckSimLoad"
+		sims, err := store.ListSimCardLastLoads(ctx)
+		if err != nil {
+			logger.Error().Err(err).Str("service", serviceName).Msg("database error")
+			return JobStats{}, err
+		}
+
+		now := time.Now()
+		countFlagged := 0
+		countSuccess := 0
+		for _, sim := range sims {
+			expiresAt := sim.CreatedAt.Time.Add(validity.Default)
+			if sim.LoadedAt.Valid {
+				expiresAt = sim.LoadedAt.Time.Add(validity.Of(sim.Promo.String))
+			}
+			expired := !expiresAt.After(now)
+
+			err := store.UpdateSimCardLoad(ctx, db.UpdateSimCardLoadParams{
+				MobileNumber:  sim.MobileNumber,
+				LoadExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+				IsExpired:     expired,
+			})
+			if err != nil {
+				logger.Error().Err(err).Str("service", serviceName).Str("mobile_number", sim.MobileNumber).Msg("cannot update sim card load")
+				continue
+			}
+			countSuccess++
+
+			if expired && !sim.LoadFlaggedAt.Valid {
+				countFlagged++
+				logger.Warn().Str("service", serviceName).
+					Str("mobile_number", sim.MobileNumber).
+					Time("load_expires_at", expiresAt).
+					Msg("[SIM] Load expired")
+			}
+		}
+		logger.Info().Str("service", serviceName).
+			Str("success", fmt.Sprintf("%d/%d", countSuccess, len(sims))).
+			Int("flagged", countFlagged).
+			Msg("sim card load checked")
+		return JobStats{Count: len(sims), CountSuccess: countSuccess}, nil
+	}
+}
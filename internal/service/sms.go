@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/emiliogozo/panahon-api-go/internal/util"
+	"github.com/rs/zerolog"
+)
+
+// NewSmsRegistry registers the SMS gateways available in conf. Every gateway
+// accepts inbound messages; only those with credentials can send.
+func NewSmsRegistry(conf util.Config, store db.Store, logger *zerolog.Logger) *sms.Registry {
+	reg := sms.NewRegistry()
+
+	pTexter := sms.NewPromoTexter(nil, conf.PtexterApiKey, conf.PtexterApiSecret, conf.PtexterSenderID)
+	reg.RegisterInbound(sms.PromoTexterKey, pTexter)
+	if len(conf.PtexterApiKey) > 0 {
+		reg.RegisterOutbound(sms.PromoTexterKey, pTexter)
+	}
+
+	gLabs := sms.NewGLabs(nil, conf.GlabsAPIURL, conf.GlabsShortCode, gLabsTokenSource(store))
+	reg.RegisterInbound(sms.GLabsKey, gLabs)
+	if len(conf.GlabsShortCode) > 0 {
+		reg.RegisterOutbound(sms.GLabsKey, gLabs)
+	}
+
+	httpGw := sms.NewHTTP(nil, conf.SmsHTTPSendURL, conf.SmsHTTPToken, sms.DefaultHTTPFields)
+	reg.RegisterInbound(sms.HTTPKey, httpGw)
+	if len(conf.SmsHTTPSendURL) > 0 {
+		reg.RegisterOutbound(sms.HTTPKey, httpGw)
+	}
+
+	if err := reg.SetRoutes(conf.SmsRoutes, conf.SmsDefaultSender); err != nil {
+		logger.Error().Err(err).
+			Str("routes", conf.SmsRoutes).
+			Msg("[SMS] Invalid routes")
+	}
+
+	return reg
+}
+
+// gLabsTokenSource reads the access token a subscriber granted on opt-in.
+func gLabsTokenSource(store db.Store) sms.TokenSource {
+	return func(ctx context.Context, mobileNumber string) (string, error) {
+		tkn, err := store.GetLatestSimAccessToken(ctx, db.GetLatestSimAccessTokenParams{
+			MobileNumber: mobileNumber,
+			Type:         sms.GLabsKey,
+		})
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return "", sms.ErrUnsubscribed
+		}
+		return tkn.AccessToken, err
+	}
+}
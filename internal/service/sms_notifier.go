@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	SmsKindAlert   = "ALERT"
+	SmsKindSummary = "SUMMARY"
+
+	SmsStatusQueued    = "QUEUED"
+	SmsStatusSending   = "SENDING"
+	SmsStatusSent      = "SENT"
+	SmsStatusFailed    = "FAILED"
+	SmsStatusCancelled = "CANCELLED"
+
+	smsMaxAttempts  = 3
+	smsBaseBackoff  = 2 * time.Second
+	smsSummaryRange = 48 * time.Hour
+
+	smsAlertWorkers = 4
+	smsAlertQueue   = 256
+)
+
+// SmsNotifier sends weather alerts and daily summaries to the Globe Labs
+// subscribers following a station. Every message is recorded in sms_messages
+// with its delivery status.
+type SmsNotifier struct {
+	store       db.Store
+	sender      sms.OutboundSender
+	logger      *zerolog.Logger
+	maxAttempts int
+	backoff     func(attempt int) time.Duration
+	alerts      chan stationAlert
+}
+
+type stationAlert struct {
+	stationID int64
+	rule      string
+	text      string
+}
+
+// NewSmsNotifier creates a notifier sending through the Globe Labs sender of
+// reg. It returns nil if Globe Labs cannot send, i.e. no short code is configured.
+func NewSmsNotifier(store db.Store, reg *sms.Registry, logger *zerolog.Logger) *SmsNotifier {
+	sender, ok := reg.Outbound(sms.GLabsKey)
+	if !ok {
+		return nil
+	}
+	return &SmsNotifier{
+		store:       store,
+		sender:      sender,
+		logger:      logger,
+		maxAttempts: smsMaxAttempts,
+		backoff:     smsBackoff,
+		alerts:      make(chan stationAlert, smsAlertQueue),
+	}
+}
+
+// Start runs the workers sending the queued station alerts in g until ctx is
+// done. The alerts still queued then are dropped.
+func (n *SmsNotifier) Start(ctx context.Context, g *errgroup.Group) {
+	for i := 0; i < smsAlertWorkers; i++ {
+		g.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case a := <-n.alerts:
+					n.notifyQueued(ctx, a)
+				}
+			}
+		})
+	}
+}
+
+// QueueStationAlert queues text for the subscribers of the alerts of the
+// station, so that slow deliveries never hold up the caller. The alert is
+// dropped, returning false, if the queue is full.
+func (n *SmsNotifier) QueueStationAlert(stationID int64, rule, text string) bool {
+	select {
+	case n.alerts <- stationAlert{stationID: stationID, rule: rule, text: text}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (n *SmsNotifier) notifyQueued(ctx context.Context, a stationAlert) {
+	stats, err := n.NotifyStationAlert(ctx, a.stationID, a.text)
+	if err != nil {
+		n.logger.Error().Err(err).
+			Int64("station_id", a.stationID).
+			Str("rule", a.rule).
+			Msg("[SMS] Cannot notify station alert")
+		return
+	}
+	n.logger.Debug().
+		Int64("station_id", a.stationID).
+		Int("count", stats.Count).
+		Int("count_success", stats.CountSuccess).
+		Msg("[SMS] Station alert notified")
+}
+
+// smsBackoff returns the delay before the next attempt, with full jitter.
+func smsBackoff(attempt int) time.Duration {
+	ceil := smsBaseBackoff << (attempt - 1)
+	return time.Duration(rand.Int63n(int64(ceil))) + time.Millisecond
+}
+
+// NotifyStationAlert sends text to the subscribers of the alerts of the station.
+func (n *SmsNotifier) NotifyStationAlert(ctx context.Context, stationID int64, text string) (JobStats, error) {
+	var stats JobStats
+
+	subs, err := n.store.ListStationAlertSubscriptions(ctx, db.ListStationAlertSubscriptionsParams{
+		StationID: stationID,
+		TokenType: sms.GLabsKey,
+	})
+	if err != nil || len(subs) == 0 {
+		return stats, err
+	}
+
+	station, err := n.store.GetStation(ctx, stationID)
+	if err != nil {
+		return stats, err
+	}
+	text = fmt.Sprintf("Panahon alert for %s: %s", station.Name, text)
+
+	for _, sub := range subs {
+		stats.Count++
+		if err := n.send(ctx, sub, SmsKindAlert, text); err == nil {
+			stats.CountSuccess++
+		}
+	}
+
+	return stats, nil
+}
+
+// SendDailySummaries sends the latest daily observation summary of the
+// followed stations to their subscribers.
+func (n *SmsNotifier) SendDailySummaries(ctx context.Context) (JobStats, error) {
+	var stats JobStats
+
+	subs, err := n.store.ListDailySummarySubscriptions(ctx, sms.GLabsKey)
+	if err != nil {
+		return stats, err
+	}
+
+	texts := make(map[int64]string)
+	for _, sub := range subs {
+		text, ok := texts[sub.StationID]
+		if !ok {
+			text, err = n.dailySummary(ctx, sub.StationID)
+			if err != nil {
+				n.logger.Error().Err(err).
+					Int64("station_id", sub.StationID).
+					Msg("[SMS] Cannot build daily summary")
+			}
+			texts[sub.StationID] = text
+		}
+		if len(text) == 0 {
+			continue
+		}
+
+		stats.Count++
+		if err := n.send(ctx, sub, SmsKindSummary, text); err == nil {
+			stats.CountSuccess++
+		}
+	}
+
+	return stats, nil
+}
+
+// dailySummary describes the latest daily observation of the station. It is
+// empty if the station has no recent daily observation.
+func (n *SmsNotifier) dailySummary(ctx context.Context, stationID int64) (string, error) {
+	station, err := n.store.GetStation(ctx, stationID)
+	if err != nil {
+		return "", err
+	}
+
+	obs, err := n.store.ListStationDailyObservations(ctx, db.ListStationDailyObservationsParams{
+		StationID:   stationID,
+		IsStartDate: true,
+		StartDate: pgtype.Timestamptz{
+			Time:  time.Now().Add(-smsSummaryRange),
+			Valid: true,
+		},
+		Limit: pgtype.Int4{Int32: 1, Valid: true},
+	})
+	if err != nil || len(obs) == 0 {
+		return "", err
+	}
+	o := obs[0]
+
+	var parts []string
+	if o.Tn.Valid && o.Tx.Valid {
+		parts = append(parts, fmt.Sprintf("temp %.1f-%.1fC", o.Tn.Float32, o.Tx.Float32))
+	}
+	if o.Rh.Valid {
+		parts = append(parts, fmt.Sprintf("RH %.0f%%", o.Rh.Float32))
+	}
+	if o.Rain.Valid {
+		parts = append(parts, fmt.Sprintf("rain %.1fmm", o.Rain.Float32))
+	}
+	if o.Gust.Valid {
+		parts = append(parts, fmt.Sprintf("gust %.1fm/s", o.Gust.Float32))
+	}
+	if len(parts) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("Panahon %s, %s: %s",
+		station.Name, o.Timestamp.Time.Format("Jan 2"), strings.Join(parts, ", ")), nil
+}
+
+func (n *SmsNotifier) send(ctx context.Context, sub db.SmsSubscription, kind, text string) error {
+	msg, err := n.store.CreateSmsMessage(ctx, db.CreateSmsMessageParams{
+		MobileNumber: sub.MobileNumber,
+		StationID:    pgtype.Int8{Int64: sub.StationID, Valid: true},
+		Kind:         kind,
+		Message:      text,
+	})
+	if err != nil {
+		n.logger.Error().Err(err).
+			Str("mobile_number", sub.MobileNumber).
+			Int64("station_id", sub.StationID).
+			Msg("[SMS] Cannot queue message")
+		return err
+	}
+
+	err = n.deliver(ctx, msg)
+	if err != nil {
+		n.logger.Warn().Err(err).
+			Int64("id", msg.ID).
+			Str("mobile_number", msg.MobileNumber).
+			Msg("[SMS] Message not delivered")
+	}
+	return err
+}
+
+// deliver sends a queued message, retrying transient failures with backoff.
+// It stops as soon as the message is cancelled, e.g. because the subscriber
+// unsubscribed while it was being sent. A message whose retry is interrupted
+// by ctx is left queued.
+func (n *SmsNotifier) deliver(ctx context.Context, msg db.SmsMessage) error {
+	for attempt := 1; ; attempt++ {
+		msg, err := n.store.StartSmsMessageAttempt(ctx, msg.ID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return sms.ErrUnsubscribed
+			}
+			return err
+		}
+
+		sendErr := n.sender.Send(ctx, msg.MobileNumber, msg.Message)
+
+		status := SmsStatusSent
+		switch {
+		case sendErr == nil:
+		case errors.Is(sendErr, sms.ErrUnsubscribed):
+			status = SmsStatusCancelled
+		case sms.IsTransient(sendErr) && attempt < n.maxAttempts:
+			status = SmsStatusQueued
+		default:
+			status = SmsStatusFailed
+		}
+
+		arg := db.FinishSmsMessageAttemptParams{
+			ID:     msg.ID,
+			Status: status,
+		}
+		if sendErr != nil {
+			arg.Error = pgtype.Text{String: sendErr.Error(), Valid: true}
+		}
+		_, err = n.store.FinishSmsMessageAttempt(ctx, arg)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				// Cancelled while in flight.
+				return sms.ErrUnsubscribed
+			}
+			return err
+		}
+		if status != SmsStatusQueued {
+			return sendErr
+		}
+
+		n.logger.Debug().Err(sendErr).
+			Int64("id", msg.ID).
+			Int("attempt", attempt).
+			Msg("[SMS] Retrying message")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(n.backoff(attempt)):
+		}
+	}
+}
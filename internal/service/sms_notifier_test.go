@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	mockdb "github.com/emiliogozo/panahon-api-go/internal/mocks/db"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// sendFunc is an sms.OutboundSender answering each attempt in turn.
+type sendFunc func(ctx context.Context, to, text string) error
+
+func (f sendFunc) Send(ctx context.Context, to, text string) error {
+	return f(ctx, to, text)
+}
+
+// sendResults returns a sender failing with errs in order, then succeeding.
+func sendResults(errs ...error) sendFunc {
+	return func(context.Context, string, string) error {
+		if len(errs) == 0 {
+			return nil
+		}
+		err := errs[0]
+		errs = errs[1:]
+		return err
+	}
+}
+
+func TestSmsNotifierDeliver(t *testing.T) {
+	msg := db.SmsMessage{ID: 1, MobileNumber: "639171234567", Message: "Panahon alert", Status: SmsStatusQueued}
+	transient := &sms.SendError{StatusCode: http.StatusServiceUnavailable}
+	permanent := &sms.SendError{StatusCode: http.StatusBadRequest}
+
+	stubAttempts := func(store *mockdb.MockStore, times int) {
+		store.EXPECT().StartSmsMessageAttempt(mock.Anything, msg.ID).
+			Return(msg, nil).
+			Times(times)
+	}
+	stubFinish := func(store *mockdb.MockStore, status string, hasError bool) {
+		store.EXPECT().FinishSmsMessageAttempt(mock.Anything, mock.MatchedBy(func(arg db.FinishSmsMessageAttemptParams) bool {
+			return arg.ID == msg.ID && arg.Status == status && arg.Error.Valid == hasError
+		})).
+			Return(db.SmsMessage{ID: msg.ID, Status: status}, nil).
+			Once()
+	}
+
+	testCases := []struct {
+		name       string
+		sender     sms.OutboundSender
+		ctx        func() (context.Context, context.CancelFunc)
+		buildStubs func(store *mockdb.MockStore)
+		checkErr   func(err error)
+	}{
+		{
+			name:   "TransientThenSent",
+			sender: sendResults(transient),
+			buildStubs: func(store *mockdb.MockStore) {
+				stubAttempts(store, 2)
+				stubFinish(store, SmsStatusQueued, true)
+				stubFinish(store, SmsStatusSent, false)
+			},
+			checkErr: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "TransientExhausted",
+			sender: sendResults(transient, transient, transient),
+			buildStubs: func(store *mockdb.MockStore) {
+				stubAttempts(store, 3)
+				store.EXPECT().FinishSmsMessageAttempt(mock.Anything, mock.MatchedBy(func(arg db.FinishSmsMessageAttemptParams) bool {
+					return arg.Status == SmsStatusQueued
+				})).
+					Return(db.SmsMessage{ID: msg.ID, Status: SmsStatusQueued}, nil).
+					Twice()
+				stubFinish(store, SmsStatusFailed, true)
+			},
+			checkErr: func(err error) {
+				require.ErrorIs(t, err, transient)
+			},
+		},
+		{
+			name:   "PermanentFailure",
+			sender: sendResults(permanent),
+			buildStubs: func(store *mockdb.MockStore) {
+				stubAttempts(store, 1)
+				stubFinish(store, SmsStatusFailed, true)
+			},
+			checkErr: func(err error) {
+				require.ErrorIs(t, err, permanent)
+			},
+		},
+		{
+			name:   "Unsubscribed",
+			sender: sendResults(sms.ErrUnsubscribed),
+			buildStubs: func(store *mockdb.MockStore) {
+				stubAttempts(store, 1)
+				stubFinish(store, SmsStatusCancelled, true)
+			},
+			checkErr: func(err error) {
+				require.ErrorIs(t, err, sms.ErrUnsubscribed)
+			},
+		},
+		{
+			name:   "CancelledInFlight",
+			sender: sendResults(),
+			buildStubs: func(store *mockdb.MockStore) {
+				stubAttempts(store, 1)
+				// The subscriber unsubscribed while the message was sent.
+				store.EXPECT().FinishSmsMessageAttempt(mock.Anything, mock.Anything).
+					Return(db.SmsMessage{}, db.ErrRecordNotFound).
+					Once()
+			},
+			checkErr: func(err error) {
+				require.ErrorIs(t, err, sms.ErrUnsubscribed)
+			},
+		},
+		{
+			name:   "CancelledBeforeAttempt",
+			sender: sendResults(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().StartSmsMessageAttempt(mock.Anything, msg.ID).
+					Return(db.SmsMessage{}, db.ErrRecordNotFound).
+					Once()
+			},
+			checkErr: func(err error) {
+				require.ErrorIs(t, err, sms.ErrUnsubscribed)
+			},
+		},
+		{
+			name: "ContextDoneDuringBackoff",
+			// The backoff outlasts the context, so the message is left queued.
+			sender: sendResults(transient),
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				stubAttempts(store, 1)
+				stubFinish(store, SmsStatusQueued, true)
+			},
+			checkErr: func(err error) {
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			store := mockdb.NewMockStore(t)
+			tc.buildStubs(store)
+
+			logger := zerolog.Nop()
+			backoff := time.Millisecond
+			ctx, cancel := context.WithCancel(context.Background())
+			if tc.ctx != nil {
+				ctx, cancel = tc.ctx()
+				backoff = time.Minute
+			}
+			defer cancel()
+
+			n := &SmsNotifier{
+				store:       store,
+				sender:      tc.sender,
+				logger:      &logger,
+				maxAttempts: smsMaxAttempts,
+				backoff:     func(int) time.Duration { return backoff },
+			}
+			tc.checkErr(n.deliver(ctx, msg))
+		})
+	}
+}
+
+func TestSmsNotifierQueueStationAlert(t *testing.T) {
+	store := mockdb.NewMockStore(t)
+	logger := zerolog.Nop()
+	n := &SmsNotifier{
+		store:  store,
+		logger: &logger,
+		alerts: make(chan stationAlert, 1),
+	}
+
+	require.True(t, n.QueueStationAlert(1, "STALE", "No data"))
+	require.False(t, n.QueueStationAlert(2, "STALE", "No data"))
+
+	notified := make(chan int64, 1)
+	store.EXPECT().ListStationAlertSubscriptions(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, arg db.ListStationAlertSubscriptionsParams) ([]db.SmsSubscription, error) {
+			notified <- arg.StationID
+			return nil, nil
+		}).
+		Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+	n.Start(ctx, g)
+
+	select {
+	case stationID := <-notified:
+		require.Equal(t, int64(1), stationID)
+	case <-time.After(time.Second):
+		t.Fatal("station alert not notified")
+	}
+
+	cancel()
+	require.NoError(t, g.Wait())
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/emiliogozo/panahon-api-go/internal/db/sqlc"
+	"github.com/emiliogozo/panahon-api-go/internal/sms"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+)
+
+const (
+	SmsQueryStatusSent   = "SENT"
+	SmsQueryStatusFailed = "FAILED"
+
+	smsQueryMaxStations = 10
+)
+
+// smsLocation is the time zone of the times quoted in SMS replies.
+var smsLocation = time.FixedZone("PHT", 8*60*60)
+
+var compassPoints = []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// SmsQuery answers the keyword commands texted by subscribers, e.g.
+// "WEATHER <station>", and replies through the gateway that received them.
+// Every query is recorded in sms_queries.
+type SmsQuery struct {
+	store    db.Store
+	commands *sms.Commands
+	logger   *zerolog.Logger
+}
+
+// NewSmsQuery creates a query service with the WEATHER, RAIN and HELP commands.
+func NewSmsQuery(store db.Store, logger *zerolog.Logger) *SmsQuery {
+	q := &SmsQuery{
+		store:    store,
+		commands: sms.NewCommands(),
+		logger:   logger,
+	}
+	q.Register(sms.Command{Keyword: "WEATHER", Usage: "WEATHER <station>", Run: q.weather})
+	q.Register(sms.Command{Keyword: "RAIN", Usage: "RAIN <province>", Run: q.rain})
+	q.Register(sms.Command{Keyword: "HELP", Usage: "HELP", Run: q.help})
+	return q
+}
+
+// Register adds a command. It panics under the same conditions as
+// sms.Commands.Register.
+func (q *SmsQuery) Register(cmd sms.Command) {
+	q.commands.Register(cmd)
+}
+
+// Match reports whether text is a command rather than, e.g., station data.
+func (q *SmsQuery) Match(text string) bool {
+	_, _, ok := q.commands.Lookup(text)
+	return ok
+}
+
+// Answer runs the command in msg and sends the reply to msg.From through
+// sender, split into as many messages as needed. A nil sender records the
+// query as failed. The returned error is only set if the query cannot be
+// answered or recorded; a failed delivery is reported in the query status.
+func (q *SmsQuery) Answer(ctx context.Context, provider string, sender sms.OutboundSender, msg sms.Message) (db.SmsQuery, error) {
+	cmd, args, ok := q.commands.Lookup(msg.Text)
+	if !ok {
+		return db.SmsQuery{}, fmt.Errorf("unknown command: %s", msg.Text)
+	}
+
+	reply, err := cmd.Run(ctx, msg.From, args)
+	if err != nil {
+		return db.SmsQuery{}, fmt.Errorf("%s: %w", cmd.Keyword, err)
+	}
+
+	arg := db.CreateSmsQueryParams{
+		MobileNumber: msg.From,
+		Provider:     strings.ToUpper(provider),
+		Keyword:      cmd.Keyword,
+		Message:      msg.Text,
+		Reply:        reply,
+		Status:       SmsQueryStatusSent,
+	}
+
+	sendErr := sms.ErrNoSender
+	if sender != nil {
+		sendErr = nil
+		for _, part := range sms.Split(reply) {
+			if sendErr = sender.Send(ctx, msg.From, part); sendErr != nil {
+				break
+			}
+			arg.Parts++
+		}
+	}
+	if sendErr != nil {
+		arg.Status = SmsQueryStatusFailed
+		arg.Error = pgtype.Text{String: sendErr.Error(), Valid: true}
+		q.logger.Warn().Err(sendErr).
+			Str("provider", arg.Provider).
+			Str("mobile_number", msg.From).
+			Str("keyword", cmd.Keyword).
+			Msg("[SMS] Reply not delivered")
+	}
+
+	return q.store.CreateSmsQuery(ctx, arg)
+}
+
+func (q *SmsQuery) weather(ctx context.Context, from string, args []string) (string, error) {
+	if len(args) == 0 {
+		return "Text WEATHER <station name or code>, e.g. WEATHER Manila", nil
+	}
+	search := strings.Join(args, " ")
+
+	station, err := q.store.FindStation(ctx, search)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Sprintf("No station matches %s. Text HELP for the list of commands.", search), nil
+		}
+		return "", err
+	}
+
+	obs, err := q.store.GetLatestStationObservation(ctx, station.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Sprintf("%s has no recent observation.", station.Name), nil
+		}
+		return "", err
+	}
+	o := obs.ObservationsCurrent
+
+	var parts []string
+	if o.Temp.Valid {
+		parts = append(parts, fmt.Sprintf("temp %.1fC", o.Temp.Float32))
+	}
+	if o.Rh.Valid {
+		parts = append(parts, fmt.Sprintf("RH %.0f%%", o.Rh.Float32))
+	}
+	if o.Rain.Valid {
+		parts = append(parts, fmt.Sprintf("rain %.1fmm", o.Rain.Float32))
+	}
+	if o.Wspd.Valid {
+		wind := fmt.Sprintf("wind %.1fm/s", o.Wspd.Float32)
+		if o.Wdir.Valid {
+			wind += " " + compassPoint(o.Wdir.Float32)
+		}
+		parts = append(parts, wind)
+	}
+	if o.Gust.Valid {
+		parts = append(parts, fmt.Sprintf("gust %.1fm/s", o.Gust.Float32))
+	}
+	if o.Mslp.Valid {
+		parts = append(parts, fmt.Sprintf("MSLP %.0fhPa", o.Mslp.Float32))
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("%s has no recent observation.", station.Name), nil
+	}
+
+	return fmt.Sprintf("%s %s: %s",
+		station.Name, smsTime(o.Timestamp.Time), strings.Join(parts, ", ")), nil
+}
+
+func (q *SmsQuery) rain(ctx context.Context, from string, args []string) (string, error) {
+	if len(args) == 0 {
+		return "Text RAIN <province>, e.g. RAIN Cebu", nil
+	}
+	province := strings.Join(args, " ")
+
+	ids, err := q.store.ListProvinceStationIDs(ctx, province)
+	if err != nil {
+		return "", err
+	}
+	if len(ids) == 0 {
+		return fmt.Sprintf("No station in %s. Text HELP for the list of commands.", province), nil
+	}
+	inProvince := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		inProvince[id] = true
+	}
+
+	obs, err := q.store.ListLatestObservations(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	var latest time.Time
+	for _, o := range obs {
+		if !inProvince[o.ID] || !o.Rain.Valid {
+			continue
+		}
+		if len(parts) == smsQueryMaxStations {
+			parts = append(parts, "...")
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s %.1fmm", o.Name, o.Rain.Float32))
+		if o.Timestamp.Time.After(latest) {
+			latest = o.Timestamp.Time
+		}
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("No recent rain observation in %s.", province), nil
+	}
+
+	return fmt.Sprintf("Rain in %s %s: %s",
+		strings.ToUpper(province), smsTime(latest), strings.Join(parts, ", ")), nil
+}
+
+func (q *SmsQuery) help(ctx context.Context, from string, args []string) (string, error) {
+	return "Panahon SMS commands: " + strings.Join(q.commands.Usages(), ", "), nil
+}
+
+func smsTime(t time.Time) string {
+	return t.In(smsLocation).Format("Jan 2 3:04PM")
+}
+
+func compassPoint(deg float32) string {
+	i := int((deg+22.5)/45) % len(compassPoints)
+	if i < 0 {
+		i += len(compassPoints)
+	}
+	return compassPoints[i]
+}
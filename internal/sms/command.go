@@ -0,0 +1,86 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CommandFunc answers a keyword query sent by from. args are the words that
+// follow the keyword.
+type CommandFunc func(ctx context.Context, from string, args []string) (string, error)
+
+// Command is a keyword subscribers can text, e.g. "WEATHER <station>".
+type Command struct {
+	Keyword string
+	Usage   string
+	Run     CommandFunc
+}
+
+// Commands holds the keyword commands understood from inbound messages. A
+// message is a command if its first word is a registered keyword.
+type Commands struct {
+	mu       sync.RWMutex
+	cmds     map[string]Command
+	keywords []string
+}
+
+// NewCommands creates an empty Commands.
+func NewCommands() *Commands {
+	return &Commands{
+		cmds: make(map[string]Command),
+	}
+}
+
+// Register makes cmd available under its keyword. It panics if the keyword is
+// empty, Run is nil or the keyword is already registered.
+func (c *Commands) Register(cmd Command) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cmd.Keyword = normalizeKey(cmd.Keyword)
+	if len(cmd.Keyword) == 0 {
+		panic("sms: Register keyword is empty")
+	}
+	if cmd.Run == nil {
+		panic(fmt.Sprintf("sms: Register command %s has no Run", cmd.Keyword))
+	}
+	if _, dup := c.cmds[cmd.Keyword]; dup {
+		panic(fmt.Sprintf("sms: Register called twice for command %s", cmd.Keyword))
+	}
+	if len(cmd.Usage) == 0 {
+		cmd.Usage = cmd.Keyword
+	}
+	c.cmds[cmd.Keyword] = cmd
+	c.keywords = append(c.keywords, cmd.Keyword)
+}
+
+// Lookup finds the command text starts with. Keywords are case-insensitive.
+func (c *Commands) Lookup(text string) (Command, []string, bool) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return Command{}, nil, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cmd, ok := c.cmds[normalizeKey(fields[0])]
+	if !ok {
+		return Command{}, nil, false
+	}
+	return cmd, fields[1:], true
+}
+
+// Usages returns the usage of every command, in registration order.
+func (c *Commands) Usages() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	usages := make([]string, len(c.keywords))
+	for i, k := range c.keywords {
+		usages[i] = c.cmds[k].Usage
+	}
+	return usages
+}
@@ -0,0 +1,44 @@
+package sms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommands(t *testing.T) {
+	echo := func(ctx context.Context, from string, args []string) (string, error) {
+		return from, nil
+	}
+
+	cmds := NewCommands()
+	cmds.Register(Command{Keyword: "weather", Usage: "WEATHER <station>", Run: echo})
+	cmds.Register(Command{Keyword: "HELP", Run: echo})
+
+	require.Equal(t, []string{"WEATHER <station>", "HELP"}, cmds.Usages())
+
+	testCases := []struct {
+		text    string
+		keyword string
+		args    []string
+		ok      bool
+	}{
+		{"WEATHER Manila Observatory", "WEATHER", []string{"Manila", "Observatory"}, true},
+		{"  weather\tQuezon ", "WEATHER", []string{"Quezon"}, true},
+		{"help", "HELP", []string{}, true},
+		{"WEATHERS Manila", "", nil, false},
+		{"RAIN Cebu", "", nil, false},
+		{"", "", nil, false},
+	}
+	for _, tc := range testCases {
+		cmd, args, ok := cmds.Lookup(tc.text)
+		require.Equal(t, tc.ok, ok, tc.text)
+		require.Equal(t, tc.keyword, cmd.Keyword, tc.text)
+		require.Equal(t, tc.args, args, tc.text)
+	}
+
+	require.Panics(t, func() { cmds.Register(Command{Keyword: "Help", Run: echo}) })
+	require.Panics(t, func() { cmds.Register(Command{Keyword: " ", Run: echo}) })
+	require.Panics(t, func() { cmds.Register(Command{Keyword: "NIL"}) })
+}
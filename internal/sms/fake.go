@@ -0,0 +1,56 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+const FakeKey = "FAKE"
+
+// Fake is an in-memory gateway for tests. Its webhook takes the same payload
+// as HTTP with DefaultHTTPFields, and sent messages are recorded instead of
+// delivered.
+type Fake struct {
+	mu   sync.Mutex
+	sent []Message
+	err  error
+}
+
+// NewFake creates a new Fake gateway.
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// ParseInbound implements InboundAdapter.
+func (f *Fake) ParseInbound(r *http.Request) ([]Message, error) {
+	return parseJSONMessages(r.Body, DefaultHTTPFields)
+}
+
+// Send implements OutboundSender.
+func (f *Fake) Send(ctx context.Context, to, text string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, Message{To: to, Text: text})
+	return nil
+}
+
+// FailWith makes Send return err. A nil err restores delivery.
+func (f *Fake) FailWith(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+// Sent returns the messages sent so far.
+func (f *Fake) Sent() []Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Message(nil), f.sent...)
+}
@@ -0,0 +1,139 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	GLabsKey = "GLABS"
+	// GLabsBaseURL is the Globe Labs API.
+	GLabsBaseURL = "https://devapi.globelabs.com.ph"
+
+	gLabsDateTimeLayout = "Mon Jan 02 2006 15:04:05 GMT-0700 (MST)"
+)
+
+// TokenSource returns the access token a subscriber granted the app on
+// opt-in, which Globe Labs requires to send it messages. It returns
+// ErrUnsubscribed if the subscriber has none.
+type TokenSource func(ctx context.Context, mobileNumber string) (string, error)
+
+// GLabs is the Globe Labs gateway. Its webhook may batch several messages
+// in one request.
+type GLabs struct {
+	client    Doer
+	baseURL   string
+	shortCode string
+	token     TokenSource
+}
+
+// NewGLabs creates a new Globe Labs gateway sending from shortCode through the
+// API at baseURL, GLabsBaseURL if empty. A default client is used if client
+// is nil.
+func NewGLabs(client Doer, baseURL, shortCode string, token TokenSource) *GLabs {
+	if len(baseURL) == 0 {
+		baseURL = GLabsBaseURL
+	}
+	return &GLabs{
+		client:    defaultClient(client),
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		shortCode: shortCode,
+		token:     token,
+	}
+}
+
+type gLabsInbound struct {
+	InboundSMSMessageList *struct {
+		InboundSMSMessage []struct {
+			DateTime           string `json:"dateTime"`
+			DestinationAddress string `json:"destinationAddress"`
+			MessageID          string `json:"messageId"`
+			Message            string `json:"message"`
+			SenderAddress      string `json:"senderAddress"`
+		} `json:"inboundSMSMessage"`
+		NumberOfMessagesInThisBatch int `json:"numberOfMessagesInThisBatch"`
+	} `json:"inboundSMSMessageList"`
+}
+
+// ParseInbound implements InboundAdapter.
+func (g *GLabs) ParseInbound(r *http.Request) ([]Message, error) {
+	var req gLabsInbound
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if req.InboundSMSMessageList == nil || len(req.InboundSMSMessageList.InboundSMSMessage) == 0 {
+		return nil, fmt.Errorf("%w: no inbound message", ErrInvalidPayload)
+	}
+
+	msgs := make([]Message, len(req.InboundSMSMessageList.InboundSMSMessage))
+	for i, m := range req.InboundSMSMessageList.InboundSMSMessage {
+		if len(m.SenderAddress) == 0 || len(m.Message) == 0 {
+			return nil, fmt.Errorf("%w: message %d has no sender or text", ErrInvalidPayload, i)
+		}
+		msgs[i] = Message{
+			ID:   m.MessageID,
+			From: trimAddress(m.SenderAddress),
+			To:   trimAddress(m.DestinationAddress),
+			Text: m.Message,
+		}
+		if ts, err := time.Parse(gLabsDateTimeLayout, m.DateTime); err == nil {
+			msgs[i].Time = ts
+		}
+	}
+
+	return msgs, nil
+}
+
+type gLabsOutbound struct {
+	OutboundSMSMessageRequest struct {
+		ClientCorrelator       string `json:"clientCorrelator"`
+		SenderAddress          string `json:"senderAddress"`
+		OutboundSMSTextMessage struct {
+			Message string `json:"message"`
+		} `json:"outboundSMSTextMessage"`
+		Address string `json:"address"`
+	} `json:"outboundSMSMessageRequest"`
+}
+
+// Send implements OutboundSender.
+func (g *GLabs) Send(ctx context.Context, to, text string) error {
+	if g.token == nil {
+		return fmt.Errorf("glabs: no token source")
+	}
+	accessToken, err := g.token(ctx, to)
+	if err != nil {
+		return fmt.Errorf("glabs: access token of %s: %w", to, err)
+	}
+	if len(accessToken) == 0 {
+		return fmt.Errorf("glabs: access token of %s: %w", to, ErrUnsubscribed)
+	}
+
+	var msg gLabsOutbound
+	msg.OutboundSMSMessageRequest.ClientCorrelator = uuid.NewString()
+	msg.OutboundSMSMessageRequest.SenderAddress = g.shortCode
+	msg.OutboundSMSMessageRequest.OutboundSMSTextMessage.Message = text
+	msg.OutboundSMSMessageRequest.Address = to
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	sendURL := fmt.Sprintf("%s/smsmessaging/v1/outbound/%s/requests?access_token=%s",
+		g.baseURL, url.PathEscape(g.shortCode), url.QueryEscape(accessToken))
+
+	err = postJSON(ctx, g.client, sendURL, nil, body)
+	var sendErr *SendError
+	if errors.As(err, &sendErr) && sendErr.StatusCode == http.StatusUnauthorized {
+		// The token is revoked once the subscriber opts out.
+		return fmt.Errorf("glabs: %w: %v", ErrUnsubscribed, err)
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const gLabsRewardsPath = "/rewards/v1/transactions/send"
+
+// GLabsRewards sends prepaid load to mobile numbers through the Globe Labs
+// rewards API. The outcome of a request is posted later to the load webhook.
+type GLabsRewards struct {
+	client       Doer
+	baseURL      string
+	appID        string
+	appSecret    string
+	rewardsToken string
+}
+
+// NewGLabsRewards creates a new Globe Labs rewards client for the API at
+// baseURL, GLabsBaseURL if empty. A default client is used if client is nil.
+func NewGLabsRewards(client Doer, baseURL, appID, appSecret, rewardsToken string) *GLabsRewards {
+	if len(baseURL) == 0 {
+		baseURL = GLabsBaseURL
+	}
+	return &GLabsRewards{
+		client:       defaultClient(client),
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		appID:        appID,
+		appSecret:    appSecret,
+		rewardsToken: rewardsToken,
+	}
+}
+
+type gLabsRewardRequest struct {
+	OutboundRewardRequest struct {
+		AppID        string `json:"app_id"`
+		AppSecret    string `json:"app_secret"`
+		RewardsToken string `json:"rewards_token"`
+		Address      string `json:"address"`
+		Promo        string `json:"promo"`
+	} `json:"outboundRewardRequest"`
+}
+
+type gLabsRewardResponse struct {
+	OutboundRewardRequest struct {
+		TransactionID int32  `json:"transaction_id"`
+		Status        string `json:"status"`
+		Address       string `json:"address"`
+		Promo         string `json:"promo"`
+	} `json:"outboundRewardRequest"`
+}
+
+// Send requests promo for mobileNumber and returns the transaction id Globe
+// Labs assigned to the request.
+func (g *GLabsRewards) Send(ctx context.Context, mobileNumber, promo string) (int32, error) {
+	var req gLabsRewardRequest
+	req.OutboundRewardRequest.AppID = g.appID
+	req.OutboundRewardRequest.AppSecret = g.appSecret
+	req.OutboundRewardRequest.RewardsToken = g.rewardsToken
+	req.OutboundRewardRequest.Address = rewardAddress(mobileNumber)
+	req.OutboundRewardRequest.Promo = promo
+	body, err := json.Marshal(req)
+	if err != nil {
+		return 0, err
+	}
+
+	var res gLabsRewardResponse
+	if err := postJSONDecode(ctx, g.client, g.baseURL+gLabsRewardsPath, nil, body, &res); err != nil {
+		return 0, fmt.Errorf("glabs rewards: %w", err)
+	}
+	if res.OutboundRewardRequest.TransactionID == 0 {
+		return 0, fmt.Errorf("glabs rewards: no transaction id in response")
+	}
+	return res.OutboundRewardRequest.TransactionID, nil
+}
+
+// rewardAddress converts a mobile number to the 10-digit form the rewards
+// API expects, e.g. 639171234567 to 9171234567.
+func rewardAddress(mobileNumber string) string {
+	s := strings.TrimPrefix(trimAddress(mobileNumber), "+")
+	if len(s) > 10 {
+		s = s[len(s)-10:]
+	}
+	return s
+}
@@ -0,0 +1,39 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGLabsRewardsSend(t *testing.T) {
+	stub := NewGLabsStub()
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	g := NewGLabsRewards(srv.Client(), srv.URL, "app", "secret", "tkn")
+
+	txID, err := g.Send(context.Background(), "639171234567", "LOAD 50")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), txID)
+
+	txID, err = g.Send(context.Background(), "+639181234567", "LOAD 50")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), txID)
+
+	rewards := stub.Rewards()
+	require.Len(t, rewards, 2)
+	require.Equal(t, GLabsReward{TransactionID: 1, Address: "9171234567", Promo: "LOAD 50"}, rewards[0])
+	require.Equal(t, "9181234567", rewards[1].Address)
+
+	stub.FailNext(http.StatusServiceUnavailable, http.StatusBadRequest)
+	_, err = g.Send(context.Background(), "639171234567", "LOAD 50")
+	require.True(t, IsTransient(err))
+	_, err = g.Send(context.Background(), "639171234567", "LOAD 50")
+	require.Error(t, err)
+	require.False(t, IsTransient(err))
+	require.Len(t, stub.Rewards(), 2)
+}
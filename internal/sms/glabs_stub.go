@@ -0,0 +1,167 @@
+package sms
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+var gLabsSendPath = regexp.MustCompile(`^/smsmessaging/v1/outbound/([^/]+)/requests$`)
+
+// GLabsStub is a local stand-in for the Globe Labs send-SMS API, for tests
+// and local development. Until a subscriber is added any access token is
+// accepted; afterwards only the tokens of the subscribers are. It also accepts
+// rewards requests, which are numbered from 1.
+type GLabsStub struct {
+	mu       sync.Mutex
+	tokens   map[string]string
+	failures []int
+	sent     []Message
+	rewards  []GLabsReward
+}
+
+// GLabsReward is a rewards request accepted by GLabsStub.
+type GLabsReward struct {
+	TransactionID int32
+	Address       string
+	Promo         string
+}
+
+// NewGLabsStub creates a new GLabsStub.
+func NewGLabsStub() *GLabsStub {
+	return &GLabsStub{tokens: make(map[string]string)}
+}
+
+// Subscribe grants accessToken to send messages to mobileNumber.
+func (s *GLabsStub) Subscribe(mobileNumber, accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[accessToken] = mobileNumber
+}
+
+// Unsubscribe revokes accessToken, as when the subscriber opts out.
+func (s *GLabsStub) Unsubscribe(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[accessToken] = ""
+}
+
+// FailNext makes the next requests fail with statusCodes, in order.
+func (s *GLabsStub) FailNext(statusCodes ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, statusCodes...)
+}
+
+// Sent returns the messages accepted so far.
+func (s *GLabsStub) Sent() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.sent...)
+}
+
+// Rewards returns the rewards requests accepted so far.
+func (s *GLabsStub) Rewards() []GLabsReward {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]GLabsReward(nil), s.rewards...)
+}
+
+type gLabsStubError struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP implements http.Handler.
+func (s *GLabsStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.URL.Path == gLabsRewardsPath && r.Method == http.MethodPost {
+		s.serveReward(w, r)
+		return
+	}
+
+	matches := gLabsSendPath.FindStringSubmatch(r.URL.Path)
+	if matches == nil || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(gLabsStubError{Error: "not found"})
+		return
+	}
+
+	var req gLabsOutbound
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(gLabsStubError{Error: err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(gLabsStubError{Error: http.StatusText(status)})
+		return
+	}
+
+	to := req.OutboundSMSMessageRequest.Address
+	if len(s.tokens) > 0 {
+		number, ok := s.tokens[r.URL.Query().Get("access_token")]
+		if !ok || number != trimAddress(to) {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(gLabsStubError{Error: "invalid access token"})
+			return
+		}
+	}
+
+	s.sent = append(s.sent, Message{
+		ID:   req.OutboundSMSMessageRequest.ClientCorrelator,
+		From: matches[1],
+		To:   trimAddress(to),
+		Text: req.OutboundSMSMessageRequest.OutboundSMSTextMessage.Message,
+	})
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(req)
+}
+
+func (s *GLabsStub) serveReward(w http.ResponseWriter, r *http.Request) {
+	var req gLabsRewardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(gLabsStubError{Error: err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(gLabsStubError{Error: http.StatusText(status)})
+		return
+	}
+
+	reward := GLabsReward{
+		TransactionID: int32(len(s.rewards) + 1),
+		Address:       req.OutboundRewardRequest.Address,
+		Promo:         req.OutboundRewardRequest.Promo,
+	}
+	s.rewards = append(s.rewards, reward)
+
+	var res gLabsRewardResponse
+	res.OutboundRewardRequest.TransactionID = reward.TransactionID
+	res.OutboundRewardRequest.Status = "Accepted"
+	res.OutboundRewardRequest.Address = reward.Address
+	res.OutboundRewardRequest.Promo = reward.Promo
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,120 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGLabsParseInbound(t *testing.T) {
+	g := NewGLabs(nil, "", "21581234", nil)
+
+	testCases := []struct {
+		name  string
+		body  string
+		check func(msgs []Message, err error)
+	}{
+		{
+			name: "OK",
+			body: `{"inboundSMSMessageList":{"inboundSMSMessage":[
+				{"dateTime":"Fri Nov 22 2013 12:12:13 GMT+0000 (UTC)","destinationAddress":"tel:21581234","messageId":"a1","message":"hello","resourceURL":null,"senderAddress":"tel:+639171234567"},
+				{"dateTime":"","destinationAddress":"tel:21581234","messageId":null,"message":"world","resourceURL":null,"senderAddress":"tel:+639181234567"}
+			],"numberOfMessagesInThisBatch":2,"resourceURL":null,"totalNumberOfPendingMessages":null}}`,
+			check: func(msgs []Message, err error) {
+				require.NoError(t, err)
+				require.Len(t, msgs, 2)
+				require.Equal(t, Message{
+					ID:   "a1",
+					From: "+639171234567",
+					To:   "21581234",
+					Text: "hello",
+					Time: time.Date(2013, 11, 22, 12, 12, 13, 0, time.UTC),
+				}, Message{
+					ID:   msgs[0].ID,
+					From: msgs[0].From,
+					To:   msgs[0].To,
+					Text: msgs[0].Text,
+					Time: msgs[0].Time.UTC(),
+				})
+				require.Empty(t, msgs[1].ID)
+				require.Equal(t, "world", msgs[1].Text)
+				require.True(t, msgs[1].Time.IsZero())
+			},
+		},
+		{
+			name: "NoMessage",
+			body: `{"inboundSMSMessageList":{"inboundSMSMessage":[]}}`,
+			check: func(msgs []Message, err error) {
+				require.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+		{
+			name: "NoSender",
+			body: `{"inboundSMSMessageList":{"inboundSMSMessage":[{"message":"hello"}]}}`,
+			check: func(msgs []Message, err error) {
+				require.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+		{
+			name: "WrongPayload",
+			body: `{"number":"639171234567","msg":"hello"}`,
+			check: func(msgs []Message, err error) {
+				require.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			tc.check(g.ParseInbound(req))
+		})
+	}
+}
+
+func TestGLabsSend(t *testing.T) {
+	stub := NewGLabsStub()
+	stub.Subscribe("639171234567", "tkn")
+	stub.Subscribe("639181234567", "revoked")
+	stub.Unsubscribe("revoked")
+	srv := httptest.NewServer(stub)
+	defer srv.Close()
+
+	tokens := map[string]string{"639171234567": "tkn", "639181234567": "revoked"}
+	g := NewGLabs(srv.Client(), srv.URL, "21581234", func(ctx context.Context, mobileNumber string) (string, error) {
+		tkn, ok := tokens[mobileNumber]
+		if !ok {
+			return "", ErrUnsubscribed
+		}
+		return tkn, nil
+	})
+
+	err := g.Send(context.Background(), "639171234567", "hello")
+	require.NoError(t, err)
+	sent := stub.Sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "21581234", sent[0].From)
+	require.Equal(t, "639171234567", sent[0].To)
+	require.Equal(t, "hello", sent[0].Text)
+	require.NotEmpty(t, sent[0].ID)
+
+	err = g.Send(context.Background(), "639181234567", "hello")
+	require.ErrorIs(t, err, ErrUnsubscribed)
+	require.False(t, IsTransient(err))
+
+	err = g.Send(context.Background(), "639191234567", "hello")
+	require.ErrorIs(t, err, ErrUnsubscribed)
+
+	stub.FailNext(http.StatusServiceUnavailable, http.StatusBadRequest)
+	err = g.Send(context.Background(), "639171234567", "hello")
+	require.True(t, IsTransient(err))
+	err = g.Send(context.Background(), "639171234567", "hello")
+	require.Error(t, err)
+	require.False(t, IsTransient(err))
+	require.Len(t, stub.Sent(), 1)
+}
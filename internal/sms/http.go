@@ -0,0 +1,124 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const HTTPKey = "HTTP"
+
+// HTTPFields names the JSON fields of the messages exchanged with an HTTP gateway.
+type HTTPFields struct {
+	ID   string
+	From string
+	To   string
+	Text string
+}
+
+// DefaultHTTPFields are the field names of Message.
+var DefaultHTTPFields = HTTPFields{
+	ID:   "id",
+	From: "from",
+	To:   "to",
+	Text: "text",
+}
+
+// HTTP is a generic gateway exchanging JSON messages. Its webhook posts a
+// message object or an array of them; messages are sent by posting a message
+// object to sendURL.
+type HTTP struct {
+	client  Doer
+	sendURL string
+	token   string
+	fields  HTTPFields
+}
+
+// NewHTTP creates a new generic HTTP gateway. token, if any, is sent as a
+// bearer token. A default client is used if client is nil.
+func NewHTTP(client Doer, sendURL, token string, fields HTTPFields) *HTTP {
+	return &HTTP{
+		client:  defaultClient(client),
+		sendURL: sendURL,
+		token:   token,
+		fields:  fields,
+	}
+}
+
+// ParseInbound implements InboundAdapter.
+func (h *HTTP) ParseInbound(r *http.Request) ([]Message, error) {
+	return parseJSONMessages(r.Body, h.fields)
+}
+
+// Send implements OutboundSender.
+func (h *HTTP) Send(ctx context.Context, to, text string) error {
+	if len(h.sendURL) == 0 {
+		return fmt.Errorf("http: no send url")
+	}
+
+	body, err := json.Marshal(map[string]string{
+		h.fields.To:   to,
+		h.fields.Text: text,
+	})
+	if err != nil {
+		return err
+	}
+
+	var header http.Header
+	if len(h.token) > 0 {
+		header = http.Header{"Authorization": {"Bearer " + h.token}}
+	}
+
+	return postJSON(ctx, h.client, h.sendURL, header, body)
+}
+
+func parseJSONMessages(r io.Reader, fields HTTPFields) ([]Message, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+
+	var raw []map[string]any
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		err = json.Unmarshal(data, &raw)
+	} else {
+		var obj map[string]any
+		err = json.Unmarshal(data, &obj)
+		raw = append(raw, obj)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("%w: no inbound message", ErrInvalidPayload)
+	}
+
+	msgs := make([]Message, len(raw))
+	for i, m := range raw {
+		msgs[i] = Message{
+			ID:   stringField(m, fields.ID),
+			From: trimAddress(stringField(m, fields.From)),
+			To:   trimAddress(stringField(m, fields.To)),
+			Text: stringField(m, fields.Text),
+		}
+		if len(msgs[i].From) == 0 || len(msgs[i].Text) == 0 {
+			return nil, fmt.Errorf("%w: message %d has no sender or text", ErrInvalidPayload, i)
+		}
+	}
+
+	return msgs, nil
+}
+
+func stringField(m map[string]any, key string) string {
+	switch v := m[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,94 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPParseInbound(t *testing.T) {
+	h := NewHTTP(nil, "", "", HTTPFields{ID: "uid", From: "sender", To: "recipient", Text: "body"})
+
+	testCases := []struct {
+		name  string
+		body  string
+		check func(msgs []Message, err error)
+	}{
+		{
+			name: "Object",
+			body: `{"uid":7,"sender":"tel:639171234567","recipient":"2158","body":"hello"}`,
+			check: func(msgs []Message, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []Message{{ID: "7", From: "639171234567", To: "2158", Text: "hello"}}, msgs)
+			},
+		},
+		{
+			name: "Array",
+			body: `[{"sender":"639171234567","body":"hello"},{"sender":"639181234567","body":"world"}]`,
+			check: func(msgs []Message, err error) {
+				require.NoError(t, err)
+				require.Len(t, msgs, 2)
+				require.Equal(t, "639181234567", msgs[1].From)
+				require.Equal(t, "world", msgs[1].Text)
+			},
+		},
+		{
+			name: "EmptyArray",
+			body: `[]`,
+			check: func(msgs []Message, err error) {
+				require.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+		{
+			name: "MissingField",
+			body: `{"from":"639171234567","text":"hello"}`,
+			check: func(msgs []Message, err error) {
+				require.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+		{
+			name: "InvalidJSON",
+			body: `{`,
+			check: func(msgs []Message, err error) {
+				require.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			tc.check(h.ParseInbound(req))
+		})
+	}
+}
+
+func TestHTTPSend(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tkn" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	h := NewHTTP(srv.Client(), srv.URL, "tkn", DefaultHTTPFields)
+	err := h.Send(context.Background(), "639171234567", "hello")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"to": "639171234567", "text": "hello"}, got)
+
+	h = NewHTTP(srv.Client(), srv.URL, "", DefaultHTTPFields)
+	err = h.Send(context.Background(), "639171234567", "hello")
+	require.ErrorContains(t, err, "401")
+
+	h = NewHTTP(srv.Client(), "", "", DefaultHTTPFields)
+	err = h.Send(context.Background(), "639171234567", "hello")
+	require.Error(t, err)
+}
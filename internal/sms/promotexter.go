@@ -0,0 +1,118 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	PromoTexterKey = "PROMOTEXTER"
+
+	promoTexterSendURL = "https://api.promotexter.com/sms/send"
+)
+
+// PromoTexter is the PromoTexter gateway. Its webhook posts one message per
+// request.
+type PromoTexter struct {
+	client    Doer
+	sendURL   string
+	apiKey    string
+	apiSecret string
+	senderID  string
+}
+
+// NewPromoTexter creates a new PromoTexter gateway. A default client is used
+// if client is nil.
+func NewPromoTexter(client Doer, apiKey, apiSecret, senderID string) *PromoTexter {
+	return &PromoTexter{
+		client:    defaultClient(client),
+		sendURL:   promoTexterSendURL,
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		senderID:  senderID,
+	}
+}
+
+type promoTexterInbound struct {
+	Number string `json:"number"`
+	Msg    string `json:"msg"`
+}
+
+// ParseInbound implements InboundAdapter.
+func (p *PromoTexter) ParseInbound(r *http.Request) ([]Message, error) {
+	var req promoTexterInbound
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if len(req.Number) == 0 || len(req.Msg) == 0 {
+		return nil, fmt.Errorf("%w: number and msg are required", ErrInvalidPayload)
+	}
+
+	return []Message{{
+		From: trimAddress(req.Number),
+		Text: req.Msg,
+	}}, nil
+}
+
+type promoTexterOutbound struct {
+	ApiKey    string `json:"apiKey"`
+	ApiSecret string `json:"apiSecret"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Text      string `json:"text"`
+}
+
+// Send implements OutboundSender.
+func (p *PromoTexter) Send(ctx context.Context, to, text string) error {
+	body, err := json.Marshal(promoTexterOutbound{
+		ApiKey:    p.apiKey,
+		ApiSecret: p.apiSecret,
+		From:      p.senderID,
+		To:        to,
+		Text:      text,
+	})
+	if err != nil {
+		return err
+	}
+
+	return postJSON(ctx, p.client, p.sendURL, nil, body)
+}
+
+// postJSON posts body to url and fails on a non-2xx response.
+func postJSON(ctx context.Context, client Doer, url string, header http.Header, body []byte) error {
+	return postJSONDecode(ctx, client, url, header, body, nil)
+}
+
+// postJSONDecode is postJSON that also decodes the response into out, unless
+// out is nil.
+func postJSONDecode(ctx context.Context, client Doer, url string, header http.Header, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return &SendError{StatusCode: resp.StatusCode, Body: string(bytes.TrimSpace(msg))}
+	}
+	if out != nil {
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+	io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoTexterParseInbound(t *testing.T) {
+	p := NewPromoTexter(nil, "", "", "")
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"number":"639171234567","msg":"hello"}`))
+	msgs, err := p.ParseInbound(req)
+	require.NoError(t, err)
+	require.Equal(t, []Message{{From: "639171234567", Text: "hello"}}, msgs)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"number":"639171234567"}`))
+	_, err = p.ParseInbound(req)
+	require.ErrorIs(t, err, ErrInvalidPayload)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`not json`))
+	_, err = p.ParseInbound(req)
+	require.ErrorIs(t, err, ErrInvalidPayload)
+}
+
+func TestPromoTexterSend(t *testing.T) {
+	var got promoTexterOutbound
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	p := NewPromoTexter(srv.Client(), "key", "secret", "PANAHON")
+	p.sendURL = srv.URL
+
+	err := p.Send(context.Background(), "639171234567", "hello")
+	require.NoError(t, err)
+	require.Equal(t, promoTexterOutbound{
+		ApiKey:    "key",
+		ApiSecret: "secret",
+		From:      "PANAHON",
+		To:        "639171234567",
+		Text:      "hello",
+	}, got)
+
+	status = http.StatusBadRequest
+	err = p.Send(context.Background(), "639171234567", "hello")
+	require.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package sms
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry holds the configured SMS gateways and routes outbound messages to
+// one of them by mobile number prefix.
+type Registry struct {
+	mu            sync.RWMutex
+	inbound       map[string]InboundAdapter
+	outbound      map[string]OutboundSender
+	routes        map[string]string
+	defaultSender string
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		inbound:  make(map[string]InboundAdapter),
+		outbound: make(map[string]OutboundSender),
+		routes:   make(map[string]string),
+	}
+}
+
+func normalizeKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
+
+// RegisterInbound makes an inbound adapter available under key. It panics if
+// key is empty, adapter is nil or key is already registered.
+func (r *Registry) RegisterInbound(key string, adapter InboundAdapter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key = normalizeKey(key)
+	if len(key) == 0 {
+		panic("sms: RegisterInbound key is empty")
+	}
+	if adapter == nil {
+		panic("sms: RegisterInbound adapter is nil")
+	}
+	if _, dup := r.inbound[key]; dup {
+		panic(fmt.Sprintf("sms: RegisterInbound called twice for adapter %s", key))
+	}
+	r.inbound[key] = adapter
+}
+
+// RegisterOutbound makes an outbound sender available under key. It panics if
+// key is empty, sender is nil or key is already registered.
+func (r *Registry) RegisterOutbound(key string, sender OutboundSender) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key = normalizeKey(key)
+	if len(key) == 0 {
+		panic("sms: RegisterOutbound key is empty")
+	}
+	if sender == nil {
+		panic("sms: RegisterOutbound sender is nil")
+	}
+	if _, dup := r.outbound[key]; dup {
+		panic(fmt.Sprintf("sms: RegisterOutbound called twice for sender %s", key))
+	}
+	r.outbound[key] = sender
+}
+
+// Inbound returns the inbound adapter registered under key. Keys are case-insensitive.
+func (r *Registry) Inbound(key string) (InboundAdapter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, ok := r.inbound[normalizeKey(key)]
+	return a, ok
+}
+
+// Outbound returns the outbound sender registered under key. Keys are case-insensitive.
+func (r *Registry) Outbound(key string) (OutboundSender, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.outbound[normalizeKey(key)]
+	return s, ok
+}
+
+// InboundKeys returns the sorted keys of the registered inbound adapters.
+func (r *Registry) InboundKeys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedKeys(r.inbound)
+}
+
+// OutboundKeys returns the sorted keys of the registered outbound senders.
+func (r *Registry) OutboundKeys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedKeys(r.outbound)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SetRoutes parses routes of the form "prefix=key,prefix=key", e.g.
+// "63917=GLABS,63918=PROMOTEXTER", and uses them to pick the sender of a
+// number. defaultKey is used for numbers matching no prefix and may be empty.
+func (r *Registry) SetRoutes(routes, defaultKey string) error {
+	parsed := make(map[string]string)
+	for _, route := range strings.Split(routes, ",") {
+		route = strings.TrimSpace(route)
+		if len(route) == 0 {
+			continue
+		}
+		prefix, key, ok := strings.Cut(route, "=")
+		prefix, key = strings.TrimSpace(prefix), normalizeKey(key)
+		if !ok || len(prefix) == 0 || len(key) == 0 {
+			return fmt.Errorf("sms: invalid route %q", route)
+		}
+		parsed[prefix] = key
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.routes = parsed
+	r.defaultSender = normalizeKey(defaultKey)
+	return nil
+}
+
+// Route returns the sender for the mobile number to. The route with the
+// longest matching prefix wins; the default sender is used otherwise.
+func (r *Registry) Route(to string) (OutboundSender, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key := r.defaultSender
+	matched := 0
+	for prefix, k := range r.routes {
+		if len(prefix) > matched && strings.HasPrefix(to, prefix) {
+			key, matched = k, len(prefix)
+		}
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoSender, to)
+	}
+
+	s, ok := r.outbound[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown sender %s for %s", ErrNoSender, key, to)
+	}
+	return s, nil
+}
@@ -0,0 +1,77 @@
+package sms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	reg := NewRegistry()
+	fake := NewFake()
+	reg.RegisterInbound(FakeKey, fake)
+	reg.RegisterOutbound(FakeKey, fake)
+
+	require.Equal(t, []string{FakeKey}, reg.InboundKeys())
+	require.Equal(t, []string{FakeKey}, reg.OutboundKeys())
+
+	a, ok := reg.Inbound("fake")
+	require.True(t, ok)
+	require.Same(t, fake, a)
+
+	s, ok := reg.Outbound(" Fake ")
+	require.True(t, ok)
+	require.Same(t, fake, s)
+
+	_, ok = reg.Inbound("unknown")
+	require.False(t, ok)
+
+	require.Panics(t, func() { reg.RegisterInbound(FakeKey, fake) })
+	require.Panics(t, func() { reg.RegisterInbound(" ", fake) })
+	require.Panics(t, func() { reg.RegisterInbound("NIL", nil) })
+	require.Panics(t, func() { reg.RegisterOutbound(FakeKey, fake) })
+	require.Panics(t, func() { reg.RegisterOutbound(" ", fake) })
+	require.Panics(t, func() { reg.RegisterOutbound("NIL", nil) })
+}
+
+func TestRegistryRoute(t *testing.T) {
+	globe, smart, other := NewFake(), NewFake(), NewFake()
+
+	reg := NewRegistry()
+	reg.RegisterOutbound("GLOBE", globe)
+	reg.RegisterOutbound("SMART", smart)
+	reg.RegisterOutbound("OTHER", other)
+
+	_, err := reg.Route("639171234567")
+	require.ErrorIs(t, err, ErrNoSender)
+
+	require.NoError(t, reg.SetRoutes("63917=globe, 639171=smart,63918=SMART", "other"))
+
+	testCases := []struct {
+		number string
+		sender *Fake
+	}{
+		{"639181234567", smart},
+		{"639171234567", smart},
+		{"639172234567", globe},
+		{"639991234567", other},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.number, func(t *testing.T) {
+			s, err := reg.Route(tc.number)
+			require.NoError(t, err)
+			require.NoError(t, s.Send(context.Background(), tc.number, "hello"))
+			require.Contains(t, tc.sender.Sent(), Message{To: tc.number, Text: "hello"})
+		})
+	}
+
+	require.NoError(t, reg.SetRoutes("63917=UNKNOWN", ""))
+	_, err = reg.Route("639171234567")
+	require.ErrorIs(t, err, ErrNoSender)
+	_, err = reg.Route("639991234567")
+	require.ErrorIs(t, err, ErrNoSender)
+
+	require.Error(t, reg.SetRoutes("63917", ""))
+	require.Error(t, reg.SetRoutes("=GLOBE", ""))
+}
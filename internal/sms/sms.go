@@ -0,0 +1,92 @@
+// Package sms abstracts the SMS gateways used to receive messages from, and
+// send messages to, stations and their caretakers.
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidPayload is returned by an InboundAdapter for a request it cannot decode.
+	ErrInvalidPayload = errors.New("invalid sms payload")
+	// ErrNoSender is returned when no OutboundSender is configured for a number.
+	ErrNoSender = errors.New("no sms sender configured")
+	// ErrUnsubscribed is returned by an OutboundSender when the recipient
+	// revoked the app's permission to message it.
+	ErrUnsubscribed = errors.New("recipient has unsubscribed")
+)
+
+// SendError is returned by an OutboundSender for a request the gateway rejected.
+type SendError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *SendError) Error() string {
+	return fmt.Sprintf("sms gateway responded with %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Body)
+}
+
+// IsTransient reports whether a failed send may succeed if retried: the
+// gateway was unreachable, overloaded or failed on its side.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, ErrUnsubscribed) || errors.Is(err, ErrNoSender) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var sendErr *SendError
+	if errors.As(err, &sendErr) {
+		return sendErr.StatusCode == http.StatusTooManyRequests || sendErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+const defaultTimeout = 10 * time.Second
+
+// Message is an SMS received through a gateway.
+type Message struct {
+	ID   string    `json:"id"`
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	Text string    `json:"text"`
+	Time time.Time `json:"time"`
+}
+
+// InboundAdapter decodes the webhook requests of an SMS gateway.
+type InboundAdapter interface {
+	// ParseInbound returns the messages carried by the request. A gateway may
+	// batch several messages in one request.
+	ParseInbound(r *http.Request) ([]Message, error)
+}
+
+// OutboundSender sends SMS through a gateway.
+type OutboundSender interface {
+	// Send delivers text to the mobile number to.
+	Send(ctx context.Context, to, text string) error
+}
+
+// Doer sends HTTP requests, e.g. *http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+func defaultClient(client Doer) Doer {
+	if client == nil {
+		return &http.Client{Timeout: defaultTimeout}
+	}
+	return client
+}
+
+// trimAddress strips the URI scheme some gateways prepend to mobile numbers,
+// e.g. tel:+639171234567.
+func trimAddress(s string) string {
+	return strings.TrimPrefix(strings.TrimSpace(s), "tel:")
+}
@@ -0,0 +1,36 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransient(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{"Nil", nil, false},
+		{"ServerError", &SendError{StatusCode: http.StatusBadGateway}, true},
+		{"TooManyRequests", fmt.Errorf("send: %w", &SendError{StatusCode: http.StatusTooManyRequests}), true},
+		{"BadRequest", &SendError{StatusCode: http.StatusBadRequest}, false},
+		{"Network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"Unsubscribed", fmt.Errorf("glabs: %w", ErrUnsubscribed), false},
+		{"NoSender", ErrNoSender, false},
+		{"Canceled", context.Canceled, false},
+		{"Other", errors.New("invalid number"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.transient, IsTransient(tc.err))
+		})
+	}
+}
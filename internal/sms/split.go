@@ -0,0 +1,58 @@
+package sms
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLength is the number of characters that fit in a single SMS.
+const MaxLength = 160
+
+// Split breaks text into messages of at most MaxLength characters. When more
+// than one message is needed, each one is numbered, e.g. "1/3 ", so that the
+// parts can be read in order even if they arrive out of order. Words are
+// only cut when longer than a whole message.
+func Split(text string) []string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= MaxLength {
+		return []string{text}
+	}
+
+	// The prefix width depends on the number of parts; grow it until the
+	// split is stable.
+	for width := 1; ; width++ {
+		limit := MaxLength - (2*width + 2)
+		chunks := splitWords(text, limit)
+		if len(fmt.Sprint(len(chunks))) <= width {
+			parts := make([]string, len(chunks))
+			for i, c := range chunks {
+				parts[i] = fmt.Sprintf("%d/%d %s", i+1, len(chunks), c)
+			}
+			return parts
+		}
+	}
+}
+
+// splitWords breaks text into chunks of at most limit characters on spaces.
+func splitWords(text string, limit int) []string {
+	var chunks []string
+	for len(text) > 0 {
+		runes := []rune(text)
+		if len(runes) <= limit {
+			chunks = append(chunks, text)
+			break
+		}
+
+		cut := limit
+		for i := limit; i > 0; i-- {
+			if runes[i] == ' ' {
+				cut = i
+				break
+			}
+		}
+		chunks = append(chunks, strings.TrimSpace(string(runes[:cut])))
+		text = strings.TrimSpace(string(runes[cut:]))
+	}
+	return chunks
+}
@@ -0,0 +1,48 @@
+package sms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	word := "abcdefghi "
+
+	testCases := []struct {
+		name  string
+		text  string
+		parts int
+	}{
+		{"Empty", "", 1},
+		{"Short", "Manila: 28.5C, RH 80%", 1},
+		{"Max", strings.Repeat("a", MaxLength), 1},
+		{"Two", strings.Repeat(word, 20), 2},
+		{"Ten", strings.Repeat(word, 150), 10},
+		{"LongWord", strings.Repeat("a", 2*MaxLength), 3},
+		{"Unicode", strings.Repeat("Dasmariñas ", 14), 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parts := Split(tc.text)
+			require.Len(t, parts, tc.parts)
+
+			var words []string
+			for i, p := range parts {
+				require.LessOrEqual(t, utf8.RuneCountInString(p), MaxLength)
+				if len(parts) > 1 {
+					prefix := fmt.Sprintf("%d/%d ", i+1, len(parts))
+					require.True(t, strings.HasPrefix(p, prefix), p)
+					p = strings.TrimPrefix(p, prefix)
+				}
+				words = append(words, p)
+			}
+			if tc.name != "LongWord" {
+				require.Equal(t, strings.TrimSpace(tc.text), strings.Join(words, " "))
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ type Config struct {
 	DBSource             string        `mapstructure:"DB_SOURCE"`
 	MigrationPath        string        `mapstructure:"MIGRATION_PATH"`
 	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	TrustedProxies       string        `mapstructure:"TRUSTED_PROXIES"`
 	CookieDomain         string        `mapstructure:"COOKIE_DOMAIN"`
 	CookiePath           string        `mapstructure:"COOKIE_PATH"`
 	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
@@ -24,6 +25,38 @@ type Config struct {
 	SwagAPIBasePath      string        `mapstructure:"SWAG_API_BASE_PATH"`
 	GlabsAppID           string        `mapstructure:"GLABS_APP_ID"`
 	GlabsAppSecret       string        `mapstructure:"GLABS_APP_SECRET"`
+	GlabsShortCode       string        `mapstructure:"GLABS_SHORT_CODE"`
+	GlabsAPIURL          string        `mapstructure:"GLABS_API_URL"`
+	GlabsWebhookSecret   string        `mapstructure:"GLABS_WEBHOOK_SECRET"`
+	GlabsWebhookIPs      string        `mapstructure:"GLABS_WEBHOOK_IPS"`
+	GlabsAllowUnsigned   bool          `mapstructure:"GLABS_WEBHOOK_UNSIGNED"`
+	GlabsRewardsToken    string        `mapstructure:"GLABS_REWARDS_TOKEN"`
+	GlabsLoadPromo       string        `mapstructure:"GLABS_LOAD_PROMO"`
+	GlabsLoadCost        int           `mapstructure:"GLABS_LOAD_COST"`
+	GlabsLoadBudget      int           `mapstructure:"GLABS_LOAD_BUDGET"`
+	GlabsLoadSilentAfter time.Duration `mapstructure:"GLABS_LOAD_SILENT_AFTER"`
+	PtexterApiKey        string        `mapstructure:"PTEXTER_API_KEY"`
+	PtexterApiSecret     string        `mapstructure:"PTEXTER_API_SECRET"`
+	PtexterSenderID      string        `mapstructure:"PTEXTER_SENDER_ID"`
+	PtexterWebhookSecret string        `mapstructure:"PTEXTER_WEBHOOK_SECRET"`
+	PtexterWebhookIPs    string        `mapstructure:"PTEXTER_WEBHOOK_IPS"`
+	PtexterAllowUnsigned bool          `mapstructure:"PTEXTER_WEBHOOK_UNSIGNED"`
+	SmsHTTPSendURL       string        `mapstructure:"SMS_HTTP_SEND_URL"`
+	SmsHTTPToken         string        `mapstructure:"SMS_HTTP_TOKEN"`
+	SmsHTTPWebhookSecret string        `mapstructure:"SMS_HTTP_WEBHOOK_SECRET"`
+	SmsHTTPWebhookIPs    string        `mapstructure:"SMS_HTTP_WEBHOOK_IPS"`
+	SmsHTTPAllowUnsigned bool          `mapstructure:"SMS_HTTP_WEBHOOK_UNSIGNED"`
+	WebhookMaxSkew       time.Duration `mapstructure:"WEBHOOK_MAX_SKEW"`
+	SmsRoutes            string        `mapstructure:"SMS_ROUTES"`
+	SmsDefaultSender     string        `mapstructure:"SMS_DEFAULT_SENDER"`
+	LufftLayoutsFile     string        `mapstructure:"LUFFT_LAYOUTS_FILE"`
+	MQTTBrokerURL        string        `mapstructure:"MQTT_BROKER_URL"`
+	MQTTClientID         string        `mapstructure:"MQTT_CLIENT_ID"`
+	MQTTUsername         string        `mapstructure:"MQTT_USERNAME"`
+	MQTTPassword         string        `mapstructure:"MQTT_PASSWORD"`
+	MQTTTopicPrefix      string        `mapstructure:"MQTT_TOPIC_PREFIX"`
+	SimLoadValidity      time.Duration `mapstructure:"SIM_LOAD_VALIDITY"`
+	SimPromoValidity     string        `mapstructure:"SIM_PROMO_VALIDITY"`
 	EnableConsoleLogging bool          `mapstructure:"ENABLE_CONSOLE_LOGGING"`
 	EnableFileLogging    bool          `mapstructure:"ENABLE_FILE_LOGGING"`
 	LogLevel             string        `mapstructure:"LOG_LEVEL"`
@@ -33,6 +66,7 @@ type Config struct {
 	LogMaxBackups        int           `mapstructure:"LOG_MAX_BACKUPS"`
 	LogMaxAge            int           `mapstructure:"LOG_MAX_AGE"`
 	CronJobs             string        `mapstructure:"CRON_JOBS"`
+	InstanceID           string        `mapstructure:"INSTANCE_ID"`
 	DockerTestPGRepo     string        `mapstructure:"DOCKERTEST_PG_REPO"`
 	DockerTestPGTag      string        `mapstructure:"DOCKERTEST_PG_TAG"`
 }